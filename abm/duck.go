@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -55,6 +56,8 @@ type DuckABM[K comparable, T any] struct {
 	tbl     string
 	getQry  string
 	listQry string
+
+	loadedAt atomic.Int64
 }
 
 func NewDuckABM[K comparable, T any](conf Conf) (*DuckABM[K, T], error) {
@@ -86,6 +89,24 @@ func (d *DuckABM[K, T]) Load(ctx context.Context) error {
 
 	d.tbl = nextTbl
 	d.getQry, d.listQry = qry(d.conf.GetSQL, d.conf.ListSQL, d.tbl)
+	d.loadedAt.Store(time.Now().UnixNano())
+	return nil
+}
+
+func (d *DuckABM[K, T]) LoadedAt() time.Time {
+	return time.Unix(0, d.loadedAt.Load())
+}
+
+// Check 超过两个周期没有加载成功, 认为数据已经过期
+func (d *DuckABM[K, T]) Check(_ context.Context) error {
+	at := d.loadedAt.Load()
+	if at == 0 {
+		return errors.New("ddb never loaded")
+	}
+	maxAge := 2 * time.Minute * time.Duration(d.conf.Period)
+	if age := time.Since(time.Unix(0, at)); age > maxAge {
+		return fmt.Errorf("ddb stale: last load %s ago", age.Truncate(time.Second))
+	}
 	return nil
 }
 
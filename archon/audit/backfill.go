@@ -0,0 +1,84 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+)
+
+const backfillBatch = 500
+
+// Backfill 给没有属性记录的在用设备补上当前值, 从设备的创建时间生效
+// 启用 Hook 之前建的设备没有属性, 按时间查询时会查不到
+// 已有记录的属性不动, 可以重复执行, 返回补录的条数
+func Backfill(ctx context.Context, cli *ent.Client) (int, error) {
+	n := 0
+	last := ""
+	for {
+		ds, err := cli.Device.Query().
+			Where(device.IDGT(last)).
+			Order(ent.Asc(device.FieldID)).
+			Limit(backfillBatch).
+			All(ctx)
+		if err != nil {
+			return n, err
+		}
+		if len(ds) == 0 {
+			return n, nil
+		}
+		last = ds[len(ds)-1].ID
+
+		c, err := backfill(ctx, cli, ds)
+		n += c
+		if err != nil {
+			return n, err
+		}
+	}
+}
+
+func backfill(ctx context.Context, cli *ent.Client, ds []*ent.Device) (int, error) {
+	ids := make([]string, len(ds))
+	for i, d := range ds {
+		ids[i] = d.ID
+	}
+	var has []struct {
+		DeviceID string `json:"device_id"`
+		Name     string `json:"name"`
+	}
+	err := cli.DeviceAttr.Query().
+		Where(deviceattr.DeviceIDIn(ids...)).
+		GroupBy(deviceattr.FieldDeviceID, deviceattr.FieldName).
+		Scan(ctx, &has)
+	if err != nil {
+		return 0, err
+	}
+	exists := make(map[[2]string]bool, len(has))
+	for _, h := range has {
+		exists[[2]string{h.DeviceID, h.Name}] = true
+	}
+
+	var bulk []*ent.DeviceAttrCreate
+	for _, d := range ds {
+		s := Snapshot(d)
+		for _, name := range Attrs {
+			if exists[[2]string{d.ID, name}] {
+				continue
+			}
+			bulk = append(bulk, cli.DeviceAttr.Create().
+				SetDeviceID(d.ID).
+				SetDeviceCode(d.DeviceCode).
+				SetName(name).
+				SetValue(attr(s, name)).
+				SetValidFrom(d.CreateTime))
+		}
+	}
+	if len(bulk) == 0 {
+		return 0, nil
+	}
+	if err := cli.DeviceAttr.CreateBulk(bulk...).Exec(ctx); err != nil {
+		return 0, err
+	}
+	return len(bulk), nil
+}
@@ -0,0 +1,75 @@
+package audit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+)
+
+func TestBackfill(t *testing.T) {
+	ctx := context.Background()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 启用 Hook 之前建的设备
+	created := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	d1 := cli.Device.Create().SetDeviceCode("D1").SetDeviceType("E").SetProject("P1").
+		SetRate(80).SetPosCode("P1-B1").SetCreateTime(created).SaveX(ctx)
+	d2 := cli.Device.Create().SetDeviceCode("D2").SetDeviceType("W").SetProject("P1").SaveX(ctx)
+	cli.Device.DeleteOne(d2).ExecX(ctx)
+
+	cli.Device.Use(Hook())
+	var d3 *ent.Device
+	err = orm.WithTx(ctx, cli, func(tx *ent.Tx) (err error) {
+		d3, err = tx.Device.Create().SetDeviceCode("D3").SetDeviceType("E").SetProject("P1").Save(ctx)
+		return
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := Backfill(ctx, cli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != len(Attrs) {
+		t.Fatalf("backfilled %d, want %d", n, len(Attrs))
+	}
+
+	attrs := cli.DeviceAttr.Query().Where(deviceattr.DeviceID(d1.ID)).AllX(ctx)
+	if len(attrs) != len(Attrs) {
+		t.Fatalf("D1 has %d attrs, want %d", len(attrs), len(Attrs))
+	}
+	for _, a := range attrs {
+		if !a.ValidFrom.Equal(created) || a.ValidTo != nil {
+			t.Errorf("D1 %s valid %v - %v, want %v -", a.Name, a.ValidFrom, a.ValidTo, created)
+		}
+		if a.Name == "rate" && a.Value != "80" {
+			t.Errorf("D1 rate = %q, want 80", a.Value)
+		}
+		if a.Name == "pos_code" && a.Value != "P1-B1" {
+			t.Errorf("D1 pos_code = %q, want P1-B1", a.Value)
+		}
+	}
+	if c := cli.DeviceAttr.Query().Where(deviceattr.DeviceID(d2.ID)).CountX(ctx); c != 0 {
+		t.Errorf("deleted D2 has %d attrs, want 0", c)
+	}
+	if c := cli.DeviceAttr.Query().Where(deviceattr.DeviceID(d3.ID)).CountX(ctx); c != len(Attrs) {
+		t.Errorf("D3 has %d attrs, want %d", c, len(Attrs))
+	}
+
+	// 重复执行不再补录
+	if n, err := Backfill(ctx, cli); err != nil || n != 0 {
+		t.Fatalf("second backfill = %d %v, want 0", n, err)
+	}
+}
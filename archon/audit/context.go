@@ -0,0 +1,97 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+// HeaderActor 没有登录信息时, 由可信的代理在请求头中说明操作人
+const HeaderActor = "X-H2o-Actor"
+
+type ctxKey int
+
+const (
+	actorKey ctxKey = iota
+	reasonKey
+	effectiveKey
+)
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey, actor)
+}
+
+func Actor(ctx context.Context) string {
+	s, _ := ctx.Value(actorKey).(string)
+	return s
+}
+
+func WithReason(ctx context.Context, reason string) context.Context {
+	return context.WithValue(ctx, reasonKey, reason)
+}
+
+func Reason(ctx context.Context) string {
+	s, _ := ctx.Value(reasonKey).(string)
+	return s
+}
+
+// WithEffective 变更的生效时间, 用于补录已经发生的变更, 不设置为当前时间
+func WithEffective(ctx context.Context, t time.Time) context.Context {
+	return context.WithValue(ctx, effectiveKey, t)
+}
+
+func Effective(ctx context.Context) (time.Time, bool) {
+	t, ok := ctx.Value(effectiveKey).(time.Time)
+	return t, ok
+}
+
+// ActorHeader 取操作人, 有登录信息时就是登录的用户
+// 请求头里的操作人谁都能伪造, 只采用来自 trusted 代理的请求头, trusted 为空时忽略请求头
+// 需要放在 auth.Middleware 之后
+func ActorHeader(trusted []netip.Prefix) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if c, ok := auth.FromContext(r.Context()); ok && c != auth.Anonymous {
+				r = r.WithContext(WithActor(r.Context(), c.Subject))
+			} else if actor := r.Header.Get(HeaderActor); actor != "" && fromTrusted(r, trusted) {
+				r = r.WithContext(WithActor(r.Context(), actor))
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func fromTrusted(r *http.Request, trusted []netip.Prefix) bool {
+	ap, err := netip.ParseAddrPort(r.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	addr := ap.Addr().Unmap()
+	for _, p := range trusted {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTrusted 可信代理的地址或网段, 如 10.0.0.0/8, 127.0.0.1
+func ParseTrusted(ss []string) ([]netip.Prefix, error) {
+	ps := make([]netip.Prefix, 0, len(ss))
+	for _, s := range ss {
+		if addr, err := netip.ParseAddr(s); err == nil {
+			ps = append(ps, netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("trusted proxy %q: %w", s, err)
+		}
+		ps = append(ps, p.Masked())
+	}
+	return ps, nil
+}
@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+func TestActorHeader(t *testing.T) {
+	trusted, err := ParseTrusted([]string{"10.0.0.0/8", "127.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		trusted bool
+		claims  *auth.Claims
+		remote  string
+		header  string
+		want    string
+	}{
+		{"登录用户", true, &auth.Claims{Subject: "alice"}, "10.1.2.3:5000", "mallory", "alice"},
+		{"可信代理", true, auth.Anonymous, "10.1.2.3:5000", "bob", "bob"},
+		{"可信代理单个地址", true, auth.Anonymous, "127.0.0.1:5000", "bob", "bob"},
+		{"IPv4 映射地址", true, auth.Anonymous, "[::ffff:10.1.2.3]:5000", "bob", "bob"},
+		{"不可信来源", true, auth.Anonymous, "192.168.1.1:5000", "mallory", ""},
+		{"没有配置可信代理", false, auth.Anonymous, "10.1.2.3:5000", "mallory", ""},
+		{"没有请求头", true, auth.Anonymous, "10.1.2.3:5000", "", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ps := trusted
+			if !tt.trusted {
+				ps = nil
+			}
+			var got string
+			h := ActorHeader(ps)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = Actor(r.Context())
+			}))
+			r := httptest.NewRequest(http.MethodPost, "/gql/query", nil)
+			r.RemoteAddr = tt.remote
+			if tt.header != "" {
+				r.Header.Set(HeaderActor, tt.header)
+			}
+			r = r.WithContext(auth.NewContext(r.Context(), tt.claims))
+			h.ServeHTTP(httptest.NewRecorder(), r)
+			if got != tt.want {
+				t.Fatalf("actor = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := ParseTrusted([]string{"proxy.local"}); err == nil {
+		t.Fatal("got nil error for host name")
+	}
+}
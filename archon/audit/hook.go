@@ -0,0 +1,290 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/hook"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+var (
+	ErrEffective = errors.New("invalid effective time")
+	ErrNotInTx   = errors.New("device mutation not in transaction")
+)
+
+// Attrs 按时间生效的属性, 计费需要按时间查询
+var Attrs = []string{
+	device.FieldRate,
+	device.FieldDeviceSn,
+	device.FieldPosCode,
+	device.FieldAreaCode,
+	device.FieldPcode,
+	device.FieldLocationID,
+	device.FieldParentID,
+}
+
+// Snapshot 记录到变更历史中的字段
+func Snapshot(d *ent.Device) map[string]any {
+	return map[string]any{
+		device.FieldDeviceCode: d.DeviceCode,
+		device.FieldDeviceType: d.DeviceType,
+		device.FieldDeviceSn:   d.DeviceSn,
+		device.FieldDeviceName: d.DeviceName,
+		device.FieldRate:       d.Rate,
+		device.FieldProject:    d.Project,
+		device.FieldPosCode:    d.PosCode,
+		device.FieldAreaCode:   d.AreaCode,
+		device.FieldPcode:      d.Pcode,
+		device.FieldParentID:   deref(d.ParentID),
+		device.FieldLocationID: deref(d.LocationID),
+		device.FieldBoxID:      deref(d.BoxID),
+		device.FieldConnKey:    d.ConnKey,
+		device.FieldModelName:  d.ModelName,
+		device.FieldStatus:     d.Status,
+		device.FieldMemo:       d.Memo,
+		device.FieldIsDel:      d.IsDel,
+	}
+}
+
+func attr(s map[string]any, name string) string {
+	switch v := s[name].(type) {
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	}
+	return ""
+}
+
+// Hook 记录设备变更的前后快照, 并维护按时间生效的属性
+// 设备变更必须在事务中, 历史和属性用 mutation 的 client 写入, 和变更一起提交或回滚
+// 软删除和恢复前后总有一边查不到, 前后快照都要包括软删除的
+func Hook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.DeviceFunc(func(ctx context.Context, m *ent.DeviceMutation) (ent.Value, error) {
+			if _, err := m.Tx(); err != nil {
+				return nil, ErrNotInTx
+			}
+			cli := m.Client()
+			all := schema.SkipSoftDelete(ctx)
+			now := time.Now()
+			at, backdated := Effective(ctx)
+			if !backdated {
+				at = now
+			}
+			if at.After(now) {
+				return nil, fmt.Errorf("%w: %s is in the future", ErrEffective, at.Format(time.DateTime))
+			}
+
+			var (
+				ids    []string
+				before []*ent.Device
+			)
+			if !m.Op().Is(ent.OpCreate) {
+				var err error
+				if ids, err = m.IDs(all); err != nil {
+					return nil, err
+				}
+				if before, err = cli.Device.Query().Where(device.IDIn(ids...)).All(all); err != nil {
+					return nil, err
+				}
+				if backdated {
+					if err := checkEffective(ctx, cli, ids, touched(m), at); err != nil {
+						return nil, err
+					}
+				}
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			var after []*ent.Device
+			switch {
+			case m.Op().Is(ent.OpCreate):
+				d, ok := v.(*ent.Device)
+				if !ok {
+					return v, fmt.Errorf("audit: unexpected create value %T", v)
+				}
+				after = []*ent.Device{d}
+			case m.Op().Is(ent.OpUpdate | ent.OpUpdateOne):
+				if after, err = cli.Device.Query().Where(device.IDIn(ids...)).All(all); err != nil {
+					return v, err
+				}
+			}
+
+			r := recorder{cli: cli, actor: Actor(ctx), reason: Reason(ctx), at: at}
+			olds := make(map[string]*ent.Device, len(before))
+			for _, d := range before {
+				olds[d.ID] = d
+			}
+			for _, d := range after {
+				if err := r.record(ctx, olds[d.ID], d); err != nil {
+					return v, err
+				}
+				delete(olds, d.ID)
+			}
+			// 剩下的是被物理删除的
+			for _, d := range olds {
+				if err := r.record(ctx, d, nil); err != nil {
+					return v, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// touched 本次变更涉及的按时间生效的属性
+func touched(m *ent.DeviceMutation) []string {
+	var names []string
+	for _, f := range slices.Concat(m.Fields(), m.ClearedFields()) {
+		if slices.Contains(Attrs, f) && !slices.Contains(names, f) {
+			names = append(names, f)
+		}
+	}
+	return names
+}
+
+// checkEffective 补录的生效时间不能早于当前值的生效时间, 否则会和已有的有效期重叠
+func checkEffective(ctx context.Context, cli *ent.Client, ids, names []string, at time.Time) error {
+	if len(names) == 0 {
+		return nil
+	}
+	latest, err := cli.DeviceAttr.Query().
+		Where(
+			deviceattr.DeviceIDIn(ids...),
+			deviceattr.NameIn(names...),
+			deviceattr.ValidToIsNil(),
+			deviceattr.ValidFromGT(at),
+		).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return fmt.Errorf("%w: %s %s is effective since %s", ErrEffective,
+		latest.DeviceCode, latest.Name, latest.ValidFrom.Format(time.DateTime))
+}
+
+type recorder struct {
+	cli    *ent.Client
+	actor  string
+	reason string
+	at     time.Time
+}
+
+func (r recorder) record(ctx context.Context, old, cur *ent.Device) error {
+	var (
+		op     devicehistory.Op
+		before map[string]any
+		after  map[string]any
+		d      = cur
+	)
+	if old != nil {
+		before = Snapshot(old)
+	}
+	if cur != nil {
+		after = Snapshot(cur)
+	} else {
+		d = old
+	}
+
+	switch {
+	case old == nil:
+		op = devicehistory.OpCreate
+	case cur == nil:
+		op = devicehistory.OpDelete
+	case old.IsDel == 0 && cur.IsDel != 0:
+		op = devicehistory.OpRemove
+	case old.IsDel != 0 && cur.IsDel == 0:
+		op = devicehistory.OpRestore
+	default:
+		op = devicehistory.OpUpdate
+	}
+
+	fields := changed(before, after)
+	if op == devicehistory.OpUpdate && len(fields) == 0 {
+		return nil
+	}
+
+	h, err := r.cli.DeviceHistory.Create().
+		SetDeviceID(d.ID).
+		SetDeviceCode(d.DeviceCode).
+		SetOp(op).
+		SetFields(fields).
+		SetBefore(before).
+		SetAfter(after).
+		SetActor(r.actor).
+		SetReason(r.reason).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range Attrs {
+		if op != devicehistory.OpDelete && !slices.Contains(fields, name) {
+			continue
+		}
+		if err := r.attr(ctx, d, h.ID, name, after); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attr 关闭当前值, 设备还在时打开新值
+func (r recorder) attr(ctx context.Context, d *ent.Device, historyID, name string, after map[string]any) error {
+	err := r.cli.DeviceAttr.Update().
+		Where(
+			deviceattr.DeviceID(d.ID),
+			deviceattr.Name(name),
+			deviceattr.ValidToIsNil(),
+		).
+		SetValidTo(r.at).
+		Exec(ctx)
+	if err != nil || after == nil {
+		return err
+	}
+
+	return r.cli.DeviceAttr.Create().
+		SetDeviceID(d.ID).
+		SetDeviceCode(d.DeviceCode).
+		SetName(name).
+		SetValue(attr(after, name)).
+		SetValidFrom(r.at).
+		SetHistoryID(historyID).
+		Exec(ctx)
+}
+
+// changed 新建时是全部字段, 删除时为空
+func changed(before, after map[string]any) []string {
+	var fields []string
+	for _, k := range slices.Sorted(maps.Keys(after)) {
+		if before == nil || before[k] != after[k] {
+			fields = append(fields, k)
+		}
+	}
+	return fields
+}
+
+func deref[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
@@ -0,0 +1,101 @@
+package bulk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// 导入导出的列, 导出按这个顺序, 导入按表头匹配, 顺序无关
+const (
+	ColDeviceCode = "device_code"
+	ColDeviceType = "device_type"
+	ColDeviceSn   = "device_sn"
+	ColDeviceName = "device_name"
+	ColRate       = "rate"
+	ColProject    = "project"
+	ColPosCode    = "pos_code"
+	ColAreaCode   = "area_code"
+	ColPcode      = "pcode"
+	ColStatus     = "status"
+	ColMemo       = "memo"
+)
+
+var Columns = []string{
+	ColDeviceCode,
+	ColDeviceType,
+	ColDeviceSn,
+	ColDeviceName,
+	ColRate,
+	ColProject,
+	ColPosCode,
+	ColAreaCode,
+	ColPcode,
+	ColStatus,
+	ColMemo,
+}
+
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+var ErrFormat = errors.New("unsupported format")
+
+// FormatOf 按文件名后缀或者直接的格式名
+func FormatOf(name string) (string, error) {
+	name = strings.ToLower(name)
+	switch {
+	case name == FormatCSV || strings.HasSuffix(name, ".csv"):
+		return FormatCSV, nil
+	case name == FormatXLSX || strings.HasSuffix(name, ".xlsx"):
+		return FormatXLSX, nil
+	}
+	return "", fmt.Errorf("%w: %s", ErrFormat, name)
+}
+
+func ContentType(format string) string {
+	if format == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Row 文件中的一行, Line 为表格中的行号, 表头是第1行
+type Row struct {
+	Line   int
+	Values map[string]string
+}
+
+func (r Row) Get(col string) string {
+	return strings.TrimSpace(r.Values[col])
+}
+
+type RowError struct {
+	Line    int    `json:"line"`
+	Code    string `json:"code,omitempty"`
+	Column  string `json:"column,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d, %s: %s", e.Line, e.Column, e.Message)
+}
+
+type Report struct {
+	Total   int        `json:"total"`
+	Created int        `json:"created"`
+	DryRun  bool       `json:"dry_run"`
+	Errors  []RowError `json:"errors"`
+}
+
+func (r *Report) OK() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *Report) fail(line int, code, col, format string, a ...any) {
+	r.Errors = append(r.Errors, RowError{Line: line, Code: code, Column: col, Message: fmt.Sprintf(format, a...)})
+}
@@ -0,0 +1,29 @@
+package bulk
+
+import (
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+)
+
+// Filter 设备列表的过滤条件, 查询和导出共用
+type Filter struct {
+	Type    string
+	PosCode string
+	Project string
+}
+
+func (f Filter) Query(cli *ent.Client) *ent.DeviceQuery {
+	q := cli.Device.Query()
+	q.Order(ent.Desc(device.FieldPosCode))
+
+	if f.Type != "" {
+		q.Where(device.DeviceTypeEQ(f.Type))
+	}
+	if f.PosCode != "" {
+		q.Where(device.PosCodeEQ(f.PosCode))
+	}
+	if f.Project != "" {
+		q.Where(device.ProjectEQ(f.Project))
+	}
+	return q
+}
@@ -0,0 +1,173 @@
+package bulk
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/xuri/excelize/v2"
+)
+
+const sheet = "device"
+
+// Read 读取文件, 第一行为表头
+func Read(r io.Reader, format string) ([]Row, error) {
+	var (
+		records [][]string
+		err     error
+	)
+	switch format {
+	case FormatCSV:
+		records, err = readCSV(r)
+	case FormatXLSX:
+		records, err = readXLSX(r)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrFormat, format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, errors.New("empty file")
+	}
+
+	header := make([]string, len(records[0]))
+	for i, h := range records[0] {
+		h = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")))
+		if h == "" {
+			continue
+		}
+		if !slices.Contains(Columns, h) {
+			return nil, fmt.Errorf("unknown column %q", h)
+		}
+		if slices.Contains(header, h) {
+			return nil, fmt.Errorf("duplicate column %q", h)
+		}
+		header[i] = h
+	}
+	if !slices.Contains(header, ColDeviceCode) {
+		return nil, fmt.Errorf("missing column %q", ColDeviceCode)
+	}
+
+	rows := make([]Row, 0, len(records)-1)
+	for i, rec := range records[1:] {
+		if blank(rec) {
+			continue
+		}
+		row := Row{Line: i + 2, Values: make(map[string]string, len(header))}
+		for j, v := range rec {
+			if j < len(header) && header[j] != "" {
+				row.Values[header[j]] = v
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func readCSV(r io.Reader) ([][]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	return cr.ReadAll()
+}
+
+func readXLSX(r io.Reader) ([][]string, error) {
+	f, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	name := f.GetSheetName(0)
+	if idx, _ := f.GetSheetIndex(sheet); idx >= 0 {
+		name = sheet
+	}
+	return f.GetRows(name)
+}
+
+func blank(rec []string) bool {
+	for _, v := range rec {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Write 按 Columns 的顺序写出设备, 格式和导入一致
+func Write(w io.Writer, format string, ds []*ent.Device) error {
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, ds)
+	case FormatXLSX:
+		return writeXLSX(w, ds)
+	}
+	return fmt.Errorf("%w: %s", ErrFormat, format)
+}
+
+func writeCSV(w io.Writer, ds []*ent.Device) error {
+	cw := csv.NewWriter(w)
+	_ = cw.Write(Columns)
+	for _, d := range ds {
+		_ = cw.Write(record(d))
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeXLSX(w io.Writer, ds []*ent.Device) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	if err := f.SetSheetName(f.GetSheetName(0), sheet); err != nil {
+		return err
+	}
+	sw, err := f.NewStreamWriter(sheet)
+	if err != nil {
+		return err
+	}
+
+	if err := sw.SetRow("A1", cells(Columns)); err != nil {
+		return err
+	}
+	for i, d := range ds {
+		cell, _ := excelize.CoordinatesToCellName(1, i+2)
+		if err := sw.SetRow(cell, cells(record(d))); err != nil {
+			return err
+		}
+	}
+	if err := sw.Flush(); err != nil {
+		return err
+	}
+	return f.Write(w)
+}
+
+// cells 都按文本写入, 导入时也按文本读取, 设备号的前导0不会丢
+func cells(rec []string) []any {
+	cs := make([]any, len(rec))
+	for i, v := range rec {
+		cs[i] = v
+	}
+	return cs
+}
+
+func record(d *ent.Device) []string {
+	return []string{
+		d.DeviceCode,
+		d.DeviceType,
+		d.DeviceSn,
+		d.DeviceName,
+		strconv.Itoa(d.Rate),
+		d.Project,
+		d.PosCode,
+		d.AreaCode,
+		d.Pcode,
+		strconv.Itoa(d.Status),
+		d.Memo,
+	}
+}
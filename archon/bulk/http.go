@@ -0,0 +1,34 @@
+package bulk
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+)
+
+// ExportHandler GET ?format=csv|xlsx&type=&posCode=&project=
+func ExportHandler(cli *ent.Client) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		format, err := FormatOf(cmp.Or(q.Get("format"), FormatCSV))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		f := Filter{Type: q.Get("type"), PosCode: q.Get("posCode"), Project: q.Get("project")}
+		ds, err := f.Query(cli).All(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		name := fmt.Sprintf("device-%s.%s", time.Now().Format("20060102150405"), format)
+		w.Header().Set("Content-Type", ContentType(format))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name))
+		_ = Write(w, format, ds)
+	})
+}
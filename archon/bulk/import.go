@@ -0,0 +1,296 @@
+package bulk
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+)
+
+// 每批写入和查询的条数, 避免超过数据库的参数个数限制
+const batch = 200
+
+type Options struct {
+	// Project 不为空时, 行内 project 为空则用它填充, 不一致则报错
+	Project string
+	// DryRun 校验并在事务中试写, 最后回滚
+	DryRun bool
+}
+
+type item struct {
+	line int
+	d    ent.Device
+}
+
+func (it item) create(tx *ent.Tx) *ent.DeviceCreate {
+	d := it.d
+	cr := tx.Device.Create().
+		SetDeviceCode(d.DeviceCode).
+		SetDeviceType(d.DeviceType).
+		SetProject(d.Project).
+		SetRate(d.Rate).
+		SetStatus(d.Status)
+	if d.DeviceSn != "" {
+		cr.SetDeviceSn(d.DeviceSn)
+	}
+	if d.DeviceName != "" {
+		cr.SetDeviceName(d.DeviceName)
+	}
+	if d.PosCode != "" {
+		cr.SetPosCode(d.PosCode)
+	}
+	if d.AreaCode != "" {
+		cr.SetAreaCode(d.AreaCode)
+	}
+	if d.Pcode != "" {
+		cr.SetPcode(d.Pcode)
+	}
+	if d.Memo != "" {
+		cr.SetMemo(d.Memo)
+	}
+	return cr
+}
+
+// Import 全部校验通过才写入, 所有行在一个事务中, 要么全部成功要么全部失败
+// 返回的 error 只表示无法完成导入, 数据问题都在 Report 中
+func Import(ctx context.Context, cli *ent.Client, rows []Row, opt Options) (*Report, error) {
+	rep := &Report{Total: len(rows), DryRun: opt.DryRun, Errors: []RowError{}}
+
+	items := check(rows, opt, rep)
+	if err := checkDB(ctx, cli, rows, opt, rep); err != nil {
+		return nil, err
+	}
+	if !rep.OK() {
+		slices.SortStableFunc(rep.Errors, func(a, b RowError) int { return cmp.Compare(a.Line, b.Line) })
+		return rep, nil
+	}
+
+	tx, err := cli.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for chunk := range slices.Chunk(items, batch) {
+		crs := make([]*ent.DeviceCreate, len(chunk))
+		for i, it := range chunk {
+			crs[i] = it.create(tx)
+		}
+		if _, err := tx.Device.CreateBulk(crs...).Save(ctx); err != nil {
+			_ = tx.Rollback()
+			if !ent.IsConstraintError(err) && !ent.IsValidationError(err) {
+				return nil, err
+			}
+			rep.Created = 0
+			if perr := probe(ctx, cli, items, rep); perr != nil {
+				return nil, perr
+			}
+			// 逐行都能写入, 冲突来自文件内的行之间, 只能报到这一批
+			if rep.OK() {
+				rep.fail(chunk[0].line, "", "", "%v", err)
+			}
+			slices.SortStableFunc(rep.Errors, func(a, b RowError) int { return cmp.Compare(a.Line, b.Line) })
+			return rep, nil
+		}
+		rep.Created += len(chunk)
+	}
+
+	if opt.DryRun {
+		return rep, tx.Rollback()
+	}
+	return rep, tx.Commit()
+}
+
+// probe 批量写入报不出是哪一行, 逐行在单独的事务中试写再回滚, 找出所有写不进去的行
+func probe(ctx context.Context, cli *ent.Client, items []item, rep *Report) error {
+	for _, it := range items {
+		tx, err := cli.Tx(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = it.create(tx).Save(ctx)
+		_ = tx.Rollback()
+		switch {
+		case err == nil:
+		case ent.IsConstraintError(err) || ent.IsValidationError(err):
+			rep.fail(it.line, it.d.DeviceCode, "", "%v", err)
+		default:
+			return err
+		}
+	}
+	return nil
+}
+
+// check 校验行内的数据, 以及文件内的重复
+func check(rows []Row, opt Options, rep *Report) []item {
+	codes := make(map[string]int, len(rows))
+	poses := make(map[posKey]int)
+	items := make([]item, 0, len(rows))
+
+	for _, row := range rows {
+		ok := true
+		fail := func(col, format string, a ...any) {
+			rep.fail(row.Line, row.Get(ColDeviceCode), col, format, a...)
+			ok = false
+		}
+
+		text := func(col string, size int, required bool) string {
+			v := row.Get(col)
+			if required && v == "" {
+				fail(col, "required")
+			}
+			if utf8.RuneCountInString(v) > size {
+				fail(col, "longer than %d", size)
+			}
+			return v
+		}
+		num := func(col string, def, least int) int {
+			v := row.Get(col)
+			if v == "" {
+				return def
+			}
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				fail(col, "not an integer: %q", v)
+				return def
+			}
+			if n < least {
+				fail(col, "less than %d", least)
+			}
+			return n
+		}
+
+		code := text(ColDeviceCode, 64, true)
+		typ := text(ColDeviceType, 64, true)
+		sn := text(ColDeviceSn, 64, false)
+		name := text(ColDeviceName, 64, false)
+		posCode := text(ColPosCode, 64, false)
+		areaCode := text(ColAreaCode, 64, false)
+		pcode := text(ColPcode, 64, false)
+		memo := text(ColMemo, 128, false)
+		rate := num(ColRate, 1, 1)
+		status := num(ColStatus, 0, 0)
+
+		project := row.Get(ColProject)
+		if project == "" {
+			project = opt.Project
+		}
+		switch {
+		case project == "":
+			fail(ColProject, "required")
+		case opt.Project != "" && project != opt.Project:
+			fail(ColProject, "%q does not match %q", project, opt.Project)
+		case utf8.RuneCountInString(project) > 64:
+			fail(ColProject, "longer than 64")
+		}
+
+		if code != "" {
+			if line, dup := codes[code]; dup {
+				fail(ColDeviceCode, "duplicate of line %d", line)
+			} else {
+				codes[code] = row.Line
+			}
+		}
+
+		if posCode != "" && typ != "" && project != "" {
+			k := posKey{project: project, posCode: posCode, typ: typ}
+			if line, dup := poses[k]; dup {
+				fail(ColPosCode, "%s already has a %s meter at line %d", posCode, typ, line)
+			} else {
+				poses[k] = row.Line
+			}
+		}
+
+		if !ok {
+			continue
+		}
+
+		items = append(items, item{line: row.Line, d: ent.Device{
+			DeviceCode: code,
+			DeviceType: typ,
+			DeviceSn:   sn,
+			DeviceName: name,
+			Rate:       rate,
+			Project:    project,
+			PosCode:    posCode,
+			AreaCode:   areaCode,
+			Pcode:      pcode,
+			Status:     status,
+			Memo:       memo,
+		}})
+	}
+	return items
+}
+
+// 同一项目同一位置, 每种类型只能有一个在用的表
+type posKey struct {
+	project string
+	posCode string
+	typ     string
+}
+
+// checkDB 和库中已有的设备比较
+// 设备号和位置都只和在用的设备比较, 软删除的设备号可以再用
+func checkDB(ctx context.Context, cli *ent.Client, rows []Row, opt Options, rep *Report) error {
+	codes := make([]string, 0, len(rows))
+	lines := make(map[string][]int, len(rows))
+	for _, row := range rows {
+		if code := row.Get(ColDeviceCode); code != "" {
+			if _, ok := lines[code]; !ok {
+				codes = append(codes, code)
+			}
+			lines[code] = append(lines[code], row.Line)
+		}
+	}
+
+	for part := range slices.Chunk(codes, batch) {
+		ds, err := cli.Device.Query().
+			Where(device.DeviceCodeIn(part...)).
+			Select(device.FieldDeviceCode).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, d := range ds {
+			for _, line := range lines[d.DeviceCode] {
+				rep.fail(line, d.DeviceCode, ColDeviceCode, "already exists")
+			}
+		}
+	}
+
+	keys := make(map[posKey][]Row)
+	var poses []string
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		project := cmp.Or(row.Get(ColProject), opt.Project)
+		k := posKey{project: project, posCode: row.Get(ColPosCode), typ: row.Get(ColDeviceType)}
+		if k.project == "" || k.posCode == "" || k.typ == "" {
+			continue
+		}
+		keys[k] = append(keys[k], row)
+		if !seen[k.posCode] {
+			seen[k.posCode] = true
+			poses = append(poses, k.posCode)
+		}
+	}
+
+	for part := range slices.Chunk(poses, batch) {
+		ds, err := cli.Device.Query().
+			Where(device.PosCodeIn(part...)).
+			Select(device.FieldDeviceCode, device.FieldDeviceType, device.FieldProject, device.FieldPosCode).
+			All(ctx)
+		if err != nil {
+			return err
+		}
+		for _, d := range ds {
+			k := posKey{project: d.Project, posCode: d.PosCode, typ: d.DeviceType}
+			for _, row := range keys[k] {
+				rep.fail(row.Line, row.Get(ColDeviceCode), ColPosCode, "%s already has %s meter %s", d.PosCode, d.DeviceType, d.DeviceCode)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package bulk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+)
+
+func testClient(t *testing.T) *ent.Client {
+	t.Helper()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	if err := cli.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func row(line int, code, pos string) Row {
+	return Row{Line: line, Values: map[string]string{
+		ColDeviceCode: code,
+		ColDeviceType: "E",
+		ColProject:    "P1",
+		ColPosCode:    pos,
+	}}
+}
+
+func errorLines(rep *Report, col string) []int {
+	var lines []int
+	for _, e := range rep.Errors {
+		if e.Column == col {
+			lines = append(lines, e.Line)
+		}
+	}
+	return lines
+}
+
+func TestImportReportsEveryExistingLine(t *testing.T) {
+	ctx := context.Background()
+	cli := testClient(t)
+	cli.Device.Create().SetDeviceCode("D1").SetDeviceType("E").SetProject("P1").SetPosCode("101").SaveX(ctx)
+
+	rep, err := Import(ctx, cli, []Row{
+		row(2, "D1", ""),
+		row(3, "D2", "101"),
+		row(4, "D1", ""),
+		row(5, "D3", "101"),
+	}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var exists []int
+	for _, e := range rep.Errors {
+		if e.Column == ColDeviceCode && e.Message == "already exists" {
+			exists = append(exists, e.Line)
+		}
+	}
+	if len(exists) != 2 || exists[0] != 2 || exists[1] != 4 {
+		t.Errorf("already exists on lines %v, want [2 4]", exists)
+	}
+	if got := errorLines(rep, ColPosCode); len(got) < 2 || got[0] != 3 {
+		t.Errorf("pos_code errors on lines %v, want 3 and 5", got)
+	}
+	if n := cli.Device.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d devices after failed import, want 1", n)
+	}
+}
+
+func TestProbeFindsConflictingRows(t *testing.T) {
+	ctx := context.Background()
+	cli := testClient(t)
+	cli.Device.Create().SetDeviceCode("D2").SetDeviceType("E").SetProject("P1").SaveX(ctx)
+
+	items := []item{
+		{line: 2, d: ent.Device{DeviceCode: "D1", DeviceType: "E", Project: "P1", Rate: 1}},
+		{line: 3, d: ent.Device{DeviceCode: "D2", DeviceType: "E", Project: "P1", Rate: 1}},
+		{line: 4, d: ent.Device{DeviceCode: "D3", DeviceType: "E", Project: "P1", Rate: 1}},
+	}
+	rep := &Report{}
+	if err := probe(ctx, cli, items, rep); err != nil {
+		t.Fatal(err)
+	}
+	if len(rep.Errors) != 1 || rep.Errors[0].Line != 3 || rep.Errors[0].Code != "D2" {
+		t.Errorf("errors = %+v, want line 3 only", rep.Errors)
+	}
+	if n := cli.Device.Query().CountX(ctx); n != 1 {
+		t.Errorf("%d devices after probe, want 1", n)
+	}
+}
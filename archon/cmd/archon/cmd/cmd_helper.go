@@ -2,13 +2,20 @@ package cmd
 
 import (
 	"cmp"
+	"context"
 	"log"
 	"log/slog"
+	"net/http"
+	"net/netip"
 
 	"github.com/spf13/viper"
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/feed"
 	"github.com/twiglab/h2o/archon/orm"
 	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/wp"
 	"github.com/twiglab/h2o/clog"
+	"github.com/twiglab/h2o/pkg/auth"
 )
 
 func webaddr() string {
@@ -24,13 +31,55 @@ func entcli() *ent.Client {
 	if err != nil {
 		log.Fatal(err)
 	}
+	cli.Device.Use(audit.Hook())
 	return cli
 }
 
+func authConf() auth.Conf {
+	return auth.Conf{
+		Mode:          viper.GetString("archon.auth.mode"),
+		Secret:        viper.GetString("archon.auth.secret"),
+		Issuer:        viper.GetString("archon.auth.issuer"),
+		ClientID:      viper.GetString("archon.auth.client_id"),
+		RolesClaim:    viper.GetString("archon.auth.roles_claim"),
+		ProjectsClaim: viper.GetString("archon.auth.projects_claim"),
+	}
+}
+
+// authMW archon.auth.mode 为 none 时不认证, 为空时不能启动
+func authMW() func(http.Handler) http.Handler {
+	v, err := auth.NewVerifier(context.Background(), authConf())
+	if err != nil {
+		log.Fatal(err)
+	}
+	return auth.Middleware(v)
+}
+
+// trustedProxies archon.audit.trusted_proxies 中的代理可以用请求头说明操作人
+func trustedProxies() []netip.Prefix {
+	ps, err := audit.ParseTrusted(viper.GetStringSlice("archon.audit.trusted_proxies"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	return ps
+}
+
+func adminConf() wp.Conf {
+	return wp.Conf{
+		Gateway: viper.GetString("archon.admin.gateway"),
+		Stale:   viper.GetDuration("archon.admin.stale"),
+	}
+}
+
 func dbx(c *ent.Client) *orm.DBx {
 	return &orm.DBx{Client: c}
 }
 
+func devFeed() *feed.Feed {
+	size := viper.GetInt("archon.feed.size")
+	return feed.New(cmp.Or(size, 10000))
+}
+
 func rootLog() *slog.Logger {
 	rlogF := viper.GetString("archon.log.root.file")
 	rlogL := viper.GetString("archon.log.root.level")
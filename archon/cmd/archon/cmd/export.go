@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/twiglab/h2o/archon/bulk"
+)
+
+var exportOpts struct {
+	format string
+	output string
+	filter bulk.Filter
+}
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "导出设备列表 (csv 或 xlsx), 格式和 import 一致",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return exportFile(cmd.Context())
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+	exportCmd.Flags().StringVar(&exportOpts.format, "format", bulk.FormatCSV, "csv 或 xlsx")
+	exportCmd.Flags().StringVarP(&exportOpts.output, "output", "o", "", "输出文件, 默认标准输出")
+	exportCmd.Flags().StringVar(&exportOpts.filter.Type, "type", "", "设备类型")
+	exportCmd.Flags().StringVar(&exportOpts.filter.PosCode, "pos-code", "", "位置编号")
+	exportCmd.Flags().StringVar(&exportOpts.filter.Project, "project", "", "项目编号")
+}
+
+func exportFile(ctx context.Context) error {
+	format, err := bulk.FormatOf(exportOpts.format)
+	if err != nil {
+		return err
+	}
+
+	cli := entcli()
+	defer cli.Close()
+
+	ds, err := exportOpts.filter.Query(cli).All(ctx)
+	if err != nil {
+		return err
+	}
+
+	if exportOpts.output == "" {
+		return bulk.Write(os.Stdout, format, ds)
+	}
+
+	f, err := os.Create(exportOpts.output)
+	if err != nil {
+		return err
+	}
+	if err := bulk.Write(f, format, ds); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
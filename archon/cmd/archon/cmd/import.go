@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"os/user"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/bulk"
+)
+
+var importOpts struct {
+	format  string
+	project string
+	dryRun  bool
+	server  string
+	token   string
+}
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import FILE",
+	Short: "批量导入设备 (csv 或 xlsx)",
+	Long: `批量导入设备, 第一行为表头, 列名见 export 的输出.
+全部校验通过才在一个事务中写入, 否则输出每行的错误.
+
+默认直接写库, 使用 --server 时通过运行中的 archon 的 GraphQL 接口导入,
+这样 hank 等订阅设备变更的服务可以马上收到新设备.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return importFile(cmd.Context(), args[0])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(importCmd)
+	importCmd.Flags().StringVar(&importOpts.format, "format", "", "csv 或 xlsx, 默认按文件名判断")
+	importCmd.Flags().StringVar(&importOpts.project, "project", "", "项目编号, 填充文件中为空的 project, 并要求其他行一致")
+	importCmd.Flags().BoolVar(&importOpts.dryRun, "dry-run", false, "只校验不写入")
+	importCmd.Flags().StringVar(&importOpts.server, "server", "", "archon 地址, 如 http://127.0.0.1:10008")
+	importCmd.Flags().StringVar(&importOpts.token, "token", "", "访问 --server 的 Bearer token, 见 archon token")
+}
+
+func importFile(ctx context.Context, name string) error {
+	format, err := bulk.FormatOf(cmp.Or(importOpts.format, name))
+	if err != nil {
+		return err
+	}
+
+	var rep *bulk.Report
+	if importOpts.server != "" {
+		rep, err = importRemote(ctx, name, format)
+	} else {
+		rep, err = importLocal(ctx, name, format)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, e := range rep.Errors {
+		fmt.Println(e.Error())
+	}
+	fmt.Printf("total: %d, created: %d, errors: %d, dry run: %t\n", rep.Total, rep.Created, len(rep.Errors), rep.DryRun)
+	if !rep.OK() {
+		return errors.New("import failed")
+	}
+	return nil
+}
+
+func importLocal(ctx context.Context, name, format string) (*bulk.Report, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	rows, err := bulk.Read(f, format)
+	if err != nil {
+		return nil, err
+	}
+
+	cli := entcli()
+	defer cli.Close()
+
+	ctx = audit.WithActor(ctx, cliActor())
+	ctx = audit.WithReason(ctx, "import "+filepath.Base(name))
+	return bulk.Import(ctx, cli, rows, bulk.Options{Project: importOpts.project, DryRun: importOpts.dryRun})
+}
+
+const importMutation = `mutation ($input: DeviceImportInput!) {
+  deviceImport(input: $input) { total created dryRun errors { line code column message } }
+}`
+
+// importRemote 按 GraphQL multipart request 规范上传
+func importRemote(ctx context.Context, name, format string) (*bulk.Report, error) {
+	input := map[string]any{"file": nil, "format": format, "dryRun": importOpts.dryRun}
+	if importOpts.project != "" {
+		input["project"] = importOpts.project
+	}
+	ops, err := json.Marshal(map[string]any{
+		"query":     importMutation,
+		"variables": map[string]any{"input": input},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	_ = mw.WriteField("operations", string(ops))
+	_ = mw.WriteField("map", `{"0": ["variables.input.file"]}`)
+	fw, err := mw.CreateFormFile("0", filepath.Base(name))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(fw, f); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, importOpts.server+"/gql/query", &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	req.Header.Set(audit.HeaderActor, cliActor())
+	if importOpts.token != "" {
+		req.Header.Set("Authorization", "Bearer "+importOpts.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Data struct {
+			DeviceImport *bulk.Report `json:"deviceImport"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.UnmarshalRead(resp.Body, &res); err != nil {
+		return nil, fmt.Errorf("%s: %w", resp.Status, err)
+	}
+	if len(res.Errors) > 0 {
+		return nil, errors.New(res.Errors[0].Message)
+	}
+	if res.Data.DeviceImport == nil {
+		return nil, fmt.Errorf("import: %s", resp.Status)
+	}
+	return res.Data.DeviceImport, nil
+}
+
+// cliActor 命令行操作以当前系统用户作为操作人
+func cliActor() string {
+	if u, err := user.Current(); err == nil {
+		return "cli:" + u.Username
+	}
+	return "cli"
+}
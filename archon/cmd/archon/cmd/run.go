@@ -1,12 +1,19 @@
 package cmd
 
 import (
+	"context"
 	"log"
+	"log/slog"
 	"net/http"
 
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/bulk"
+	"github.com/twiglab/h2o/archon/feed"
 	"github.com/twiglab/h2o/archon/gql"
 	"github.com/twiglab/h2o/archon/wp"
+	"github.com/twiglab/h2o/pkg/auth"
+	"github.com/twiglab/h2o/pkg/registry"
 
 	"github.com/spf13/cobra"
 
@@ -38,10 +45,26 @@ func run() {
 
 	cli := entcli()
 
+	// 启用变更记录前的设备补上按时间生效的属性
+	if n, err := audit.Backfill(context.Background(), cli); err != nil {
+		log.Fatal(err)
+	} else if n > 0 {
+		slog.Info("device attrs backfilled", slog.Int("count", n))
+	}
+
+	authed := authMW()
+
+	fd := devFeed()
+	cli.Device.Use(feed.Hook(fd))
+	// 全量同步只给不限项目的调用方
+	http.Handle(registry.SnapshotPath, authed(auth.RequireAllProjects(feed.SnapshotHandler(cli, fd))))
+	http.Handle(registry.EventsPath, authed(auth.RequireAllProjects(feed.EventsHandler(fd))))
+
 	http.Handle("/gql", playground.ApolloSandboxHandler("gql", "/gql/query"))
-	http.Handle("/gql/query", gql.Handle(cli))
+	http.Handle("/gql/query", authed(audit.ActorHeader(trustedProxies())(gql.Handle(cli))))
+	http.Handle("/device/export", authed(bulk.ExportHandler(cli)))
 
-	_, admin := wp.AdminPage()
+	_, admin := wp.AdminPage(adminConf())
 	http.Handle("/", admin)
 
 	if err := http.ListenAndServe(webaddr(), nil); err != nil {
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+var tokenOpts struct {
+	subject  string
+	name     string
+	roles    []string
+	projects []string
+	ttl      time.Duration
+}
+
+// tokenCmd represents the token command
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "签发本地测试用的 token (archon.auth.mode = static)",
+	Long: `用 archon.auth.secret 签发 HS256 的 token, 用于测试和没有 OIDC 的部署.
+vigil, chrgg 配置相同的 secret 和 issuer 即可验证同一个 token.
+
+  archon token --sub ops --role operator --project p1 --project p2
+  archon token --sub hank --role read-only --project '*'`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return issue()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tokenCmd)
+	tokenCmd.Flags().StringVar(&tokenOpts.subject, "sub", "", "用户或者服务名")
+	tokenCmd.Flags().StringVar(&tokenOpts.name, "name", "", "显示名称")
+	tokenCmd.Flags().StringSliceVar(&tokenOpts.roles, "role", nil, "admin, operator, finance, read-only")
+	tokenCmd.Flags().StringSliceVar(&tokenOpts.projects, "project", nil, "可以访问的项目, * 为全部")
+	tokenCmd.Flags().DurationVar(&tokenOpts.ttl, "ttl", 24*time.Hour, "有效期")
+}
+
+func issue() error {
+	c := authConf()
+	if c.Mode != "static" {
+		return fmt.Errorf("archon.auth.mode is %q, not static", c.Mode)
+	}
+	if c.Secret == "" {
+		return errors.New("archon.auth.secret is empty")
+	}
+	if tokenOpts.subject == "" {
+		return errors.New("--sub is required")
+	}
+
+	s := &auth.Static{Secret: []byte(c.Secret), Issuer: c.Issuer}
+	token, err := s.Issue(&auth.Claims{
+		Subject:  tokenOpts.subject,
+		Name:     tokenOpts.name,
+		Roles:    tokenOpts.roles,
+		Projects: tokenOpts.projects,
+	}, tokenOpts.ttl)
+	if err != nil {
+		return err
+	}
+	fmt.Println(token)
+	return nil
+}
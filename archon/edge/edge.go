@@ -0,0 +1,166 @@
+// Package edge 生成边缘网关(nab)的 driver-box 配置并下发
+package edge
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+var client = &http.Client{Timeout: 30 * time.Second}
+
+// Build 网关的完整配置, 只包含指定了模型的设备, 已删除的设备不下发
+// 网关上定义的连接都下发, 没有设备的插件也会出现在配置中
+func Build(ctx context.Context, cli *ent.Client, box *ent.EdgeBox) (provision.Config, error) {
+	ds, err := box.QueryDevices().
+		Where(device.ModelNameNEQ("")).
+		Order(ent.Asc(device.FieldDeviceCode)).
+		All(ctx)
+	if err != nil {
+		return provision.Config{}, err
+	}
+
+	names := make([]string, 0)
+	for _, d := range ds {
+		if !slices.Contains(names, d.ModelName) {
+			names = append(names, d.ModelName)
+		}
+	}
+	ms, err := cli.DriverModel.Query().Where(drivermodel.NameIn(names...)).All(ctx)
+	if err != nil {
+		return provision.Config{}, err
+	}
+	models := make(map[string]*ent.DriverModel, len(ms))
+	for _, m := range ms {
+		models[m.Name] = m
+	}
+
+	plugins := make(map[string]*provision.Plugin)
+	plugin := func(name string) *provision.Plugin {
+		p, ok := plugins[name]
+		if !ok {
+			p = &provision.Plugin{Name: name, Connections: make(map[string]any)}
+			plugins[name] = p
+		}
+		return p
+	}
+	for key, c := range box.Connections {
+		opts := c.Options
+		if opts == nil {
+			opts = map[string]any{}
+		}
+		plugin(c.Plugin).Connections[key] = opts
+	}
+
+	var errs []error
+	for _, d := range ds {
+		m, ok := models[d.ModelName]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: model %s not found", d.DeviceCode, d.ModelName))
+			continue
+		}
+		c, ok := box.Connections[d.ConnKey]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: connection %q not found on %s", d.DeviceCode, d.ConnKey, box.Code))
+			continue
+		}
+		if c.Plugin != m.Plugin {
+			errs = append(errs, fmt.Errorf("%s: model %s is %s, connection %s is %s", d.DeviceCode, m.Name, m.Plugin, d.ConnKey, c.Plugin))
+			continue
+		}
+
+		p := plugin(m.Plugin)
+		i := slices.IndexFunc(p.DeviceModels, func(x provision.Model) bool { return x.Name == m.Name })
+		if i < 0 {
+			p.DeviceModels = append(p.DeviceModels, provision.Model{
+				Name:         m.Name,
+				ModelID:      cmp.Or(m.ModelID, m.Name),
+				Description:  cmp.Or(m.Description, m.Name),
+				Attributes:   m.Attributes,
+				DevicePoints: m.Points,
+			})
+			i = len(p.DeviceModels) - 1
+		}
+		p.DeviceModels[i].Devices = append(p.DeviceModels[i].Devices, provision.Device{
+			ID:            d.DeviceCode,
+			Description:   cmp.Or(d.DeviceName, d.DeviceCode),
+			ConnectionKey: d.ConnKey,
+			Properties:    d.DriverProps,
+		})
+	}
+	if len(errs) > 0 {
+		return provision.Config{}, errors.Join(errs...)
+	}
+
+	cfg := provision.Config{Box: box.Code, Prune: true}
+	for _, name := range slices.Sorted(maps.Keys(plugins)) {
+		cfg.Plugins = append(cfg.Plugins, *plugins[name])
+	}
+	cfg.Version, err = version(cfg.Plugins)
+	return cfg, err
+}
+
+// version 配置内容的摘要, map 按 key 排序, 内容不变时版本不变
+func version(ps []provision.Plugin) (string, error) {
+	b, err := json.Marshal(ps, json.Deterministic(true))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+func boxClient(box *ent.EdgeBox) *provision.Client {
+	return &provision.Client{URL: box.URL, Token: box.Token, Client: client}
+}
+
+// Push 生成配置并下发, 结果记录到网关上, dryRun 时只让网关校验, 不记录
+func Push(ctx context.Context, cli *ent.Client, id string, dryRun bool) (provision.Report, error) {
+	box, err := cli.EdgeBox.Get(ctx, id)
+	if err != nil {
+		return provision.Report{}, err
+	}
+	cfg, err := Build(ctx, cli, box)
+	if err != nil {
+		return provision.Report{}, err
+	}
+	cfg.DryRun = dryRun
+
+	rep, err := boxClient(box).Apply(ctx, cfg)
+	if dryRun {
+		return rep, err
+	}
+
+	up := box.Update().SetPushTime(time.Now())
+	switch {
+	case err != nil:
+		up.SetPushError(err.Error())
+	case !rep.OK():
+		up.SetPushError(strings.Join(rep.Errors, "; "))
+	default:
+		up.SetPushVersion(cfg.Version).SetPushError("")
+	}
+	if _, uerr := up.Save(ctx); uerr != nil {
+		return rep, errors.Join(err, uerr)
+	}
+	return rep, err
+}
+
+// Status 网关上最后一次下发的结果
+func Status(ctx context.Context, box *ent.EdgeBox) (provision.Report, error) {
+	return boxClient(box).Status(ctx)
+}
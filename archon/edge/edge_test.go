@@ -0,0 +1,136 @@
+package edge
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+func testClient(t *testing.T) *ent.Client {
+	t.Helper()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	if err := cli.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func testBox(t *testing.T, cli *ent.Client) *ent.EdgeBox {
+	t.Helper()
+	ctx := context.Background()
+	cli.DriverModel.Create().SetName("em").SetPlugin("modbus").
+		SetPoints([]map[string]any{{"name": "energy"}}).SaveX(ctx)
+	cli.DriverModel.Create().SetName("wm").SetPlugin("dlt645").
+		SetPoints([]map[string]any{{"name": "flow"}}).SaveX(ctx)
+	return cli.EdgeBox.Create().SetCode("B1").SetProject("P1").SetURL("http://127.0.0.1:8081").
+		SetConnections(map[string]provision.Connection{
+			"rtu1": {Plugin: "modbus", Options: map[string]any{"mode": "rtu", "address": "/dev/ttyS0"}},
+			"com2": {Plugin: "dlt645"},
+			"ip1":  {Plugin: "bacnet"},
+		}).SaveX(ctx)
+}
+
+func addDevice(t *testing.T, cli *ent.Client, box *ent.EdgeBox, code, model, conn string) *ent.Device {
+	t.Helper()
+	return cli.Device.Create().SetDeviceCode(code).SetDeviceType("E").SetProject("P1").
+		SetModelName(model).SetConnKey(conn).SetDriverProps(map[string]string{"unitID": "1"}).
+		SetBox(box).SaveX(context.Background())
+}
+
+func TestBuild(t *testing.T) {
+	ctx := context.Background()
+	cli := testClient(t)
+	box := testBox(t, cli)
+	addDevice(t, cli, box, "E2", "em", "rtu1")
+	addDevice(t, cli, box, "E1", "em", "rtu1")
+	addDevice(t, cli, box, "W1", "wm", "com2")
+	// 没有模型的设备不下发
+	addDevice(t, cli, box, "X1", "", "")
+	del := addDevice(t, cli, box, "E3", "em", "rtu1")
+	cli.Device.UpdateOne(del).SetIsDel(time.Now().UnixNano()).SetDeleteTime(time.Now()).ExecX(ctx)
+
+	cfg, err := Build(ctx, cli, box)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Box != "B1" || !cfg.Prune || cfg.Version == "" {
+		t.Fatalf("got box %s prune %v version %q", cfg.Box, cfg.Prune, cfg.Version)
+	}
+	var names []string
+	for _, p := range cfg.Plugins {
+		names = append(names, p.Name)
+	}
+	// 插件按名称排序, 没有设备的 bacnet 也下发连接
+	if strings.Join(names, ",") != "bacnet,dlt645,modbus" {
+		t.Fatalf("plugins = %v", names)
+	}
+	if _, ok := cfg.Plugins[0].Connections["ip1"]; !ok || len(cfg.Plugins[0].DeviceModels) != 0 {
+		t.Fatalf("bacnet = %+v", cfg.Plugins[0])
+	}
+	mb := cfg.Plugins[2]
+	if len(mb.DeviceModels) != 1 || mb.DeviceModels[0].ModelID != "em" {
+		t.Fatalf("modbus models = %+v", mb.DeviceModels)
+	}
+	ds := mb.DeviceModels[0].Devices
+	if len(ds) != 2 || ds[0].ID != "E1" || ds[1].ID != "E2" || ds[0].ConnectionKey != "rtu1" {
+		t.Fatalf("modbus devices = %+v", ds)
+	}
+
+	// 内容不变时版本不变, 和 map 的遍历顺序无关
+	for range 5 {
+		again, err := Build(ctx, cli, cli.EdgeBox.GetX(ctx, box.ID))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.Version != cfg.Version {
+			t.Fatalf("version %s, want %s", again.Version, cfg.Version)
+		}
+	}
+
+	cli.Device.Update().SetDriverProps(map[string]string{"unitID": "2"}).ExecX(ctx)
+	changed, err := Build(ctx, cli, box)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if changed.Version == cfg.Version {
+		t.Fatal("version unchanged after device props changed")
+	}
+}
+
+func TestBuildMismatch(t *testing.T) {
+	tests := []struct {
+		name  string
+		model string
+		conn  string
+		err   string
+	}{
+		{"模型不存在", "gm", "rtu1", "E1: model gm not found"},
+		{"连接不存在", "em", "rtu9", `E1: connection "rtu9" not found on B1`},
+		{"插件不一致", "wm", "rtu1", "E1: model wm is dlt645, connection rtu1 is modbus"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cli := testClient(t)
+			box := testBox(t, cli)
+			addDevice(t, cli, box, "E1", tt.model, tt.conn)
+			addDevice(t, cli, box, "E2", "em", "rtu1")
+
+			cfg, err := Build(context.Background(), cli, box)
+			if err == nil || err.Error() != tt.err {
+				t.Fatalf("err = %v, want %s", err, tt.err)
+			}
+			if len(cfg.Plugins) != 0 || cfg.Version != "" {
+				t.Fatalf("got config %+v with error", cfg)
+			}
+		})
+	}
+}
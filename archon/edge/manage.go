@@ -0,0 +1,143 @@
+package edge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+var (
+	ErrConnInUse = errors.New("connection in use")
+	ErrPlugin    = errors.New("plugin mismatch")
+	ErrProject   = errors.New("project mismatch")
+)
+
+// SetConn 新增或者修改网关的连接, 修改插件时要求没有设备使用
+func SetConn(ctx context.Context, cli *ent.Client, boxID, key string, c provision.Connection) (*ent.EdgeBox, error) {
+	var box *ent.EdgeBox
+	err := orm.WithTx(ctx, cli, func(tx *ent.Tx) error {
+		b, err := tx.EdgeBox.Get(ctx, boxID)
+		if err != nil {
+			return err
+		}
+		if old, ok := b.Connections[key]; ok && old.Plugin != c.Plugin {
+			if err := unused(ctx, b, key); err != nil {
+				return err
+			}
+		}
+		conns := maps.Clone(b.Connections)
+		if conns == nil {
+			conns = make(map[string]provision.Connection)
+		}
+		conns[key] = c
+		box, err = b.Update().SetConnections(conns).Save(ctx)
+		return err
+	})
+	return box, err
+}
+
+// RemoveConn 删除网关的连接, 要求没有设备使用
+func RemoveConn(ctx context.Context, cli *ent.Client, boxID, key string) (*ent.EdgeBox, error) {
+	var box *ent.EdgeBox
+	err := orm.WithTx(ctx, cli, func(tx *ent.Tx) error {
+		b, err := tx.EdgeBox.Get(ctx, boxID)
+		if err != nil {
+			return err
+		}
+		if err := unused(ctx, b, key); err != nil {
+			return err
+		}
+		conns := maps.Clone(b.Connections)
+		delete(conns, key)
+		box, err = b.Update().SetConnections(conns).Save(ctx)
+		return err
+	})
+	return box, err
+}
+
+func unused(ctx context.Context, b *ent.EdgeBox, key string) error {
+	n, err := b.QueryDevices().Where(device.ConnKey(key)).Count(ctx)
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		return fmt.Errorf("%w: %s used by %d devices", ErrConnInUse, key, n)
+	}
+	return nil
+}
+
+// SaveModel 按名称新增或者覆盖模型
+func SaveModel(ctx context.Context, cli *ent.Client, m *ent.DriverModel) (*ent.DriverModel, error) {
+	for i, p := range m.Points {
+		if name, _ := p["name"].(string); name == "" {
+			return nil, fmt.Errorf("model %s point %d: name is required", m.Name, i)
+		}
+	}
+	id, err := cli.DriverModel.Create().
+		SetName(m.Name).
+		SetPlugin(m.Plugin).
+		SetModelID(m.ModelID).
+		SetDescription(m.Description).
+		SetAttributes(m.Attributes).
+		SetPoints(m.Points).
+		OnConflictColumns(drivermodel.FieldName).
+		UpdateNewValues().
+		ID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cli.DriverModel.Get(ctx, id)
+}
+
+// Placement 设备在网关上的位置, BoxID 为空表示从网关上移除
+type Placement struct {
+	BoxID     string
+	ConnKey   string
+	ModelName string
+	Props     map[string]string
+}
+
+// Assign 指定设备所在的网关, 连接和模型, 要求网关和设备属于同一个项目, 连接和模型属于同一个插件
+// 只修改档案, 需要下发到网关后才生效
+func Assign(ctx context.Context, cli *ent.Client, id string, p Placement) (*ent.Device, error) {
+	d, err := cli.Device.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	up := d.Update()
+	if p.BoxID == "" {
+		return up.ClearBoxID().ClearConnKey().ClearModelName().ClearDriverProps().Save(ctx)
+	}
+
+	box, err := cli.EdgeBox.Get(ctx, p.BoxID)
+	if err != nil {
+		return nil, err
+	}
+	if box.Project != d.Project {
+		return nil, fmt.Errorf("%w: box %s is %s, device %s is %s", ErrProject, box.Code, box.Project, d.DeviceCode, d.Project)
+	}
+	c, ok := box.Connections[p.ConnKey]
+	if !ok {
+		return nil, fmt.Errorf("connection %q not found on %s", p.ConnKey, box.Code)
+	}
+	m, err := cli.DriverModel.Query().Where(drivermodel.Name(p.ModelName)).Only(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("model %q: %w", p.ModelName, err)
+	}
+	if m.Plugin != c.Plugin {
+		return nil, fmt.Errorf("%w: model %s is %s, connection %s is %s", ErrPlugin, m.Name, m.Plugin, p.ConnKey, c.Plugin)
+	}
+
+	up.SetBoxID(box.ID).SetConnKey(p.ConnKey).SetModelName(m.Name)
+	if p.Props != nil {
+		up.SetDriverProps(p.Props)
+	}
+	return up.Save(ctx)
+}
@@ -0,0 +1,100 @@
+package feed
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+// Feed 设备变更流, 保留最近 size 条变更, 供断线重连的客户端补齐
+type Feed struct {
+	epoch string
+	size  int
+
+	mu   sync.Mutex
+	rev  uint64
+	ring []registry.Event
+	subs map[chan registry.Event]struct{}
+}
+
+func New(size int) *Feed {
+	u, _ := uuid.NewV7()
+	return &Feed{
+		epoch: u.String(),
+		size:  size,
+		subs:  make(map[chan registry.Event]struct{}),
+	}
+}
+
+func (f *Feed) Epoch() string {
+	return f.epoch
+}
+
+func (f *Feed) Rev() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.rev
+}
+
+func (f *Feed) Publish(op string, d registry.Device) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.rev++
+	ev := registry.Event{Epoch: f.epoch, Rev: f.rev, Op: op, Device: d}
+
+	f.ring = append(f.ring, ev)
+	if len(f.ring) > f.size {
+		f.ring = f.ring[len(f.ring)-f.size:]
+	}
+
+	for ch := range f.subs {
+		select {
+		case ch <- ev:
+		default:
+			// 跟不上的客户端直接断开, 重连后从 ring 里补齐
+			delete(f.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// Resync 批量修改无法逐条描述, 通知所有客户端重新拉取快照
+func (f *Feed) Resync() {
+	f.Publish(registry.OpResync, registry.Device{})
+}
+
+// subscribe 返回 since 之后的积压变更和后续变更的 channel
+// epoch 不一致或者积压已经被覆盖, ok 为 false
+func (f *Feed) subscribe(epoch string, since uint64) (backlog []registry.Event, ch chan registry.Event, ok bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if epoch != f.epoch || since > f.rev {
+		return nil, nil, false
+	}
+	if since < f.rev {
+		if len(f.ring) == 0 || f.ring[0].Rev > since+1 {
+			return nil, nil, false
+		}
+		for _, ev := range f.ring {
+			if ev.Rev > since {
+				backlog = append(backlog, ev)
+			}
+		}
+	}
+
+	ch = make(chan registry.Event, 64)
+	f.subs[ch] = struct{}{}
+	return backlog, ch, true
+}
+
+func (f *Feed) unsubscribe(ch chan registry.Event) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.subs[ch]; ok {
+		delete(f.subs, ch)
+		close(ch)
+	}
+}
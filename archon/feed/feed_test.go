@@ -0,0 +1,157 @@
+package feed
+
+import (
+	"context"
+	"encoding/json/v2"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+func TestSubscribeBacklog(t *testing.T) {
+	f := New(2)
+	for _, code := range []string{"A", "B", "C", "D"} {
+		f.Publish(registry.OpCreate, registry.Device{Code: code})
+	}
+
+	backlog, ch, ok := f.subscribe(f.Epoch(), 2)
+	if !ok {
+		t.Fatal("subscribe since 2 refused")
+	}
+	f.unsubscribe(ch)
+	if len(backlog) != 2 || backlog[0].Rev != 3 || backlog[1].Rev != 4 {
+		t.Fatalf("backlog = %+v, want rev 3 4", backlog)
+	}
+
+	for _, tc := range []struct {
+		name  string
+		epoch string
+		since uint64
+	}{
+		{"other epoch", "x", 4},
+		{"ahead of feed", f.Epoch(), 5},
+		{"backlog overwritten", f.Epoch(), 1},
+	} {
+		if _, _, ok := f.subscribe(tc.epoch, tc.since); ok {
+			t.Errorf("%s: subscribe ok, want resync", tc.name)
+		}
+	}
+}
+
+// archon 模拟 archon 的档案服务, 快照从内存中的设备表生成
+type archon struct {
+	mu      sync.Mutex
+	devices map[string]registry.Device
+	feed    atomic.Pointer[Feed]
+}
+
+func newArchon() *archon {
+	a := &archon{devices: make(map[string]registry.Device)}
+	a.feed.Store(New(100))
+	return a
+}
+
+func (a *archon) publish(op string, d registry.Device) {
+	a.mu.Lock()
+	if op == registry.OpRemove {
+		delete(a.devices, d.Code)
+	} else {
+		a.devices[d.Code] = d
+	}
+	a.mu.Unlock()
+	a.feed.Load().Publish(op, d)
+}
+
+func (a *archon) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(registry.SnapshotPath, func(w http.ResponseWriter, r *http.Request) {
+		f := a.feed.Load()
+		snap := registry.Snapshot{Epoch: f.Epoch(), Rev: f.Rev()}
+		a.mu.Lock()
+		for _, d := range a.devices {
+			snap.Devices = append(snap.Devices, d)
+		}
+		a.mu.Unlock()
+		_ = json.MarshalWrite(w, snap)
+	})
+	mux.HandleFunc(registry.EventsPath, func(w http.ResponseWriter, r *http.Request) {
+		EventsHandler(a.feed.Load()).ServeHTTP(w, r)
+	})
+	return mux
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timeout waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestMirrorFollowsFeed(t *testing.T) {
+	a := newArchon()
+	a.publish(registry.OpCreate, registry.Device{Code: "A", Project: "P1"})
+
+	srv := httptest.NewServer(a.handler())
+	defer srv.Close()
+
+	m := registry.NewMirror(srv.URL)
+	if err := m.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if d, ok := m.Get("A"); !ok || d.Project != "P1" {
+		t.Fatalf("snapshot A = %+v %v", d, ok)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Run(ctx)
+	waitFor(t, "stream online", func() bool { return m.Check(ctx) == nil })
+
+	a.publish(registry.OpModify, registry.Device{Code: "A", Project: "P2"})
+	a.publish(registry.OpCreate, registry.Device{Code: "B", Project: "P1"})
+	a.publish(registry.OpRemove, registry.Device{Code: "A"})
+	waitFor(t, "changes applied", func() bool {
+		_, hasA := m.Get("A")
+		_, hasB := m.Get("B")
+		return !hasA && hasB
+	})
+
+	// archon 重启后 epoch 变化, 客户端重新拉取快照
+	a.feed.Store(New(100))
+	a.publish(registry.OpCreate, registry.Device{Code: "C", Project: "P3"})
+	srv.CloseClientConnections()
+	waitFor(t, "resync after restart", func() bool {
+		d, ok := m.Get("C")
+		return ok && d.Project == "P3" && m.Len() == 2
+	})
+}
+
+func TestMirrorResyncWhenBacklogLost(t *testing.T) {
+	a := newArchon()
+	a.feed.Store(New(1))
+	srv := httptest.NewServer(a.handler())
+	defer srv.Close()
+
+	m := registry.NewMirror(srv.URL)
+	if err := m.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// 断线期间的变更超出了 ring 的容量, 只能重新拉快照
+	a.publish(registry.OpCreate, registry.Device{Code: "A"})
+	a.publish(registry.OpCreate, registry.Device{Code: "B"})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go m.Run(ctx)
+	waitFor(t, "resync", func() bool { return m.Len() == 2 })
+}
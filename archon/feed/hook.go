@@ -0,0 +1,75 @@
+package feed
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/hook"
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+func ToDevice(d *ent.Device) registry.Device {
+	var parent string
+	if d.ParentID != nil {
+		parent = *d.ParentID
+	}
+	return registry.Device{
+		ID:   d.ID,
+		Code: d.DeviceCode,
+		Type: d.DeviceType,
+		SN:   d.DeviceSn,
+		Name: d.DeviceName,
+
+		Project:  d.Project,
+		PosCode:  d.PosCode,
+		AreaCode: d.AreaCode,
+		Pcode:    d.Pcode,
+
+		Parent: parent,
+
+		Rate:   d.Rate,
+		Status: d.Status,
+	}
+}
+
+// Hook 设备变更后发布事件, 在事务中的变更等提交以后再发布
+func Hook(f *Feed) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.DeviceFunc(func(ctx context.Context, m *ent.DeviceMutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+
+			publish := func() {
+				d, ok := v.(*ent.Device)
+				switch {
+				case !ok:
+					f.Resync()
+				case d.IsDel != 0:
+					f.Publish(registry.OpRemove, ToDevice(d))
+				case m.Op().Is(ent.OpCreate):
+					f.Publish(registry.OpCreate, ToDevice(d))
+				default:
+					f.Publish(registry.OpModify, ToDevice(d))
+				}
+			}
+
+			if tx, err := m.Tx(); err == nil {
+				tx.OnCommit(func(next ent.Committer) ent.Committer {
+					return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+						if err := next.Commit(ctx, tx); err != nil {
+							return err
+						}
+						publish()
+						return nil
+					})
+				})
+				return v, nil
+			}
+
+			publish()
+			return v, nil
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package feed
+
+import (
+	"encoding/json/v2"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+// SnapshotHandler 先取 rev 再查库, 快照可能已经包含部分后续变更, 客户端重放是幂等的
+func SnapshotHandler(cli *ent.Client, f *Feed) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		snap := registry.Snapshot{Epoch: f.Epoch(), Rev: f.Rev()}
+
+		ds, err := cli.Device.Query().All(r.Context())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		snap.Devices = make([]registry.Device, 0, len(ds))
+		for _, d := range ds {
+			snap.Devices = append(snap.Devices, ToDevice(d))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.MarshalWrite(w, snap)
+	})
+}
+
+// EventsHandler 以 SSE 推送变更
+func EventsHandler(f *Feed) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		since, _ := strconv.ParseUint(r.URL.Query().Get("since"), 10, 64)
+		epoch := r.URL.Query().Get("epoch")
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+
+		backlog, ch, ok := f.subscribe(epoch, since)
+		if !ok {
+			_ = writeEvent(w, registry.Event{Epoch: f.Epoch(), Rev: f.Rev(), Op: registry.OpResync})
+			flusher.Flush()
+			return
+		}
+		defer f.unsubscribe(ch)
+
+		for _, ev := range backlog {
+			if err := writeEvent(w, ev); err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+
+		ping := time.NewTicker(30 * time.Second)
+		defer ping.Stop()
+
+		for {
+			select {
+			case <-ping.C:
+				if _, err := fmt.Fprint(w, ": ping\n\n"); err != nil {
+					return
+				}
+				flusher.Flush()
+			case ev, ok := <-ch:
+				if !ok {
+					return
+				}
+				if err := writeEvent(w, ev); err != nil {
+					return
+				}
+				flusher.Flush()
+			case <-r.Context().Done():
+				return
+			}
+		}
+	})
+}
+
+func writeEvent(w http.ResponseWriter, ev registry.Event) error {
+	bs, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\ndata: %s\n\n", ev.Rev, bs)
+	return err
+}
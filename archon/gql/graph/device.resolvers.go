@@ -6,112 +6,237 @@ package graph
 // Code generated by github.com/99designs/gqlgen version v0.17.94
 
 import (
+	"cmp"
 	"context"
+	"time"
 
+	"github.com/twiglab/h2o/archon/bulk"
 	"github.com/twiglab/h2o/archon/gql/graph/model"
+	"github.com/twiglab/h2o/archon/orm"
 	"github.com/twiglab/h2o/archon/orm/ent"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
 )
 
-// DeviceCreate is the resolver for the deviceCreate field.
-func (r *mutationResolver) DeviceCreate(ctx context.Context, input model.DeviceCreateInput) (*ent.Device, error) {
-	cr := r.DBx.Client.Device.Create()
-
-	cr.
-		SetDeviceCode(input.DeviceCode).
-		SetDeviceType(input.DeviceType).
-		SetProject(input.Project)
-
-	if input.DeviceSn != nil {
-		cr.SetDeviceSn(*input.DeviceSn)
+// Parent is the resolver for the parent field.
+func (r *deviceResolver) Parent(ctx context.Context, obj *ent.Device) (*ent.Device, error) {
+	if obj.ParentID == nil {
+		return nil, nil
 	}
-
-	if input.DeviceName != nil {
-		cr.SetDeviceName(*input.DeviceName)
+	parent, err := r.DBx.Client.Device.Get(ctx, *obj.ParentID)
+	if ent.IsNotFound(err) {
+		// 上级已经删除
+		return nil, nil
 	}
+	return parent, err
+}
 
-	if input.Rate != nil {
-		cr.SetRate(*input.Rate)
-	}
+// Children is the resolver for the children field.
+func (r *deviceResolver) Children(ctx context.Context, obj *ent.Device) ([]*ent.Device, error) {
+	return obj.QueryChildren().
+		Order(ent.Asc(device.FieldDeviceCode)).
+		All(ctx)
+}
 
-	if input.AreaCode != nil {
-		cr.SetPosCode(*input.AreaCode)
-	}
+// History is the resolver for the history field.
+func (r *deviceResolver) History(ctx context.Context, obj *ent.Device) ([]*ent.DeviceHistory, error) {
+	return r.DBx.Client.DeviceHistory.Query().
+		Where(devicehistory.DeviceID(obj.ID)).
+		Order(ent.Asc(devicehistory.FieldCreateTime)).
+		All(ctx)
+}
 
-	if input.PosCode != nil {
-		cr.SetPosCode(*input.PosCode)
-	}
+// Attrs is the resolver for the attrs field.
+func (r *deviceResolver) Attrs(ctx context.Context, obj *ent.Device, name *string) ([]*ent.DeviceAttr, error) {
+	q := r.DBx.Client.DeviceAttr.Query()
+	q.Where(deviceattr.DeviceID(obj.ID))
+	q.Order(ent.Asc(deviceattr.FieldName), ent.Asc(deviceattr.FieldValidFrom))
 
-	if input.Pcode != nil {
-		cr.SetPcode(*input.Pcode)
+	if name != nil {
+		q.Where(deviceattr.Name(*name))
 	}
 
-	if input.Memo != nil {
-		cr.SetMemo(*input.Memo)
-	}
+	return q.All(ctx)
+}
 
-	return cr.Save(ctx)
+// Op is the resolver for the op field.
+func (r *deviceHistoryResolver) Op(ctx context.Context, obj *ent.DeviceHistory) (string, error) {
+	return obj.Op.String(), nil
 }
 
-// DeviceModify is the resolver for the deviceModify field.
-func (r *mutationResolver) DeviceModify(ctx context.Context, input model.DeviceModifyInput) (*ent.Device, error) {
-	update := r.DBx.Client.Device.UpdateOneID(input.ID)
-	if input.DeviceSn != nil {
-		update.SetDeviceSn(*input.DeviceSn)
+// DeviceCreate is the resolver for the deviceCreate field.
+func (r *mutationResolver) DeviceCreate(ctx context.Context, input model.DeviceCreateInput) (*ent.Device, error) {
+	// 设备和变更历史在同一个事务里提交
+	var d *ent.Device
+	err := orm.WithTx(ctx, r.DBx.Client, func(tx *ent.Tx) error {
+		cr := tx.Device.Create()
+
+		cr.
+			SetDeviceCode(input.DeviceCode).
+			SetDeviceType(input.DeviceType).
+			SetProject(input.Project)
+
+		if input.DeviceSn != nil {
+			cr.SetDeviceSn(*input.DeviceSn)
+		}
+
+		if input.DeviceName != nil {
+			cr.SetDeviceName(*input.DeviceName)
+		}
+
+		if input.Rate != nil {
+			cr.SetRate(*input.Rate)
+		}
+
+		if input.AreaCode != nil {
+			cr.SetPosCode(*input.AreaCode)
+		}
+
+		if input.PosCode != nil {
+			cr.SetPosCode(*input.PosCode)
+		}
+
+		if input.Pcode != nil {
+			cr.SetPcode(*input.Pcode)
+		}
+
+		if input.Memo != nil {
+			cr.SetMemo(*input.Memo)
+		}
+
+		var err error
+		d, err = cr.Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return d.Unwrap(), nil
+}
 
-	if input.DeviceName != nil {
-		update.SetDeviceName(*input.DeviceName)
+// DeviceModify is the resolver for the deviceModify field.
+func (r *mutationResolver) DeviceModify(ctx context.Context, input model.DeviceModifyInput) (*ent.Device, error) {
+	var d *ent.Device
+	err := orm.WithTx(ctx, r.DBx.Client, func(tx *ent.Tx) error {
+		update := tx.Device.UpdateOneID(input.ID)
+		if input.DeviceSn != nil {
+			update.SetDeviceSn(*input.DeviceSn)
+		}
+
+		if input.DeviceName != nil {
+			update.SetDeviceName(*input.DeviceName)
+		}
+
+		if input.Rate != nil {
+			update.SetRate(*input.Rate)
+		}
+
+		if input.AreaCode != nil {
+			update.SetPosCode(*input.AreaCode)
+		}
+
+		if input.PosCode != nil {
+			update.SetPosCode(*input.PosCode)
+		}
+
+		if input.Pcode != nil {
+			update.SetPcode(*input.Pcode)
+		}
+
+		if input.Memo != nil {
+			update.SetMemo(*input.Memo)
+		}
+
+		var err error
+		d, err = update.Save(audited(ctx, input.Reason, input.EffectiveAt))
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
+	return d.Unwrap(), nil
+}
 
-	if input.Rate != nil {
-		update.SetRate(*input.Rate)
-	}
+// DeviceRemove is the resolver for the deviceRemove field.
+func (r *mutationResolver) DeviceRemove(ctx context.Context, input model.DeviceRemoveInput) (*ent.Device, error) {
+	return r.DBx.RemoveDevice(audited(ctx, input.Reason, nil), input.ID)
+}
 
-	if input.AreaCode != nil {
-		update.SetPosCode(*input.AreaCode)
-	}
+// DeviceRestore is the resolver for the deviceRestore field.
+func (r *mutationResolver) DeviceRestore(ctx context.Context, input model.DeviceRestoreInput) (*ent.Device, error) {
+	return r.DBx.RestoreDevice(audited(ctx, input.Reason, nil), input.ID)
+}
 
-	if input.PosCode != nil {
-		update.SetPosCode(*input.PosCode)
+// DeviceClean is the resolver for the deviceClean field.
+func (r *mutationResolver) DeviceClean(ctx context.Context, input model.DeviceCleanInput) (*model.DeviceCleanResult, error) {
+	before := time.Now().AddDate(0, 0, -input.Days)
+	n, err := r.DBx.PurgeDevices(ctx, before)
+	if err != nil {
+		return nil, err
 	}
+	return &model.DeviceCleanResult{Before: before, Purged: n}, nil
+}
 
-	if input.Pcode != nil {
-		update.SetPcode(*input.Pcode)
+// DeviceImport is the resolver for the deviceImport field.
+func (r *mutationResolver) DeviceImport(ctx context.Context, input model.DeviceImportInput) (*bulk.Report, error) {
+	format, err := bulk.FormatOf(cmp.Or(deref(input.Format), input.File.Filename))
+	if err != nil {
+		return nil, err
 	}
 
-	if input.Memo != nil {
-		update.SetMemo(*input.Memo)
+	rows, err := bulk.Read(input.File.File, format)
+	if err != nil {
+		return nil, err
 	}
 
-	return update.Save(ctx)
-}
-
-// DeviceRemove is the resolver for the deviceRemove field.
-func (r *mutationResolver) DeviceRemove(ctx context.Context, input model.DeviceRemoveInput) (*ent.Device, error) {
-	update := r.DBx.Client.Device.UpdateOneID(input.ID)
-	update.AddIsDel(1)
-	return update.Save(ctx)
+	opt := bulk.Options{Project: deref(input.Project), DryRun: deref(input.DryRun)}
+	return bulk.Import(ctx, r.DBx.Client, rows, opt)
 }
 
-// DeviceClean is the resolver for the deviceClean field.
-func (r *mutationResolver) DeviceClean(ctx context.Context, input *model.DeviceCleanInput) (*model.DeviceCleanResult, error) {
-	return &model.DeviceCleanResult{Name: input.Name}, nil
+// DeviceSetParent is the resolver for the deviceSetParent field.
+func (r *mutationResolver) DeviceSetParent(ctx context.Context, input model.DeviceSetParentInput) (*ent.Device, error) {
+	return r.DBx.SetParent(ctx, input.ID, input.ParentID)
 }
 
 // DeviceQuery is the resolver for the deviceQuery field.
 func (r *queryResolver) DeviceQuery(ctx context.Context, input model.DeviceListInput) ([]*ent.Device, error) {
-	q := r.DBx.Client.Device.Query()
-	q.Where(device.IsDelEQ(0))
-	q.Order(ent.Desc(device.FieldPosCode))
+	f := bulk.Filter{
+		Type:    deref(input.Type),
+		PosCode: deref(input.PosCode),
+		Project: deref(input.Project),
+	}
+	return f.Query(r.DBx.Client).All(ctx)
+}
+
+// DeviceHistory is the resolver for the deviceHistory field.
+func (r *queryResolver) DeviceHistory(ctx context.Context, input model.DeviceHistoryInput) ([]*ent.DeviceHistory, error) {
+	q := r.DBx.Client.DeviceHistory.Query()
+	q.Where(devicehistory.DeviceCode(input.DeviceCode))
+	q.Order(ent.Asc(devicehistory.FieldCreateTime))
 
-	if input.Type != nil {
-		q.Where(device.DeviceTypeEQ(*input.Type))
+	if input.Start != nil {
+		q.Where(devicehistory.CreateTimeGTE(*input.Start))
+	}
+
+	if input.End != nil {
+		q.Where(devicehistory.CreateTimeLT(*input.End))
 	}
 
 	return q.All(ctx)
 }
 
+// DeviceAttrsAt is the resolver for the deviceAttrsAt field.
+func (r *queryResolver) DeviceAttrsAt(ctx context.Context, deviceCode string, at time.Time) ([]*ent.DeviceAttr, error) {
+	return r.DBx.AttrsAt(ctx, deviceCode, at)
+}
+
+// Device returns DeviceResolver implementation.
+func (r *Resolver) Device() DeviceResolver { return &deviceResolver{r} }
+
+// DeviceHistory returns DeviceHistoryResolver implementation.
+func (r *Resolver) DeviceHistory() DeviceHistoryResolver { return &deviceHistoryResolver{r} }
+
 // Mutation returns MutationResolver implementation.
 func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 
@@ -119,6 +244,8 @@ func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
 type (
-	mutationResolver struct{ *Resolver }
-	queryResolver    struct{ *Resolver }
+	deviceResolver        struct{ *Resolver }
+	deviceHistoryResolver struct{ *Resolver }
+	mutationResolver      struct{ *Resolver }
+	queryResolver         struct{ *Resolver }
 )
@@ -0,0 +1,211 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	json "encoding/json/v2"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/twiglab/h2o/archon/edge"
+	"github.com/twiglab/h2o/archon/gql/graph/model"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// Box is the resolver for the box field.
+func (r *deviceResolver) Box(ctx context.Context, obj *ent.Device) (*ent.EdgeBox, error) {
+	if obj.BoxID == nil {
+		return nil, nil
+	}
+	return obj.QueryBox().Only(ctx)
+}
+
+// DriverProps is the resolver for the driverProps field.
+func (r *deviceResolver) DriverProps(ctx context.Context, obj *ent.Device) (map[string]any, error) {
+	if obj.DriverProps == nil {
+		return nil, nil
+	}
+	m := make(map[string]any, len(obj.DriverProps))
+	for k, v := range obj.DriverProps {
+		m[k] = v
+	}
+	return m, nil
+}
+
+// Connections is the resolver for the connections field.
+func (r *edgeBoxResolver) Connections(ctx context.Context, obj *ent.EdgeBox) ([]*model.EdgeConn, error) {
+	cs := make([]*model.EdgeConn, 0, len(obj.Connections))
+	for _, k := range slices.Sorted(maps.Keys(obj.Connections)) {
+		c := obj.Connections[k]
+		cs = append(cs, &model.EdgeConn{Key: k, Plugin: c.Plugin, Options: c.Options})
+	}
+	return cs, nil
+}
+
+// Devices is the resolver for the devices field.
+func (r *edgeBoxResolver) Devices(ctx context.Context, obj *ent.EdgeBox) ([]*ent.Device, error) {
+	return obj.QueryDevices().Order(ent.Asc(device.FieldDeviceCode)).All(ctx)
+}
+
+// EdgeBoxCreate is the resolver for the edgeBoxCreate field.
+func (r *mutationResolver) EdgeBoxCreate(ctx context.Context, input model.EdgeBoxCreateInput) (*ent.EdgeBox, error) {
+	cr := r.DBx.Client.EdgeBox.Create()
+
+	cr.
+		SetCode(input.Code).
+		SetProject(input.Project).
+		SetURL(input.URL)
+
+	if input.Name != nil {
+		cr.SetName(*input.Name)
+	}
+
+	if input.Token != nil {
+		cr.SetToken(*input.Token)
+	}
+
+	if input.Memo != nil {
+		cr.SetMemo(*input.Memo)
+	}
+
+	return cr.Save(ctx)
+}
+
+// EdgeBoxModify is the resolver for the edgeBoxModify field.
+func (r *mutationResolver) EdgeBoxModify(ctx context.Context, input model.EdgeBoxModifyInput) (*ent.EdgeBox, error) {
+	update := r.DBx.Client.EdgeBox.UpdateOneID(input.ID)
+	if input.Name != nil {
+		update.SetName(*input.Name)
+	}
+
+	if input.URL != nil {
+		update.SetURL(*input.URL)
+	}
+
+	if input.Token != nil {
+		update.SetToken(*input.Token)
+	}
+
+	if input.Memo != nil {
+		update.SetMemo(*input.Memo)
+	}
+
+	return update.Save(ctx)
+}
+
+// EdgeConnSet is the resolver for the edgeConnSet field.
+func (r *mutationResolver) EdgeConnSet(ctx context.Context, input model.EdgeConnSetInput) (*ent.EdgeBox, error) {
+	c := provision.Connection{Plugin: input.Plugin, Options: input.Options}
+	return edge.SetConn(ctx, r.DBx.Client, input.BoxID, input.Key, c)
+}
+
+// EdgeConnRemove is the resolver for the edgeConnRemove field.
+func (r *mutationResolver) EdgeConnRemove(ctx context.Context, input model.EdgeConnRemoveInput) (*ent.EdgeBox, error) {
+	return edge.RemoveConn(ctx, r.DBx.Client, input.BoxID, input.Key)
+}
+
+// DriverModelSave is the resolver for the driverModelSave field.
+func (r *mutationResolver) DriverModelSave(ctx context.Context, input model.DriverModelSaveInput) (*ent.DriverModel, error) {
+	m := &ent.DriverModel{
+		Name:        input.Name,
+		Plugin:      input.Plugin,
+		ModelID:     deref(input.ModelID),
+		Description: deref(input.Description),
+		Attributes:  input.Attributes,
+		Points:      input.Points,
+	}
+	return edge.SaveModel(ctx, r.DBx.Client, m)
+}
+
+// DeviceProvision is the resolver for the deviceProvision field.
+func (r *mutationResolver) DeviceProvision(ctx context.Context, input model.DeviceProvisionInput) (*ent.Device, error) {
+	p := edge.Placement{
+		BoxID:     deref(input.BoxID),
+		ConnKey:   deref(input.ConnKey),
+		ModelName: deref(input.ModelName),
+	}
+	if input.DriverProps != nil {
+		p.Props = make(map[string]string, len(input.DriverProps))
+		for k, v := range input.DriverProps {
+			p.Props[k] = fmt.Sprint(v)
+		}
+	}
+	return edge.Assign(audited(ctx, input.Reason, nil), r.DBx.Client, input.ID, p)
+}
+
+// EdgeBoxPush is the resolver for the edgeBoxPush field.
+func (r *mutationResolver) EdgeBoxPush(ctx context.Context, input model.EdgeBoxPushInput) (*provision.Report, error) {
+	rep, err := edge.Push(ctx, r.DBx.Client, input.ID, deref(input.DryRun))
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// EdgeBoxQuery is the resolver for the edgeBoxQuery field.
+func (r *queryResolver) EdgeBoxQuery(ctx context.Context, project *string) ([]*ent.EdgeBox, error) {
+	q := r.DBx.Client.EdgeBox.Query()
+	if project != nil {
+		q.Where(edgebox.Project(*project))
+	}
+	return q.Order(ent.Asc(edgebox.FieldCode)).All(ctx)
+}
+
+// EdgeBox is the resolver for the edgeBox field.
+func (r *queryResolver) EdgeBox(ctx context.Context, id string) (*ent.EdgeBox, error) {
+	return r.DBx.Client.EdgeBox.Get(ctx, id)
+}
+
+// DriverModels is the resolver for the driverModels field.
+func (r *queryResolver) DriverModels(ctx context.Context, plugin *string) ([]*ent.DriverModel, error) {
+	q := r.DBx.Client.DriverModel.Query()
+	if plugin != nil {
+		q.Where(drivermodel.Plugin(*plugin))
+	}
+	return q.Order(ent.Asc(drivermodel.FieldName)).All(ctx)
+}
+
+// EdgeBoxPreview is the resolver for the edgeBoxPreview field.
+func (r *queryResolver) EdgeBoxPreview(ctx context.Context, id string) (*model.EdgePreview, error) {
+	box, err := r.DBx.Client.EdgeBox.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := edge.Build(ctx, r.DBx.Client, box)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(cfg.Plugins)
+	if err != nil {
+		return nil, err
+	}
+	p := &model.EdgePreview{Version: cfg.Version}
+	return p, json.Unmarshal(b, &p.Plugins)
+}
+
+// EdgeBoxStatus is the resolver for the edgeBoxStatus field.
+func (r *queryResolver) EdgeBoxStatus(ctx context.Context, id string) (*provision.Report, error) {
+	box, err := r.DBx.Client.EdgeBox.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	rep, err := edge.Status(ctx, box)
+	if err != nil {
+		return nil, err
+	}
+	return &rep, nil
+}
+
+// EdgeBox returns EdgeBoxResolver implementation.
+func (r *Resolver) EdgeBox() EdgeBoxResolver { return &edgeBoxResolver{r} }
+
+type edgeBoxResolver struct{ *Resolver }
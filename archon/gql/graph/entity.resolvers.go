@@ -0,0 +1,43 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/archon/gql/graph/model"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+)
+
+// FindManyDeviceByDeviceCodes is the resolver for the findManyDeviceByDeviceCodes field.
+func (r *entityResolver) FindManyDeviceByDeviceCodes(ctx context.Context, reps []*model.DeviceByDeviceCodesInput) ([]*ent.Device, error) {
+	codes := make([]string, len(reps))
+	for i, rep := range reps {
+		codes[i] = rep.DeviceCode
+	}
+
+	ds, err := r.DBx.Client.Device.Query().Where(device.DeviceCodeIn(codes...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byCode := make(map[string]*ent.Device, len(ds))
+	for _, d := range ds {
+		byCode[d.DeviceCode] = d
+	}
+
+	// 按 reps 的顺序返回, 查不到(不存在或者无权限)的为 nil
+	res := make([]*ent.Device, len(reps))
+	for i, code := range codes {
+		res[i] = byCode[code]
+	}
+	return res, nil
+}
+
+// Entity returns EntityResolver implementation.
+func (r *Resolver) Entity() EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
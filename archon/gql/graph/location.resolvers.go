@@ -0,0 +1,226 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/archon/gql/graph/model"
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+)
+
+// Location is the resolver for the location field.
+func (r *deviceResolver) Location(ctx context.Context, obj *ent.Device) (*ent.Location, error) {
+	if obj.LocationID == nil {
+		return nil, nil
+	}
+	return r.DBx.Client.Location.Get(ctx, *obj.LocationID)
+}
+
+// Kind is the resolver for the kind field.
+func (r *locationResolver) Kind(ctx context.Context, obj *ent.Location) (string, error) {
+	return obj.Kind.String(), nil
+}
+
+// Parent is the resolver for the parent field.
+func (r *locationResolver) Parent(ctx context.Context, obj *ent.Location) (*ent.Location, error) {
+	if obj.ParentID == nil {
+		return nil, nil
+	}
+	return r.DBx.Client.Location.Get(ctx, *obj.ParentID)
+}
+
+// Children is the resolver for the children field.
+func (r *locationResolver) Children(ctx context.Context, obj *ent.Location) ([]*ent.Location, error) {
+	return obj.QueryChildren().Order(ent.Asc(location.FieldCode)).All(ctx)
+}
+
+// Devices is the resolver for the devices field.
+func (r *locationResolver) Devices(ctx context.Context, obj *ent.Location) ([]*ent.Device, error) {
+	return obj.QueryDevices().
+		Order(ent.Asc(device.FieldDeviceCode)).
+		All(ctx)
+}
+
+// Occupancies is the resolver for the occupancies field.
+func (r *locationResolver) Occupancies(ctx context.Context, obj *ent.Location, at *time.Time) ([]*ent.Occupancy, error) {
+	q := obj.QueryOccupancies().Order(ent.Asc(occupancy.FieldStartAt))
+	if at != nil {
+		q.Where(
+			occupancy.StartAtLTE(*at),
+			occupancy.Or(occupancy.EndAtIsNil(), occupancy.EndAtGT(*at)),
+		)
+	}
+	return q.All(ctx)
+}
+
+// LocationCreate is the resolver for the locationCreate field.
+func (r *mutationResolver) LocationCreate(ctx context.Context, input model.LocationCreateInput) (*ent.Location, error) {
+	kind := location.Kind(input.Kind)
+	if err := location.KindValidator(kind); err != nil {
+		return nil, err
+	}
+	return r.DBx.CreateLocation(ctx, orm.LocationInput{
+		Kind:     kind,
+		Code:     input.Code,
+		Name:     deref(input.Name),
+		Memo:     deref(input.Memo),
+		ParentID: input.ParentID,
+	})
+}
+
+// LocationModify is the resolver for the locationModify field.
+func (r *mutationResolver) LocationModify(ctx context.Context, input model.LocationModifyInput) (*ent.Location, error) {
+	return r.DBx.ModifyLocation(ctx, input.ID, orm.LocationPatch{
+		Code: input.Code,
+		Name: input.Name,
+		Memo: input.Memo,
+	})
+}
+
+// LocationRemove is the resolver for the locationRemove field.
+func (r *mutationResolver) LocationRemove(ctx context.Context, input model.LocationRemoveInput) (*ent.Location, error) {
+	return r.DBx.RemoveLocation(ctx, input.ID)
+}
+
+// DeviceMove is the resolver for the deviceMove field.
+func (r *mutationResolver) DeviceMove(ctx context.Context, input model.DeviceMoveInput) (*ent.Device, error) {
+	return r.DBx.MoveDevice(audited(ctx, input.Reason, input.EffectiveAt), input.ID, input.LocationID)
+}
+
+// TenantCreate is the resolver for the tenantCreate field.
+func (r *mutationResolver) TenantCreate(ctx context.Context, input model.TenantCreateInput) (*ent.Tenant, error) {
+	cr := r.DBx.Client.Tenant.Create()
+
+	cr.
+		SetCode(input.Code).
+		SetName(input.Name)
+
+	if input.Contact != nil {
+		cr.SetContact(*input.Contact)
+	}
+
+	if input.Phone != nil {
+		cr.SetPhone(*input.Phone)
+	}
+
+	if input.Memo != nil {
+		cr.SetMemo(*input.Memo)
+	}
+
+	return cr.Save(ctx)
+}
+
+// TenantModify is the resolver for the tenantModify field.
+func (r *mutationResolver) TenantModify(ctx context.Context, input model.TenantModifyInput) (*ent.Tenant, error) {
+	update := r.DBx.Client.Tenant.UpdateOneID(input.ID)
+	if input.Name != nil {
+		update.SetName(*input.Name)
+	}
+
+	if input.Contact != nil {
+		update.SetContact(*input.Contact)
+	}
+
+	if input.Phone != nil {
+		update.SetPhone(*input.Phone)
+	}
+
+	if input.Memo != nil {
+		update.SetMemo(*input.Memo)
+	}
+
+	return update.Save(ctx)
+}
+
+// OccupancyCreate is the resolver for the occupancyCreate field.
+func (r *mutationResolver) OccupancyCreate(ctx context.Context, input model.OccupancyCreateInput) (*ent.Occupancy, error) {
+	role := occupancy.Role(input.Role)
+	if err := occupancy.RoleValidator(role); err != nil {
+		return nil, err
+	}
+	return r.DBx.CreateOccupancy(ctx, orm.OccupancyInput{
+		TenantID: input.TenantID,
+		UnitID:   input.UnitID,
+		Role:     role,
+		StartAt:  input.StartAt,
+		EndAt:    input.EndAt,
+		Memo:     deref(input.Memo),
+	})
+}
+
+// OccupancyEnd is the resolver for the occupancyEnd field.
+func (r *mutationResolver) OccupancyEnd(ctx context.Context, input model.OccupancyEndInput) (*ent.Occupancy, error) {
+	return r.DBx.EndOccupancy(ctx, input.ID, input.EndAt)
+}
+
+// Role is the resolver for the role field.
+func (r *occupancyResolver) Role(ctx context.Context, obj *ent.Occupancy) (string, error) {
+	return obj.Role.String(), nil
+}
+
+// Tenant is the resolver for the tenant field.
+func (r *occupancyResolver) Tenant(ctx context.Context, obj *ent.Occupancy) (*ent.Tenant, error) {
+	return r.DBx.Client.Tenant.Get(ctx, obj.TenantID)
+}
+
+// Unit is the resolver for the unit field.
+func (r *occupancyResolver) Unit(ctx context.Context, obj *ent.Occupancy) (*ent.Location, error) {
+	return r.DBx.Client.Location.Get(ctx, obj.UnitID)
+}
+
+// LocationTree is the resolver for the locationTree field.
+func (r *queryResolver) LocationTree(ctx context.Context, project string) ([]*ent.Location, error) {
+	return r.DBx.Tree(ctx, project)
+}
+
+// Location is the resolver for the location field.
+func (r *queryResolver) Location(ctx context.Context, id string) (*ent.Location, error) {
+	return r.DBx.Client.Location.Get(ctx, id)
+}
+
+// TenantQuery is the resolver for the tenantQuery field.
+func (r *queryResolver) TenantQuery(ctx context.Context, code *string) ([]*ent.Tenant, error) {
+	q := r.DBx.Client.Tenant.Query()
+	q.Order(ent.Asc(tenant.FieldCode))
+
+	if code != nil {
+		q.Where(tenant.CodeEQ(*code))
+	}
+
+	return q.All(ctx)
+}
+
+// TenantMeters is the resolver for the tenantMeters field.
+func (r *queryResolver) TenantMeters(ctx context.Context, input model.TenantMetersInput) ([]*orm.TenantMeter, error) {
+	return r.DBx.TenantMeters(ctx, input.TenantID, input.From, deref(input.To))
+}
+
+// Occupancies is the resolver for the occupancies field.
+func (r *tenantResolver) Occupancies(ctx context.Context, obj *ent.Tenant) ([]*ent.Occupancy, error) {
+	return obj.QueryOccupancies().Order(ent.Asc(occupancy.FieldStartAt)).All(ctx)
+}
+
+// Location returns LocationResolver implementation.
+func (r *Resolver) Location() LocationResolver { return &locationResolver{r} }
+
+// Occupancy returns OccupancyResolver implementation.
+func (r *Resolver) Occupancy() OccupancyResolver { return &occupancyResolver{r} }
+
+// Tenant returns TenantResolver implementation.
+func (r *Resolver) Tenant() TenantResolver { return &tenantResolver{r} }
+
+type (
+	locationResolver  struct{ *Resolver }
+	occupancyResolver struct{ *Resolver }
+	tenantResolver    struct{ *Resolver }
+)
@@ -2,12 +2,23 @@
 
 package model
 
+import (
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+type DeviceByDeviceCodesInput struct {
+	DeviceCode string `json:"DeviceCode"`
+}
+
 type DeviceCleanInput struct {
-	Name string `json:"name"`
+	Days int `json:"days"`
 }
 
 type DeviceCleanResult struct {
-	Name string `json:"name"`
+	Before time.Time `json:"before"`
+	Purged int       `json:"purged"`
 }
 
 type DeviceCreateInput struct {
@@ -23,22 +34,174 @@ type DeviceCreateInput struct {
 	Memo       *string `json:"memo,omitempty"`
 }
 
+type DeviceHistoryInput struct {
+	DeviceCode string     `json:"deviceCode"`
+	Start      *time.Time `json:"start,omitempty"`
+	End        *time.Time `json:"end,omitempty"`
+}
+
+type DeviceImportInput struct {
+	File    graphql.Upload `json:"file"`
+	Format  *string        `json:"format,omitempty"`
+	Project *string        `json:"project,omitempty"`
+	DryRun  *bool          `json:"dryRun,omitempty"`
+}
+
 type DeviceListInput struct {
 	Type    *string `json:"type,omitempty"`
 	PosCode *string `json:"posCode,omitempty"`
+	Project *string `json:"project,omitempty"`
 }
 
 type DeviceModifyInput struct {
-	ID         string  `json:"id"`
-	DeviceSn   *string `json:"deviceSN,omitempty"`
-	DeviceName *string `json:"deviceName,omitempty"`
-	Rate       *int    `json:"rate,omitempty"`
-	PosCode    *string `json:"posCode,omitempty"`
-	AreaCode   *string `json:"areaCode,omitempty"`
-	Pcode      *string `json:"pcode,omitempty"`
-	Memo       *string `json:"memo,omitempty"`
+	ID          string     `json:"id"`
+	Reason      *string    `json:"reason,omitempty"`
+	EffectiveAt *time.Time `json:"effectiveAt,omitempty"`
+	DeviceSn    *string    `json:"deviceSN,omitempty"`
+	DeviceName  *string    `json:"deviceName,omitempty"`
+	Rate        *int       `json:"rate,omitempty"`
+	PosCode     *string    `json:"posCode,omitempty"`
+	AreaCode    *string    `json:"areaCode,omitempty"`
+	Pcode       *string    `json:"pcode,omitempty"`
+	Memo        *string    `json:"memo,omitempty"`
+}
+
+type DeviceMoveInput struct {
+	ID          string     `json:"id"`
+	LocationID  string     `json:"locationId"`
+	Reason      *string    `json:"reason,omitempty"`
+	EffectiveAt *time.Time `json:"effectiveAt,omitempty"`
+}
+
+type DeviceProvisionInput struct {
+	ID          string         `json:"id"`
+	BoxID       *string        `json:"boxId,omitempty"`
+	ConnKey     *string        `json:"connKey,omitempty"`
+	ModelName   *string        `json:"modelName,omitempty"`
+	DriverProps map[string]any `json:"driverProps,omitempty"`
+	Reason      *string        `json:"reason,omitempty"`
 }
 
 type DeviceRemoveInput struct {
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+type DeviceRestoreInput struct {
+	ID     string  `json:"id"`
+	Reason *string `json:"reason,omitempty"`
+}
+
+type DeviceSetParentInput struct {
+	ID       string  `json:"id"`
+	ParentID *string `json:"parentId,omitempty"`
+}
+
+type DriverModelSaveInput struct {
+	Name        string           `json:"name"`
+	Plugin      string           `json:"plugin"`
+	Points      []map[string]any `json:"points"`
+	ModelID     *string          `json:"modelId,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Attributes  map[string]any   `json:"attributes,omitempty"`
+}
+
+type EdgeBoxCreateInput struct {
+	Code    string  `json:"code"`
+	Project string  `json:"project"`
+	URL     string  `json:"url"`
+	Name    *string `json:"name,omitempty"`
+	Token   *string `json:"token,omitempty"`
+	Memo    *string `json:"memo,omitempty"`
+}
+
+type EdgeBoxModifyInput struct {
+	ID    string  `json:"id"`
+	Name  *string `json:"name,omitempty"`
+	URL   *string `json:"url,omitempty"`
+	Token *string `json:"token,omitempty"`
+	Memo  *string `json:"memo,omitempty"`
+}
+
+type EdgeBoxPushInput struct {
+	ID     string `json:"id"`
+	DryRun *bool  `json:"dryRun,omitempty"`
+}
+
+type EdgeConn struct {
+	Key     string         `json:"key"`
+	Plugin  string         `json:"plugin"`
+	Options map[string]any `json:"options,omitempty"`
+}
+
+type EdgeConnRemoveInput struct {
+	BoxID string `json:"boxId"`
+	Key   string `json:"key"`
+}
+
+type EdgeConnSetInput struct {
+	BoxID   string         `json:"boxId"`
+	Key     string         `json:"key"`
+	Plugin  string         `json:"plugin"`
+	Options map[string]any `json:"options,omitempty"`
+}
+
+type EdgePreview struct {
+	Version string           `json:"version"`
+	Plugins []map[string]any `json:"plugins"`
+}
+
+type LocationCreateInput struct {
+	Kind     string  `json:"kind"`
+	Code     string  `json:"code"`
+	ParentID *string `json:"parentId,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Memo     *string `json:"memo,omitempty"`
+}
+
+type LocationModifyInput struct {
+	ID   string  `json:"id"`
+	Code *string `json:"code,omitempty"`
+	Name *string `json:"name,omitempty"`
+	Memo *string `json:"memo,omitempty"`
+}
+
+type LocationRemoveInput struct {
 	ID string `json:"id"`
 }
+
+type OccupancyCreateInput struct {
+	TenantID string     `json:"tenantId"`
+	UnitID   string     `json:"unitId"`
+	Role     string     `json:"role"`
+	StartAt  time.Time  `json:"startAt"`
+	EndAt    *time.Time `json:"endAt,omitempty"`
+	Memo     *string    `json:"memo,omitempty"`
+}
+
+type OccupancyEndInput struct {
+	ID    string    `json:"id"`
+	EndAt time.Time `json:"endAt"`
+}
+
+type TenantCreateInput struct {
+	Code    string  `json:"code"`
+	Name    string  `json:"name"`
+	Contact *string `json:"contact,omitempty"`
+	Phone   *string `json:"phone,omitempty"`
+	Memo    *string `json:"memo,omitempty"`
+}
+
+type TenantMetersInput struct {
+	TenantID string     `json:"tenantId"`
+	From     time.Time  `json:"from"`
+	To       *time.Time `json:"to,omitempty"`
+}
+
+type TenantModifyInput struct {
+	ID      string  `json:"id"`
+	Name    *string `json:"name,omitempty"`
+	Contact *string `json:"contact,omitempty"`
+	Phone   *string `json:"phone,omitempty"`
+	Memo    *string `json:"memo,omitempty"`
+}
@@ -1,6 +1,12 @@
 package graph
 
-import "github.com/twiglab/h2o/archon/orm"
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/orm"
+)
 
 // This file will not be regenerated automatically.
 //
@@ -8,3 +14,22 @@ import "github.com/twiglab/h2o/archon/orm"
 // here.
 
 type Resolver struct{ DBx orm.DBx }
+
+func deref[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
+}
+
+// audited 把变更原因和生效时间带给审计的 hook
+func audited(ctx context.Context, reason *string, at *time.Time) context.Context {
+	if reason != nil {
+		ctx = audit.WithReason(ctx, *reason)
+	}
+	if at != nil {
+		ctx = audit.WithEffective(ctx, *at)
+	}
+	return ctx
+}
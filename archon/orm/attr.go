@@ -0,0 +1,21 @@
+package orm
+
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+)
+
+// AttrsAt 设备在 at 时刻生效的属性
+func (x DBx) AttrsAt(ctx context.Context, code string, at time.Time) ([]*ent.DeviceAttr, error) {
+	return x.Client.DeviceAttr.Query().
+		Where(
+			deviceattr.DeviceCode(code),
+			deviceattr.ValidFromLTE(at),
+			deviceattr.Or(deviceattr.ValidToIsNil(), deviceattr.ValidToGT(at)),
+		).
+		Order(ent.Asc(deviceattr.FieldName)).
+		All(ctx)
+}
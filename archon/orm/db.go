@@ -1,6 +1,6 @@
 package orm
 
-//go:generate go tool ent generate ./schema --target ./ent --feature sql/execquery,sql/upsert,privacy,sql/lock
+//go:generate go tool ent generate ./schema --target ./ent --feature sql/execquery,sql/upsert,privacy,intercept,sql/lock --template ./template
 
 import (
 	"context"
@@ -11,6 +11,8 @@ import (
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/twiglab/h2o/archon/orm/ent"
 
+	_ "github.com/twiglab/h2o/archon/orm/ent/runtime"
+
 	_ "github.com/mattn/go-sqlite3"
 )
 
@@ -1,7 +1,24 @@
 package orm
 
-import "github.com/twiglab/h2o/archon/orm/ent"
+import (
+	"context"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+)
 
 type DBx struct {
 	Client *ent.Client
 }
+
+// WithTx 在事务中执行 fn, fn 返回错误时回滚
+func WithTx(ctx context.Context, cli *ent.Client, fn func(tx *ent.Tx) error) error {
+	tx, err := cli.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,111 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+var (
+	ErrCodeInUse   = errors.New("device code in use")
+	ErrRetention   = errors.New("invalid retention")
+	ErrHasChildren = errors.New("device has children")
+)
+
+// RemoveDevice 软删除, 删除后设备号可以给新的设备用
+// 还有在用的下级表时不能删除, 先删除下级表或者改挂到其他表下
+func (x DBx) RemoveDevice(ctx context.Context, id string) (*ent.Device, error) {
+	var d *ent.Device
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		now := time.Now()
+		var err error
+		d, err = tx.Device.UpdateOneID(id).
+			Where(device.IsDel(0)).
+			SetIsDel(now.UnixNano()).
+			SetDeleteTime(now).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		exist, err := tx.Device.Query().Where(device.ParentID(id)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("%w: %s", ErrHasChildren, d.DeviceCode)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.Unwrap(), nil
+}
+
+// RestoreDevice 恢复软删除的设备, 设备号已经被在用的设备占用时不能恢复
+func (x DBx) RestoreDevice(ctx context.Context, id string) (*ent.Device, error) {
+	var d *ent.Device
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		var err error
+		d, err = tx.Device.Query().
+			Where(device.ID(id), device.IsDelNEQ(0)).
+			Only(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return err
+		}
+
+		exist, err := tx.Device.Query().Where(device.DeviceCode(d.DeviceCode)).Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("%w: %s", ErrCodeInUse, d.DeviceCode)
+		}
+
+		d, err = tx.Device.UpdateOne(d).SetIsDel(0).ClearDeleteTime().Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.Unwrap(), nil
+}
+
+// PurgeDevices 物理删除 before 之前软删除的设备, 返回删除的数量
+// 变更历史和属性保留, 下级表的上级清空
+func (x DBx) PurgeDevices(ctx context.Context, before time.Time) (int, error) {
+	if before.After(time.Now()) {
+		return 0, fmt.Errorf("%w: %s is in the future", ErrRetention, before.Format(time.DateTime))
+	}
+	ctx = schema.SkipSoftDelete(ctx)
+
+	var n int
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		ids, err := tx.Device.Query().
+			Where(
+				device.IsDelNEQ(0),
+				device.Or(
+					device.DeleteTimeLT(before),
+					// 早期软删除的 is_del 为 1, 没有删除时间, 按最后修改时间
+					device.And(device.DeleteTimeIsNil(), device.UpdateTimeLT(before)),
+				),
+			).
+			IDs(ctx)
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+
+		if err := tx.Device.Update().Where(device.ParentIDIn(ids...)).ClearParentID().Exec(ctx); err != nil {
+			return err
+		}
+
+		n, err = tx.Device.Delete().Where(device.IDIn(ids...)).Exec(ctx)
+		return err
+	})
+	return n, err
+}
@@ -0,0 +1,114 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+func TestPurgeDevicesLegacyRows(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	now := time.Now()
+	old := now.AddDate(0, 0, -100)
+
+	create := func(code string) string {
+		return mustDevice(t, ctx, x, code).ID
+	}
+	live := create("LIVE")
+	deleted := create("DELETED")
+	legacyOld := create("LEGACY_OLD")
+	legacyNew := create("LEGACY_NEW")
+
+	all := schema.SkipSoftDelete(ctx)
+	mustTx(t, ctx, x, func(tx *ent.Tx) error {
+		tx.Device.UpdateOneID(deleted).SetIsDel(now.UnixNano()).SetDeleteTime(old).ExecX(all)
+		// 早期的软删除: is_del 为 1, 没有删除时间
+		tx.Device.UpdateOneID(legacyOld).SetIsDel(1).ExecX(all)
+		tx.Device.UpdateOneID(legacyNew).SetIsDel(1).ExecX(all)
+		return nil
+	})
+	if _, err := x.Client.ExecContext(ctx, "UPDATE device SET update_time = ? WHERE id = ?", old, legacyOld); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := x.PurgeDevices(ctx, now.AddDate(0, 0, -30))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("purged %d, want 2", n)
+	}
+	left := x.Client.Device.Query().Order(device.ByDeviceCode()).IDsX(all)
+	if len(left) != 2 || left[0] != legacyNew || left[1] != live {
+		t.Fatalf("left %v, want [LEGACY_NEW LIVE]", left)
+	}
+}
+
+func TestDeviceAuditInTx(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+
+	if _, err := x.Client.Device.Create().SetDeviceCode("D1").SetDeviceType("E").SetProject("P1").Save(ctx); !errors.Is(err, audit.ErrNotInTx) {
+		t.Fatalf("create outside tx: err = %v, want ErrNotInTx", err)
+	}
+
+	// 变更之后出错, 设备和变更历史一起回滚
+	errAbort := errors.New("abort")
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		tx.Device.Create().SetDeviceCode("D1").SetDeviceType("E").SetProject("P1").SaveX(ctx)
+		return errAbort
+	})
+	if !errors.Is(err, errAbort) {
+		t.Fatalf("err = %v, want abort", err)
+	}
+	if n := x.Client.Device.Query().CountX(ctx); n != 0 {
+		t.Fatalf("%d devices after rollback, want 0", n)
+	}
+	if n := x.Client.DeviceHistory.Query().CountX(ctx); n != 0 {
+		t.Fatalf("%d histories after rollback, want 0", n)
+	}
+
+	d := mustDevice(t, ctx, x, "D1")
+	if _, err := x.RemoveDevice(ctx, d.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n := x.Client.DeviceHistory.Query().CountX(ctx); n != 2 {
+		t.Fatalf("%d histories, want create and remove", n)
+	}
+}
+
+func TestRemoveDeviceWithChildren(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	parent := mustDevice(t, ctx, x, "P")
+	child := mustDevice(t, ctx, x, "C")
+	mustTx(t, ctx, x, func(tx *ent.Tx) error {
+		return tx.Device.UpdateOneID(child.ID).SetParentID(parent.ID).Exec(ctx)
+	})
+
+	if _, err := x.RemoveDevice(ctx, parent.ID); !errors.Is(err, ErrHasChildren) {
+		t.Fatalf("err = %v, want ErrHasChildren", err)
+	}
+	// 删除和变更历史一起回滚
+	if d := x.Client.Device.GetX(ctx, parent.ID); d.IsDel != 0 {
+		t.Fatalf("parent is_del = %d after rejected remove", d.IsDel)
+	}
+	if n := x.Client.DeviceHistory.Query().CountX(ctx); n != 3 {
+		t.Fatalf("%d histories, want 3", n)
+	}
+
+	// 下级表删除之后可以删除
+	if _, err := x.RemoveDevice(ctx, child.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.RemoveDevice(ctx, parent.ID); err != nil {
+		t.Fatal(err)
+	}
+}
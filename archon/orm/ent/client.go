@@ -14,7 +14,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
 
 	stdsql "database/sql"
 )
@@ -26,6 +34,20 @@ type Client struct {
 	Schema *migrate.Schema
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceAttr is the client for interacting with the DeviceAttr builders.
+	DeviceAttr *DeviceAttrClient
+	// DeviceHistory is the client for interacting with the DeviceHistory builders.
+	DeviceHistory *DeviceHistoryClient
+	// DriverModel is the client for interacting with the DriverModel builders.
+	DriverModel *DriverModelClient
+	// EdgeBox is the client for interacting with the EdgeBox builders.
+	EdgeBox *EdgeBoxClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// Occupancy is the client for interacting with the Occupancy builders.
+	Occupancy *OccupancyClient
+	// Tenant is the client for interacting with the Tenant builders.
+	Tenant *TenantClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -38,6 +60,13 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Device = NewDeviceClient(c.config)
+	c.DeviceAttr = NewDeviceAttrClient(c.config)
+	c.DeviceHistory = NewDeviceHistoryClient(c.config)
+	c.DriverModel = NewDriverModelClient(c.config)
+	c.EdgeBox = NewEdgeBoxClient(c.config)
+	c.Location = NewLocationClient(c.config)
+	c.Occupancy = NewOccupancyClient(c.config)
+	c.Tenant = NewTenantClient(c.config)
 }
 
 type (
@@ -128,9 +157,16 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Device: NewDeviceClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Device:        NewDeviceClient(cfg),
+		DeviceAttr:    NewDeviceAttrClient(cfg),
+		DeviceHistory: NewDeviceHistoryClient(cfg),
+		DriverModel:   NewDriverModelClient(cfg),
+		EdgeBox:       NewEdgeBoxClient(cfg),
+		Location:      NewLocationClient(cfg),
+		Occupancy:     NewOccupancyClient(cfg),
+		Tenant:        NewTenantClient(cfg),
 	}, nil
 }
 
@@ -148,9 +184,16 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:    ctx,
-		config: cfg,
-		Device: NewDeviceClient(cfg),
+		ctx:           ctx,
+		config:        cfg,
+		Device:        NewDeviceClient(cfg),
+		DeviceAttr:    NewDeviceAttrClient(cfg),
+		DeviceHistory: NewDeviceHistoryClient(cfg),
+		DriverModel:   NewDriverModelClient(cfg),
+		EdgeBox:       NewEdgeBoxClient(cfg),
+		Location:      NewLocationClient(cfg),
+		Occupancy:     NewOccupancyClient(cfg),
+		Tenant:        NewTenantClient(cfg),
 	}, nil
 }
 
@@ -179,13 +222,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Device.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.Device, c.DeviceAttr, c.DeviceHistory, c.DriverModel, c.EdgeBox, c.Location,
+		c.Occupancy, c.Tenant,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Device.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.Device, c.DeviceAttr, c.DeviceHistory, c.DriverModel, c.EdgeBox, c.Location,
+		c.Occupancy, c.Tenant,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -193,6 +246,20 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *DeviceAttrMutation:
+		return c.DeviceAttr.mutate(ctx, m)
+	case *DeviceHistoryMutation:
+		return c.DeviceHistory.mutate(ctx, m)
+	case *DriverModelMutation:
+		return c.DriverModel.mutate(ctx, m)
+	case *EdgeBoxMutation:
+		return c.EdgeBox.mutate(ctx, m)
+	case *LocationMutation:
+		return c.Location.mutate(ctx, m)
+	case *OccupancyMutation:
+		return c.Occupancy.mutate(ctx, m)
+	case *TenantMutation:
+		return c.Tenant.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -306,14 +373,80 @@ func (c *DeviceClient) GetX(ctx context.Context, id string) *Device {
 	return obj
 }
 
+// QueryLocation queries the location edge of a Device.
+func (c *DeviceClient) QueryLocation(_m *Device) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.LocationTable, device.LocationColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryParent queries the parent edge of a Device.
+func (c *DeviceClient) QueryParent(_m *Device) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.ParentTable, device.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Device.
+func (c *DeviceClient) QueryChildren(_m *Device) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, device.ChildrenTable, device.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryBox queries the box edge of a Device.
+func (c *DeviceClient) QueryBox(_m *Device) *EdgeBoxQuery {
+	query := (&EdgeBoxClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(device.Table, device.FieldID, id),
+			sqlgraph.To(edgebox.Table, edgebox.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, device.BoxTable, device.BoxColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *DeviceClient) Hooks() []Hook {
-	return c.hooks.Device
+	hooks := c.hooks.Device
+	return append(hooks[:len(hooks):len(hooks)], device.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
 func (c *DeviceClient) Interceptors() []Interceptor {
-	return c.inters.Device
+	inters := c.inters.Device
+	return append(inters[:len(inters):len(inters)], device.Interceptors[:]...)
 }
 
 func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, error) {
@@ -331,13 +464,1081 @@ func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, er
 	}
 }
 
+// DeviceAttrClient is a client for the DeviceAttr schema.
+type DeviceAttrClient struct {
+	config
+}
+
+// NewDeviceAttrClient returns a client for the DeviceAttr from the given config.
+func NewDeviceAttrClient(c config) *DeviceAttrClient {
+	return &DeviceAttrClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceattr.Hooks(f(g(h())))`.
+func (c *DeviceAttrClient) Use(hooks ...Hook) {
+	c.hooks.DeviceAttr = append(c.hooks.DeviceAttr, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceattr.Intercept(f(g(h())))`.
+func (c *DeviceAttrClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceAttr = append(c.inters.DeviceAttr, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceAttr entity.
+func (c *DeviceAttrClient) Create() *DeviceAttrCreate {
+	mutation := newDeviceAttrMutation(c.config, OpCreate)
+	return &DeviceAttrCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceAttr entities.
+func (c *DeviceAttrClient) CreateBulk(builders ...*DeviceAttrCreate) *DeviceAttrCreateBulk {
+	return &DeviceAttrCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceAttrClient) MapCreateBulk(slice any, setFunc func(*DeviceAttrCreate, int)) *DeviceAttrCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceAttrCreateBulk{err: fmt.Errorf("calling to DeviceAttrClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceAttrCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceAttrCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceAttr.
+func (c *DeviceAttrClient) Update() *DeviceAttrUpdate {
+	mutation := newDeviceAttrMutation(c.config, OpUpdate)
+	return &DeviceAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceAttrClient) UpdateOne(_m *DeviceAttr) *DeviceAttrUpdateOne {
+	mutation := newDeviceAttrMutation(c.config, OpUpdateOne, withDeviceAttr(_m))
+	return &DeviceAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceAttrClient) UpdateOneID(id string) *DeviceAttrUpdateOne {
+	mutation := newDeviceAttrMutation(c.config, OpUpdateOne, withDeviceAttrID(id))
+	return &DeviceAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceAttr.
+func (c *DeviceAttrClient) Delete() *DeviceAttrDelete {
+	mutation := newDeviceAttrMutation(c.config, OpDelete)
+	return &DeviceAttrDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceAttrClient) DeleteOne(_m *DeviceAttr) *DeviceAttrDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceAttrClient) DeleteOneID(id string) *DeviceAttrDeleteOne {
+	builder := c.Delete().Where(deviceattr.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceAttrDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceAttr.
+func (c *DeviceAttrClient) Query() *DeviceAttrQuery {
+	return &DeviceAttrQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceAttr},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceAttr entity by its id.
+func (c *DeviceAttrClient) Get(ctx context.Context, id string) (*DeviceAttr, error) {
+	return c.Query().Where(deviceattr.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceAttrClient) GetX(ctx context.Context, id string) *DeviceAttr {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceAttrClient) Hooks() []Hook {
+	hooks := c.hooks.DeviceAttr
+	return append(hooks[:len(hooks):len(hooks)], deviceattr.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceAttrClient) Interceptors() []Interceptor {
+	return c.inters.DeviceAttr
+}
+
+func (c *DeviceAttrClient) mutate(ctx context.Context, m *DeviceAttrMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceAttrCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceAttrUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceAttrUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceAttrDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceAttr mutation op: %q", m.Op())
+	}
+}
+
+// DeviceHistoryClient is a client for the DeviceHistory schema.
+type DeviceHistoryClient struct {
+	config
+}
+
+// NewDeviceHistoryClient returns a client for the DeviceHistory from the given config.
+func NewDeviceHistoryClient(c config) *DeviceHistoryClient {
+	return &DeviceHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `devicehistory.Hooks(f(g(h())))`.
+func (c *DeviceHistoryClient) Use(hooks ...Hook) {
+	c.hooks.DeviceHistory = append(c.hooks.DeviceHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `devicehistory.Intercept(f(g(h())))`.
+func (c *DeviceHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceHistory = append(c.inters.DeviceHistory, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceHistory entity.
+func (c *DeviceHistoryClient) Create() *DeviceHistoryCreate {
+	mutation := newDeviceHistoryMutation(c.config, OpCreate)
+	return &DeviceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceHistory entities.
+func (c *DeviceHistoryClient) CreateBulk(builders ...*DeviceHistoryCreate) *DeviceHistoryCreateBulk {
+	return &DeviceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceHistoryClient) MapCreateBulk(slice any, setFunc func(*DeviceHistoryCreate, int)) *DeviceHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceHistoryCreateBulk{err: fmt.Errorf("calling to DeviceHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceHistory.
+func (c *DeviceHistoryClient) Update() *DeviceHistoryUpdate {
+	mutation := newDeviceHistoryMutation(c.config, OpUpdate)
+	return &DeviceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceHistoryClient) UpdateOne(_m *DeviceHistory) *DeviceHistoryUpdateOne {
+	mutation := newDeviceHistoryMutation(c.config, OpUpdateOne, withDeviceHistory(_m))
+	return &DeviceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceHistoryClient) UpdateOneID(id string) *DeviceHistoryUpdateOne {
+	mutation := newDeviceHistoryMutation(c.config, OpUpdateOne, withDeviceHistoryID(id))
+	return &DeviceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceHistory.
+func (c *DeviceHistoryClient) Delete() *DeviceHistoryDelete {
+	mutation := newDeviceHistoryMutation(c.config, OpDelete)
+	return &DeviceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceHistoryClient) DeleteOne(_m *DeviceHistory) *DeviceHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceHistoryClient) DeleteOneID(id string) *DeviceHistoryDeleteOne {
+	builder := c.Delete().Where(devicehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceHistory.
+func (c *DeviceHistoryClient) Query() *DeviceHistoryQuery {
+	return &DeviceHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceHistory entity by its id.
+func (c *DeviceHistoryClient) Get(ctx context.Context, id string) (*DeviceHistory, error) {
+	return c.Query().Where(devicehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceHistoryClient) GetX(ctx context.Context, id string) *DeviceHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceHistoryClient) Hooks() []Hook {
+	hooks := c.hooks.DeviceHistory
+	return append(hooks[:len(hooks):len(hooks)], devicehistory.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceHistoryClient) Interceptors() []Interceptor {
+	return c.inters.DeviceHistory
+}
+
+func (c *DeviceHistoryClient) mutate(ctx context.Context, m *DeviceHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceHistory mutation op: %q", m.Op())
+	}
+}
+
+// DriverModelClient is a client for the DriverModel schema.
+type DriverModelClient struct {
+	config
+}
+
+// NewDriverModelClient returns a client for the DriverModel from the given config.
+func NewDriverModelClient(c config) *DriverModelClient {
+	return &DriverModelClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `drivermodel.Hooks(f(g(h())))`.
+func (c *DriverModelClient) Use(hooks ...Hook) {
+	c.hooks.DriverModel = append(c.hooks.DriverModel, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `drivermodel.Intercept(f(g(h())))`.
+func (c *DriverModelClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DriverModel = append(c.inters.DriverModel, interceptors...)
+}
+
+// Create returns a builder for creating a DriverModel entity.
+func (c *DriverModelClient) Create() *DriverModelCreate {
+	mutation := newDriverModelMutation(c.config, OpCreate)
+	return &DriverModelCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DriverModel entities.
+func (c *DriverModelClient) CreateBulk(builders ...*DriverModelCreate) *DriverModelCreateBulk {
+	return &DriverModelCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DriverModelClient) MapCreateBulk(slice any, setFunc func(*DriverModelCreate, int)) *DriverModelCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DriverModelCreateBulk{err: fmt.Errorf("calling to DriverModelClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DriverModelCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DriverModelCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DriverModel.
+func (c *DriverModelClient) Update() *DriverModelUpdate {
+	mutation := newDriverModelMutation(c.config, OpUpdate)
+	return &DriverModelUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DriverModelClient) UpdateOne(_m *DriverModel) *DriverModelUpdateOne {
+	mutation := newDriverModelMutation(c.config, OpUpdateOne, withDriverModel(_m))
+	return &DriverModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DriverModelClient) UpdateOneID(id string) *DriverModelUpdateOne {
+	mutation := newDriverModelMutation(c.config, OpUpdateOne, withDriverModelID(id))
+	return &DriverModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DriverModel.
+func (c *DriverModelClient) Delete() *DriverModelDelete {
+	mutation := newDriverModelMutation(c.config, OpDelete)
+	return &DriverModelDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DriverModelClient) DeleteOne(_m *DriverModel) *DriverModelDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DriverModelClient) DeleteOneID(id string) *DriverModelDeleteOne {
+	builder := c.Delete().Where(drivermodel.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DriverModelDeleteOne{builder}
+}
+
+// Query returns a query builder for DriverModel.
+func (c *DriverModelClient) Query() *DriverModelQuery {
+	return &DriverModelQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDriverModel},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DriverModel entity by its id.
+func (c *DriverModelClient) Get(ctx context.Context, id string) (*DriverModel, error) {
+	return c.Query().Where(drivermodel.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DriverModelClient) GetX(ctx context.Context, id string) *DriverModel {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DriverModelClient) Hooks() []Hook {
+	hooks := c.hooks.DriverModel
+	return append(hooks[:len(hooks):len(hooks)], drivermodel.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *DriverModelClient) Interceptors() []Interceptor {
+	return c.inters.DriverModel
+}
+
+func (c *DriverModelClient) mutate(ctx context.Context, m *DriverModelMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DriverModelCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DriverModelUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DriverModelUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DriverModelDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DriverModel mutation op: %q", m.Op())
+	}
+}
+
+// EdgeBoxClient is a client for the EdgeBox schema.
+type EdgeBoxClient struct {
+	config
+}
+
+// NewEdgeBoxClient returns a client for the EdgeBox from the given config.
+func NewEdgeBoxClient(c config) *EdgeBoxClient {
+	return &EdgeBoxClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `edgebox.Hooks(f(g(h())))`.
+func (c *EdgeBoxClient) Use(hooks ...Hook) {
+	c.hooks.EdgeBox = append(c.hooks.EdgeBox, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `edgebox.Intercept(f(g(h())))`.
+func (c *EdgeBoxClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EdgeBox = append(c.inters.EdgeBox, interceptors...)
+}
+
+// Create returns a builder for creating a EdgeBox entity.
+func (c *EdgeBoxClient) Create() *EdgeBoxCreate {
+	mutation := newEdgeBoxMutation(c.config, OpCreate)
+	return &EdgeBoxCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EdgeBox entities.
+func (c *EdgeBoxClient) CreateBulk(builders ...*EdgeBoxCreate) *EdgeBoxCreateBulk {
+	return &EdgeBoxCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EdgeBoxClient) MapCreateBulk(slice any, setFunc func(*EdgeBoxCreate, int)) *EdgeBoxCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EdgeBoxCreateBulk{err: fmt.Errorf("calling to EdgeBoxClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EdgeBoxCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EdgeBoxCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EdgeBox.
+func (c *EdgeBoxClient) Update() *EdgeBoxUpdate {
+	mutation := newEdgeBoxMutation(c.config, OpUpdate)
+	return &EdgeBoxUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EdgeBoxClient) UpdateOne(_m *EdgeBox) *EdgeBoxUpdateOne {
+	mutation := newEdgeBoxMutation(c.config, OpUpdateOne, withEdgeBox(_m))
+	return &EdgeBoxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EdgeBoxClient) UpdateOneID(id string) *EdgeBoxUpdateOne {
+	mutation := newEdgeBoxMutation(c.config, OpUpdateOne, withEdgeBoxID(id))
+	return &EdgeBoxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EdgeBox.
+func (c *EdgeBoxClient) Delete() *EdgeBoxDelete {
+	mutation := newEdgeBoxMutation(c.config, OpDelete)
+	return &EdgeBoxDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EdgeBoxClient) DeleteOne(_m *EdgeBox) *EdgeBoxDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EdgeBoxClient) DeleteOneID(id string) *EdgeBoxDeleteOne {
+	builder := c.Delete().Where(edgebox.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EdgeBoxDeleteOne{builder}
+}
+
+// Query returns a query builder for EdgeBox.
+func (c *EdgeBoxClient) Query() *EdgeBoxQuery {
+	return &EdgeBoxQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEdgeBox},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EdgeBox entity by its id.
+func (c *EdgeBoxClient) Get(ctx context.Context, id string) (*EdgeBox, error) {
+	return c.Query().Where(edgebox.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EdgeBoxClient) GetX(ctx context.Context, id string) *EdgeBox {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDevices queries the devices edge of a EdgeBox.
+func (c *EdgeBoxClient) QueryDevices(_m *EdgeBox) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(edgebox.Table, edgebox.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, edgebox.DevicesTable, edgebox.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *EdgeBoxClient) Hooks() []Hook {
+	hooks := c.hooks.EdgeBox
+	return append(hooks[:len(hooks):len(hooks)], edgebox.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *EdgeBoxClient) Interceptors() []Interceptor {
+	return c.inters.EdgeBox
+}
+
+func (c *EdgeBoxClient) mutate(ctx context.Context, m *EdgeBoxMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EdgeBoxCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EdgeBoxUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EdgeBoxUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EdgeBoxDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EdgeBox mutation op: %q", m.Op())
+	}
+}
+
+// LocationClient is a client for the Location schema.
+type LocationClient struct {
+	config
+}
+
+// NewLocationClient returns a client for the Location from the given config.
+func NewLocationClient(c config) *LocationClient {
+	return &LocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `location.Hooks(f(g(h())))`.
+func (c *LocationClient) Use(hooks ...Hook) {
+	c.hooks.Location = append(c.hooks.Location, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `location.Intercept(f(g(h())))`.
+func (c *LocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Location = append(c.inters.Location, interceptors...)
+}
+
+// Create returns a builder for creating a Location entity.
+func (c *LocationClient) Create() *LocationCreate {
+	mutation := newLocationMutation(c.config, OpCreate)
+	return &LocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Location entities.
+func (c *LocationClient) CreateBulk(builders ...*LocationCreate) *LocationCreateBulk {
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LocationClient) MapCreateBulk(slice any, setFunc func(*LocationCreate, int)) *LocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LocationCreateBulk{err: fmt.Errorf("calling to LocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Location.
+func (c *LocationClient) Update() *LocationUpdate {
+	mutation := newLocationMutation(c.config, OpUpdate)
+	return &LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LocationClient) UpdateOne(_m *Location) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocation(_m))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LocationClient) UpdateOneID(id string) *LocationUpdateOne {
+	mutation := newLocationMutation(c.config, OpUpdateOne, withLocationID(id))
+	return &LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Location.
+func (c *LocationClient) Delete() *LocationDelete {
+	mutation := newLocationMutation(c.config, OpDelete)
+	return &LocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LocationClient) DeleteOne(_m *Location) *LocationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LocationClient) DeleteOneID(id string) *LocationDeleteOne {
+	builder := c.Delete().Where(location.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LocationDeleteOne{builder}
+}
+
+// Query returns a query builder for Location.
+func (c *LocationClient) Query() *LocationQuery {
+	return &LocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Location entity by its id.
+func (c *LocationClient) Get(ctx context.Context, id string) (*Location, error) {
+	return c.Query().Where(location.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LocationClient) GetX(ctx context.Context, id string) *Location {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryParent queries the parent edge of a Location.
+func (c *LocationClient) QueryParent(_m *Location) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, location.ParentTable, location.ParentColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryChildren queries the children edge of a Location.
+func (c *LocationClient) QueryChildren(_m *Location) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.ChildrenTable, location.ChildrenColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDevices queries the devices edge of a Location.
+func (c *LocationClient) QueryDevices(_m *Location) *DeviceQuery {
+	query := (&DeviceClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(device.Table, device.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.DevicesTable, location.DevicesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryOccupancies queries the occupancies edge of a Location.
+func (c *LocationClient) QueryOccupancies(_m *Location) *OccupancyQuery {
+	query := (&OccupancyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(location.Table, location.FieldID, id),
+			sqlgraph.To(occupancy.Table, occupancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, location.OccupanciesTable, location.OccupanciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *LocationClient) Hooks() []Hook {
+	hooks := c.hooks.Location
+	return append(hooks[:len(hooks):len(hooks)], location.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LocationClient) Interceptors() []Interceptor {
+	return c.inters.Location
+}
+
+func (c *LocationClient) mutate(ctx context.Context, m *LocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Location mutation op: %q", m.Op())
+	}
+}
+
+// OccupancyClient is a client for the Occupancy schema.
+type OccupancyClient struct {
+	config
+}
+
+// NewOccupancyClient returns a client for the Occupancy from the given config.
+func NewOccupancyClient(c config) *OccupancyClient {
+	return &OccupancyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `occupancy.Hooks(f(g(h())))`.
+func (c *OccupancyClient) Use(hooks ...Hook) {
+	c.hooks.Occupancy = append(c.hooks.Occupancy, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `occupancy.Intercept(f(g(h())))`.
+func (c *OccupancyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Occupancy = append(c.inters.Occupancy, interceptors...)
+}
+
+// Create returns a builder for creating a Occupancy entity.
+func (c *OccupancyClient) Create() *OccupancyCreate {
+	mutation := newOccupancyMutation(c.config, OpCreate)
+	return &OccupancyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Occupancy entities.
+func (c *OccupancyClient) CreateBulk(builders ...*OccupancyCreate) *OccupancyCreateBulk {
+	return &OccupancyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OccupancyClient) MapCreateBulk(slice any, setFunc func(*OccupancyCreate, int)) *OccupancyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OccupancyCreateBulk{err: fmt.Errorf("calling to OccupancyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OccupancyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OccupancyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Occupancy.
+func (c *OccupancyClient) Update() *OccupancyUpdate {
+	mutation := newOccupancyMutation(c.config, OpUpdate)
+	return &OccupancyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OccupancyClient) UpdateOne(_m *Occupancy) *OccupancyUpdateOne {
+	mutation := newOccupancyMutation(c.config, OpUpdateOne, withOccupancy(_m))
+	return &OccupancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OccupancyClient) UpdateOneID(id string) *OccupancyUpdateOne {
+	mutation := newOccupancyMutation(c.config, OpUpdateOne, withOccupancyID(id))
+	return &OccupancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Occupancy.
+func (c *OccupancyClient) Delete() *OccupancyDelete {
+	mutation := newOccupancyMutation(c.config, OpDelete)
+	return &OccupancyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OccupancyClient) DeleteOne(_m *Occupancy) *OccupancyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OccupancyClient) DeleteOneID(id string) *OccupancyDeleteOne {
+	builder := c.Delete().Where(occupancy.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OccupancyDeleteOne{builder}
+}
+
+// Query returns a query builder for Occupancy.
+func (c *OccupancyClient) Query() *OccupancyQuery {
+	return &OccupancyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOccupancy},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Occupancy entity by its id.
+func (c *OccupancyClient) Get(ctx context.Context, id string) (*Occupancy, error) {
+	return c.Query().Where(occupancy.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OccupancyClient) GetX(ctx context.Context, id string) *Occupancy {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryTenant queries the tenant edge of a Occupancy.
+func (c *OccupancyClient) QueryTenant(_m *Occupancy) *TenantQuery {
+	query := (&TenantClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(occupancy.Table, occupancy.FieldID, id),
+			sqlgraph.To(tenant.Table, tenant.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, occupancy.TenantTable, occupancy.TenantColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryUnit queries the unit edge of a Occupancy.
+func (c *OccupancyClient) QueryUnit(_m *Occupancy) *LocationQuery {
+	query := (&LocationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(occupancy.Table, occupancy.FieldID, id),
+			sqlgraph.To(location.Table, location.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, occupancy.UnitTable, occupancy.UnitColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OccupancyClient) Hooks() []Hook {
+	hooks := c.hooks.Occupancy
+	return append(hooks[:len(hooks):len(hooks)], occupancy.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *OccupancyClient) Interceptors() []Interceptor {
+	return c.inters.Occupancy
+}
+
+func (c *OccupancyClient) mutate(ctx context.Context, m *OccupancyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OccupancyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OccupancyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OccupancyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OccupancyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Occupancy mutation op: %q", m.Op())
+	}
+}
+
+// TenantClient is a client for the Tenant schema.
+type TenantClient struct {
+	config
+}
+
+// NewTenantClient returns a client for the Tenant from the given config.
+func NewTenantClient(c config) *TenantClient {
+	return &TenantClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tenant.Hooks(f(g(h())))`.
+func (c *TenantClient) Use(hooks ...Hook) {
+	c.hooks.Tenant = append(c.hooks.Tenant, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tenant.Intercept(f(g(h())))`.
+func (c *TenantClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Tenant = append(c.inters.Tenant, interceptors...)
+}
+
+// Create returns a builder for creating a Tenant entity.
+func (c *TenantClient) Create() *TenantCreate {
+	mutation := newTenantMutation(c.config, OpCreate)
+	return &TenantCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Tenant entities.
+func (c *TenantClient) CreateBulk(builders ...*TenantCreate) *TenantCreateBulk {
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TenantClient) MapCreateBulk(slice any, setFunc func(*TenantCreate, int)) *TenantCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TenantCreateBulk{err: fmt.Errorf("calling to TenantClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TenantCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TenantCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Tenant.
+func (c *TenantClient) Update() *TenantUpdate {
+	mutation := newTenantMutation(c.config, OpUpdate)
+	return &TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TenantClient) UpdateOne(_m *Tenant) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenant(_m))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TenantClient) UpdateOneID(id string) *TenantUpdateOne {
+	mutation := newTenantMutation(c.config, OpUpdateOne, withTenantID(id))
+	return &TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Tenant.
+func (c *TenantClient) Delete() *TenantDelete {
+	mutation := newTenantMutation(c.config, OpDelete)
+	return &TenantDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TenantClient) DeleteOne(_m *Tenant) *TenantDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TenantClient) DeleteOneID(id string) *TenantDeleteOne {
+	builder := c.Delete().Where(tenant.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TenantDeleteOne{builder}
+}
+
+// Query returns a query builder for Tenant.
+func (c *TenantClient) Query() *TenantQuery {
+	return &TenantQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTenant},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Tenant entity by its id.
+func (c *TenantClient) Get(ctx context.Context, id string) (*Tenant, error) {
+	return c.Query().Where(tenant.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TenantClient) GetX(ctx context.Context, id string) *Tenant {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOccupancies queries the occupancies edge of a Tenant.
+func (c *TenantClient) QueryOccupancies(_m *Tenant) *OccupancyQuery {
+	query := (&OccupancyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(tenant.Table, tenant.FieldID, id),
+			sqlgraph.To(occupancy.Table, occupancy.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, tenant.OccupanciesTable, tenant.OccupanciesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *TenantClient) Hooks() []Hook {
+	hooks := c.hooks.Tenant
+	return append(hooks[:len(hooks):len(hooks)], tenant.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *TenantClient) Interceptors() []Interceptor {
+	return c.inters.Tenant
+}
+
+func (c *TenantClient) mutate(ctx context.Context, m *TenantMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TenantCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TenantUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TenantUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TenantDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Tenant mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Device []ent.Hook
+		Device, DeviceAttr, DeviceHistory, DriverModel, EdgeBox, Location, Occupancy,
+		Tenant []ent.Hook
 	}
 	inters struct {
-		Device []ent.Interceptor
+		Device, DeviceAttr, DeviceHistory, DriverModel, EdgeBox, Location, Occupancy,
+		Tenant []ent.Interceptor
 	}
 )
 
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -10,6 +11,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
 )
 
 // Device is the model entity for the Device schema.
@@ -21,6 +24,10 @@ type Device struct {
 	CreateTime time.Time `json:"create_time,omitempty"`
 	// UpdateTime holds the value of the "update_time" field.
 	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 软删除
+	IsDel int64 `json:"is_del,omitempty"`
+	// 删除时间
+	DeleteTime *time.Time `json:"delete_time,omitempty"`
 	// 设备号
 	DeviceCode string `json:"device_code,omitempty"`
 	// 设备类型
@@ -39,25 +46,97 @@ type Device struct {
 	AreaCode string `json:"area_code,omitempty"`
 	// 对外位置编号
 	Pcode string `json:"pcode,omitempty"`
+	// 上级表, 总表
+	ParentID *string `json:"parent_id,omitempty"`
+	// 所在位置, pos_code 与位置编号一致
+	LocationID *string `json:"location_id,omitempty"`
+	// 接入的边缘网关
+	BoxID *string `json:"box_id,omitempty"`
+	// 网关上的连接
+	ConnKey string `json:"conn_key,omitempty"`
+	// driver-box 设备模型
+	ModelName string `json:"model_name,omitempty"`
+	// 协议参数, 如 modbus 的 unitID
+	DriverProps map[string]string `json:"driver_props,omitempty"`
 	// 状态
 	Status int `json:"status,omitempty"`
 	// 备注
 	Memo string `json:"memo,omitempty"`
-	// 软删除
-	IsDel        int `json:"is_del,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeviceQuery when eager-loading is set.
+	Edges        DeviceEdges `json:"edges"`
 	selectValues sql.SelectValues
 }
 
+// DeviceEdges holds the relations/edges for other nodes in the graph.
+type DeviceEdges struct {
+	// Location holds the value of the location edge.
+	Location *Location `json:"location,omitempty"`
+	// Parent holds the value of the parent edge.
+	Parent *Device `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Device `json:"children,omitempty"`
+	// Box holds the value of the box edge.
+	Box *EdgeBox `json:"box,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// LocationOrErr returns the Location value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) LocationOrErr() (*Location, error) {
+	if e.Location != nil {
+		return e.Location, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "location"}
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) ParentOrErr() (*Device, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: device.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeviceEdges) ChildrenOrErr() ([]*Device, error) {
+	if e.loadedTypes[2] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// BoxOrErr returns the Box value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeviceEdges) BoxOrErr() (*EdgeBox, error) {
+	if e.Box != nil {
+		return e.Box, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: edgebox.Label}
+	}
+	return nil, &NotLoadedError{edge: "box"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case device.FieldRate, device.FieldStatus, device.FieldIsDel:
+		case device.FieldDriverProps:
+			values[i] = new([]byte)
+		case device.FieldIsDel, device.FieldRate, device.FieldStatus:
 			values[i] = new(sql.NullInt64)
-		case device.FieldID, device.FieldDeviceCode, device.FieldDeviceType, device.FieldDeviceSn, device.FieldDeviceName, device.FieldProject, device.FieldPosCode, device.FieldAreaCode, device.FieldPcode, device.FieldMemo:
+		case device.FieldID, device.FieldDeviceCode, device.FieldDeviceType, device.FieldDeviceSn, device.FieldDeviceName, device.FieldProject, device.FieldPosCode, device.FieldAreaCode, device.FieldPcode, device.FieldParentID, device.FieldLocationID, device.FieldBoxID, device.FieldConnKey, device.FieldModelName, device.FieldMemo:
 			values[i] = new(sql.NullString)
-		case device.FieldCreateTime, device.FieldUpdateTime:
+		case device.FieldCreateTime, device.FieldUpdateTime, device.FieldDeleteTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -92,6 +171,19 @@ func (_m *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.UpdateTime = value.Time
 			}
+		case device.FieldIsDel:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field is_del", values[i])
+			} else if value.Valid {
+				_m.IsDel = value.Int64
+			}
+		case device.FieldDeleteTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field delete_time", values[i])
+			} else if value.Valid {
+				_m.DeleteTime = new(time.Time)
+				*_m.DeleteTime = value.Time
+			}
 		case device.FieldDeviceCode:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field device_code", values[i])
@@ -146,6 +238,47 @@ func (_m *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Pcode = value.String
 			}
+		case device.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(string)
+				*_m.ParentID = value.String
+			}
+		case device.FieldLocationID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location_id", values[i])
+			} else if value.Valid {
+				_m.LocationID = new(string)
+				*_m.LocationID = value.String
+			}
+		case device.FieldBoxID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field box_id", values[i])
+			} else if value.Valid {
+				_m.BoxID = new(string)
+				*_m.BoxID = value.String
+			}
+		case device.FieldConnKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field conn_key", values[i])
+			} else if value.Valid {
+				_m.ConnKey = value.String
+			}
+		case device.FieldModelName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model_name", values[i])
+			} else if value.Valid {
+				_m.ModelName = value.String
+			}
+		case device.FieldDriverProps:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field driver_props", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.DriverProps); err != nil {
+					return fmt.Errorf("unmarshal field driver_props: %w", err)
+				}
+			}
 		case device.FieldStatus:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field status", values[i])
@@ -158,12 +291,6 @@ func (_m *Device) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Memo = value.String
 			}
-		case device.FieldIsDel:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for field is_del", values[i])
-			} else if value.Valid {
-				_m.IsDel = int(value.Int64)
-			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -177,6 +304,26 @@ func (_m *Device) Value(name string) (ent.Value, error) {
 	return _m.selectValues.Get(name)
 }
 
+// QueryLocation queries the "location" edge of the Device entity.
+func (_m *Device) QueryLocation() *LocationQuery {
+	return NewDeviceClient(_m.config).QueryLocation(_m)
+}
+
+// QueryParent queries the "parent" edge of the Device entity.
+func (_m *Device) QueryParent() *DeviceQuery {
+	return NewDeviceClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Device entity.
+func (_m *Device) QueryChildren() *DeviceQuery {
+	return NewDeviceClient(_m.config).QueryChildren(_m)
+}
+
+// QueryBox queries the "box" edge of the Device entity.
+func (_m *Device) QueryBox() *EdgeBoxQuery {
+	return NewDeviceClient(_m.config).QueryBox(_m)
+}
+
 // Update returns a builder for updating this Device.
 // Note that you need to call Device.Unwrap() before calling this method if this Device
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +353,14 @@ func (_m *Device) String() string {
 	builder.WriteString("update_time=")
 	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("is_del=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDel))
+	builder.WriteString(", ")
+	if v := _m.DeleteTime; v != nil {
+		builder.WriteString("delete_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("device_code=")
 	builder.WriteString(_m.DeviceCode)
 	builder.WriteString(", ")
@@ -233,14 +388,35 @@ func (_m *Device) String() string {
 	builder.WriteString("pcode=")
 	builder.WriteString(_m.Pcode)
 	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.LocationID; v != nil {
+		builder.WriteString("location_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.BoxID; v != nil {
+		builder.WriteString("box_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("conn_key=")
+	builder.WriteString(_m.ConnKey)
+	builder.WriteString(", ")
+	builder.WriteString("model_name=")
+	builder.WriteString(_m.ModelName)
+	builder.WriteString(", ")
+	builder.WriteString("driver_props=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DriverProps))
+	builder.WriteString(", ")
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
 	builder.WriteString("memo=")
 	builder.WriteString(_m.Memo)
-	builder.WriteString(", ")
-	builder.WriteString("is_del=")
-	builder.WriteString(fmt.Sprintf("%v", _m.IsDel))
 	builder.WriteByte(')')
 	return builder.String()
 }
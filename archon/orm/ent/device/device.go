@@ -5,7 +5,9 @@ package device
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 )
 
 const (
@@ -17,6 +19,10 @@ const (
 	FieldCreateTime = "create_time"
 	// FieldUpdateTime holds the string denoting the update_time field in the database.
 	FieldUpdateTime = "update_time"
+	// FieldIsDel holds the string denoting the is_del field in the database.
+	FieldIsDel = "is_del"
+	// FieldDeleteTime holds the string denoting the delete_time field in the database.
+	FieldDeleteTime = "delete_time"
 	// FieldDeviceCode holds the string denoting the device_code field in the database.
 	FieldDeviceCode = "device_code"
 	// FieldDeviceType holds the string denoting the device_type field in the database.
@@ -35,14 +41,54 @@ const (
 	FieldAreaCode = "area_code"
 	// FieldPcode holds the string denoting the pcode field in the database.
 	FieldPcode = "pcode"
+	// FieldParentID holds the string denoting the parent_id field in the database.
+	FieldParentID = "parent_id"
+	// FieldLocationID holds the string denoting the location_id field in the database.
+	FieldLocationID = "location_id"
+	// FieldBoxID holds the string denoting the box_id field in the database.
+	FieldBoxID = "box_id"
+	// FieldConnKey holds the string denoting the conn_key field in the database.
+	FieldConnKey = "conn_key"
+	// FieldModelName holds the string denoting the model_name field in the database.
+	FieldModelName = "model_name"
+	// FieldDriverProps holds the string denoting the driver_props field in the database.
+	FieldDriverProps = "driver_props"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
 	// FieldMemo holds the string denoting the memo field in the database.
 	FieldMemo = "memo"
-	// FieldIsDel holds the string denoting the is_del field in the database.
-	FieldIsDel = "is_del"
+	// EdgeLocation holds the string denoting the location edge name in mutations.
+	EdgeLocation = "location"
+	// EdgeParent holds the string denoting the parent edge name in mutations.
+	EdgeParent = "parent"
+	// EdgeChildren holds the string denoting the children edge name in mutations.
+	EdgeChildren = "children"
+	// EdgeBox holds the string denoting the box edge name in mutations.
+	EdgeBox = "box"
 	// Table holds the table name of the device in the database.
 	Table = "device"
+	// LocationTable is the table that holds the location relation/edge.
+	LocationTable = "device"
+	// LocationInverseTable is the table name for the Location entity.
+	// It exists in this package in order to avoid circular dependency with the "location" package.
+	LocationInverseTable = "location"
+	// LocationColumn is the table column denoting the location relation/edge.
+	LocationColumn = "location_id"
+	// ParentTable is the table that holds the parent relation/edge.
+	ParentTable = "device"
+	// ParentColumn is the table column denoting the parent relation/edge.
+	ParentColumn = "parent_id"
+	// ChildrenTable is the table that holds the children relation/edge.
+	ChildrenTable = "device"
+	// ChildrenColumn is the table column denoting the children relation/edge.
+	ChildrenColumn = "parent_id"
+	// BoxTable is the table that holds the box relation/edge.
+	BoxTable = "device"
+	// BoxInverseTable is the table name for the EdgeBox entity.
+	// It exists in this package in order to avoid circular dependency with the "edgebox" package.
+	BoxInverseTable = "edge_box"
+	// BoxColumn is the table column denoting the box relation/edge.
+	BoxColumn = "box_id"
 )
 
 // Columns holds all SQL columns for device fields.
@@ -50,6 +96,8 @@ var Columns = []string{
 	FieldID,
 	FieldCreateTime,
 	FieldUpdateTime,
+	FieldIsDel,
+	FieldDeleteTime,
 	FieldDeviceCode,
 	FieldDeviceType,
 	FieldDeviceSn,
@@ -59,9 +107,14 @@ var Columns = []string{
 	FieldPosCode,
 	FieldAreaCode,
 	FieldPcode,
+	FieldParentID,
+	FieldLocationID,
+	FieldBoxID,
+	FieldConnKey,
+	FieldModelName,
+	FieldDriverProps,
 	FieldStatus,
 	FieldMemo,
-	FieldIsDel,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -74,13 +127,23 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/twiglab/h2o/archon/orm/ent/runtime"
 var (
+	Hooks        [1]ent.Hook
+	Interceptors [1]ent.Interceptor
+	Policy       ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
 	DefaultUpdateTime func() time.Time
 	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
 	UpdateDefaultUpdateTime func() time.Time
+	// DefaultIsDel holds the default value on creation for the "is_del" field.
+	DefaultIsDel int64
 	// DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
 	DeviceCodeValidator func(string) error
 	// DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
@@ -91,8 +154,6 @@ var (
 	ProjectValidator func(string) error
 	// DefaultStatus holds the default value on creation for the "status" field.
 	DefaultStatus int
-	// DefaultIsDel holds the default value on creation for the "is_del" field.
-	DefaultIsDel int
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 	// IDValidator is a validator for the "id" field. It is called by the builders before save.
@@ -117,6 +178,16 @@ func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
 }
 
+// ByIsDel orders the results by the is_del field.
+func ByIsDel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDel, opts...).ToFunc()
+}
+
+// ByDeleteTime orders the results by the delete_time field.
+func ByDeleteTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeleteTime, opts...).ToFunc()
+}
+
 // ByDeviceCode orders the results by the device_code field.
 func ByDeviceCode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeviceCode, opts...).ToFunc()
@@ -162,6 +233,31 @@ func ByPcode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPcode, opts...).ToFunc()
 }
 
+// ByParentID orders the results by the parent_id field.
+func ByParentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentID, opts...).ToFunc()
+}
+
+// ByLocationID orders the results by the location_id field.
+func ByLocationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocationID, opts...).ToFunc()
+}
+
+// ByBoxID orders the results by the box_id field.
+func ByBoxID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBoxID, opts...).ToFunc()
+}
+
+// ByConnKey orders the results by the conn_key field.
+func ByConnKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldConnKey, opts...).ToFunc()
+}
+
+// ByModelName orders the results by the model_name field.
+func ByModelName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldModelName, opts...).ToFunc()
+}
+
 // ByStatus orders the results by the status field.
 func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
@@ -172,7 +268,65 @@ func ByMemo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMemo, opts...).ToFunc()
 }
 
-// ByIsDel orders the results by the is_del field.
-func ByIsDel(opts ...sql.OrderTermOption) OrderOption {
-	return sql.OrderByField(FieldIsDel, opts...).ToFunc()
+// ByLocationField orders the results by location field.
+func ByLocationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLocationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByParentField orders the results by parent field.
+func ByParentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newParentStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChildrenCount orders the results by children count.
+func ByChildrenCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChildrenStep(), opts...)
+	}
+}
+
+// ByChildren orders the results by children terms.
+func ByChildren(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChildrenStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBoxField orders the results by box field.
+func ByBoxField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBoxStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newLocationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LocationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+	)
+}
+func newParentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+	)
+}
+func newChildrenStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(Table, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+	)
+}
+func newBoxStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BoxInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, BoxTable, BoxColumn),
+	)
 }
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/twiglab/h2o/archon/orm/ent/predicate"
 )
 
@@ -74,6 +75,16 @@ func UpdateTime(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldUpdateTime, v))
 }
 
+// IsDel applies equality check predicate on the "is_del" field. It's identical to IsDelEQ.
+func IsDel(v int64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldIsDel, v))
+}
+
+// DeleteTime applies equality check predicate on the "delete_time" field. It's identical to DeleteTimeEQ.
+func DeleteTime(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeleteTime, v))
+}
+
 // DeviceCode applies equality check predicate on the "device_code" field. It's identical to DeviceCodeEQ.
 func DeviceCode(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldDeviceCode, v))
@@ -119,6 +130,31 @@ func Pcode(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldPcode, v))
 }
 
+// ParentID applies equality check predicate on the "parent_id" field. It's identical to ParentIDEQ.
+func ParentID(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldParentID, v))
+}
+
+// LocationID applies equality check predicate on the "location_id" field. It's identical to LocationIDEQ.
+func LocationID(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLocationID, v))
+}
+
+// BoxID applies equality check predicate on the "box_id" field. It's identical to BoxIDEQ.
+func BoxID(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldBoxID, v))
+}
+
+// ConnKey applies equality check predicate on the "conn_key" field. It's identical to ConnKeyEQ.
+func ConnKey(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldConnKey, v))
+}
+
+// ModelName applies equality check predicate on the "model_name" field. It's identical to ModelNameEQ.
+func ModelName(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldModelName, v))
+}
+
 // Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
 func Status(v int) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldStatus, v))
@@ -129,11 +165,6 @@ func Memo(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldMemo, v))
 }
 
-// IsDel applies equality check predicate on the "is_del" field. It's identical to IsDelEQ.
-func IsDel(v int) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldIsDel, v))
-}
-
 // CreateTimeEQ applies the EQ predicate on the "create_time" field.
 func CreateTimeEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreateTime, v))
@@ -214,6 +245,96 @@ func UpdateTimeLTE(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldLTE(FieldUpdateTime, v))
 }
 
+// IsDelEQ applies the EQ predicate on the "is_del" field.
+func IsDelEQ(v int64) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldIsDel, v))
+}
+
+// IsDelNEQ applies the NEQ predicate on the "is_del" field.
+func IsDelNEQ(v int64) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldIsDel, v))
+}
+
+// IsDelIn applies the In predicate on the "is_del" field.
+func IsDelIn(vs ...int64) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldIsDel, vs...))
+}
+
+// IsDelNotIn applies the NotIn predicate on the "is_del" field.
+func IsDelNotIn(vs ...int64) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldIsDel, vs...))
+}
+
+// IsDelGT applies the GT predicate on the "is_del" field.
+func IsDelGT(v int64) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldIsDel, v))
+}
+
+// IsDelGTE applies the GTE predicate on the "is_del" field.
+func IsDelGTE(v int64) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldIsDel, v))
+}
+
+// IsDelLT applies the LT predicate on the "is_del" field.
+func IsDelLT(v int64) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldIsDel, v))
+}
+
+// IsDelLTE applies the LTE predicate on the "is_del" field.
+func IsDelLTE(v int64) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldIsDel, v))
+}
+
+// DeleteTimeEQ applies the EQ predicate on the "delete_time" field.
+func DeleteTimeEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeNEQ applies the NEQ predicate on the "delete_time" field.
+func DeleteTimeNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldDeleteTime, v))
+}
+
+// DeleteTimeIn applies the In predicate on the "delete_time" field.
+func DeleteTimeIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeNotIn applies the NotIn predicate on the "delete_time" field.
+func DeleteTimeNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldDeleteTime, vs...))
+}
+
+// DeleteTimeGT applies the GT predicate on the "delete_time" field.
+func DeleteTimeGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldDeleteTime, v))
+}
+
+// DeleteTimeGTE applies the GTE predicate on the "delete_time" field.
+func DeleteTimeGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeLT applies the LT predicate on the "delete_time" field.
+func DeleteTimeLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldDeleteTime, v))
+}
+
+// DeleteTimeLTE applies the LTE predicate on the "delete_time" field.
+func DeleteTimeLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldDeleteTime, v))
+}
+
+// DeleteTimeIsNil applies the IsNil predicate on the "delete_time" field.
+func DeleteTimeIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDeleteTime))
+}
+
+// DeleteTimeNotNil applies the NotNil predicate on the "delete_time" field.
+func DeleteTimeNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDeleteTime))
+}
+
 // DeviceCodeEQ applies the EQ predicate on the "device_code" field.
 func DeviceCodeEQ(v string) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldDeviceCode, v))
@@ -824,6 +945,391 @@ func PcodeContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldPcode, v))
 }
 
+// ParentIDEQ applies the EQ predicate on the "parent_id" field.
+func ParentIDEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldParentID, v))
+}
+
+// ParentIDNEQ applies the NEQ predicate on the "parent_id" field.
+func ParentIDNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldParentID, v))
+}
+
+// ParentIDIn applies the In predicate on the "parent_id" field.
+func ParentIDIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldParentID, vs...))
+}
+
+// ParentIDNotIn applies the NotIn predicate on the "parent_id" field.
+func ParentIDNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldParentID, vs...))
+}
+
+// ParentIDGT applies the GT predicate on the "parent_id" field.
+func ParentIDGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldParentID, v))
+}
+
+// ParentIDGTE applies the GTE predicate on the "parent_id" field.
+func ParentIDGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldParentID, v))
+}
+
+// ParentIDLT applies the LT predicate on the "parent_id" field.
+func ParentIDLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldParentID, v))
+}
+
+// ParentIDLTE applies the LTE predicate on the "parent_id" field.
+func ParentIDLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldParentID, v))
+}
+
+// ParentIDContains applies the Contains predicate on the "parent_id" field.
+func ParentIDContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldParentID, v))
+}
+
+// ParentIDHasPrefix applies the HasPrefix predicate on the "parent_id" field.
+func ParentIDHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldParentID, v))
+}
+
+// ParentIDHasSuffix applies the HasSuffix predicate on the "parent_id" field.
+func ParentIDHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldParentID, v))
+}
+
+// ParentIDIsNil applies the IsNil predicate on the "parent_id" field.
+func ParentIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldParentID))
+}
+
+// ParentIDNotNil applies the NotNil predicate on the "parent_id" field.
+func ParentIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldParentID))
+}
+
+// ParentIDEqualFold applies the EqualFold predicate on the "parent_id" field.
+func ParentIDEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldParentID, v))
+}
+
+// ParentIDContainsFold applies the ContainsFold predicate on the "parent_id" field.
+func ParentIDContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldParentID, v))
+}
+
+// LocationIDEQ applies the EQ predicate on the "location_id" field.
+func LocationIDEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLocationID, v))
+}
+
+// LocationIDNEQ applies the NEQ predicate on the "location_id" field.
+func LocationIDNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLocationID, v))
+}
+
+// LocationIDIn applies the In predicate on the "location_id" field.
+func LocationIDIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLocationID, vs...))
+}
+
+// LocationIDNotIn applies the NotIn predicate on the "location_id" field.
+func LocationIDNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLocationID, vs...))
+}
+
+// LocationIDGT applies the GT predicate on the "location_id" field.
+func LocationIDGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLocationID, v))
+}
+
+// LocationIDGTE applies the GTE predicate on the "location_id" field.
+func LocationIDGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLocationID, v))
+}
+
+// LocationIDLT applies the LT predicate on the "location_id" field.
+func LocationIDLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLocationID, v))
+}
+
+// LocationIDLTE applies the LTE predicate on the "location_id" field.
+func LocationIDLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLocationID, v))
+}
+
+// LocationIDContains applies the Contains predicate on the "location_id" field.
+func LocationIDContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldLocationID, v))
+}
+
+// LocationIDHasPrefix applies the HasPrefix predicate on the "location_id" field.
+func LocationIDHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldLocationID, v))
+}
+
+// LocationIDHasSuffix applies the HasSuffix predicate on the "location_id" field.
+func LocationIDHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldLocationID, v))
+}
+
+// LocationIDIsNil applies the IsNil predicate on the "location_id" field.
+func LocationIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLocationID))
+}
+
+// LocationIDNotNil applies the NotNil predicate on the "location_id" field.
+func LocationIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLocationID))
+}
+
+// LocationIDEqualFold applies the EqualFold predicate on the "location_id" field.
+func LocationIDEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldLocationID, v))
+}
+
+// LocationIDContainsFold applies the ContainsFold predicate on the "location_id" field.
+func LocationIDContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldLocationID, v))
+}
+
+// BoxIDEQ applies the EQ predicate on the "box_id" field.
+func BoxIDEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldBoxID, v))
+}
+
+// BoxIDNEQ applies the NEQ predicate on the "box_id" field.
+func BoxIDNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldBoxID, v))
+}
+
+// BoxIDIn applies the In predicate on the "box_id" field.
+func BoxIDIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldBoxID, vs...))
+}
+
+// BoxIDNotIn applies the NotIn predicate on the "box_id" field.
+func BoxIDNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldBoxID, vs...))
+}
+
+// BoxIDGT applies the GT predicate on the "box_id" field.
+func BoxIDGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldBoxID, v))
+}
+
+// BoxIDGTE applies the GTE predicate on the "box_id" field.
+func BoxIDGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldBoxID, v))
+}
+
+// BoxIDLT applies the LT predicate on the "box_id" field.
+func BoxIDLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldBoxID, v))
+}
+
+// BoxIDLTE applies the LTE predicate on the "box_id" field.
+func BoxIDLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldBoxID, v))
+}
+
+// BoxIDContains applies the Contains predicate on the "box_id" field.
+func BoxIDContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldBoxID, v))
+}
+
+// BoxIDHasPrefix applies the HasPrefix predicate on the "box_id" field.
+func BoxIDHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldBoxID, v))
+}
+
+// BoxIDHasSuffix applies the HasSuffix predicate on the "box_id" field.
+func BoxIDHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldBoxID, v))
+}
+
+// BoxIDIsNil applies the IsNil predicate on the "box_id" field.
+func BoxIDIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldBoxID))
+}
+
+// BoxIDNotNil applies the NotNil predicate on the "box_id" field.
+func BoxIDNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldBoxID))
+}
+
+// BoxIDEqualFold applies the EqualFold predicate on the "box_id" field.
+func BoxIDEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldBoxID, v))
+}
+
+// BoxIDContainsFold applies the ContainsFold predicate on the "box_id" field.
+func BoxIDContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldBoxID, v))
+}
+
+// ConnKeyEQ applies the EQ predicate on the "conn_key" field.
+func ConnKeyEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldConnKey, v))
+}
+
+// ConnKeyNEQ applies the NEQ predicate on the "conn_key" field.
+func ConnKeyNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldConnKey, v))
+}
+
+// ConnKeyIn applies the In predicate on the "conn_key" field.
+func ConnKeyIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldConnKey, vs...))
+}
+
+// ConnKeyNotIn applies the NotIn predicate on the "conn_key" field.
+func ConnKeyNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldConnKey, vs...))
+}
+
+// ConnKeyGT applies the GT predicate on the "conn_key" field.
+func ConnKeyGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldConnKey, v))
+}
+
+// ConnKeyGTE applies the GTE predicate on the "conn_key" field.
+func ConnKeyGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldConnKey, v))
+}
+
+// ConnKeyLT applies the LT predicate on the "conn_key" field.
+func ConnKeyLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldConnKey, v))
+}
+
+// ConnKeyLTE applies the LTE predicate on the "conn_key" field.
+func ConnKeyLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldConnKey, v))
+}
+
+// ConnKeyContains applies the Contains predicate on the "conn_key" field.
+func ConnKeyContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldConnKey, v))
+}
+
+// ConnKeyHasPrefix applies the HasPrefix predicate on the "conn_key" field.
+func ConnKeyHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldConnKey, v))
+}
+
+// ConnKeyHasSuffix applies the HasSuffix predicate on the "conn_key" field.
+func ConnKeyHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldConnKey, v))
+}
+
+// ConnKeyIsNil applies the IsNil predicate on the "conn_key" field.
+func ConnKeyIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldConnKey))
+}
+
+// ConnKeyNotNil applies the NotNil predicate on the "conn_key" field.
+func ConnKeyNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldConnKey))
+}
+
+// ConnKeyEqualFold applies the EqualFold predicate on the "conn_key" field.
+func ConnKeyEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldConnKey, v))
+}
+
+// ConnKeyContainsFold applies the ContainsFold predicate on the "conn_key" field.
+func ConnKeyContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldConnKey, v))
+}
+
+// ModelNameEQ applies the EQ predicate on the "model_name" field.
+func ModelNameEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldModelName, v))
+}
+
+// ModelNameNEQ applies the NEQ predicate on the "model_name" field.
+func ModelNameNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldModelName, v))
+}
+
+// ModelNameIn applies the In predicate on the "model_name" field.
+func ModelNameIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldModelName, vs...))
+}
+
+// ModelNameNotIn applies the NotIn predicate on the "model_name" field.
+func ModelNameNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldModelName, vs...))
+}
+
+// ModelNameGT applies the GT predicate on the "model_name" field.
+func ModelNameGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldModelName, v))
+}
+
+// ModelNameGTE applies the GTE predicate on the "model_name" field.
+func ModelNameGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldModelName, v))
+}
+
+// ModelNameLT applies the LT predicate on the "model_name" field.
+func ModelNameLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldModelName, v))
+}
+
+// ModelNameLTE applies the LTE predicate on the "model_name" field.
+func ModelNameLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldModelName, v))
+}
+
+// ModelNameContains applies the Contains predicate on the "model_name" field.
+func ModelNameContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldModelName, v))
+}
+
+// ModelNameHasPrefix applies the HasPrefix predicate on the "model_name" field.
+func ModelNameHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldModelName, v))
+}
+
+// ModelNameHasSuffix applies the HasSuffix predicate on the "model_name" field.
+func ModelNameHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldModelName, v))
+}
+
+// ModelNameIsNil applies the IsNil predicate on the "model_name" field.
+func ModelNameIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldModelName))
+}
+
+// ModelNameNotNil applies the NotNil predicate on the "model_name" field.
+func ModelNameNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldModelName))
+}
+
+// ModelNameEqualFold applies the EqualFold predicate on the "model_name" field.
+func ModelNameEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldModelName, v))
+}
+
+// ModelNameContainsFold applies the ContainsFold predicate on the "model_name" field.
+func ModelNameContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldModelName, v))
+}
+
+// DriverPropsIsNil applies the IsNil predicate on the "driver_props" field.
+func DriverPropsIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldDriverProps))
+}
+
+// DriverPropsNotNil applies the NotNil predicate on the "driver_props" field.
+func DriverPropsNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldDriverProps))
+}
+
 // StatusEQ applies the EQ predicate on the "status" field.
 func StatusEQ(v int) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldStatus, v))
@@ -939,44 +1445,96 @@ func MemoContainsFold(v string) predicate.Device {
 	return predicate.Device(sql.FieldContainsFold(FieldMemo, v))
 }
 
-// IsDelEQ applies the EQ predicate on the "is_del" field.
-func IsDelEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldEQ(FieldIsDel, v))
-}
-
-// IsDelNEQ applies the NEQ predicate on the "is_del" field.
-func IsDelNEQ(v int) predicate.Device {
-	return predicate.Device(sql.FieldNEQ(FieldIsDel, v))
-}
-
-// IsDelIn applies the In predicate on the "is_del" field.
-func IsDelIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldIn(FieldIsDel, vs...))
-}
-
-// IsDelNotIn applies the NotIn predicate on the "is_del" field.
-func IsDelNotIn(vs ...int) predicate.Device {
-	return predicate.Device(sql.FieldNotIn(FieldIsDel, vs...))
-}
-
-// IsDelGT applies the GT predicate on the "is_del" field.
-func IsDelGT(v int) predicate.Device {
-	return predicate.Device(sql.FieldGT(FieldIsDel, v))
-}
-
-// IsDelGTE applies the GTE predicate on the "is_del" field.
-func IsDelGTE(v int) predicate.Device {
-	return predicate.Device(sql.FieldGTE(FieldIsDel, v))
-}
-
-// IsDelLT applies the LT predicate on the "is_del" field.
-func IsDelLT(v int) predicate.Device {
-	return predicate.Device(sql.FieldLT(FieldIsDel, v))
-}
-
-// IsDelLTE applies the LTE predicate on the "is_del" field.
-func IsDelLTE(v int) predicate.Device {
-	return predicate.Device(sql.FieldLTE(FieldIsDel, v))
+// HasLocation applies the HasEdge predicate on the "location" edge.
+func HasLocation() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LocationTable, LocationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLocationWith applies the HasEdge predicate on the "location" edge with a given conditions (other predicates).
+func HasLocationWith(preds ...predicate.Location) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newLocationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasParent applies the HasEdge predicate on the "parent" edge.
+func HasParent() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ParentTable, ParentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasParentWith applies the HasEdge predicate on the "parent" edge with a given conditions (other predicates).
+func HasParentWith(preds ...predicate.Device) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newParentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChildren applies the HasEdge predicate on the "children" edge.
+func HasChildren() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChildrenTable, ChildrenColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChildrenWith applies the HasEdge predicate on the "children" edge with a given conditions (other predicates).
+func HasChildrenWith(preds ...predicate.Device) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newChildrenStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBox applies the HasEdge predicate on the "box" edge.
+func HasBox() predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, BoxTable, BoxColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBoxWith applies the HasEdge predicate on the "box" edge with a given conditions (other predicates).
+func HasBoxWith(preds ...predicate.EdgeBox) predicate.Device {
+	return predicate.Device(func(s *sql.Selector) {
+		step := newBoxStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
 }
 
 // And groups predicates with the AND operator between them.
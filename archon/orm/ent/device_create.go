@@ -13,6 +13,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
 )
 
 // DeviceCreate is the builder for creating a Device entity.
@@ -51,6 +53,34 @@ func (_c *DeviceCreate) SetNillableUpdateTime(v *time.Time) *DeviceCreate {
 	return _c
 }
 
+// SetIsDel sets the "is_del" field.
+func (_c *DeviceCreate) SetIsDel(v int64) *DeviceCreate {
+	_c.mutation.SetIsDel(v)
+	return _c
+}
+
+// SetNillableIsDel sets the "is_del" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableIsDel(v *int64) *DeviceCreate {
+	if v != nil {
+		_c.SetIsDel(*v)
+	}
+	return _c
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_c *DeviceCreate) SetDeleteTime(v time.Time) *DeviceCreate {
+	_c.mutation.SetDeleteTime(v)
+	return _c
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableDeleteTime(v *time.Time) *DeviceCreate {
+	if v != nil {
+		_c.SetDeleteTime(*v)
+	}
+	return _c
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (_c *DeviceCreate) SetDeviceCode(v string) *DeviceCreate {
 	_c.mutation.SetDeviceCode(v)
@@ -153,6 +183,82 @@ func (_c *DeviceCreate) SetNillablePcode(v *string) *DeviceCreate {
 	return _c
 }
 
+// SetParentID sets the "parent_id" field.
+func (_c *DeviceCreate) SetParentID(v string) *DeviceCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableParentID(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetLocationID sets the "location_id" field.
+func (_c *DeviceCreate) SetLocationID(v string) *DeviceCreate {
+	_c.mutation.SetLocationID(v)
+	return _c
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableLocationID(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetLocationID(*v)
+	}
+	return _c
+}
+
+// SetBoxID sets the "box_id" field.
+func (_c *DeviceCreate) SetBoxID(v string) *DeviceCreate {
+	_c.mutation.SetBoxID(v)
+	return _c
+}
+
+// SetNillableBoxID sets the "box_id" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableBoxID(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetBoxID(*v)
+	}
+	return _c
+}
+
+// SetConnKey sets the "conn_key" field.
+func (_c *DeviceCreate) SetConnKey(v string) *DeviceCreate {
+	_c.mutation.SetConnKey(v)
+	return _c
+}
+
+// SetNillableConnKey sets the "conn_key" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableConnKey(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetConnKey(*v)
+	}
+	return _c
+}
+
+// SetModelName sets the "model_name" field.
+func (_c *DeviceCreate) SetModelName(v string) *DeviceCreate {
+	_c.mutation.SetModelName(v)
+	return _c
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableModelName(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetModelName(*v)
+	}
+	return _c
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (_c *DeviceCreate) SetDriverProps(v map[string]string) *DeviceCreate {
+	_c.mutation.SetDriverProps(v)
+	return _c
+}
+
 // SetStatus sets the "status" field.
 func (_c *DeviceCreate) SetStatus(v int) *DeviceCreate {
 	_c.mutation.SetStatus(v)
@@ -181,20 +287,6 @@ func (_c *DeviceCreate) SetNillableMemo(v *string) *DeviceCreate {
 	return _c
 }
 
-// SetIsDel sets the "is_del" field.
-func (_c *DeviceCreate) SetIsDel(v int) *DeviceCreate {
-	_c.mutation.SetIsDel(v)
-	return _c
-}
-
-// SetNillableIsDel sets the "is_del" field if the given value is not nil.
-func (_c *DeviceCreate) SetNillableIsDel(v *int) *DeviceCreate {
-	if v != nil {
-		_c.SetIsDel(*v)
-	}
-	return _c
-}
-
 // SetID sets the "id" field.
 func (_c *DeviceCreate) SetID(v string) *DeviceCreate {
 	_c.mutation.SetID(v)
@@ -209,6 +301,36 @@ func (_c *DeviceCreate) SetNillableID(v *string) *DeviceCreate {
 	return _c
 }
 
+// SetLocation sets the "location" edge to the Location entity.
+func (_c *DeviceCreate) SetLocation(v *Location) *DeviceCreate {
+	return _c.SetLocationID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Device entity.
+func (_c *DeviceCreate) SetParent(v *Device) *DeviceCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Device entity by IDs.
+func (_c *DeviceCreate) AddChildIDs(ids ...string) *DeviceCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Device entity.
+func (_c *DeviceCreate) AddChildren(v ...*Device) *DeviceCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// SetBox sets the "box" edge to the EdgeBox entity.
+func (_c *DeviceCreate) SetBox(v *EdgeBox) *DeviceCreate {
+	return _c.SetBoxID(v.ID)
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_c *DeviceCreate) Mutation() *DeviceMutation {
 	return _c.mutation
@@ -216,7 +338,9 @@ func (_c *DeviceCreate) Mutation() *DeviceMutation {
 
 // Save creates the Device in the database.
 func (_c *DeviceCreate) Save(ctx context.Context) (*Device, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -243,15 +367,25 @@ func (_c *DeviceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DeviceCreate) defaults() {
+func (_c *DeviceCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if device.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if device.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
+	if _, ok := _c.mutation.IsDel(); !ok {
+		v := device.DefaultIsDel
+		_c.mutation.SetIsDel(v)
+	}
 	if _, ok := _c.mutation.Rate(); !ok {
 		v := device.DefaultRate
 		_c.mutation.SetRate(v)
@@ -260,14 +394,14 @@ func (_c *DeviceCreate) defaults() {
 		v := device.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
-	if _, ok := _c.mutation.IsDel(); !ok {
-		v := device.DefaultIsDel
-		_c.mutation.SetIsDel(v)
-	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if device.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -278,6 +412,9 @@ func (_c *DeviceCreate) check() error {
 	if _, ok := _c.mutation.UpdateTime(); !ok {
 		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Device.update_time"`)}
 	}
+	if _, ok := _c.mutation.IsDel(); !ok {
+		return &ValidationError{Name: "is_del", err: errors.New(`ent: missing required field "Device.is_del"`)}
+	}
 	if _, ok := _c.mutation.DeviceCode(); !ok {
 		return &ValidationError{Name: "device_code", err: errors.New(`ent: missing required field "Device.device_code"`)}
 	}
@@ -308,9 +445,6 @@ func (_c *DeviceCreate) check() error {
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Device.status"`)}
 	}
-	if _, ok := _c.mutation.IsDel(); !ok {
-		return &ValidationError{Name: "is_del", err: errors.New(`ent: missing required field "Device.is_del"`)}
-	}
 	if v, ok := _c.mutation.ID(); ok {
 		if err := device.IDValidator(v); err != nil {
 			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Device.id": %w`, err)}
@@ -360,6 +494,14 @@ func (_c *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldUpdateTime, field.TypeTime, value)
 		_node.UpdateTime = value
 	}
+	if value, ok := _c.mutation.IsDel(); ok {
+		_spec.SetField(device.FieldIsDel, field.TypeInt64, value)
+		_node.IsDel = value
+	}
+	if value, ok := _c.mutation.DeleteTime(); ok {
+		_spec.SetField(device.FieldDeleteTime, field.TypeTime, value)
+		_node.DeleteTime = &value
+	}
 	if value, ok := _c.mutation.DeviceCode(); ok {
 		_spec.SetField(device.FieldDeviceCode, field.TypeString, value)
 		_node.DeviceCode = value
@@ -396,6 +538,18 @@ func (_c *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldPcode, field.TypeString, value)
 		_node.Pcode = value
 	}
+	if value, ok := _c.mutation.ConnKey(); ok {
+		_spec.SetField(device.FieldConnKey, field.TypeString, value)
+		_node.ConnKey = value
+	}
+	if value, ok := _c.mutation.ModelName(); ok {
+		_spec.SetField(device.FieldModelName, field.TypeString, value)
+		_node.ModelName = value
+	}
+	if value, ok := _c.mutation.DriverProps(); ok {
+		_spec.SetField(device.FieldDriverProps, field.TypeJSON, value)
+		_node.DriverProps = value
+	}
 	if value, ok := _c.mutation.Status(); ok {
 		_spec.SetField(device.FieldStatus, field.TypeInt, value)
 		_node.Status = value
@@ -404,9 +558,72 @@ func (_c *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldMemo, field.TypeString, value)
 		_node.Memo = value
 	}
-	if value, ok := _c.mutation.IsDel(); ok {
-		_spec.SetField(device.FieldIsDel, field.TypeInt, value)
-		_node.IsDel = value
+	if nodes := _c.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LocationTable,
+			Columns: []string{device.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.LocationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.ParentTable,
+			Columns: []string{device.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BoxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.BoxTable,
+			Columns: []string{device.BoxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.BoxID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
 }
@@ -472,6 +689,42 @@ func (u *DeviceUpsert) UpdateUpdateTime() *DeviceUpsert {
 	return u
 }
 
+// SetIsDel sets the "is_del" field.
+func (u *DeviceUpsert) SetIsDel(v int64) *DeviceUpsert {
+	u.Set(device.FieldIsDel, v)
+	return u
+}
+
+// UpdateIsDel sets the "is_del" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateIsDel() *DeviceUpsert {
+	u.SetExcluded(device.FieldIsDel)
+	return u
+}
+
+// AddIsDel adds v to the "is_del" field.
+func (u *DeviceUpsert) AddIsDel(v int64) *DeviceUpsert {
+	u.Add(device.FieldIsDel, v)
+	return u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DeviceUpsert) SetDeleteTime(v time.Time) *DeviceUpsert {
+	u.Set(device.FieldDeleteTime, v)
+	return u
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDeleteTime() *DeviceUpsert {
+	u.SetExcluded(device.FieldDeleteTime)
+	return u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DeviceUpsert) ClearDeleteTime() *DeviceUpsert {
+	u.SetNull(device.FieldDeleteTime)
+	return u
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (u *DeviceUpsert) SetDeviceCode(v string) *DeviceUpsert {
 	u.Set(device.FieldDeviceCode, v)
@@ -550,6 +803,18 @@ func (u *DeviceUpsert) AddRate(v int) *DeviceUpsert {
 	return u
 }
 
+// SetProject sets the "project" field.
+func (u *DeviceUpsert) SetProject(v string) *DeviceUpsert {
+	u.Set(device.FieldProject, v)
+	return u
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateProject() *DeviceUpsert {
+	u.SetExcluded(device.FieldProject)
+	return u
+}
+
 // SetPosCode sets the "pos_code" field.
 func (u *DeviceUpsert) SetPosCode(v string) *DeviceUpsert {
 	u.Set(device.FieldPosCode, v)
@@ -604,6 +869,114 @@ func (u *DeviceUpsert) ClearPcode() *DeviceUpsert {
 	return u
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *DeviceUpsert) SetParentID(v string) *DeviceUpsert {
+	u.Set(device.FieldParentID, v)
+	return u
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateParentID() *DeviceUpsert {
+	u.SetExcluded(device.FieldParentID)
+	return u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DeviceUpsert) ClearParentID() *DeviceUpsert {
+	u.SetNull(device.FieldParentID)
+	return u
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *DeviceUpsert) SetLocationID(v string) *DeviceUpsert {
+	u.Set(device.FieldLocationID, v)
+	return u
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateLocationID() *DeviceUpsert {
+	u.SetExcluded(device.FieldLocationID)
+	return u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *DeviceUpsert) ClearLocationID() *DeviceUpsert {
+	u.SetNull(device.FieldLocationID)
+	return u
+}
+
+// SetBoxID sets the "box_id" field.
+func (u *DeviceUpsert) SetBoxID(v string) *DeviceUpsert {
+	u.Set(device.FieldBoxID, v)
+	return u
+}
+
+// UpdateBoxID sets the "box_id" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateBoxID() *DeviceUpsert {
+	u.SetExcluded(device.FieldBoxID)
+	return u
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (u *DeviceUpsert) ClearBoxID() *DeviceUpsert {
+	u.SetNull(device.FieldBoxID)
+	return u
+}
+
+// SetConnKey sets the "conn_key" field.
+func (u *DeviceUpsert) SetConnKey(v string) *DeviceUpsert {
+	u.Set(device.FieldConnKey, v)
+	return u
+}
+
+// UpdateConnKey sets the "conn_key" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateConnKey() *DeviceUpsert {
+	u.SetExcluded(device.FieldConnKey)
+	return u
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (u *DeviceUpsert) ClearConnKey() *DeviceUpsert {
+	u.SetNull(device.FieldConnKey)
+	return u
+}
+
+// SetModelName sets the "model_name" field.
+func (u *DeviceUpsert) SetModelName(v string) *DeviceUpsert {
+	u.Set(device.FieldModelName, v)
+	return u
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateModelName() *DeviceUpsert {
+	u.SetExcluded(device.FieldModelName)
+	return u
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *DeviceUpsert) ClearModelName() *DeviceUpsert {
+	u.SetNull(device.FieldModelName)
+	return u
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (u *DeviceUpsert) SetDriverProps(v map[string]string) *DeviceUpsert {
+	u.Set(device.FieldDriverProps, v)
+	return u
+}
+
+// UpdateDriverProps sets the "driver_props" field to the value that was provided on create.
+func (u *DeviceUpsert) UpdateDriverProps() *DeviceUpsert {
+	u.SetExcluded(device.FieldDriverProps)
+	return u
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (u *DeviceUpsert) ClearDriverProps() *DeviceUpsert {
+	u.SetNull(device.FieldDriverProps)
+	return u
+}
+
 // SetStatus sets the "status" field.
 func (u *DeviceUpsert) SetStatus(v int) *DeviceUpsert {
 	u.Set(device.FieldStatus, v)
@@ -640,24 +1013,6 @@ func (u *DeviceUpsert) ClearMemo() *DeviceUpsert {
 	return u
 }
 
-// SetIsDel sets the "is_del" field.
-func (u *DeviceUpsert) SetIsDel(v int) *DeviceUpsert {
-	u.Set(device.FieldIsDel, v)
-	return u
-}
-
-// UpdateIsDel sets the "is_del" field to the value that was provided on create.
-func (u *DeviceUpsert) UpdateIsDel() *DeviceUpsert {
-	u.SetExcluded(device.FieldIsDel)
-	return u
-}
-
-// AddIsDel adds v to the "is_del" field.
-func (u *DeviceUpsert) AddIsDel(v int) *DeviceUpsert {
-	u.Add(device.FieldIsDel, v)
-	return u
-}
-
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -678,9 +1033,6 @@ func (u *DeviceUpsertOne) UpdateNewValues() *DeviceUpsertOne {
 		if _, exists := u.create.mutation.CreateTime(); exists {
 			s.SetIgnore(device.FieldCreateTime)
 		}
-		if _, exists := u.create.mutation.Project(); exists {
-			s.SetIgnore(device.FieldProject)
-		}
 	}))
 	return u
 }
@@ -726,6 +1078,48 @@ func (u *DeviceUpsertOne) UpdateUpdateTime() *DeviceUpsertOne {
 	})
 }
 
+// SetIsDel sets the "is_del" field.
+func (u *DeviceUpsertOne) SetIsDel(v int64) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetIsDel(v)
+	})
+}
+
+// AddIsDel adds v to the "is_del" field.
+func (u *DeviceUpsertOne) AddIsDel(v int64) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.AddIsDel(v)
+	})
+}
+
+// UpdateIsDel sets the "is_del" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateIsDel() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateIsDel()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DeviceUpsertOne) SetDeleteTime(v time.Time) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDeleteTime() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DeviceUpsertOne) ClearDeleteTime() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (u *DeviceUpsertOne) SetDeviceCode(v string) *DeviceUpsertOne {
 	return u.Update(func(s *DeviceUpsert) {
@@ -817,6 +1211,20 @@ func (u *DeviceUpsertOne) UpdateRate() *DeviceUpsertOne {
 	})
 }
 
+// SetProject sets the "project" field.
+func (u *DeviceUpsertOne) SetProject(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateProject() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateProject()
+	})
+}
+
 // SetPosCode sets the "pos_code" field.
 func (u *DeviceUpsertOne) SetPosCode(v string) *DeviceUpsertOne {
 	return u.Update(func(s *DeviceUpsert) {
@@ -880,6 +1288,132 @@ func (u *DeviceUpsertOne) ClearPcode() *DeviceUpsertOne {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *DeviceUpsertOne) SetParentID(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateParentID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DeviceUpsertOne) ClearParentID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *DeviceUpsertOne) SetLocationID(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLocationID(v)
+	})
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateLocationID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLocationID()
+	})
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *DeviceUpsertOne) ClearLocationID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLocationID()
+	})
+}
+
+// SetBoxID sets the "box_id" field.
+func (u *DeviceUpsertOne) SetBoxID(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetBoxID(v)
+	})
+}
+
+// UpdateBoxID sets the "box_id" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateBoxID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateBoxID()
+	})
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (u *DeviceUpsertOne) ClearBoxID() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearBoxID()
+	})
+}
+
+// SetConnKey sets the "conn_key" field.
+func (u *DeviceUpsertOne) SetConnKey(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetConnKey(v)
+	})
+}
+
+// UpdateConnKey sets the "conn_key" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateConnKey() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateConnKey()
+	})
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (u *DeviceUpsertOne) ClearConnKey() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearConnKey()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *DeviceUpsertOne) SetModelName(v string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateModelName() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *DeviceUpsertOne) ClearModelName() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (u *DeviceUpsertOne) SetDriverProps(v map[string]string) *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDriverProps(v)
+	})
+}
+
+// UpdateDriverProps sets the "driver_props" field to the value that was provided on create.
+func (u *DeviceUpsertOne) UpdateDriverProps() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDriverProps()
+	})
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (u *DeviceUpsertOne) ClearDriverProps() *DeviceUpsertOne {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDriverProps()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DeviceUpsertOne) SetStatus(v int) *DeviceUpsertOne {
 	return u.Update(func(s *DeviceUpsert) {
@@ -922,27 +1456,6 @@ func (u *DeviceUpsertOne) ClearMemo() *DeviceUpsertOne {
 	})
 }
 
-// SetIsDel sets the "is_del" field.
-func (u *DeviceUpsertOne) SetIsDel(v int) *DeviceUpsertOne {
-	return u.Update(func(s *DeviceUpsert) {
-		s.SetIsDel(v)
-	})
-}
-
-// AddIsDel adds v to the "is_del" field.
-func (u *DeviceUpsertOne) AddIsDel(v int) *DeviceUpsertOne {
-	return u.Update(func(s *DeviceUpsert) {
-		s.AddIsDel(v)
-	})
-}
-
-// UpdateIsDel sets the "is_del" field to the value that was provided on create.
-func (u *DeviceUpsertOne) UpdateIsDel() *DeviceUpsertOne {
-	return u.Update(func(s *DeviceUpsert) {
-		s.UpdateIsDel()
-	})
-}
-
 // Exec executes the query.
 func (u *DeviceUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1129,9 +1642,6 @@ func (u *DeviceUpsertBulk) UpdateNewValues() *DeviceUpsertBulk {
 			if _, exists := b.mutation.CreateTime(); exists {
 				s.SetIgnore(device.FieldCreateTime)
 			}
-			if _, exists := b.mutation.Project(); exists {
-				s.SetIgnore(device.FieldProject)
-			}
 		}
 	}))
 	return u
@@ -1178,6 +1688,48 @@ func (u *DeviceUpsertBulk) UpdateUpdateTime() *DeviceUpsertBulk {
 	})
 }
 
+// SetIsDel sets the "is_del" field.
+func (u *DeviceUpsertBulk) SetIsDel(v int64) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetIsDel(v)
+	})
+}
+
+// AddIsDel adds v to the "is_del" field.
+func (u *DeviceUpsertBulk) AddIsDel(v int64) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.AddIsDel(v)
+	})
+}
+
+// UpdateIsDel sets the "is_del" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateIsDel() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateIsDel()
+	})
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (u *DeviceUpsertBulk) SetDeleteTime(v time.Time) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDeleteTime(v)
+	})
+}
+
+// UpdateDeleteTime sets the "delete_time" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDeleteTime() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDeleteTime()
+	})
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (u *DeviceUpsertBulk) ClearDeleteTime() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDeleteTime()
+	})
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (u *DeviceUpsertBulk) SetDeviceCode(v string) *DeviceUpsertBulk {
 	return u.Update(func(s *DeviceUpsert) {
@@ -1269,6 +1821,20 @@ func (u *DeviceUpsertBulk) UpdateRate() *DeviceUpsertBulk {
 	})
 }
 
+// SetProject sets the "project" field.
+func (u *DeviceUpsertBulk) SetProject(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateProject() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateProject()
+	})
+}
+
 // SetPosCode sets the "pos_code" field.
 func (u *DeviceUpsertBulk) SetPosCode(v string) *DeviceUpsertBulk {
 	return u.Update(func(s *DeviceUpsert) {
@@ -1332,6 +1898,132 @@ func (u *DeviceUpsertBulk) ClearPcode() *DeviceUpsertBulk {
 	})
 }
 
+// SetParentID sets the "parent_id" field.
+func (u *DeviceUpsertBulk) SetParentID(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetParentID(v)
+	})
+}
+
+// UpdateParentID sets the "parent_id" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateParentID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateParentID()
+	})
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (u *DeviceUpsertBulk) ClearParentID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearParentID()
+	})
+}
+
+// SetLocationID sets the "location_id" field.
+func (u *DeviceUpsertBulk) SetLocationID(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetLocationID(v)
+	})
+}
+
+// UpdateLocationID sets the "location_id" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateLocationID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateLocationID()
+	})
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (u *DeviceUpsertBulk) ClearLocationID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearLocationID()
+	})
+}
+
+// SetBoxID sets the "box_id" field.
+func (u *DeviceUpsertBulk) SetBoxID(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetBoxID(v)
+	})
+}
+
+// UpdateBoxID sets the "box_id" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateBoxID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateBoxID()
+	})
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (u *DeviceUpsertBulk) ClearBoxID() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearBoxID()
+	})
+}
+
+// SetConnKey sets the "conn_key" field.
+func (u *DeviceUpsertBulk) SetConnKey(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetConnKey(v)
+	})
+}
+
+// UpdateConnKey sets the "conn_key" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateConnKey() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateConnKey()
+	})
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (u *DeviceUpsertBulk) ClearConnKey() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearConnKey()
+	})
+}
+
+// SetModelName sets the "model_name" field.
+func (u *DeviceUpsertBulk) SetModelName(v string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetModelName(v)
+	})
+}
+
+// UpdateModelName sets the "model_name" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateModelName() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateModelName()
+	})
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (u *DeviceUpsertBulk) ClearModelName() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearModelName()
+	})
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (u *DeviceUpsertBulk) SetDriverProps(v map[string]string) *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.SetDriverProps(v)
+	})
+}
+
+// UpdateDriverProps sets the "driver_props" field to the value that was provided on create.
+func (u *DeviceUpsertBulk) UpdateDriverProps() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.UpdateDriverProps()
+	})
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (u *DeviceUpsertBulk) ClearDriverProps() *DeviceUpsertBulk {
+	return u.Update(func(s *DeviceUpsert) {
+		s.ClearDriverProps()
+	})
+}
+
 // SetStatus sets the "status" field.
 func (u *DeviceUpsertBulk) SetStatus(v int) *DeviceUpsertBulk {
 	return u.Update(func(s *DeviceUpsert) {
@@ -1374,27 +2066,6 @@ func (u *DeviceUpsertBulk) ClearMemo() *DeviceUpsertBulk {
 	})
 }
 
-// SetIsDel sets the "is_del" field.
-func (u *DeviceUpsertBulk) SetIsDel(v int) *DeviceUpsertBulk {
-	return u.Update(func(s *DeviceUpsert) {
-		s.SetIsDel(v)
-	})
-}
-
-// AddIsDel adds v to the "is_del" field.
-func (u *DeviceUpsertBulk) AddIsDel(v int) *DeviceUpsertBulk {
-	return u.Update(func(s *DeviceUpsert) {
-		s.AddIsDel(v)
-	})
-}
-
-// UpdateIsDel sets the "is_del" field to the value that was provided on create.
-func (u *DeviceUpsertBulk) UpdateIsDel() *DeviceUpsertBulk {
-	return u.Update(func(s *DeviceUpsert) {
-		s.UpdateIsDel()
-	})
-}
-
 // Exec executes the query.
 func (u *DeviceUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
 	"github.com/twiglab/h2o/archon/orm/ent/predicate"
 )
 
@@ -34,6 +36,47 @@ func (_u *DeviceUpdate) SetUpdateTime(v time.Time) *DeviceUpdate {
 	return _u
 }
 
+// SetIsDel sets the "is_del" field.
+func (_u *DeviceUpdate) SetIsDel(v int64) *DeviceUpdate {
+	_u.mutation.ResetIsDel()
+	_u.mutation.SetIsDel(v)
+	return _u
+}
+
+// SetNillableIsDel sets the "is_del" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableIsDel(v *int64) *DeviceUpdate {
+	if v != nil {
+		_u.SetIsDel(*v)
+	}
+	return _u
+}
+
+// AddIsDel adds value to the "is_del" field.
+func (_u *DeviceUpdate) AddIsDel(v int64) *DeviceUpdate {
+	_u.mutation.AddIsDel(v)
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *DeviceUpdate) SetDeleteTime(v time.Time) *DeviceUpdate {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableDeleteTime(v *time.Time) *DeviceUpdate {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *DeviceUpdate) ClearDeleteTime() *DeviceUpdate {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (_u *DeviceUpdate) SetDeviceCode(v string) *DeviceUpdate {
 	_u.mutation.SetDeviceCode(v)
@@ -123,6 +166,20 @@ func (_u *DeviceUpdate) AddRate(v int) *DeviceUpdate {
 	return _u
 }
 
+// SetProject sets the "project" field.
+func (_u *DeviceUpdate) SetProject(v string) *DeviceUpdate {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableProject(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
 // SetPosCode sets the "pos_code" field.
 func (_u *DeviceUpdate) SetPosCode(v string) *DeviceUpdate {
 	_u.mutation.SetPosCode(v)
@@ -183,6 +240,118 @@ func (_u *DeviceUpdate) ClearPcode() *DeviceUpdate {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *DeviceUpdate) SetParentID(v string) *DeviceUpdate {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableParentID(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *DeviceUpdate) ClearParentID() *DeviceUpdate {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *DeviceUpdate) SetLocationID(v string) *DeviceUpdate {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableLocationID(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (_u *DeviceUpdate) ClearLocationID() *DeviceUpdate {
+	_u.mutation.ClearLocationID()
+	return _u
+}
+
+// SetBoxID sets the "box_id" field.
+func (_u *DeviceUpdate) SetBoxID(v string) *DeviceUpdate {
+	_u.mutation.SetBoxID(v)
+	return _u
+}
+
+// SetNillableBoxID sets the "box_id" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableBoxID(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetBoxID(*v)
+	}
+	return _u
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (_u *DeviceUpdate) ClearBoxID() *DeviceUpdate {
+	_u.mutation.ClearBoxID()
+	return _u
+}
+
+// SetConnKey sets the "conn_key" field.
+func (_u *DeviceUpdate) SetConnKey(v string) *DeviceUpdate {
+	_u.mutation.SetConnKey(v)
+	return _u
+}
+
+// SetNillableConnKey sets the "conn_key" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableConnKey(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetConnKey(*v)
+	}
+	return _u
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (_u *DeviceUpdate) ClearConnKey() *DeviceUpdate {
+	_u.mutation.ClearConnKey()
+	return _u
+}
+
+// SetModelName sets the "model_name" field.
+func (_u *DeviceUpdate) SetModelName(v string) *DeviceUpdate {
+	_u.mutation.SetModelName(v)
+	return _u
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableModelName(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetModelName(*v)
+	}
+	return _u
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (_u *DeviceUpdate) ClearModelName() *DeviceUpdate {
+	_u.mutation.ClearModelName()
+	return _u
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (_u *DeviceUpdate) SetDriverProps(v map[string]string) *DeviceUpdate {
+	_u.mutation.SetDriverProps(v)
+	return _u
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (_u *DeviceUpdate) ClearDriverProps() *DeviceUpdate {
+	_u.mutation.ClearDriverProps()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DeviceUpdate) SetStatus(v int) *DeviceUpdate {
 	_u.mutation.ResetStatus()
@@ -224,25 +393,34 @@ func (_u *DeviceUpdate) ClearMemo() *DeviceUpdate {
 	return _u
 }
 
-// SetIsDel sets the "is_del" field.
-func (_u *DeviceUpdate) SetIsDel(v int) *DeviceUpdate {
-	_u.mutation.ResetIsDel()
-	_u.mutation.SetIsDel(v)
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *DeviceUpdate) SetLocation(v *Location) *DeviceUpdate {
+	return _u.SetLocationID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Device entity.
+func (_u *DeviceUpdate) SetParent(v *Device) *DeviceUpdate {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Device entity by IDs.
+func (_u *DeviceUpdate) AddChildIDs(ids ...string) *DeviceUpdate {
+	_u.mutation.AddChildIDs(ids...)
 	return _u
 }
 
-// SetNillableIsDel sets the "is_del" field if the given value is not nil.
-func (_u *DeviceUpdate) SetNillableIsDel(v *int) *DeviceUpdate {
-	if v != nil {
-		_u.SetIsDel(*v)
+// AddChildren adds the "children" edges to the Device entity.
+func (_u *DeviceUpdate) AddChildren(v ...*Device) *DeviceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.AddChildIDs(ids...)
 }
 
-// AddIsDel adds value to the "is_del" field.
-func (_u *DeviceUpdate) AddIsDel(v int) *DeviceUpdate {
-	_u.mutation.AddIsDel(v)
-	return _u
+// SetBox sets the "box" edge to the EdgeBox entity.
+func (_u *DeviceUpdate) SetBox(v *EdgeBox) *DeviceUpdate {
+	return _u.SetBoxID(v.ID)
 }
 
 // Mutation returns the DeviceMutation object of the builder.
@@ -250,9 +428,50 @@ func (_u *DeviceUpdate) Mutation() *DeviceMutation {
 	return _u.mutation
 }
 
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *DeviceUpdate) ClearLocation() *DeviceUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Device entity.
+func (_u *DeviceUpdate) ClearParent() *DeviceUpdate {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Device entity.
+func (_u *DeviceUpdate) ClearChildren() *DeviceUpdate {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Device entities by IDs.
+func (_u *DeviceUpdate) RemoveChildIDs(ids ...string) *DeviceUpdate {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Device entities.
+func (_u *DeviceUpdate) RemoveChildren(v ...*Device) *DeviceUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBox clears the "box" edge to the EdgeBox entity.
+func (_u *DeviceUpdate) ClearBox() *DeviceUpdate {
+	_u.mutation.ClearBox()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeviceUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -279,11 +498,15 @@ func (_u *DeviceUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeviceUpdate) defaults() {
+func (_u *DeviceUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if device.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized device.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := device.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -298,6 +521,11 @@ func (_u *DeviceUpdate) check() error {
 			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "Device.device_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := device.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "Device.project": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -316,6 +544,18 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(device.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.IsDel(); ok {
+		_spec.SetField(device.FieldIsDel, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedIsDel(); ok {
+		_spec.AddField(device.FieldIsDel, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(device.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(device.FieldDeleteTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.DeviceCode(); ok {
 		_spec.SetField(device.FieldDeviceCode, field.TypeString, value)
 	}
@@ -340,6 +580,9 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedRate(); ok {
 		_spec.AddField(device.FieldRate, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(device.FieldProject, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PosCode(); ok {
 		_spec.SetField(device.FieldPosCode, field.TypeString, value)
 	}
@@ -358,6 +601,24 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.PcodeCleared() {
 		_spec.ClearField(device.FieldPcode, field.TypeString)
 	}
+	if value, ok := _u.mutation.ConnKey(); ok {
+		_spec.SetField(device.FieldConnKey, field.TypeString, value)
+	}
+	if _u.mutation.ConnKeyCleared() {
+		_spec.ClearField(device.FieldConnKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModelName(); ok {
+		_spec.SetField(device.FieldModelName, field.TypeString, value)
+	}
+	if _u.mutation.ModelNameCleared() {
+		_spec.ClearField(device.FieldModelName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DriverProps(); ok {
+		_spec.SetField(device.FieldDriverProps, field.TypeJSON, value)
+	}
+	if _u.mutation.DriverPropsCleared() {
+		_spec.ClearField(device.FieldDriverProps, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(device.FieldStatus, field.TypeInt, value)
 	}
@@ -370,11 +631,137 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.MemoCleared() {
 		_spec.ClearField(device.FieldMemo, field.TypeString)
 	}
-	if value, ok := _u.mutation.IsDel(); ok {
-		_spec.SetField(device.FieldIsDel, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedIsDel(); ok {
-		_spec.AddField(device.FieldIsDel, field.TypeInt, value)
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LocationTable,
+			Columns: []string{device.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LocationTable,
+			Columns: []string{device.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.ParentTable,
+			Columns: []string{device.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.ParentTable,
+			Columns: []string{device.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BoxCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.BoxTable,
+			Columns: []string{device.BoxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BoxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.BoxTable,
+			Columns: []string{device.BoxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -402,6 +789,47 @@ func (_u *DeviceUpdateOne) SetUpdateTime(v time.Time) *DeviceUpdateOne {
 	return _u
 }
 
+// SetIsDel sets the "is_del" field.
+func (_u *DeviceUpdateOne) SetIsDel(v int64) *DeviceUpdateOne {
+	_u.mutation.ResetIsDel()
+	_u.mutation.SetIsDel(v)
+	return _u
+}
+
+// SetNillableIsDel sets the "is_del" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableIsDel(v *int64) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetIsDel(*v)
+	}
+	return _u
+}
+
+// AddIsDel adds value to the "is_del" field.
+func (_u *DeviceUpdateOne) AddIsDel(v int64) *DeviceUpdateOne {
+	_u.mutation.AddIsDel(v)
+	return _u
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (_u *DeviceUpdateOne) SetDeleteTime(v time.Time) *DeviceUpdateOne {
+	_u.mutation.SetDeleteTime(v)
+	return _u
+}
+
+// SetNillableDeleteTime sets the "delete_time" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableDeleteTime(v *time.Time) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetDeleteTime(*v)
+	}
+	return _u
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (_u *DeviceUpdateOne) ClearDeleteTime() *DeviceUpdateOne {
+	_u.mutation.ClearDeleteTime()
+	return _u
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (_u *DeviceUpdateOne) SetDeviceCode(v string) *DeviceUpdateOne {
 	_u.mutation.SetDeviceCode(v)
@@ -491,6 +919,20 @@ func (_u *DeviceUpdateOne) AddRate(v int) *DeviceUpdateOne {
 	return _u
 }
 
+// SetProject sets the "project" field.
+func (_u *DeviceUpdateOne) SetProject(v string) *DeviceUpdateOne {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableProject(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
 // SetPosCode sets the "pos_code" field.
 func (_u *DeviceUpdateOne) SetPosCode(v string) *DeviceUpdateOne {
 	_u.mutation.SetPosCode(v)
@@ -551,6 +993,118 @@ func (_u *DeviceUpdateOne) ClearPcode() *DeviceUpdateOne {
 	return _u
 }
 
+// SetParentID sets the "parent_id" field.
+func (_u *DeviceUpdateOne) SetParentID(v string) *DeviceUpdateOne {
+	_u.mutation.SetParentID(v)
+	return _u
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableParentID(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetParentID(*v)
+	}
+	return _u
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (_u *DeviceUpdateOne) ClearParentID() *DeviceUpdateOne {
+	_u.mutation.ClearParentID()
+	return _u
+}
+
+// SetLocationID sets the "location_id" field.
+func (_u *DeviceUpdateOne) SetLocationID(v string) *DeviceUpdateOne {
+	_u.mutation.SetLocationID(v)
+	return _u
+}
+
+// SetNillableLocationID sets the "location_id" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableLocationID(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetLocationID(*v)
+	}
+	return _u
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (_u *DeviceUpdateOne) ClearLocationID() *DeviceUpdateOne {
+	_u.mutation.ClearLocationID()
+	return _u
+}
+
+// SetBoxID sets the "box_id" field.
+func (_u *DeviceUpdateOne) SetBoxID(v string) *DeviceUpdateOne {
+	_u.mutation.SetBoxID(v)
+	return _u
+}
+
+// SetNillableBoxID sets the "box_id" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableBoxID(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetBoxID(*v)
+	}
+	return _u
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (_u *DeviceUpdateOne) ClearBoxID() *DeviceUpdateOne {
+	_u.mutation.ClearBoxID()
+	return _u
+}
+
+// SetConnKey sets the "conn_key" field.
+func (_u *DeviceUpdateOne) SetConnKey(v string) *DeviceUpdateOne {
+	_u.mutation.SetConnKey(v)
+	return _u
+}
+
+// SetNillableConnKey sets the "conn_key" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableConnKey(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetConnKey(*v)
+	}
+	return _u
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (_u *DeviceUpdateOne) ClearConnKey() *DeviceUpdateOne {
+	_u.mutation.ClearConnKey()
+	return _u
+}
+
+// SetModelName sets the "model_name" field.
+func (_u *DeviceUpdateOne) SetModelName(v string) *DeviceUpdateOne {
+	_u.mutation.SetModelName(v)
+	return _u
+}
+
+// SetNillableModelName sets the "model_name" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableModelName(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetModelName(*v)
+	}
+	return _u
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (_u *DeviceUpdateOne) ClearModelName() *DeviceUpdateOne {
+	_u.mutation.ClearModelName()
+	return _u
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (_u *DeviceUpdateOne) SetDriverProps(v map[string]string) *DeviceUpdateOne {
+	_u.mutation.SetDriverProps(v)
+	return _u
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (_u *DeviceUpdateOne) ClearDriverProps() *DeviceUpdateOne {
+	_u.mutation.ClearDriverProps()
+	return _u
+}
+
 // SetStatus sets the "status" field.
 func (_u *DeviceUpdateOne) SetStatus(v int) *DeviceUpdateOne {
 	_u.mutation.ResetStatus()
@@ -592,25 +1146,34 @@ func (_u *DeviceUpdateOne) ClearMemo() *DeviceUpdateOne {
 	return _u
 }
 
-// SetIsDel sets the "is_del" field.
-func (_u *DeviceUpdateOne) SetIsDel(v int) *DeviceUpdateOne {
-	_u.mutation.ResetIsDel()
-	_u.mutation.SetIsDel(v)
+// SetLocation sets the "location" edge to the Location entity.
+func (_u *DeviceUpdateOne) SetLocation(v *Location) *DeviceUpdateOne {
+	return _u.SetLocationID(v.ID)
+}
+
+// SetParent sets the "parent" edge to the Device entity.
+func (_u *DeviceUpdateOne) SetParent(v *Device) *DeviceUpdateOne {
+	return _u.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Device entity by IDs.
+func (_u *DeviceUpdateOne) AddChildIDs(ids ...string) *DeviceUpdateOne {
+	_u.mutation.AddChildIDs(ids...)
 	return _u
 }
 
-// SetNillableIsDel sets the "is_del" field if the given value is not nil.
-func (_u *DeviceUpdateOne) SetNillableIsDel(v *int) *DeviceUpdateOne {
-	if v != nil {
-		_u.SetIsDel(*v)
+// AddChildren adds the "children" edges to the Device entity.
+func (_u *DeviceUpdateOne) AddChildren(v ...*Device) *DeviceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
 	}
-	return _u
+	return _u.AddChildIDs(ids...)
 }
 
-// AddIsDel adds value to the "is_del" field.
-func (_u *DeviceUpdateOne) AddIsDel(v int) *DeviceUpdateOne {
-	_u.mutation.AddIsDel(v)
-	return _u
+// SetBox sets the "box" edge to the EdgeBox entity.
+func (_u *DeviceUpdateOne) SetBox(v *EdgeBox) *DeviceUpdateOne {
+	return _u.SetBoxID(v.ID)
 }
 
 // Mutation returns the DeviceMutation object of the builder.
@@ -618,6 +1181,45 @@ func (_u *DeviceUpdateOne) Mutation() *DeviceMutation {
 	return _u.mutation
 }
 
+// ClearLocation clears the "location" edge to the Location entity.
+func (_u *DeviceUpdateOne) ClearLocation() *DeviceUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// ClearParent clears the "parent" edge to the Device entity.
+func (_u *DeviceUpdateOne) ClearParent() *DeviceUpdateOne {
+	_u.mutation.ClearParent()
+	return _u
+}
+
+// ClearChildren clears all "children" edges to the Device entity.
+func (_u *DeviceUpdateOne) ClearChildren() *DeviceUpdateOne {
+	_u.mutation.ClearChildren()
+	return _u
+}
+
+// RemoveChildIDs removes the "children" edge to Device entities by IDs.
+func (_u *DeviceUpdateOne) RemoveChildIDs(ids ...string) *DeviceUpdateOne {
+	_u.mutation.RemoveChildIDs(ids...)
+	return _u
+}
+
+// RemoveChildren removes "children" edges to Device entities.
+func (_u *DeviceUpdateOne) RemoveChildren(v ...*Device) *DeviceUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveChildIDs(ids...)
+}
+
+// ClearBox clears the "box" edge to the EdgeBox entity.
+func (_u *DeviceUpdateOne) ClearBox() *DeviceUpdateOne {
+	_u.mutation.ClearBox()
+	return _u
+}
+
 // Where appends a list predicates to the DeviceUpdate builder.
 func (_u *DeviceUpdateOne) Where(ps ...predicate.Device) *DeviceUpdateOne {
 	_u.mutation.Where(ps...)
@@ -633,7 +1235,9 @@ func (_u *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateO
 
 // Save executes the query and returns the updated Device entity.
 func (_u *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -660,11 +1264,15 @@ func (_u *DeviceUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeviceUpdateOne) defaults() {
+func (_u *DeviceUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if device.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized device.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := device.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -679,6 +1287,11 @@ func (_u *DeviceUpdateOne) check() error {
 			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "Device.device_type": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := device.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "Device.project": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -714,6 +1327,18 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 	if value, ok := _u.mutation.UpdateTime(); ok {
 		_spec.SetField(device.FieldUpdateTime, field.TypeTime, value)
 	}
+	if value, ok := _u.mutation.IsDel(); ok {
+		_spec.SetField(device.FieldIsDel, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedIsDel(); ok {
+		_spec.AddField(device.FieldIsDel, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.DeleteTime(); ok {
+		_spec.SetField(device.FieldDeleteTime, field.TypeTime, value)
+	}
+	if _u.mutation.DeleteTimeCleared() {
+		_spec.ClearField(device.FieldDeleteTime, field.TypeTime)
+	}
 	if value, ok := _u.mutation.DeviceCode(); ok {
 		_spec.SetField(device.FieldDeviceCode, field.TypeString, value)
 	}
@@ -738,6 +1363,9 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 	if value, ok := _u.mutation.AddedRate(); ok {
 		_spec.AddField(device.FieldRate, field.TypeInt, value)
 	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(device.FieldProject, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.PosCode(); ok {
 		_spec.SetField(device.FieldPosCode, field.TypeString, value)
 	}
@@ -756,6 +1384,24 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 	if _u.mutation.PcodeCleared() {
 		_spec.ClearField(device.FieldPcode, field.TypeString)
 	}
+	if value, ok := _u.mutation.ConnKey(); ok {
+		_spec.SetField(device.FieldConnKey, field.TypeString, value)
+	}
+	if _u.mutation.ConnKeyCleared() {
+		_spec.ClearField(device.FieldConnKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.ModelName(); ok {
+		_spec.SetField(device.FieldModelName, field.TypeString, value)
+	}
+	if _u.mutation.ModelNameCleared() {
+		_spec.ClearField(device.FieldModelName, field.TypeString)
+	}
+	if value, ok := _u.mutation.DriverProps(); ok {
+		_spec.SetField(device.FieldDriverProps, field.TypeJSON, value)
+	}
+	if _u.mutation.DriverPropsCleared() {
+		_spec.ClearField(device.FieldDriverProps, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(device.FieldStatus, field.TypeInt, value)
 	}
@@ -768,11 +1414,137 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 	if _u.mutation.MemoCleared() {
 		_spec.ClearField(device.FieldMemo, field.TypeString)
 	}
-	if value, ok := _u.mutation.IsDel(); ok {
-		_spec.SetField(device.FieldIsDel, field.TypeInt, value)
-	}
-	if value, ok := _u.mutation.AddedIsDel(); ok {
-		_spec.AddField(device.FieldIsDel, field.TypeInt, value)
+	if _u.mutation.LocationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LocationTable,
+			Columns: []string{device.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LocationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.LocationTable,
+			Columns: []string{device.LocationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ParentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.ParentTable,
+			Columns: []string{device.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.ParentTable,
+			Columns: []string{device.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedChildrenIDs(); len(nodes) > 0 && !_u.mutation.ChildrenCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   device.ChildrenTable,
+			Columns: []string{device.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BoxCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.BoxTable,
+			Columns: []string{device.BoxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BoxIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   device.BoxTable,
+			Columns: []string{device.BoxColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
 	_node = &Device{config: _u.config}
 	_spec.Assign = _node.assignValues
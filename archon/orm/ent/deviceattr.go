@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+)
+
+// DeviceAttr is the model entity for the DeviceAttr schema.
+type DeviceAttr struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// 设备
+	DeviceID string `json:"device_id,omitempty"`
+	// 设备号
+	DeviceCode string `json:"device_code,omitempty"`
+	// 属性名
+	Name string `json:"name,omitempty"`
+	// 属性值
+	Value string `json:"value,omitempty"`
+	// 生效, 包含
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// 失效, 不包含
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// 对应的变更记录
+	HistoryID    string `json:"history_id,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeviceAttr) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deviceattr.FieldID, deviceattr.FieldDeviceID, deviceattr.FieldDeviceCode, deviceattr.FieldName, deviceattr.FieldValue, deviceattr.FieldHistoryID:
+			values[i] = new(sql.NullString)
+		case deviceattr.FieldValidFrom, deviceattr.FieldValidTo:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeviceAttr fields.
+func (_m *DeviceAttr) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deviceattr.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case deviceattr.FieldDeviceID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_id", values[i])
+			} else if value.Valid {
+				_m.DeviceID = value.String
+			}
+		case deviceattr.FieldDeviceCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_code", values[i])
+			} else if value.Valid {
+				_m.DeviceCode = value.String
+			}
+		case deviceattr.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case deviceattr.FieldValue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field value", values[i])
+			} else if value.Valid {
+				_m.Value = value.String
+			}
+		case deviceattr.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				_m.ValidFrom = value.Time
+			}
+		case deviceattr.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				_m.ValidTo = new(time.Time)
+				*_m.ValidTo = value.Time
+			}
+		case deviceattr.FieldHistoryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field history_id", values[i])
+			} else if value.Valid {
+				_m.HistoryID = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// GetValue returns the ent.Value that was dynamically selected and assigned to the DeviceAttr.
+// This includes values selected through modifiers, order, etc.
+func (_m *DeviceAttr) GetValue(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this DeviceAttr.
+// Note that you need to call DeviceAttr.Unwrap() before calling this method if this DeviceAttr
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *DeviceAttr) Update() *DeviceAttrUpdateOne {
+	return NewDeviceAttrClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the DeviceAttr entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *DeviceAttr) Unwrap() *DeviceAttr {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeviceAttr is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *DeviceAttr) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeviceAttr(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("device_id=")
+	builder.WriteString(_m.DeviceID)
+	builder.WriteString(", ")
+	builder.WriteString("device_code=")
+	builder.WriteString(_m.DeviceCode)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("value=")
+	builder.WriteString(_m.Value)
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(_m.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("history_id=")
+	builder.WriteString(_m.HistoryID)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeviceAttrs is a parsable slice of DeviceAttr.
+type DeviceAttrs []*DeviceAttr
@@ -0,0 +1,550 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceattr
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldID, id))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceCode applies equality check predicate on the "device_code" field. It's identical to DeviceCodeEQ.
+func DeviceCode(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldName, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValue, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValidTo, v))
+}
+
+// HistoryID applies equality check predicate on the "history_id" field. It's identical to HistoryIDEQ.
+func HistoryID(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// DeviceCodeEQ applies the EQ predicate on the "device_code" field.
+func DeviceCodeEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeNEQ applies the NEQ predicate on the "device_code" field.
+func DeviceCodeNEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeIn applies the In predicate on the "device_code" field.
+func DeviceCodeIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeNotIn applies the NotIn predicate on the "device_code" field.
+func DeviceCodeNotIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeGT applies the GT predicate on the "device_code" field.
+func DeviceCodeGT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldDeviceCode, v))
+}
+
+// DeviceCodeGTE applies the GTE predicate on the "device_code" field.
+func DeviceCodeGTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeLT applies the LT predicate on the "device_code" field.
+func DeviceCodeLT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldDeviceCode, v))
+}
+
+// DeviceCodeLTE applies the LTE predicate on the "device_code" field.
+func DeviceCodeLTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeContains applies the Contains predicate on the "device_code" field.
+func DeviceCodeContains(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContains(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasPrefix applies the HasPrefix predicate on the "device_code" field.
+func DeviceCodeHasPrefix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasPrefix(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasSuffix applies the HasSuffix predicate on the "device_code" field.
+func DeviceCodeHasSuffix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasSuffix(FieldDeviceCode, v))
+}
+
+// DeviceCodeEqualFold applies the EqualFold predicate on the "device_code" field.
+func DeviceCodeEqualFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldDeviceCode, v))
+}
+
+// DeviceCodeContainsFold applies the ContainsFold predicate on the "device_code" field.
+func DeviceCodeContainsFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldDeviceCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldName, v))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueIsNil applies the IsNil predicate on the "value" field.
+func ValueIsNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIsNull(FieldValue))
+}
+
+// ValueNotNil applies the NotNil predicate on the "value" field.
+func ValueNotNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotNull(FieldValue))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldValue, v))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotNull(FieldValidTo))
+}
+
+// HistoryIDEQ applies the EQ predicate on the "history_id" field.
+func HistoryIDEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEQ(FieldHistoryID, v))
+}
+
+// HistoryIDNEQ applies the NEQ predicate on the "history_id" field.
+func HistoryIDNEQ(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNEQ(FieldHistoryID, v))
+}
+
+// HistoryIDIn applies the In predicate on the "history_id" field.
+func HistoryIDIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDNotIn applies the NotIn predicate on the "history_id" field.
+func HistoryIDNotIn(vs ...string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotIn(FieldHistoryID, vs...))
+}
+
+// HistoryIDGT applies the GT predicate on the "history_id" field.
+func HistoryIDGT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGT(FieldHistoryID, v))
+}
+
+// HistoryIDGTE applies the GTE predicate on the "history_id" field.
+func HistoryIDGTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldGTE(FieldHistoryID, v))
+}
+
+// HistoryIDLT applies the LT predicate on the "history_id" field.
+func HistoryIDLT(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLT(FieldHistoryID, v))
+}
+
+// HistoryIDLTE applies the LTE predicate on the "history_id" field.
+func HistoryIDLTE(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldLTE(FieldHistoryID, v))
+}
+
+// HistoryIDContains applies the Contains predicate on the "history_id" field.
+func HistoryIDContains(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContains(FieldHistoryID, v))
+}
+
+// HistoryIDHasPrefix applies the HasPrefix predicate on the "history_id" field.
+func HistoryIDHasPrefix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasPrefix(FieldHistoryID, v))
+}
+
+// HistoryIDHasSuffix applies the HasSuffix predicate on the "history_id" field.
+func HistoryIDHasSuffix(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldHasSuffix(FieldHistoryID, v))
+}
+
+// HistoryIDIsNil applies the IsNil predicate on the "history_id" field.
+func HistoryIDIsNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldIsNull(FieldHistoryID))
+}
+
+// HistoryIDNotNil applies the NotNil predicate on the "history_id" field.
+func HistoryIDNotNil() predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldNotNull(FieldHistoryID))
+}
+
+// HistoryIDEqualFold applies the EqualFold predicate on the "history_id" field.
+func HistoryIDEqualFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldEqualFold(FieldHistoryID, v))
+}
+
+// HistoryIDContainsFold applies the ContainsFold predicate on the "history_id" field.
+func HistoryIDContainsFold(v string) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.FieldContainsFold(FieldHistoryID, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceAttr) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceAttr) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceAttr) predicate.DeviceAttr {
+	return predicate.DeviceAttr(sql.NotPredicates(p))
+}
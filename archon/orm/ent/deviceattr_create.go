@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+)
+
+// DeviceAttrCreate is the builder for creating a DeviceAttr entity.
+type DeviceAttrCreate struct {
+	config
+	mutation *DeviceAttrMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *DeviceAttrCreate) SetDeviceID(v string) *DeviceAttrCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (_c *DeviceAttrCreate) SetDeviceCode(v string) *DeviceAttrCreate {
+	_c.mutation.SetDeviceCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DeviceAttrCreate) SetName(v string) *DeviceAttrCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DeviceAttrCreate) SetValue(v string) *DeviceAttrCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_c *DeviceAttrCreate) SetNillableValue(v *string) *DeviceAttrCreate {
+	if v != nil {
+		_c.SetValue(*v)
+	}
+	return _c
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (_c *DeviceAttrCreate) SetValidFrom(v time.Time) *DeviceAttrCreate {
+	_c.mutation.SetValidFrom(v)
+	return _c
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_c *DeviceAttrCreate) SetValidTo(v time.Time) *DeviceAttrCreate {
+	_c.mutation.SetValidTo(v)
+	return _c
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_c *DeviceAttrCreate) SetNillableValidTo(v *time.Time) *DeviceAttrCreate {
+	if v != nil {
+		_c.SetValidTo(*v)
+	}
+	return _c
+}
+
+// SetHistoryID sets the "history_id" field.
+func (_c *DeviceAttrCreate) SetHistoryID(v string) *DeviceAttrCreate {
+	_c.mutation.SetHistoryID(v)
+	return _c
+}
+
+// SetNillableHistoryID sets the "history_id" field if the given value is not nil.
+func (_c *DeviceAttrCreate) SetNillableHistoryID(v *string) *DeviceAttrCreate {
+	if v != nil {
+		_c.SetHistoryID(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceAttrCreate) SetID(v string) *DeviceAttrCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeviceAttrCreate) SetNillableID(v *string) *DeviceAttrCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeviceAttrMutation object of the builder.
+func (_c *DeviceAttrCreate) Mutation() *DeviceAttrMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceAttr in the database.
+func (_c *DeviceAttrCreate) Save(ctx context.Context) (*DeviceAttr, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceAttrCreate) SaveX(ctx context.Context) *DeviceAttr {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAttrCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAttrCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceAttrCreate) defaults() error {
+	if _, ok := _c.mutation.ID(); !ok {
+		if deviceattr.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized deviceattr.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := deviceattr.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceAttrCreate) check() error {
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DeviceAttr.device_id"`)}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := deviceattr.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "DeviceAttr.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeviceCode(); !ok {
+		return &ValidationError{Name: "device_code", err: errors.New(`ent: missing required field "DeviceAttr.device_code"`)}
+	}
+	if v, ok := _c.mutation.DeviceCode(); ok {
+		if err := deviceattr.DeviceCodeValidator(v); err != nil {
+			return &ValidationError{Name: "device_code", err: fmt.Errorf(`ent: validator failed for field "DeviceAttr.device_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DeviceAttr.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := deviceattr.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DeviceAttr.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "DeviceAttr.valid_from"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := deviceattr.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DeviceAttr.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DeviceAttrCreate) sqlSave(ctx context.Context) (*DeviceAttr, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DeviceAttr.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceAttrCreate) createSpec() (*DeviceAttr, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceAttr{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceattr.Table, sqlgraph.NewFieldSpec(deviceattr.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(deviceattr.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.DeviceCode(); ok {
+		_spec.SetField(deviceattr.FieldDeviceCode, field.TypeString, value)
+		_node.DeviceCode = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(deviceattr.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(deviceattr.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	if value, ok := _c.mutation.ValidFrom(); ok {
+		_spec.SetField(deviceattr.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := _c.mutation.ValidTo(); ok {
+		_spec.SetField(deviceattr.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := _c.mutation.HistoryID(); ok {
+		_spec.SetField(deviceattr.FieldHistoryID, field.TypeString, value)
+		_node.HistoryID = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceAttr.Create().
+//		SetDeviceID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceAttrUpsert) {
+//			SetDeviceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceAttrCreate) OnConflict(opts ...sql.ConflictOption) *DeviceAttrUpsertOne {
+	_c.conflict = opts
+	return &DeviceAttrUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceAttrCreate) OnConflictColumns(columns ...string) *DeviceAttrUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceAttrUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeviceAttrUpsertOne is the builder for "upsert"-ing
+	//  one DeviceAttr node.
+	DeviceAttrUpsertOne struct {
+		create *DeviceAttrCreate
+	}
+
+	// DeviceAttrUpsert is the "OnConflict" setter.
+	DeviceAttrUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetValidTo sets the "valid_to" field.
+func (u *DeviceAttrUpsert) SetValidTo(v time.Time) *DeviceAttrUpsert {
+	u.Set(deviceattr.FieldValidTo, v)
+	return u
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *DeviceAttrUpsert) UpdateValidTo() *DeviceAttrUpsert {
+	u.SetExcluded(deviceattr.FieldValidTo)
+	return u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *DeviceAttrUpsert) ClearValidTo() *DeviceAttrUpsert {
+	u.SetNull(deviceattr.FieldValidTo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deviceattr.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceAttrUpsertOne) UpdateNewValues() *DeviceAttrUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(deviceattr.FieldID)
+		}
+		if _, exists := u.create.mutation.DeviceID(); exists {
+			s.SetIgnore(deviceattr.FieldDeviceID)
+		}
+		if _, exists := u.create.mutation.DeviceCode(); exists {
+			s.SetIgnore(deviceattr.FieldDeviceCode)
+		}
+		if _, exists := u.create.mutation.Name(); exists {
+			s.SetIgnore(deviceattr.FieldName)
+		}
+		if _, exists := u.create.mutation.Value(); exists {
+			s.SetIgnore(deviceattr.FieldValue)
+		}
+		if _, exists := u.create.mutation.ValidFrom(); exists {
+			s.SetIgnore(deviceattr.FieldValidFrom)
+		}
+		if _, exists := u.create.mutation.HistoryID(); exists {
+			s.SetIgnore(deviceattr.FieldHistoryID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeviceAttrUpsertOne) Ignore() *DeviceAttrUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceAttrUpsertOne) DoNothing() *DeviceAttrUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceAttrCreate.OnConflict
+// documentation for more info.
+func (u *DeviceAttrUpsertOne) Update(set func(*DeviceAttrUpsert)) *DeviceAttrUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceAttrUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *DeviceAttrUpsertOne) SetValidTo(v time.Time) *DeviceAttrUpsertOne {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *DeviceAttrUpsertOne) UpdateValidTo() *DeviceAttrUpsertOne {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *DeviceAttrUpsertOne) ClearValidTo() *DeviceAttrUpsertOne {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceAttrUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceAttrCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceAttrUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeviceAttrUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeviceAttrUpsertOne.ID is not supported by MySQL driver. Use DeviceAttrUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeviceAttrUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeviceAttrCreateBulk is the builder for creating many DeviceAttr entities in bulk.
+type DeviceAttrCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceAttrCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeviceAttr entities in the database.
+func (_c *DeviceAttrCreateBulk) Save(ctx context.Context) ([]*DeviceAttr, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceAttr, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceAttrMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceAttrCreateBulk) SaveX(ctx context.Context) []*DeviceAttr {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceAttrCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceAttrCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceAttr.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceAttrUpsert) {
+//			SetDeviceID(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceAttrCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeviceAttrUpsertBulk {
+	_c.conflict = opts
+	return &DeviceAttrUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceAttrCreateBulk) OnConflictColumns(columns ...string) *DeviceAttrUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceAttrUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeviceAttrUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeviceAttr nodes.
+type DeviceAttrUpsertBulk struct {
+	create *DeviceAttrCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(deviceattr.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceAttrUpsertBulk) UpdateNewValues() *DeviceAttrUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(deviceattr.FieldID)
+			}
+			if _, exists := b.mutation.DeviceID(); exists {
+				s.SetIgnore(deviceattr.FieldDeviceID)
+			}
+			if _, exists := b.mutation.DeviceCode(); exists {
+				s.SetIgnore(deviceattr.FieldDeviceCode)
+			}
+			if _, exists := b.mutation.Name(); exists {
+				s.SetIgnore(deviceattr.FieldName)
+			}
+			if _, exists := b.mutation.Value(); exists {
+				s.SetIgnore(deviceattr.FieldValue)
+			}
+			if _, exists := b.mutation.ValidFrom(); exists {
+				s.SetIgnore(deviceattr.FieldValidFrom)
+			}
+			if _, exists := b.mutation.HistoryID(); exists {
+				s.SetIgnore(deviceattr.FieldHistoryID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceAttr.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeviceAttrUpsertBulk) Ignore() *DeviceAttrUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceAttrUpsertBulk) DoNothing() *DeviceAttrUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceAttrCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeviceAttrUpsertBulk) Update(set func(*DeviceAttrUpsert)) *DeviceAttrUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceAttrUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (u *DeviceAttrUpsertBulk) SetValidTo(v time.Time) *DeviceAttrUpsertBulk {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.SetValidTo(v)
+	})
+}
+
+// UpdateValidTo sets the "valid_to" field to the value that was provided on create.
+func (u *DeviceAttrUpsertBulk) UpdateValidTo() *DeviceAttrUpsertBulk {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.UpdateValidTo()
+	})
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (u *DeviceAttrUpsertBulk) ClearValidTo() *DeviceAttrUpsertBulk {
+	return u.Update(func(s *DeviceAttrUpsert) {
+		s.ClearValidTo()
+	})
+}
+
+// Exec executes the query.
+func (u *DeviceAttrUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeviceAttrCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceAttrCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceAttrUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// DeviceAttrDelete is the builder for deleting a DeviceAttr entity.
+type DeviceAttrDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeviceAttrMutation
+}
+
+// Where appends a list predicates to the DeviceAttrDelete builder.
+func (_d *DeviceAttrDelete) Where(ps ...predicate.DeviceAttr) *DeviceAttrDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DeviceAttrDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceAttrDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DeviceAttrDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deviceattr.Table, sqlgraph.NewFieldSpec(deviceattr.FieldID, field.TypeString))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DeviceAttrDeleteOne is the builder for deleting a single DeviceAttr entity.
+type DeviceAttrDeleteOne struct {
+	_d *DeviceAttrDelete
+}
+
+// Where appends a list predicates to the DeviceAttrDelete builder.
+func (_d *DeviceAttrDeleteOne) Where(ps ...predicate.DeviceAttr) *DeviceAttrDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DeviceAttrDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deviceattr.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceAttrDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
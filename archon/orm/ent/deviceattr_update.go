@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// DeviceAttrUpdate is the builder for updating DeviceAttr entities.
+type DeviceAttrUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceAttrMutation
+}
+
+// Where appends a list predicates to the DeviceAttrUpdate builder.
+func (_u *DeviceAttrUpdate) Where(ps ...predicate.DeviceAttr) *DeviceAttrUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *DeviceAttrUpdate) SetValidTo(v time.Time) *DeviceAttrUpdate {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *DeviceAttrUpdate) SetNillableValidTo(v *time.Time) *DeviceAttrUpdate {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *DeviceAttrUpdate) ClearValidTo() *DeviceAttrUpdate {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// Mutation returns the DeviceAttrMutation object of the builder.
+func (_u *DeviceAttrUpdate) Mutation() *DeviceAttrMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceAttrUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAttrUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceAttrUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAttrUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceAttrUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceattr.Table, deviceattr.Columns, sqlgraph.NewFieldSpec(deviceattr.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(deviceattr.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(deviceattr.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(deviceattr.FieldValidTo, field.TypeTime)
+	}
+	if _u.mutation.HistoryIDCleared() {
+		_spec.ClearField(deviceattr.FieldHistoryID, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceattr.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceAttrUpdateOne is the builder for updating a single DeviceAttr entity.
+type DeviceAttrUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceAttrMutation
+}
+
+// SetValidTo sets the "valid_to" field.
+func (_u *DeviceAttrUpdateOne) SetValidTo(v time.Time) *DeviceAttrUpdateOne {
+	_u.mutation.SetValidTo(v)
+	return _u
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (_u *DeviceAttrUpdateOne) SetNillableValidTo(v *time.Time) *DeviceAttrUpdateOne {
+	if v != nil {
+		_u.SetValidTo(*v)
+	}
+	return _u
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (_u *DeviceAttrUpdateOne) ClearValidTo() *DeviceAttrUpdateOne {
+	_u.mutation.ClearValidTo()
+	return _u
+}
+
+// Mutation returns the DeviceAttrMutation object of the builder.
+func (_u *DeviceAttrUpdateOne) Mutation() *DeviceAttrMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceAttrUpdate builder.
+func (_u *DeviceAttrUpdateOne) Where(ps ...predicate.DeviceAttr) *DeviceAttrUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceAttrUpdateOne) Select(field string, fields ...string) *DeviceAttrUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceAttr entity.
+func (_u *DeviceAttrUpdateOne) Save(ctx context.Context) (*DeviceAttr, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceAttrUpdateOne) SaveX(ctx context.Context) *DeviceAttr {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceAttrUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceAttrUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceAttrUpdateOne) sqlSave(ctx context.Context) (_node *DeviceAttr, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceattr.Table, deviceattr.Columns, sqlgraph.NewFieldSpec(deviceattr.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceAttr.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceattr.FieldID)
+		for _, f := range fields {
+			if !deviceattr.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceattr.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.ValueCleared() {
+		_spec.ClearField(deviceattr.FieldValue, field.TypeString)
+	}
+	if value, ok := _u.mutation.ValidTo(); ok {
+		_spec.SetField(deviceattr.FieldValidTo, field.TypeTime, value)
+	}
+	if _u.mutation.ValidToCleared() {
+		_spec.ClearField(deviceattr.FieldValidTo, field.TypeTime)
+	}
+	if _u.mutation.HistoryIDCleared() {
+		_spec.ClearField(deviceattr.FieldHistoryID, field.TypeString)
+	}
+	_node = &DeviceAttr{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceattr.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,146 @@
+// Code generated by ent, DO NOT EDIT.
+
+package devicehistory
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the devicehistory type in the database.
+	Label = "device_history"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldDeviceID holds the string denoting the device_id field in the database.
+	FieldDeviceID = "device_id"
+	// FieldDeviceCode holds the string denoting the device_code field in the database.
+	FieldDeviceCode = "device_code"
+	// FieldOp holds the string denoting the op field in the database.
+	FieldOp = "op"
+	// FieldFields holds the string denoting the fields field in the database.
+	FieldFields = "fields"
+	// FieldBefore holds the string denoting the before field in the database.
+	FieldBefore = "before"
+	// FieldAfter holds the string denoting the after field in the database.
+	FieldAfter = "after"
+	// FieldActor holds the string denoting the actor field in the database.
+	FieldActor = "actor"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// Table holds the table name of the devicehistory in the database.
+	Table = "device_history"
+)
+
+// Columns holds all SQL columns for devicehistory fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldDeviceID,
+	FieldDeviceCode,
+	FieldOp,
+	FieldFields,
+	FieldBefore,
+	FieldAfter,
+	FieldActor,
+	FieldReason,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/twiglab/h2o/archon/orm/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	DeviceIDValidator func(string) error
+	// DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	DeviceCodeValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// Op defines the type for the "op" enum field.
+type Op string
+
+// Op values.
+const (
+	OpCreate  Op = "create"
+	OpUpdate  Op = "update"
+	OpRemove  Op = "remove"
+	OpRestore Op = "restore"
+	OpDelete  Op = "delete"
+)
+
+func (_op Op) String() string {
+	return string(_op)
+}
+
+// OpValidator is a validator for the "op" field enum values. It is called by the builders before save.
+func OpValidator(_op Op) error {
+	switch _op {
+	case OpCreate, OpUpdate, OpRemove, OpRestore, OpDelete:
+		return nil
+	default:
+		return fmt.Errorf("devicehistory: invalid enum value for op field: %q", _op)
+	}
+}
+
+// OrderOption defines the ordering options for the DeviceHistory queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByDeviceID orders the results by the device_id field.
+func ByDeviceID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceID, opts...).ToFunc()
+}
+
+// ByDeviceCode orders the results by the device_code field.
+func ByDeviceCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceCode, opts...).ToFunc()
+}
+
+// ByOp orders the results by the op field.
+func ByOp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOp, opts...).ToFunc()
+}
+
+// ByActor orders the results by the actor field.
+func ByActor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActor, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package devicehistory
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceCode applies equality check predicate on the "device_code" field. It's identical to DeviceCodeEQ.
+func DeviceCode(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// Actor applies equality check predicate on the "actor" field. It's identical to ActorEQ.
+func Actor(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// DeviceIDContains applies the Contains predicate on the "device_id" field.
+func DeviceIDContains(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContains(FieldDeviceID, v))
+}
+
+// DeviceIDHasPrefix applies the HasPrefix predicate on the "device_id" field.
+func DeviceIDHasPrefix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasPrefix(FieldDeviceID, v))
+}
+
+// DeviceIDHasSuffix applies the HasSuffix predicate on the "device_id" field.
+func DeviceIDHasSuffix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasSuffix(FieldDeviceID, v))
+}
+
+// DeviceIDEqualFold applies the EqualFold predicate on the "device_id" field.
+func DeviceIDEqualFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEqualFold(FieldDeviceID, v))
+}
+
+// DeviceIDContainsFold applies the ContainsFold predicate on the "device_id" field.
+func DeviceIDContainsFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContainsFold(FieldDeviceID, v))
+}
+
+// DeviceCodeEQ applies the EQ predicate on the "device_code" field.
+func DeviceCodeEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeNEQ applies the NEQ predicate on the "device_code" field.
+func DeviceCodeNEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeIn applies the In predicate on the "device_code" field.
+func DeviceCodeIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeNotIn applies the NotIn predicate on the "device_code" field.
+func DeviceCodeNotIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeGT applies the GT predicate on the "device_code" field.
+func DeviceCodeGT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldDeviceCode, v))
+}
+
+// DeviceCodeGTE applies the GTE predicate on the "device_code" field.
+func DeviceCodeGTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeLT applies the LT predicate on the "device_code" field.
+func DeviceCodeLT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldDeviceCode, v))
+}
+
+// DeviceCodeLTE applies the LTE predicate on the "device_code" field.
+func DeviceCodeLTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeContains applies the Contains predicate on the "device_code" field.
+func DeviceCodeContains(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContains(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasPrefix applies the HasPrefix predicate on the "device_code" field.
+func DeviceCodeHasPrefix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasPrefix(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasSuffix applies the HasSuffix predicate on the "device_code" field.
+func DeviceCodeHasSuffix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasSuffix(FieldDeviceCode, v))
+}
+
+// DeviceCodeEqualFold applies the EqualFold predicate on the "device_code" field.
+func DeviceCodeEqualFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEqualFold(FieldDeviceCode, v))
+}
+
+// DeviceCodeContainsFold applies the ContainsFold predicate on the "device_code" field.
+func DeviceCodeContainsFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContainsFold(FieldDeviceCode, v))
+}
+
+// OpEQ applies the EQ predicate on the "op" field.
+func OpEQ(v Op) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldOp, v))
+}
+
+// OpNEQ applies the NEQ predicate on the "op" field.
+func OpNEQ(v Op) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldOp, v))
+}
+
+// OpIn applies the In predicate on the "op" field.
+func OpIn(vs ...Op) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldOp, vs...))
+}
+
+// OpNotIn applies the NotIn predicate on the "op" field.
+func OpNotIn(vs ...Op) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldOp, vs...))
+}
+
+// FieldsIsNil applies the IsNil predicate on the "fields" field.
+func FieldsIsNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIsNull(FieldFields))
+}
+
+// FieldsNotNil applies the NotNil predicate on the "fields" field.
+func FieldsNotNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotNull(FieldFields))
+}
+
+// BeforeIsNil applies the IsNil predicate on the "before" field.
+func BeforeIsNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIsNull(FieldBefore))
+}
+
+// BeforeNotNil applies the NotNil predicate on the "before" field.
+func BeforeNotNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotNull(FieldBefore))
+}
+
+// AfterIsNil applies the IsNil predicate on the "after" field.
+func AfterIsNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIsNull(FieldAfter))
+}
+
+// AfterNotNil applies the NotNil predicate on the "after" field.
+func AfterNotNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotNull(FieldAfter))
+}
+
+// ActorEQ applies the EQ predicate on the "actor" field.
+func ActorEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldActor, v))
+}
+
+// ActorNEQ applies the NEQ predicate on the "actor" field.
+func ActorNEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldActor, v))
+}
+
+// ActorIn applies the In predicate on the "actor" field.
+func ActorIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldActor, vs...))
+}
+
+// ActorNotIn applies the NotIn predicate on the "actor" field.
+func ActorNotIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldActor, vs...))
+}
+
+// ActorGT applies the GT predicate on the "actor" field.
+func ActorGT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldActor, v))
+}
+
+// ActorGTE applies the GTE predicate on the "actor" field.
+func ActorGTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldActor, v))
+}
+
+// ActorLT applies the LT predicate on the "actor" field.
+func ActorLT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldActor, v))
+}
+
+// ActorLTE applies the LTE predicate on the "actor" field.
+func ActorLTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldActor, v))
+}
+
+// ActorContains applies the Contains predicate on the "actor" field.
+func ActorContains(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContains(FieldActor, v))
+}
+
+// ActorHasPrefix applies the HasPrefix predicate on the "actor" field.
+func ActorHasPrefix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasPrefix(FieldActor, v))
+}
+
+// ActorHasSuffix applies the HasSuffix predicate on the "actor" field.
+func ActorHasSuffix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasSuffix(FieldActor, v))
+}
+
+// ActorIsNil applies the IsNil predicate on the "actor" field.
+func ActorIsNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIsNull(FieldActor))
+}
+
+// ActorNotNil applies the NotNil predicate on the "actor" field.
+func ActorNotNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotNull(FieldActor))
+}
+
+// ActorEqualFold applies the EqualFold predicate on the "actor" field.
+func ActorEqualFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEqualFold(FieldActor, v))
+}
+
+// ActorContainsFold applies the ContainsFold predicate on the "actor" field.
+func ActorContainsFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContainsFold(FieldActor, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.FieldContainsFold(FieldReason, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceHistory) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceHistory) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceHistory) predicate.DeviceHistory {
+	return predicate.DeviceHistory(sql.NotPredicates(p))
+}
@@ -0,0 +1,668 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+)
+
+// DeviceHistoryCreate is the builder for creating a DeviceHistory entity.
+type DeviceHistoryCreate struct {
+	config
+	mutation *DeviceHistoryMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *DeviceHistoryCreate) SetCreateTime(v time.Time) *DeviceHistoryCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *DeviceHistoryCreate) SetNillableCreateTime(v *time.Time) *DeviceHistoryCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *DeviceHistoryCreate) SetDeviceID(v string) *DeviceHistoryCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (_c *DeviceHistoryCreate) SetDeviceCode(v string) *DeviceHistoryCreate {
+	_c.mutation.SetDeviceCode(v)
+	return _c
+}
+
+// SetOp sets the "op" field.
+func (_c *DeviceHistoryCreate) SetOp(v devicehistory.Op) *DeviceHistoryCreate {
+	_c.mutation.SetOpField(v)
+	return _c
+}
+
+// SetFields sets the "fields" field.
+func (_c *DeviceHistoryCreate) SetFields(v []string) *DeviceHistoryCreate {
+	_c.mutation.SetFields(v)
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *DeviceHistoryCreate) SetBefore(v map[string]interface{}) *DeviceHistoryCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *DeviceHistoryCreate) SetAfter(v map[string]interface{}) *DeviceHistoryCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetActor sets the "actor" field.
+func (_c *DeviceHistoryCreate) SetActor(v string) *DeviceHistoryCreate {
+	_c.mutation.SetActor(v)
+	return _c
+}
+
+// SetNillableActor sets the "actor" field if the given value is not nil.
+func (_c *DeviceHistoryCreate) SetNillableActor(v *string) *DeviceHistoryCreate {
+	if v != nil {
+		_c.SetActor(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *DeviceHistoryCreate) SetReason(v string) *DeviceHistoryCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *DeviceHistoryCreate) SetNillableReason(v *string) *DeviceHistoryCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DeviceHistoryCreate) SetID(v string) *DeviceHistoryCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DeviceHistoryCreate) SetNillableID(v *string) *DeviceHistoryCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeviceHistoryMutation object of the builder.
+func (_c *DeviceHistoryCreate) Mutation() *DeviceHistoryMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceHistory in the database.
+func (_c *DeviceHistoryCreate) Save(ctx context.Context) (*DeviceHistory, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceHistoryCreate) SaveX(ctx context.Context) *DeviceHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceHistoryCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceHistoryCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceHistoryCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if devicehistory.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized devicehistory.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := devicehistory.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if devicehistory.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized devicehistory.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := devicehistory.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceHistoryCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DeviceHistory.create_time"`)}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DeviceHistory.device_id"`)}
+	}
+	if v, ok := _c.mutation.DeviceID(); ok {
+		if err := devicehistory.DeviceIDValidator(v); err != nil {
+			return &ValidationError{Name: "device_id", err: fmt.Errorf(`ent: validator failed for field "DeviceHistory.device_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeviceCode(); !ok {
+		return &ValidationError{Name: "device_code", err: errors.New(`ent: missing required field "DeviceHistory.device_code"`)}
+	}
+	if v, ok := _c.mutation.DeviceCode(); ok {
+		if err := devicehistory.DeviceCodeValidator(v); err != nil {
+			return &ValidationError{Name: "device_code", err: fmt.Errorf(`ent: validator failed for field "DeviceHistory.device_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetOp(); !ok {
+		return &ValidationError{Name: "op", err: errors.New(`ent: missing required field "DeviceHistory.op"`)}
+	}
+	if v, ok := _c.mutation.GetOp(); ok {
+		if err := devicehistory.OpValidator(v); err != nil {
+			return &ValidationError{Name: "op", err: fmt.Errorf(`ent: validator failed for field "DeviceHistory.op": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := devicehistory.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DeviceHistory.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DeviceHistoryCreate) sqlSave(ctx context.Context) (*DeviceHistory, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DeviceHistory.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceHistoryCreate) createSpec() (*DeviceHistory, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceHistory{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(devicehistory.Table, sqlgraph.NewFieldSpec(devicehistory.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(devicehistory.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(devicehistory.FieldDeviceID, field.TypeString, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.DeviceCode(); ok {
+		_spec.SetField(devicehistory.FieldDeviceCode, field.TypeString, value)
+		_node.DeviceCode = value
+	}
+	if value, ok := _c.mutation.GetOp(); ok {
+		_spec.SetField(devicehistory.FieldOp, field.TypeEnum, value)
+		_node.Op = value
+	}
+	if value, ok := _c.mutation.GetFields(); ok {
+		_spec.SetField(devicehistory.FieldFields, field.TypeJSON, value)
+		_node.Fields = value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(devicehistory.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(devicehistory.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.Actor(); ok {
+		_spec.SetField(devicehistory.FieldActor, field.TypeString, value)
+		_node.Actor = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(devicehistory.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceHistory.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceHistoryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceHistoryCreate) OnConflict(opts ...sql.ConflictOption) *DeviceHistoryUpsertOne {
+	_c.conflict = opts
+	return &DeviceHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceHistoryCreate) OnConflictColumns(columns ...string) *DeviceHistoryUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceHistoryUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DeviceHistoryUpsertOne is the builder for "upsert"-ing
+	//  one DeviceHistory node.
+	DeviceHistoryUpsertOne struct {
+		create *DeviceHistoryCreate
+	}
+
+	// DeviceHistoryUpsert is the "OnConflict" setter.
+	DeviceHistoryUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(devicehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceHistoryUpsertOne) UpdateNewValues() *DeviceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(devicehistory.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(devicehistory.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.DeviceID(); exists {
+			s.SetIgnore(devicehistory.FieldDeviceID)
+		}
+		if _, exists := u.create.mutation.DeviceCode(); exists {
+			s.SetIgnore(devicehistory.FieldDeviceCode)
+		}
+		if _, exists := u.create.mutation.GetOp(); exists {
+			s.SetIgnore(devicehistory.FieldOp)
+		}
+		if _, exists := u.create.mutation.GetFields(); exists {
+			s.SetIgnore(devicehistory.FieldFields)
+		}
+		if _, exists := u.create.mutation.Before(); exists {
+			s.SetIgnore(devicehistory.FieldBefore)
+		}
+		if _, exists := u.create.mutation.After(); exists {
+			s.SetIgnore(devicehistory.FieldAfter)
+		}
+		if _, exists := u.create.mutation.Actor(); exists {
+			s.SetIgnore(devicehistory.FieldActor)
+		}
+		if _, exists := u.create.mutation.Reason(); exists {
+			s.SetIgnore(devicehistory.FieldReason)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeviceHistoryUpsertOne) Ignore() *DeviceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceHistoryUpsertOne) DoNothing() *DeviceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceHistoryCreate.OnConflict
+// documentation for more info.
+func (u *DeviceHistoryUpsertOne) Update(set func(*DeviceHistoryUpsert)) *DeviceHistoryUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DeviceHistoryUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceHistoryCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceHistoryUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeviceHistoryUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DeviceHistoryUpsertOne.ID is not supported by MySQL driver. Use DeviceHistoryUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeviceHistoryUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeviceHistoryCreateBulk is the builder for creating many DeviceHistory entities in bulk.
+type DeviceHistoryCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceHistoryCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeviceHistory entities in the database.
+func (_c *DeviceHistoryCreateBulk) Save(ctx context.Context) ([]*DeviceHistory, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceHistory, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceHistoryMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceHistoryCreateBulk) SaveX(ctx context.Context) []*DeviceHistory {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceHistoryCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceHistoryCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeviceHistory.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeviceHistoryUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DeviceHistoryCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeviceHistoryUpsertBulk {
+	_c.conflict = opts
+	return &DeviceHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DeviceHistoryCreateBulk) OnConflictColumns(columns ...string) *DeviceHistoryUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DeviceHistoryUpsertBulk{
+		create: _c,
+	}
+}
+
+// DeviceHistoryUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeviceHistory nodes.
+type DeviceHistoryUpsertBulk struct {
+	create *DeviceHistoryCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(devicehistory.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DeviceHistoryUpsertBulk) UpdateNewValues() *DeviceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(devicehistory.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(devicehistory.FieldCreateTime)
+			}
+			if _, exists := b.mutation.DeviceID(); exists {
+				s.SetIgnore(devicehistory.FieldDeviceID)
+			}
+			if _, exists := b.mutation.DeviceCode(); exists {
+				s.SetIgnore(devicehistory.FieldDeviceCode)
+			}
+			if _, exists := b.mutation.GetOp(); exists {
+				s.SetIgnore(devicehistory.FieldOp)
+			}
+			if _, exists := b.mutation.GetFields(); exists {
+				s.SetIgnore(devicehistory.FieldFields)
+			}
+			if _, exists := b.mutation.Before(); exists {
+				s.SetIgnore(devicehistory.FieldBefore)
+			}
+			if _, exists := b.mutation.After(); exists {
+				s.SetIgnore(devicehistory.FieldAfter)
+			}
+			if _, exists := b.mutation.Actor(); exists {
+				s.SetIgnore(devicehistory.FieldActor)
+			}
+			if _, exists := b.mutation.Reason(); exists {
+				s.SetIgnore(devicehistory.FieldReason)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeviceHistory.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeviceHistoryUpsertBulk) Ignore() *DeviceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeviceHistoryUpsertBulk) DoNothing() *DeviceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeviceHistoryCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeviceHistoryUpsertBulk) Update(set func(*DeviceHistoryUpsert)) *DeviceHistoryUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeviceHistoryUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *DeviceHistoryUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeviceHistoryCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeviceHistoryCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeviceHistoryUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
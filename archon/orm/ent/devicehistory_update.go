@@ -0,0 +1,205 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// DeviceHistoryUpdate is the builder for updating DeviceHistory entities.
+type DeviceHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceHistoryMutation
+}
+
+// Where appends a list predicates to the DeviceHistoryUpdate builder.
+func (_u *DeviceHistoryUpdate) Where(ps ...predicate.DeviceHistory) *DeviceHistoryUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the DeviceHistoryMutation object of the builder.
+func (_u *DeviceHistoryUpdate) Mutation() *DeviceHistoryMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceHistoryUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceHistoryUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(devicehistory.Table, devicehistory.Columns, sqlgraph.NewFieldSpec(devicehistory.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FieldsCleared() {
+		_spec.ClearField(devicehistory.FieldFields, field.TypeJSON)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(devicehistory.FieldBefore, field.TypeJSON)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(devicehistory.FieldAfter, field.TypeJSON)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(devicehistory.FieldActor, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(devicehistory.FieldReason, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceHistoryUpdateOne is the builder for updating a single DeviceHistory entity.
+type DeviceHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceHistoryMutation
+}
+
+// Mutation returns the DeviceHistoryMutation object of the builder.
+func (_u *DeviceHistoryUpdateOne) Mutation() *DeviceHistoryMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceHistoryUpdate builder.
+func (_u *DeviceHistoryUpdateOne) Where(ps ...predicate.DeviceHistory) *DeviceHistoryUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceHistoryUpdateOne) Select(field string, fields ...string) *DeviceHistoryUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceHistory entity.
+func (_u *DeviceHistoryUpdateOne) Save(ctx context.Context) (*DeviceHistory, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceHistoryUpdateOne) SaveX(ctx context.Context) *DeviceHistory {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceHistoryUpdateOne) sqlSave(ctx context.Context) (_node *DeviceHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(devicehistory.Table, devicehistory.Columns, sqlgraph.NewFieldSpec(devicehistory.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, devicehistory.FieldID)
+		for _, f := range fields {
+			if !devicehistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != devicehistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.FieldsCleared() {
+		_spec.ClearField(devicehistory.FieldFields, field.TypeJSON)
+	}
+	if _u.mutation.BeforeCleared() {
+		_spec.ClearField(devicehistory.FieldBefore, field.TypeJSON)
+	}
+	if _u.mutation.AfterCleared() {
+		_spec.ClearField(devicehistory.FieldAfter, field.TypeJSON)
+	}
+	if _u.mutation.ActorCleared() {
+		_spec.ClearField(devicehistory.FieldActor, field.TypeString)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(devicehistory.FieldReason, field.TypeString)
+	}
+	_node = &DeviceHistory{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{devicehistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,963 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+)
+
+// DriverModelCreate is the builder for creating a DriverModel entity.
+type DriverModelCreate struct {
+	config
+	mutation *DriverModelMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *DriverModelCreate) SetCreateTime(v time.Time) *DriverModelCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *DriverModelCreate) SetNillableCreateTime(v *time.Time) *DriverModelCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *DriverModelCreate) SetUpdateTime(v time.Time) *DriverModelCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *DriverModelCreate) SetNillableUpdateTime(v *time.Time) *DriverModelCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DriverModelCreate) SetName(v string) *DriverModelCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetPlugin sets the "plugin" field.
+func (_c *DriverModelCreate) SetPlugin(v string) *DriverModelCreate {
+	_c.mutation.SetPlugin(v)
+	return _c
+}
+
+// SetModelID sets the "model_id" field.
+func (_c *DriverModelCreate) SetModelID(v string) *DriverModelCreate {
+	_c.mutation.SetModelID(v)
+	return _c
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_c *DriverModelCreate) SetNillableModelID(v *string) *DriverModelCreate {
+	if v != nil {
+		_c.SetModelID(*v)
+	}
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *DriverModelCreate) SetDescription(v string) *DriverModelCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *DriverModelCreate) SetNillableDescription(v *string) *DriverModelCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetAttributes sets the "attributes" field.
+func (_c *DriverModelCreate) SetAttributes(v map[string]interface{}) *DriverModelCreate {
+	_c.mutation.SetAttributes(v)
+	return _c
+}
+
+// SetPoints sets the "points" field.
+func (_c *DriverModelCreate) SetPoints(v []map[string]interface{}) *DriverModelCreate {
+	_c.mutation.SetPoints(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *DriverModelCreate) SetID(v string) *DriverModelCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *DriverModelCreate) SetNillableID(v *string) *DriverModelCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DriverModelMutation object of the builder.
+func (_c *DriverModelCreate) Mutation() *DriverModelMutation {
+	return _c.mutation
+}
+
+// Save creates the DriverModel in the database.
+func (_c *DriverModelCreate) Save(ctx context.Context) (*DriverModel, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DriverModelCreate) SaveX(ctx context.Context) *DriverModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverModelCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverModelCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DriverModelCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if drivermodel.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized drivermodel.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := drivermodel.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if drivermodel.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized drivermodel.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := drivermodel.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if drivermodel.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized drivermodel.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := drivermodel.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DriverModelCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "DriverModel.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "DriverModel.update_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DriverModel.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := drivermodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverModel.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Plugin(); !ok {
+		return &ValidationError{Name: "plugin", err: errors.New(`ent: missing required field "DriverModel.plugin"`)}
+	}
+	if v, ok := _c.mutation.Plugin(); ok {
+		if err := drivermodel.PluginValidator(v); err != nil {
+			return &ValidationError{Name: "plugin", err: fmt.Errorf(`ent: validator failed for field "DriverModel.plugin": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Points(); !ok {
+		return &ValidationError{Name: "points", err: errors.New(`ent: missing required field "DriverModel.points"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := drivermodel.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "DriverModel.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *DriverModelCreate) sqlSave(ctx context.Context) (*DriverModel, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected DriverModel.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DriverModelCreate) createSpec() (*DriverModel, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DriverModel{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(drivermodel.Table, sqlgraph.NewFieldSpec(drivermodel.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(drivermodel.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(drivermodel.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(drivermodel.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Plugin(); ok {
+		_spec.SetField(drivermodel.FieldPlugin, field.TypeString, value)
+		_node.Plugin = value
+	}
+	if value, ok := _c.mutation.ModelID(); ok {
+		_spec.SetField(drivermodel.FieldModelID, field.TypeString, value)
+		_node.ModelID = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(drivermodel.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Attributes(); ok {
+		_spec.SetField(drivermodel.FieldAttributes, field.TypeJSON, value)
+		_node.Attributes = value
+	}
+	if value, ok := _c.mutation.Points(); ok {
+		_spec.SetField(drivermodel.FieldPoints, field.TypeJSON, value)
+		_node.Points = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DriverModel.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DriverModelUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DriverModelCreate) OnConflict(opts ...sql.ConflictOption) *DriverModelUpsertOne {
+	_c.conflict = opts
+	return &DriverModelUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DriverModelCreate) OnConflictColumns(columns ...string) *DriverModelUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DriverModelUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// DriverModelUpsertOne is the builder for "upsert"-ing
+	//  one DriverModel node.
+	DriverModelUpsertOne struct {
+		create *DriverModelCreate
+	}
+
+	// DriverModelUpsert is the "OnConflict" setter.
+	DriverModelUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DriverModelUpsert) SetUpdateTime(v time.Time) *DriverModelUpsert {
+	u.Set(drivermodel.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdateUpdateTime() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldUpdateTime)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DriverModelUpsert) SetName(v string) *DriverModelUpsert {
+	u.Set(drivermodel.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdateName() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldName)
+	return u
+}
+
+// SetPlugin sets the "plugin" field.
+func (u *DriverModelUpsert) SetPlugin(v string) *DriverModelUpsert {
+	u.Set(drivermodel.FieldPlugin, v)
+	return u
+}
+
+// UpdatePlugin sets the "plugin" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdatePlugin() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldPlugin)
+	return u
+}
+
+// SetModelID sets the "model_id" field.
+func (u *DriverModelUpsert) SetModelID(v string) *DriverModelUpsert {
+	u.Set(drivermodel.FieldModelID, v)
+	return u
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdateModelID() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldModelID)
+	return u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *DriverModelUpsert) ClearModelID() *DriverModelUpsert {
+	u.SetNull(drivermodel.FieldModelID)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *DriverModelUpsert) SetDescription(v string) *DriverModelUpsert {
+	u.Set(drivermodel.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdateDescription() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldDescription)
+	return u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DriverModelUpsert) ClearDescription() *DriverModelUpsert {
+	u.SetNull(drivermodel.FieldDescription)
+	return u
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *DriverModelUpsert) SetAttributes(v map[string]interface{}) *DriverModelUpsert {
+	u.Set(drivermodel.FieldAttributes, v)
+	return u
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdateAttributes() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldAttributes)
+	return u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (u *DriverModelUpsert) ClearAttributes() *DriverModelUpsert {
+	u.SetNull(drivermodel.FieldAttributes)
+	return u
+}
+
+// SetPoints sets the "points" field.
+func (u *DriverModelUpsert) SetPoints(v []map[string]interface{}) *DriverModelUpsert {
+	u.Set(drivermodel.FieldPoints, v)
+	return u
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *DriverModelUpsert) UpdatePoints() *DriverModelUpsert {
+	u.SetExcluded(drivermodel.FieldPoints)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(drivermodel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DriverModelUpsertOne) UpdateNewValues() *DriverModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(drivermodel.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(drivermodel.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DriverModelUpsertOne) Ignore() *DriverModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DriverModelUpsertOne) DoNothing() *DriverModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DriverModelCreate.OnConflict
+// documentation for more info.
+func (u *DriverModelUpsertOne) Update(set func(*DriverModelUpsert)) *DriverModelUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DriverModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DriverModelUpsertOne) SetUpdateTime(v time.Time) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdateUpdateTime() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DriverModelUpsertOne) SetName(v string) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdateName() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPlugin sets the "plugin" field.
+func (u *DriverModelUpsertOne) SetPlugin(v string) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetPlugin(v)
+	})
+}
+
+// UpdatePlugin sets the "plugin" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdatePlugin() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdatePlugin()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *DriverModelUpsertOne) SetModelID(v string) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdateModelID() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *DriverModelUpsertOne) ClearModelID() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DriverModelUpsertOne) SetDescription(v string) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdateDescription() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DriverModelUpsertOne) ClearDescription() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *DriverModelUpsertOne) SetAttributes(v map[string]interface{}) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetAttributes(v)
+	})
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdateAttributes() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateAttributes()
+	})
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (u *DriverModelUpsertOne) ClearAttributes() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearAttributes()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *DriverModelUpsertOne) SetPoints(v []map[string]interface{}) *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *DriverModelUpsertOne) UpdatePoints() *DriverModelUpsertOne {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// Exec executes the query.
+func (u *DriverModelUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DriverModelCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DriverModelUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DriverModelUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: DriverModelUpsertOne.ID is not supported by MySQL driver. Use DriverModelUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DriverModelUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DriverModelCreateBulk is the builder for creating many DriverModel entities in bulk.
+type DriverModelCreateBulk struct {
+	config
+	err      error
+	builders []*DriverModelCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DriverModel entities in the database.
+func (_c *DriverModelCreateBulk) Save(ctx context.Context) ([]*DriverModel, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DriverModel, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DriverModelMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DriverModelCreateBulk) SaveX(ctx context.Context) []*DriverModel {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DriverModelCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DriverModelCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DriverModel.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DriverModelUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *DriverModelCreateBulk) OnConflict(opts ...sql.ConflictOption) *DriverModelUpsertBulk {
+	_c.conflict = opts
+	return &DriverModelUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *DriverModelCreateBulk) OnConflictColumns(columns ...string) *DriverModelUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &DriverModelUpsertBulk{
+		create: _c,
+	}
+}
+
+// DriverModelUpsertBulk is the builder for "upsert"-ing
+// a bulk of DriverModel nodes.
+type DriverModelUpsertBulk struct {
+	create *DriverModelCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(drivermodel.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *DriverModelUpsertBulk) UpdateNewValues() *DriverModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(drivermodel.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(drivermodel.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DriverModel.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DriverModelUpsertBulk) Ignore() *DriverModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DriverModelUpsertBulk) DoNothing() *DriverModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DriverModelCreateBulk.OnConflict
+// documentation for more info.
+func (u *DriverModelUpsertBulk) Update(set func(*DriverModelUpsert)) *DriverModelUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DriverModelUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *DriverModelUpsertBulk) SetUpdateTime(v time.Time) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdateUpdateTime() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DriverModelUpsertBulk) SetName(v string) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdateName() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetPlugin sets the "plugin" field.
+func (u *DriverModelUpsertBulk) SetPlugin(v string) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetPlugin(v)
+	})
+}
+
+// UpdatePlugin sets the "plugin" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdatePlugin() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdatePlugin()
+	})
+}
+
+// SetModelID sets the "model_id" field.
+func (u *DriverModelUpsertBulk) SetModelID(v string) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetModelID(v)
+	})
+}
+
+// UpdateModelID sets the "model_id" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdateModelID() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateModelID()
+	})
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (u *DriverModelUpsertBulk) ClearModelID() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearModelID()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DriverModelUpsertBulk) SetDescription(v string) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdateDescription() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// ClearDescription clears the value of the "description" field.
+func (u *DriverModelUpsertBulk) ClearDescription() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearDescription()
+	})
+}
+
+// SetAttributes sets the "attributes" field.
+func (u *DriverModelUpsertBulk) SetAttributes(v map[string]interface{}) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetAttributes(v)
+	})
+}
+
+// UpdateAttributes sets the "attributes" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdateAttributes() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdateAttributes()
+	})
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (u *DriverModelUpsertBulk) ClearAttributes() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.ClearAttributes()
+	})
+}
+
+// SetPoints sets the "points" field.
+func (u *DriverModelUpsertBulk) SetPoints(v []map[string]interface{}) *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.SetPoints(v)
+	})
+}
+
+// UpdatePoints sets the "points" field to the value that was provided on create.
+func (u *DriverModelUpsertBulk) UpdatePoints() *DriverModelUpsertBulk {
+	return u.Update(func(s *DriverModelUpsert) {
+		s.UpdatePoints()
+	})
+}
+
+// Exec executes the query.
+func (u *DriverModelUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DriverModelCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DriverModelCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DriverModelUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
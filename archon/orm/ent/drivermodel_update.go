@@ -0,0 +1,509 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// DriverModelUpdate is the builder for updating DriverModel entities.
+type DriverModelUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DriverModelMutation
+}
+
+// Where appends a list predicates to the DriverModelUpdate builder.
+func (_u *DriverModelUpdate) Where(ps ...predicate.DriverModel) *DriverModelUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DriverModelUpdate) SetUpdateTime(v time.Time) *DriverModelUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DriverModelUpdate) SetName(v string) *DriverModelUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DriverModelUpdate) SetNillableName(v *string) *DriverModelUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPlugin sets the "plugin" field.
+func (_u *DriverModelUpdate) SetPlugin(v string) *DriverModelUpdate {
+	_u.mutation.SetPlugin(v)
+	return _u
+}
+
+// SetNillablePlugin sets the "plugin" field if the given value is not nil.
+func (_u *DriverModelUpdate) SetNillablePlugin(v *string) *DriverModelUpdate {
+	if v != nil {
+		_u.SetPlugin(*v)
+	}
+	return _u
+}
+
+// SetModelID sets the "model_id" field.
+func (_u *DriverModelUpdate) SetModelID(v string) *DriverModelUpdate {
+	_u.mutation.SetModelID(v)
+	return _u
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_u *DriverModelUpdate) SetNillableModelID(v *string) *DriverModelUpdate {
+	if v != nil {
+		_u.SetModelID(*v)
+	}
+	return _u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (_u *DriverModelUpdate) ClearModelID() *DriverModelUpdate {
+	_u.mutation.ClearModelID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DriverModelUpdate) SetDescription(v string) *DriverModelUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DriverModelUpdate) SetNillableDescription(v *string) *DriverModelUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DriverModelUpdate) ClearDescription() *DriverModelUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *DriverModelUpdate) SetAttributes(v map[string]interface{}) *DriverModelUpdate {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *DriverModelUpdate) ClearAttributes() *DriverModelUpdate {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *DriverModelUpdate) SetPoints(v []map[string]interface{}) *DriverModelUpdate {
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// AppendPoints appends value to the "points" field.
+func (_u *DriverModelUpdate) AppendPoints(v []map[string]interface{}) *DriverModelUpdate {
+	_u.mutation.AppendPoints(v)
+	return _u
+}
+
+// Mutation returns the DriverModelMutation object of the builder.
+func (_u *DriverModelUpdate) Mutation() *DriverModelMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DriverModelUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverModelUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DriverModelUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverModelUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DriverModelUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if drivermodel.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized drivermodel.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := drivermodel.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverModelUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := drivermodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverModel.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Plugin(); ok {
+		if err := drivermodel.PluginValidator(v); err != nil {
+			return &ValidationError{Name: "plugin", err: fmt.Errorf(`ent: validator failed for field "DriverModel.plugin": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DriverModelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(drivermodel.Table, drivermodel.Columns, sqlgraph.NewFieldSpec(drivermodel.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(drivermodel.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(drivermodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Plugin(); ok {
+		_spec.SetField(drivermodel.FieldPlugin, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModelID(); ok {
+		_spec.SetField(drivermodel.FieldModelID, field.TypeString, value)
+	}
+	if _u.mutation.ModelIDCleared() {
+		_spec.ClearField(drivermodel.FieldModelID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(drivermodel.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(drivermodel.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(drivermodel.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(drivermodel.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(drivermodel.FieldPoints, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPoints(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, drivermodel.FieldPoints, value)
+		})
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{drivermodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DriverModelUpdateOne is the builder for updating a single DriverModel entity.
+type DriverModelUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DriverModelMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *DriverModelUpdateOne) SetUpdateTime(v time.Time) *DriverModelUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DriverModelUpdateOne) SetName(v string) *DriverModelUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DriverModelUpdateOne) SetNillableName(v *string) *DriverModelUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetPlugin sets the "plugin" field.
+func (_u *DriverModelUpdateOne) SetPlugin(v string) *DriverModelUpdateOne {
+	_u.mutation.SetPlugin(v)
+	return _u
+}
+
+// SetNillablePlugin sets the "plugin" field if the given value is not nil.
+func (_u *DriverModelUpdateOne) SetNillablePlugin(v *string) *DriverModelUpdateOne {
+	if v != nil {
+		_u.SetPlugin(*v)
+	}
+	return _u
+}
+
+// SetModelID sets the "model_id" field.
+func (_u *DriverModelUpdateOne) SetModelID(v string) *DriverModelUpdateOne {
+	_u.mutation.SetModelID(v)
+	return _u
+}
+
+// SetNillableModelID sets the "model_id" field if the given value is not nil.
+func (_u *DriverModelUpdateOne) SetNillableModelID(v *string) *DriverModelUpdateOne {
+	if v != nil {
+		_u.SetModelID(*v)
+	}
+	return _u
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (_u *DriverModelUpdateOne) ClearModelID() *DriverModelUpdateOne {
+	_u.mutation.ClearModelID()
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *DriverModelUpdateOne) SetDescription(v string) *DriverModelUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *DriverModelUpdateOne) SetNillableDescription(v *string) *DriverModelUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *DriverModelUpdateOne) ClearDescription() *DriverModelUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetAttributes sets the "attributes" field.
+func (_u *DriverModelUpdateOne) SetAttributes(v map[string]interface{}) *DriverModelUpdateOne {
+	_u.mutation.SetAttributes(v)
+	return _u
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (_u *DriverModelUpdateOne) ClearAttributes() *DriverModelUpdateOne {
+	_u.mutation.ClearAttributes()
+	return _u
+}
+
+// SetPoints sets the "points" field.
+func (_u *DriverModelUpdateOne) SetPoints(v []map[string]interface{}) *DriverModelUpdateOne {
+	_u.mutation.SetPoints(v)
+	return _u
+}
+
+// AppendPoints appends value to the "points" field.
+func (_u *DriverModelUpdateOne) AppendPoints(v []map[string]interface{}) *DriverModelUpdateOne {
+	_u.mutation.AppendPoints(v)
+	return _u
+}
+
+// Mutation returns the DriverModelMutation object of the builder.
+func (_u *DriverModelUpdateOne) Mutation() *DriverModelMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DriverModelUpdate builder.
+func (_u *DriverModelUpdateOne) Where(ps ...predicate.DriverModel) *DriverModelUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DriverModelUpdateOne) Select(field string, fields ...string) *DriverModelUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DriverModel entity.
+func (_u *DriverModelUpdateOne) Save(ctx context.Context) (*DriverModel, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DriverModelUpdateOne) SaveX(ctx context.Context) *DriverModel {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DriverModelUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DriverModelUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DriverModelUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if drivermodel.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized drivermodel.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := drivermodel.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DriverModelUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := drivermodel.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "DriverModel.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Plugin(); ok {
+		if err := drivermodel.PluginValidator(v); err != nil {
+			return &ValidationError{Name: "plugin", err: fmt.Errorf(`ent: validator failed for field "DriverModel.plugin": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DriverModelUpdateOne) sqlSave(ctx context.Context) (_node *DriverModel, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(drivermodel.Table, drivermodel.Columns, sqlgraph.NewFieldSpec(drivermodel.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DriverModel.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, drivermodel.FieldID)
+		for _, f := range fields {
+			if !drivermodel.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != drivermodel.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(drivermodel.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(drivermodel.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Plugin(); ok {
+		_spec.SetField(drivermodel.FieldPlugin, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ModelID(); ok {
+		_spec.SetField(drivermodel.FieldModelID, field.TypeString, value)
+	}
+	if _u.mutation.ModelIDCleared() {
+		_spec.ClearField(drivermodel.FieldModelID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(drivermodel.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(drivermodel.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Attributes(); ok {
+		_spec.SetField(drivermodel.FieldAttributes, field.TypeJSON, value)
+	}
+	if _u.mutation.AttributesCleared() {
+		_spec.ClearField(drivermodel.FieldAttributes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Points(); ok {
+		_spec.SetField(drivermodel.FieldPoints, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPoints(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, drivermodel.FieldPoints, value)
+		})
+	}
+	_node = &DriverModel{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{drivermodel.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
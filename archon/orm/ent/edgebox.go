@@ -0,0 +1,259 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// EdgeBox is the model entity for the EdgeBox schema.
+type EdgeBox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 编号
+	Code string `json:"code,omitempty"`
+	// 名称
+	Name string `json:"name,omitempty"`
+	// 项目编号
+	Project string `json:"project,omitempty"`
+	// REST 接口地址
+	URL string `json:"url,omitempty"`
+	// 下发配置的 token
+	Token string `json:"-"`
+	// 连接, key 为 connectionKey
+	Connections map[string]provision.Connection `json:"connections,omitempty"`
+	// 最后下发时间
+	PushTime *time.Time `json:"push_time,omitempty"`
+	// 最后下发成功的版本
+	PushVersion string `json:"push_version,omitempty"`
+	// 最后下发的错误
+	PushError string `json:"push_error,omitempty"`
+	// 备注
+	Memo string `json:"memo,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EdgeBoxQuery when eager-loading is set.
+	Edges        EdgeBoxEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EdgeBoxEdges holds the relations/edges for other nodes in the graph.
+type EdgeBoxEdges struct {
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e EdgeBoxEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[0] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EdgeBox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case edgebox.FieldConnections:
+			values[i] = new([]byte)
+		case edgebox.FieldID, edgebox.FieldCode, edgebox.FieldName, edgebox.FieldProject, edgebox.FieldURL, edgebox.FieldToken, edgebox.FieldPushVersion, edgebox.FieldPushError, edgebox.FieldMemo:
+			values[i] = new(sql.NullString)
+		case edgebox.FieldCreateTime, edgebox.FieldUpdateTime, edgebox.FieldPushTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EdgeBox fields.
+func (_m *EdgeBox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case edgebox.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case edgebox.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case edgebox.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case edgebox.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case edgebox.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case edgebox.FieldProject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project", values[i])
+			} else if value.Valid {
+				_m.Project = value.String
+			}
+		case edgebox.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				_m.URL = value.String
+			}
+		case edgebox.FieldToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field token", values[i])
+			} else if value.Valid {
+				_m.Token = value.String
+			}
+		case edgebox.FieldConnections:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field connections", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Connections); err != nil {
+					return fmt.Errorf("unmarshal field connections: %w", err)
+				}
+			}
+		case edgebox.FieldPushTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field push_time", values[i])
+			} else if value.Valid {
+				_m.PushTime = new(time.Time)
+				*_m.PushTime = value.Time
+			}
+		case edgebox.FieldPushVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_version", values[i])
+			} else if value.Valid {
+				_m.PushVersion = value.String
+			}
+		case edgebox.FieldPushError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field push_error", values[i])
+			} else if value.Valid {
+				_m.PushError = value.String
+			}
+		case edgebox.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EdgeBox.
+// This includes values selected through modifiers, order, etc.
+func (_m *EdgeBox) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryDevices queries the "devices" edge of the EdgeBox entity.
+func (_m *EdgeBox) QueryDevices() *DeviceQuery {
+	return NewEdgeBoxClient(_m.config).QueryDevices(_m)
+}
+
+// Update returns a builder for updating this EdgeBox.
+// Note that you need to call EdgeBox.Unwrap() before calling this method if this EdgeBox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EdgeBox) Update() *EdgeBoxUpdateOne {
+	return NewEdgeBoxClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EdgeBox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EdgeBox) Unwrap() *EdgeBox {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EdgeBox is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EdgeBox) String() string {
+	var builder strings.Builder
+	builder.WriteString("EdgeBox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("project=")
+	builder.WriteString(_m.Project)
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(_m.URL)
+	builder.WriteString(", ")
+	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("connections=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Connections))
+	builder.WriteString(", ")
+	if v := _m.PushTime; v != nil {
+		builder.WriteString("push_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("push_version=")
+	builder.WriteString(_m.PushVersion)
+	builder.WriteString(", ")
+	builder.WriteString("push_error=")
+	builder.WriteString(_m.PushError)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EdgeBoxes is a parsable slice of EdgeBox.
+type EdgeBoxes []*EdgeBox
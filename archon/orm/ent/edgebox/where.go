@@ -0,0 +1,869 @@
+// Code generated by ent, DO NOT EDIT.
+
+package edgebox
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldCode, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldName, v))
+}
+
+// Project applies equality check predicate on the "project" field. It's identical to ProjectEQ.
+func Project(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldProject, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldURL, v))
+}
+
+// Token applies equality check predicate on the "token" field. It's identical to TokenEQ.
+func Token(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldToken, v))
+}
+
+// PushTime applies equality check predicate on the "push_time" field. It's identical to PushTimeEQ.
+func PushTime(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushTime, v))
+}
+
+// PushVersion applies equality check predicate on the "push_version" field. It's identical to PushVersionEQ.
+func PushVersion(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushVersion, v))
+}
+
+// PushError applies equality check predicate on the "push_error" field. It's identical to PushErrorEQ.
+func PushError(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushError, v))
+}
+
+// Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
+func Memo(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldMemo, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldCode, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameIsNil applies the IsNil predicate on the "name" field.
+func NameIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldName))
+}
+
+// NameNotNil applies the NotNil predicate on the "name" field.
+func NameNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldName))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldName, v))
+}
+
+// ProjectEQ applies the EQ predicate on the "project" field.
+func ProjectEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldProject, v))
+}
+
+// ProjectNEQ applies the NEQ predicate on the "project" field.
+func ProjectNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldProject, v))
+}
+
+// ProjectIn applies the In predicate on the "project" field.
+func ProjectIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldProject, vs...))
+}
+
+// ProjectNotIn applies the NotIn predicate on the "project" field.
+func ProjectNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldProject, vs...))
+}
+
+// ProjectGT applies the GT predicate on the "project" field.
+func ProjectGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldProject, v))
+}
+
+// ProjectGTE applies the GTE predicate on the "project" field.
+func ProjectGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldProject, v))
+}
+
+// ProjectLT applies the LT predicate on the "project" field.
+func ProjectLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldProject, v))
+}
+
+// ProjectLTE applies the LTE predicate on the "project" field.
+func ProjectLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldProject, v))
+}
+
+// ProjectContains applies the Contains predicate on the "project" field.
+func ProjectContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldProject, v))
+}
+
+// ProjectHasPrefix applies the HasPrefix predicate on the "project" field.
+func ProjectHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldProject, v))
+}
+
+// ProjectHasSuffix applies the HasSuffix predicate on the "project" field.
+func ProjectHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldProject, v))
+}
+
+// ProjectEqualFold applies the EqualFold predicate on the "project" field.
+func ProjectEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldProject, v))
+}
+
+// ProjectContainsFold applies the ContainsFold predicate on the "project" field.
+func ProjectContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldProject, v))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldURL, v))
+}
+
+// TokenEQ applies the EQ predicate on the "token" field.
+func TokenEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldToken, v))
+}
+
+// TokenNEQ applies the NEQ predicate on the "token" field.
+func TokenNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldToken, v))
+}
+
+// TokenIn applies the In predicate on the "token" field.
+func TokenIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldToken, vs...))
+}
+
+// TokenNotIn applies the NotIn predicate on the "token" field.
+func TokenNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldToken, vs...))
+}
+
+// TokenGT applies the GT predicate on the "token" field.
+func TokenGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldToken, v))
+}
+
+// TokenGTE applies the GTE predicate on the "token" field.
+func TokenGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldToken, v))
+}
+
+// TokenLT applies the LT predicate on the "token" field.
+func TokenLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldToken, v))
+}
+
+// TokenLTE applies the LTE predicate on the "token" field.
+func TokenLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldToken, v))
+}
+
+// TokenContains applies the Contains predicate on the "token" field.
+func TokenContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldToken, v))
+}
+
+// TokenHasPrefix applies the HasPrefix predicate on the "token" field.
+func TokenHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldToken, v))
+}
+
+// TokenHasSuffix applies the HasSuffix predicate on the "token" field.
+func TokenHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldToken, v))
+}
+
+// TokenIsNil applies the IsNil predicate on the "token" field.
+func TokenIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldToken))
+}
+
+// TokenNotNil applies the NotNil predicate on the "token" field.
+func TokenNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldToken))
+}
+
+// TokenEqualFold applies the EqualFold predicate on the "token" field.
+func TokenEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldToken, v))
+}
+
+// TokenContainsFold applies the ContainsFold predicate on the "token" field.
+func TokenContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldToken, v))
+}
+
+// ConnectionsIsNil applies the IsNil predicate on the "connections" field.
+func ConnectionsIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldConnections))
+}
+
+// ConnectionsNotNil applies the NotNil predicate on the "connections" field.
+func ConnectionsNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldConnections))
+}
+
+// PushTimeEQ applies the EQ predicate on the "push_time" field.
+func PushTimeEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushTime, v))
+}
+
+// PushTimeNEQ applies the NEQ predicate on the "push_time" field.
+func PushTimeNEQ(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldPushTime, v))
+}
+
+// PushTimeIn applies the In predicate on the "push_time" field.
+func PushTimeIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldPushTime, vs...))
+}
+
+// PushTimeNotIn applies the NotIn predicate on the "push_time" field.
+func PushTimeNotIn(vs ...time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldPushTime, vs...))
+}
+
+// PushTimeGT applies the GT predicate on the "push_time" field.
+func PushTimeGT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldPushTime, v))
+}
+
+// PushTimeGTE applies the GTE predicate on the "push_time" field.
+func PushTimeGTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldPushTime, v))
+}
+
+// PushTimeLT applies the LT predicate on the "push_time" field.
+func PushTimeLT(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldPushTime, v))
+}
+
+// PushTimeLTE applies the LTE predicate on the "push_time" field.
+func PushTimeLTE(v time.Time) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldPushTime, v))
+}
+
+// PushTimeIsNil applies the IsNil predicate on the "push_time" field.
+func PushTimeIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldPushTime))
+}
+
+// PushTimeNotNil applies the NotNil predicate on the "push_time" field.
+func PushTimeNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldPushTime))
+}
+
+// PushVersionEQ applies the EQ predicate on the "push_version" field.
+func PushVersionEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushVersion, v))
+}
+
+// PushVersionNEQ applies the NEQ predicate on the "push_version" field.
+func PushVersionNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldPushVersion, v))
+}
+
+// PushVersionIn applies the In predicate on the "push_version" field.
+func PushVersionIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldPushVersion, vs...))
+}
+
+// PushVersionNotIn applies the NotIn predicate on the "push_version" field.
+func PushVersionNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldPushVersion, vs...))
+}
+
+// PushVersionGT applies the GT predicate on the "push_version" field.
+func PushVersionGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldPushVersion, v))
+}
+
+// PushVersionGTE applies the GTE predicate on the "push_version" field.
+func PushVersionGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldPushVersion, v))
+}
+
+// PushVersionLT applies the LT predicate on the "push_version" field.
+func PushVersionLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldPushVersion, v))
+}
+
+// PushVersionLTE applies the LTE predicate on the "push_version" field.
+func PushVersionLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldPushVersion, v))
+}
+
+// PushVersionContains applies the Contains predicate on the "push_version" field.
+func PushVersionContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldPushVersion, v))
+}
+
+// PushVersionHasPrefix applies the HasPrefix predicate on the "push_version" field.
+func PushVersionHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldPushVersion, v))
+}
+
+// PushVersionHasSuffix applies the HasSuffix predicate on the "push_version" field.
+func PushVersionHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldPushVersion, v))
+}
+
+// PushVersionIsNil applies the IsNil predicate on the "push_version" field.
+func PushVersionIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldPushVersion))
+}
+
+// PushVersionNotNil applies the NotNil predicate on the "push_version" field.
+func PushVersionNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldPushVersion))
+}
+
+// PushVersionEqualFold applies the EqualFold predicate on the "push_version" field.
+func PushVersionEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldPushVersion, v))
+}
+
+// PushVersionContainsFold applies the ContainsFold predicate on the "push_version" field.
+func PushVersionContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldPushVersion, v))
+}
+
+// PushErrorEQ applies the EQ predicate on the "push_error" field.
+func PushErrorEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldPushError, v))
+}
+
+// PushErrorNEQ applies the NEQ predicate on the "push_error" field.
+func PushErrorNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldPushError, v))
+}
+
+// PushErrorIn applies the In predicate on the "push_error" field.
+func PushErrorIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldPushError, vs...))
+}
+
+// PushErrorNotIn applies the NotIn predicate on the "push_error" field.
+func PushErrorNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldPushError, vs...))
+}
+
+// PushErrorGT applies the GT predicate on the "push_error" field.
+func PushErrorGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldPushError, v))
+}
+
+// PushErrorGTE applies the GTE predicate on the "push_error" field.
+func PushErrorGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldPushError, v))
+}
+
+// PushErrorLT applies the LT predicate on the "push_error" field.
+func PushErrorLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldPushError, v))
+}
+
+// PushErrorLTE applies the LTE predicate on the "push_error" field.
+func PushErrorLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldPushError, v))
+}
+
+// PushErrorContains applies the Contains predicate on the "push_error" field.
+func PushErrorContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldPushError, v))
+}
+
+// PushErrorHasPrefix applies the HasPrefix predicate on the "push_error" field.
+func PushErrorHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldPushError, v))
+}
+
+// PushErrorHasSuffix applies the HasSuffix predicate on the "push_error" field.
+func PushErrorHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldPushError, v))
+}
+
+// PushErrorIsNil applies the IsNil predicate on the "push_error" field.
+func PushErrorIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldPushError))
+}
+
+// PushErrorNotNil applies the NotNil predicate on the "push_error" field.
+func PushErrorNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldPushError))
+}
+
+// PushErrorEqualFold applies the EqualFold predicate on the "push_error" field.
+func PushErrorEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldPushError, v))
+}
+
+// PushErrorContainsFold applies the ContainsFold predicate on the "push_error" field.
+func PushErrorContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldPushError, v))
+}
+
+// MemoEQ applies the EQ predicate on the "memo" field.
+func MemoEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEQ(FieldMemo, v))
+}
+
+// MemoNEQ applies the NEQ predicate on the "memo" field.
+func MemoNEQ(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNEQ(FieldMemo, v))
+}
+
+// MemoIn applies the In predicate on the "memo" field.
+func MemoIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIn(FieldMemo, vs...))
+}
+
+// MemoNotIn applies the NotIn predicate on the "memo" field.
+func MemoNotIn(vs ...string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotIn(FieldMemo, vs...))
+}
+
+// MemoGT applies the GT predicate on the "memo" field.
+func MemoGT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGT(FieldMemo, v))
+}
+
+// MemoGTE applies the GTE predicate on the "memo" field.
+func MemoGTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldGTE(FieldMemo, v))
+}
+
+// MemoLT applies the LT predicate on the "memo" field.
+func MemoLT(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLT(FieldMemo, v))
+}
+
+// MemoLTE applies the LTE predicate on the "memo" field.
+func MemoLTE(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldLTE(FieldMemo, v))
+}
+
+// MemoContains applies the Contains predicate on the "memo" field.
+func MemoContains(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContains(FieldMemo, v))
+}
+
+// MemoHasPrefix applies the HasPrefix predicate on the "memo" field.
+func MemoHasPrefix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasPrefix(FieldMemo, v))
+}
+
+// MemoHasSuffix applies the HasSuffix predicate on the "memo" field.
+func MemoHasSuffix(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldHasSuffix(FieldMemo, v))
+}
+
+// MemoIsNil applies the IsNil predicate on the "memo" field.
+func MemoIsNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldIsNull(FieldMemo))
+}
+
+// MemoNotNil applies the NotNil predicate on the "memo" field.
+func MemoNotNil() predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldNotNull(FieldMemo))
+}
+
+// MemoEqualFold applies the EqualFold predicate on the "memo" field.
+func MemoEqualFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldEqualFold(FieldMemo, v))
+}
+
+// MemoContainsFold applies the ContainsFold predicate on the "memo" field.
+func MemoContainsFold(v string) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.FieldContainsFold(FieldMemo, v))
+}
+
+// HasDevices applies the HasEdge predicate on the "devices" edge.
+func HasDevices() predicate.EdgeBox {
+	return predicate.EdgeBox(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DevicesTable, DevicesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDevicesWith applies the HasEdge predicate on the "devices" edge with a given conditions (other predicates).
+func HasDevicesWith(preds ...predicate.Device) predicate.EdgeBox {
+	return predicate.EdgeBox(func(s *sql.Selector) {
+		step := newDevicesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EdgeBox) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EdgeBox) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EdgeBox) predicate.EdgeBox {
+	return predicate.EdgeBox(sql.NotPredicates(p))
+}
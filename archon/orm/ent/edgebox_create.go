@@ -0,0 +1,1313 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// EdgeBoxCreate is the builder for creating a EdgeBox entity.
+type EdgeBoxCreate struct {
+	config
+	mutation *EdgeBoxMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *EdgeBoxCreate) SetCreateTime(v time.Time) *EdgeBoxCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableCreateTime(v *time.Time) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *EdgeBoxCreate) SetUpdateTime(v time.Time) *EdgeBoxCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableUpdateTime(v *time.Time) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *EdgeBoxCreate) SetCode(v string) *EdgeBoxCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EdgeBoxCreate) SetName(v string) *EdgeBoxCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableName(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" field.
+func (_c *EdgeBoxCreate) SetProject(v string) *EdgeBoxCreate {
+	_c.mutation.SetProject(v)
+	return _c
+}
+
+// SetURL sets the "url" field.
+func (_c *EdgeBoxCreate) SetURL(v string) *EdgeBoxCreate {
+	_c.mutation.SetURL(v)
+	return _c
+}
+
+// SetToken sets the "token" field.
+func (_c *EdgeBoxCreate) SetToken(v string) *EdgeBoxCreate {
+	_c.mutation.SetToken(v)
+	return _c
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableToken(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetToken(*v)
+	}
+	return _c
+}
+
+// SetConnections sets the "connections" field.
+func (_c *EdgeBoxCreate) SetConnections(v map[string]provision.Connection) *EdgeBoxCreate {
+	_c.mutation.SetConnections(v)
+	return _c
+}
+
+// SetPushTime sets the "push_time" field.
+func (_c *EdgeBoxCreate) SetPushTime(v time.Time) *EdgeBoxCreate {
+	_c.mutation.SetPushTime(v)
+	return _c
+}
+
+// SetNillablePushTime sets the "push_time" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillablePushTime(v *time.Time) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetPushTime(*v)
+	}
+	return _c
+}
+
+// SetPushVersion sets the "push_version" field.
+func (_c *EdgeBoxCreate) SetPushVersion(v string) *EdgeBoxCreate {
+	_c.mutation.SetPushVersion(v)
+	return _c
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillablePushVersion(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetPushVersion(*v)
+	}
+	return _c
+}
+
+// SetPushError sets the "push_error" field.
+func (_c *EdgeBoxCreate) SetPushError(v string) *EdgeBoxCreate {
+	_c.mutation.SetPushError(v)
+	return _c
+}
+
+// SetNillablePushError sets the "push_error" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillablePushError(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetPushError(*v)
+	}
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *EdgeBoxCreate) SetMemo(v string) *EdgeBoxCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableMemo(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EdgeBoxCreate) SetID(v string) *EdgeBoxCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EdgeBoxCreate) SetNillableID(v *string) *EdgeBoxCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_c *EdgeBoxCreate) AddDeviceIDs(ids ...string) *EdgeBoxCreate {
+	_c.mutation.AddDeviceIDs(ids...)
+	return _c
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_c *EdgeBoxCreate) AddDevices(v ...*Device) *EdgeBoxCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeviceIDs(ids...)
+}
+
+// Mutation returns the EdgeBoxMutation object of the builder.
+func (_c *EdgeBoxCreate) Mutation() *EdgeBoxMutation {
+	return _c.mutation
+}
+
+// Save creates the EdgeBox in the database.
+func (_c *EdgeBoxCreate) Save(ctx context.Context) (*EdgeBox, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EdgeBoxCreate) SaveX(ctx context.Context) *EdgeBox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EdgeBoxCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EdgeBoxCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EdgeBoxCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if edgebox.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized edgebox.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := edgebox.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if edgebox.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized edgebox.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := edgebox.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if edgebox.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized edgebox.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := edgebox.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EdgeBoxCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "EdgeBox.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "EdgeBox.update_time"`)}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "EdgeBox.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := edgebox.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Project(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required field "EdgeBox.project"`)}
+	}
+	if v, ok := _c.mutation.Project(); ok {
+		if err := edgebox.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.project": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "EdgeBox.url"`)}
+	}
+	if v, ok := _c.mutation.URL(); ok {
+		if err := edgebox.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.url": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := edgebox.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *EdgeBoxCreate) sqlSave(ctx context.Context) (*EdgeBox, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected EdgeBox.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EdgeBoxCreate) createSpec() (*EdgeBox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EdgeBox{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(edgebox.Table, sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(edgebox.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(edgebox.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(edgebox.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(edgebox.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Project(); ok {
+		_spec.SetField(edgebox.FieldProject, field.TypeString, value)
+		_node.Project = value
+	}
+	if value, ok := _c.mutation.URL(); ok {
+		_spec.SetField(edgebox.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := _c.mutation.Token(); ok {
+		_spec.SetField(edgebox.FieldToken, field.TypeString, value)
+		_node.Token = value
+	}
+	if value, ok := _c.mutation.Connections(); ok {
+		_spec.SetField(edgebox.FieldConnections, field.TypeJSON, value)
+		_node.Connections = value
+	}
+	if value, ok := _c.mutation.PushTime(); ok {
+		_spec.SetField(edgebox.FieldPushTime, field.TypeTime, value)
+		_node.PushTime = &value
+	}
+	if value, ok := _c.mutation.PushVersion(); ok {
+		_spec.SetField(edgebox.FieldPushVersion, field.TypeString, value)
+		_node.PushVersion = value
+	}
+	if value, ok := _c.mutation.PushError(); ok {
+		_spec.SetField(edgebox.FieldPushError, field.TypeString, value)
+		_node.PushError = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(edgebox.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if nodes := _c.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EdgeBox.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EdgeBoxUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EdgeBoxCreate) OnConflict(opts ...sql.ConflictOption) *EdgeBoxUpsertOne {
+	_c.conflict = opts
+	return &EdgeBoxUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EdgeBoxCreate) OnConflictColumns(columns ...string) *EdgeBoxUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EdgeBoxUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// EdgeBoxUpsertOne is the builder for "upsert"-ing
+	//  one EdgeBox node.
+	EdgeBoxUpsertOne struct {
+		create *EdgeBoxCreate
+	}
+
+	// EdgeBoxUpsert is the "OnConflict" setter.
+	EdgeBoxUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EdgeBoxUpsert) SetUpdateTime(v time.Time) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateUpdateTime() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldUpdateTime)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *EdgeBoxUpsert) SetCode(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateCode() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *EdgeBoxUpsert) SetName(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateName() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *EdgeBoxUpsert) ClearName() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldName)
+	return u
+}
+
+// SetProject sets the "project" field.
+func (u *EdgeBoxUpsert) SetProject(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldProject, v)
+	return u
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateProject() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldProject)
+	return u
+}
+
+// SetURL sets the "url" field.
+func (u *EdgeBoxUpsert) SetURL(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldURL, v)
+	return u
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateURL() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldURL)
+	return u
+}
+
+// SetToken sets the "token" field.
+func (u *EdgeBoxUpsert) SetToken(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldToken, v)
+	return u
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateToken() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldToken)
+	return u
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *EdgeBoxUpsert) ClearToken() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldToken)
+	return u
+}
+
+// SetConnections sets the "connections" field.
+func (u *EdgeBoxUpsert) SetConnections(v map[string]provision.Connection) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldConnections, v)
+	return u
+}
+
+// UpdateConnections sets the "connections" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateConnections() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldConnections)
+	return u
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (u *EdgeBoxUpsert) ClearConnections() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldConnections)
+	return u
+}
+
+// SetPushTime sets the "push_time" field.
+func (u *EdgeBoxUpsert) SetPushTime(v time.Time) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldPushTime, v)
+	return u
+}
+
+// UpdatePushTime sets the "push_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdatePushTime() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldPushTime)
+	return u
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (u *EdgeBoxUpsert) ClearPushTime() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldPushTime)
+	return u
+}
+
+// SetPushVersion sets the "push_version" field.
+func (u *EdgeBoxUpsert) SetPushVersion(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldPushVersion, v)
+	return u
+}
+
+// UpdatePushVersion sets the "push_version" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdatePushVersion() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldPushVersion)
+	return u
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (u *EdgeBoxUpsert) ClearPushVersion() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldPushVersion)
+	return u
+}
+
+// SetPushError sets the "push_error" field.
+func (u *EdgeBoxUpsert) SetPushError(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldPushError, v)
+	return u
+}
+
+// UpdatePushError sets the "push_error" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdatePushError() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldPushError)
+	return u
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (u *EdgeBoxUpsert) ClearPushError() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldPushError)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *EdgeBoxUpsert) SetMemo(v string) *EdgeBoxUpsert {
+	u.Set(edgebox.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *EdgeBoxUpsert) UpdateMemo() *EdgeBoxUpsert {
+	u.SetExcluded(edgebox.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *EdgeBoxUpsert) ClearMemo() *EdgeBoxUpsert {
+	u.SetNull(edgebox.FieldMemo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(edgebox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EdgeBoxUpsertOne) UpdateNewValues() *EdgeBoxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(edgebox.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(edgebox.FieldCreateTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *EdgeBoxUpsertOne) Ignore() *EdgeBoxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EdgeBoxUpsertOne) DoNothing() *EdgeBoxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EdgeBoxCreate.OnConflict
+// documentation for more info.
+func (u *EdgeBoxUpsertOne) Update(set func(*EdgeBoxUpsert)) *EdgeBoxUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EdgeBoxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EdgeBoxUpsertOne) SetUpdateTime(v time.Time) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateUpdateTime() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *EdgeBoxUpsertOne) SetCode(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateCode() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EdgeBoxUpsertOne) SetName(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateName() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *EdgeBoxUpsertOne) ClearName() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *EdgeBoxUpsertOne) SetProject(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateProject() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *EdgeBoxUpsertOne) SetURL(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateURL() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EdgeBoxUpsertOne) SetToken(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateToken() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *EdgeBoxUpsertOne) ClearToken() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetConnections sets the "connections" field.
+func (u *EdgeBoxUpsertOne) SetConnections(v map[string]provision.Connection) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetConnections(v)
+	})
+}
+
+// UpdateConnections sets the "connections" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateConnections() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateConnections()
+	})
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (u *EdgeBoxUpsertOne) ClearConnections() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearConnections()
+	})
+}
+
+// SetPushTime sets the "push_time" field.
+func (u *EdgeBoxUpsertOne) SetPushTime(v time.Time) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushTime(v)
+	})
+}
+
+// UpdatePushTime sets the "push_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdatePushTime() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushTime()
+	})
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (u *EdgeBoxUpsertOne) ClearPushTime() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushTime()
+	})
+}
+
+// SetPushVersion sets the "push_version" field.
+func (u *EdgeBoxUpsertOne) SetPushVersion(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushVersion(v)
+	})
+}
+
+// UpdatePushVersion sets the "push_version" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdatePushVersion() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushVersion()
+	})
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (u *EdgeBoxUpsertOne) ClearPushVersion() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushVersion()
+	})
+}
+
+// SetPushError sets the "push_error" field.
+func (u *EdgeBoxUpsertOne) SetPushError(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushError(v)
+	})
+}
+
+// UpdatePushError sets the "push_error" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdatePushError() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushError()
+	})
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (u *EdgeBoxUpsertOne) ClearPushError() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushError()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *EdgeBoxUpsertOne) SetMemo(v string) *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *EdgeBoxUpsertOne) UpdateMemo() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *EdgeBoxUpsertOne) ClearMemo() *EdgeBoxUpsertOne {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *EdgeBoxUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EdgeBoxCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EdgeBoxUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *EdgeBoxUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: EdgeBoxUpsertOne.ID is not supported by MySQL driver. Use EdgeBoxUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *EdgeBoxUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// EdgeBoxCreateBulk is the builder for creating many EdgeBox entities in bulk.
+type EdgeBoxCreateBulk struct {
+	config
+	err      error
+	builders []*EdgeBoxCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the EdgeBox entities in the database.
+func (_c *EdgeBoxCreateBulk) Save(ctx context.Context) ([]*EdgeBox, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EdgeBox, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EdgeBoxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EdgeBoxCreateBulk) SaveX(ctx context.Context) []*EdgeBox {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EdgeBoxCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EdgeBoxCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.EdgeBox.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.EdgeBoxUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *EdgeBoxCreateBulk) OnConflict(opts ...sql.ConflictOption) *EdgeBoxUpsertBulk {
+	_c.conflict = opts
+	return &EdgeBoxUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *EdgeBoxCreateBulk) OnConflictColumns(columns ...string) *EdgeBoxUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &EdgeBoxUpsertBulk{
+		create: _c,
+	}
+}
+
+// EdgeBoxUpsertBulk is the builder for "upsert"-ing
+// a bulk of EdgeBox nodes.
+type EdgeBoxUpsertBulk struct {
+	create *EdgeBoxCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(edgebox.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *EdgeBoxUpsertBulk) UpdateNewValues() *EdgeBoxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(edgebox.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(edgebox.FieldCreateTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.EdgeBox.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *EdgeBoxUpsertBulk) Ignore() *EdgeBoxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *EdgeBoxUpsertBulk) DoNothing() *EdgeBoxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the EdgeBoxCreateBulk.OnConflict
+// documentation for more info.
+func (u *EdgeBoxUpsertBulk) Update(set func(*EdgeBoxUpsert)) *EdgeBoxUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&EdgeBoxUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *EdgeBoxUpsertBulk) SetUpdateTime(v time.Time) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateUpdateTime() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *EdgeBoxUpsertBulk) SetCode(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateCode() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *EdgeBoxUpsertBulk) SetName(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateName() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *EdgeBoxUpsertBulk) ClearName() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *EdgeBoxUpsertBulk) SetProject(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateProject() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetURL sets the "url" field.
+func (u *EdgeBoxUpsertBulk) SetURL(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetURL(v)
+	})
+}
+
+// UpdateURL sets the "url" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateURL() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateURL()
+	})
+}
+
+// SetToken sets the "token" field.
+func (u *EdgeBoxUpsertBulk) SetToken(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetToken(v)
+	})
+}
+
+// UpdateToken sets the "token" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateToken() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateToken()
+	})
+}
+
+// ClearToken clears the value of the "token" field.
+func (u *EdgeBoxUpsertBulk) ClearToken() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearToken()
+	})
+}
+
+// SetConnections sets the "connections" field.
+func (u *EdgeBoxUpsertBulk) SetConnections(v map[string]provision.Connection) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetConnections(v)
+	})
+}
+
+// UpdateConnections sets the "connections" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateConnections() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateConnections()
+	})
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (u *EdgeBoxUpsertBulk) ClearConnections() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearConnections()
+	})
+}
+
+// SetPushTime sets the "push_time" field.
+func (u *EdgeBoxUpsertBulk) SetPushTime(v time.Time) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushTime(v)
+	})
+}
+
+// UpdatePushTime sets the "push_time" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdatePushTime() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushTime()
+	})
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (u *EdgeBoxUpsertBulk) ClearPushTime() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushTime()
+	})
+}
+
+// SetPushVersion sets the "push_version" field.
+func (u *EdgeBoxUpsertBulk) SetPushVersion(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushVersion(v)
+	})
+}
+
+// UpdatePushVersion sets the "push_version" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdatePushVersion() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushVersion()
+	})
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (u *EdgeBoxUpsertBulk) ClearPushVersion() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushVersion()
+	})
+}
+
+// SetPushError sets the "push_error" field.
+func (u *EdgeBoxUpsertBulk) SetPushError(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetPushError(v)
+	})
+}
+
+// UpdatePushError sets the "push_error" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdatePushError() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdatePushError()
+	})
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (u *EdgeBoxUpsertBulk) ClearPushError() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearPushError()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *EdgeBoxUpsertBulk) SetMemo(v string) *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *EdgeBoxUpsertBulk) UpdateMemo() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *EdgeBoxUpsertBulk) ClearMemo() *EdgeBoxUpsertBulk {
+	return u.Update(func(s *EdgeBoxUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *EdgeBoxUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the EdgeBoxCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for EdgeBoxCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *EdgeBoxUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,884 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// EdgeBoxUpdate is the builder for updating EdgeBox entities.
+type EdgeBoxUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EdgeBoxMutation
+}
+
+// Where appends a list predicates to the EdgeBoxUpdate builder.
+func (_u *EdgeBoxUpdate) Where(ps ...predicate.EdgeBox) *EdgeBoxUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EdgeBoxUpdate) SetUpdateTime(v time.Time) *EdgeBoxUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *EdgeBoxUpdate) SetCode(v string) *EdgeBoxUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableCode(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EdgeBoxUpdate) SetName(v string) *EdgeBoxUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableName(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *EdgeBoxUpdate) ClearName() *EdgeBoxUpdate {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetProject sets the "project" field.
+func (_u *EdgeBoxUpdate) SetProject(v string) *EdgeBoxUpdate {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableProject(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *EdgeBoxUpdate) SetURL(v string) *EdgeBoxUpdate {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableURL(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *EdgeBoxUpdate) SetToken(v string) *EdgeBoxUpdate {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableToken(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *EdgeBoxUpdate) ClearToken() *EdgeBoxUpdate {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetConnections sets the "connections" field.
+func (_u *EdgeBoxUpdate) SetConnections(v map[string]provision.Connection) *EdgeBoxUpdate {
+	_u.mutation.SetConnections(v)
+	return _u
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (_u *EdgeBoxUpdate) ClearConnections() *EdgeBoxUpdate {
+	_u.mutation.ClearConnections()
+	return _u
+}
+
+// SetPushTime sets the "push_time" field.
+func (_u *EdgeBoxUpdate) SetPushTime(v time.Time) *EdgeBoxUpdate {
+	_u.mutation.SetPushTime(v)
+	return _u
+}
+
+// SetNillablePushTime sets the "push_time" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillablePushTime(v *time.Time) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetPushTime(*v)
+	}
+	return _u
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (_u *EdgeBoxUpdate) ClearPushTime() *EdgeBoxUpdate {
+	_u.mutation.ClearPushTime()
+	return _u
+}
+
+// SetPushVersion sets the "push_version" field.
+func (_u *EdgeBoxUpdate) SetPushVersion(v string) *EdgeBoxUpdate {
+	_u.mutation.SetPushVersion(v)
+	return _u
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillablePushVersion(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetPushVersion(*v)
+	}
+	return _u
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (_u *EdgeBoxUpdate) ClearPushVersion() *EdgeBoxUpdate {
+	_u.mutation.ClearPushVersion()
+	return _u
+}
+
+// SetPushError sets the "push_error" field.
+func (_u *EdgeBoxUpdate) SetPushError(v string) *EdgeBoxUpdate {
+	_u.mutation.SetPushError(v)
+	return _u
+}
+
+// SetNillablePushError sets the "push_error" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillablePushError(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetPushError(*v)
+	}
+	return _u
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (_u *EdgeBoxUpdate) ClearPushError() *EdgeBoxUpdate {
+	_u.mutation.ClearPushError()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *EdgeBoxUpdate) SetMemo(v string) *EdgeBoxUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *EdgeBoxUpdate) SetNillableMemo(v *string) *EdgeBoxUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *EdgeBoxUpdate) ClearMemo() *EdgeBoxUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_u *EdgeBoxUpdate) AddDeviceIDs(ids ...string) *EdgeBoxUpdate {
+	_u.mutation.AddDeviceIDs(ids...)
+	return _u
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_u *EdgeBoxUpdate) AddDevices(v ...*Device) *EdgeBoxUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeviceIDs(ids...)
+}
+
+// Mutation returns the EdgeBoxMutation object of the builder.
+func (_u *EdgeBoxUpdate) Mutation() *EdgeBoxMutation {
+	return _u.mutation
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (_u *EdgeBoxUpdate) ClearDevices() *EdgeBoxUpdate {
+	_u.mutation.ClearDevices()
+	return _u
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (_u *EdgeBoxUpdate) RemoveDeviceIDs(ids ...string) *EdgeBoxUpdate {
+	_u.mutation.RemoveDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (_u *EdgeBoxUpdate) RemoveDevices(v ...*Device) *EdgeBoxUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EdgeBoxUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EdgeBoxUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EdgeBoxUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EdgeBoxUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EdgeBoxUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if edgebox.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized edgebox.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := edgebox.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EdgeBoxUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := edgebox.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := edgebox.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.project": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := edgebox.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EdgeBoxUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(edgebox.Table, edgebox.Columns, sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(edgebox.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(edgebox.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(edgebox.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(edgebox.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(edgebox.FieldProject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(edgebox.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(edgebox.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(edgebox.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Connections(); ok {
+		_spec.SetField(edgebox.FieldConnections, field.TypeJSON, value)
+	}
+	if _u.mutation.ConnectionsCleared() {
+		_spec.ClearField(edgebox.FieldConnections, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PushTime(); ok {
+		_spec.SetField(edgebox.FieldPushTime, field.TypeTime, value)
+	}
+	if _u.mutation.PushTimeCleared() {
+		_spec.ClearField(edgebox.FieldPushTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PushVersion(); ok {
+		_spec.SetField(edgebox.FieldPushVersion, field.TypeString, value)
+	}
+	if _u.mutation.PushVersionCleared() {
+		_spec.ClearField(edgebox.FieldPushVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushError(); ok {
+		_spec.SetField(edgebox.FieldPushError, field.TypeString, value)
+	}
+	if _u.mutation.PushErrorCleared() {
+		_spec.ClearField(edgebox.FieldPushError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(edgebox.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(edgebox.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !_u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{edgebox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EdgeBoxUpdateOne is the builder for updating a single EdgeBox entity.
+type EdgeBoxUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EdgeBoxMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *EdgeBoxUpdateOne) SetUpdateTime(v time.Time) *EdgeBoxUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *EdgeBoxUpdateOne) SetCode(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableCode(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EdgeBoxUpdateOne) SetName(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableName(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// ClearName clears the value of the "name" field.
+func (_u *EdgeBoxUpdateOne) ClearName() *EdgeBoxUpdateOne {
+	_u.mutation.ClearName()
+	return _u
+}
+
+// SetProject sets the "project" field.
+func (_u *EdgeBoxUpdateOne) SetProject(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableProject(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
+// SetURL sets the "url" field.
+func (_u *EdgeBoxUpdateOne) SetURL(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetURL(v)
+	return _u
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableURL(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetURL(*v)
+	}
+	return _u
+}
+
+// SetToken sets the "token" field.
+func (_u *EdgeBoxUpdateOne) SetToken(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetToken(v)
+	return _u
+}
+
+// SetNillableToken sets the "token" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableToken(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetToken(*v)
+	}
+	return _u
+}
+
+// ClearToken clears the value of the "token" field.
+func (_u *EdgeBoxUpdateOne) ClearToken() *EdgeBoxUpdateOne {
+	_u.mutation.ClearToken()
+	return _u
+}
+
+// SetConnections sets the "connections" field.
+func (_u *EdgeBoxUpdateOne) SetConnections(v map[string]provision.Connection) *EdgeBoxUpdateOne {
+	_u.mutation.SetConnections(v)
+	return _u
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (_u *EdgeBoxUpdateOne) ClearConnections() *EdgeBoxUpdateOne {
+	_u.mutation.ClearConnections()
+	return _u
+}
+
+// SetPushTime sets the "push_time" field.
+func (_u *EdgeBoxUpdateOne) SetPushTime(v time.Time) *EdgeBoxUpdateOne {
+	_u.mutation.SetPushTime(v)
+	return _u
+}
+
+// SetNillablePushTime sets the "push_time" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillablePushTime(v *time.Time) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetPushTime(*v)
+	}
+	return _u
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (_u *EdgeBoxUpdateOne) ClearPushTime() *EdgeBoxUpdateOne {
+	_u.mutation.ClearPushTime()
+	return _u
+}
+
+// SetPushVersion sets the "push_version" field.
+func (_u *EdgeBoxUpdateOne) SetPushVersion(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetPushVersion(v)
+	return _u
+}
+
+// SetNillablePushVersion sets the "push_version" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillablePushVersion(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetPushVersion(*v)
+	}
+	return _u
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (_u *EdgeBoxUpdateOne) ClearPushVersion() *EdgeBoxUpdateOne {
+	_u.mutation.ClearPushVersion()
+	return _u
+}
+
+// SetPushError sets the "push_error" field.
+func (_u *EdgeBoxUpdateOne) SetPushError(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetPushError(v)
+	return _u
+}
+
+// SetNillablePushError sets the "push_error" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillablePushError(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetPushError(*v)
+	}
+	return _u
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (_u *EdgeBoxUpdateOne) ClearPushError() *EdgeBoxUpdateOne {
+	_u.mutation.ClearPushError()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *EdgeBoxUpdateOne) SetMemo(v string) *EdgeBoxUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *EdgeBoxUpdateOne) SetNillableMemo(v *string) *EdgeBoxUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *EdgeBoxUpdateOne) ClearMemo() *EdgeBoxUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_u *EdgeBoxUpdateOne) AddDeviceIDs(ids ...string) *EdgeBoxUpdateOne {
+	_u.mutation.AddDeviceIDs(ids...)
+	return _u
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_u *EdgeBoxUpdateOne) AddDevices(v ...*Device) *EdgeBoxUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddDeviceIDs(ids...)
+}
+
+// Mutation returns the EdgeBoxMutation object of the builder.
+func (_u *EdgeBoxUpdateOne) Mutation() *EdgeBoxMutation {
+	return _u.mutation
+}
+
+// ClearDevices clears all "devices" edges to the Device entity.
+func (_u *EdgeBoxUpdateOne) ClearDevices() *EdgeBoxUpdateOne {
+	_u.mutation.ClearDevices()
+	return _u
+}
+
+// RemoveDeviceIDs removes the "devices" edge to Device entities by IDs.
+func (_u *EdgeBoxUpdateOne) RemoveDeviceIDs(ids ...string) *EdgeBoxUpdateOne {
+	_u.mutation.RemoveDeviceIDs(ids...)
+	return _u
+}
+
+// RemoveDevices removes "devices" edges to Device entities.
+func (_u *EdgeBoxUpdateOne) RemoveDevices(v ...*Device) *EdgeBoxUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveDeviceIDs(ids...)
+}
+
+// Where appends a list predicates to the EdgeBoxUpdate builder.
+func (_u *EdgeBoxUpdateOne) Where(ps ...predicate.EdgeBox) *EdgeBoxUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EdgeBoxUpdateOne) Select(field string, fields ...string) *EdgeBoxUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EdgeBox entity.
+func (_u *EdgeBoxUpdateOne) Save(ctx context.Context) (*EdgeBox, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EdgeBoxUpdateOne) SaveX(ctx context.Context) *EdgeBox {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EdgeBoxUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EdgeBoxUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EdgeBoxUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if edgebox.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized edgebox.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := edgebox.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EdgeBoxUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := edgebox.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := edgebox.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.project": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.URL(); ok {
+		if err := edgebox.URLValidator(v); err != nil {
+			return &ValidationError{Name: "url", err: fmt.Errorf(`ent: validator failed for field "EdgeBox.url": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EdgeBoxUpdateOne) sqlSave(ctx context.Context) (_node *EdgeBox, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(edgebox.Table, edgebox.Columns, sqlgraph.NewFieldSpec(edgebox.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EdgeBox.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, edgebox.FieldID)
+		for _, f := range fields {
+			if !edgebox.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != edgebox.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(edgebox.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(edgebox.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(edgebox.FieldName, field.TypeString, value)
+	}
+	if _u.mutation.NameCleared() {
+		_spec.ClearField(edgebox.FieldName, field.TypeString)
+	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(edgebox.FieldProject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.URL(); ok {
+		_spec.SetField(edgebox.FieldURL, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Token(); ok {
+		_spec.SetField(edgebox.FieldToken, field.TypeString, value)
+	}
+	if _u.mutation.TokenCleared() {
+		_spec.ClearField(edgebox.FieldToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Connections(); ok {
+		_spec.SetField(edgebox.FieldConnections, field.TypeJSON, value)
+	}
+	if _u.mutation.ConnectionsCleared() {
+		_spec.ClearField(edgebox.FieldConnections, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.PushTime(); ok {
+		_spec.SetField(edgebox.FieldPushTime, field.TypeTime, value)
+	}
+	if _u.mutation.PushTimeCleared() {
+		_spec.ClearField(edgebox.FieldPushTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PushVersion(); ok {
+		_spec.SetField(edgebox.FieldPushVersion, field.TypeString, value)
+	}
+	if _u.mutation.PushVersionCleared() {
+		_spec.ClearField(edgebox.FieldPushVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.PushError(); ok {
+		_spec.SetField(edgebox.FieldPushError, field.TypeString, value)
+	}
+	if _u.mutation.PushErrorCleared() {
+		_spec.ClearField(edgebox.FieldPushError, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(edgebox.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(edgebox.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedDevicesIDs(); len(nodes) > 0 && !_u.mutation.DevicesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   edgebox.DevicesTable,
+			Columns: []string{edgebox.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EdgeBox{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{edgebox.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,6 +13,13 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -73,7 +80,14 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			device.Table: device.ValidColumn,
+			device.Table:        device.ValidColumn,
+			deviceattr.Table:    deviceattr.ValidColumn,
+			devicehistory.Table: devicehistory.ValidColumn,
+			drivermodel.Table:   drivermodel.ValidColumn,
+			edgebox.Table:       edgebox.ValidColumn,
+			location.Table:      location.ValidColumn,
+			occupancy.Table:     occupancy.ValidColumn,
+			tenant.Table:        tenant.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
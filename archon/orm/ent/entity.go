@@ -0,0 +1,27 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+// IsEntity federation entity.
+func (Device) IsEntity() {}
+
+// IsEntity federation entity.
+func (DeviceAttr) IsEntity() {}
+
+// IsEntity federation entity.
+func (DeviceHistory) IsEntity() {}
+
+// IsEntity federation entity.
+func (DriverModel) IsEntity() {}
+
+// IsEntity federation entity.
+func (EdgeBox) IsEntity() {}
+
+// IsEntity federation entity.
+func (Location) IsEntity() {}
+
+// IsEntity federation entity.
+func (Occupancy) IsEntity() {}
+
+// IsEntity federation entity.
+func (Tenant) IsEntity() {}
@@ -21,6 +21,90 @@ func (f DeviceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, erro
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceMutation", m)
 }
 
+// The DeviceAttrFunc type is an adapter to allow the use of ordinary
+// function as DeviceAttr mutator.
+type DeviceAttrFunc func(context.Context, *ent.DeviceAttrMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceAttrFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceAttrMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceAttrMutation", m)
+}
+
+// The DeviceHistoryFunc type is an adapter to allow the use of ordinary
+// function as DeviceHistory mutator.
+type DeviceHistoryFunc func(context.Context, *ent.DeviceHistoryMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeviceHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeviceHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeviceHistoryMutation", m)
+}
+
+// The DriverModelFunc type is an adapter to allow the use of ordinary
+// function as DriverModel mutator.
+type DriverModelFunc func(context.Context, *ent.DriverModelMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DriverModelFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DriverModelMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DriverModelMutation", m)
+}
+
+// The EdgeBoxFunc type is an adapter to allow the use of ordinary
+// function as EdgeBox mutator.
+type EdgeBoxFunc func(context.Context, *ent.EdgeBoxMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f EdgeBoxFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.EdgeBoxMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.EdgeBoxMutation", m)
+}
+
+// The LocationFunc type is an adapter to allow the use of ordinary
+// function as Location mutator.
+type LocationFunc func(context.Context, *ent.LocationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LocationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LocationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LocationMutation", m)
+}
+
+// The OccupancyFunc type is an adapter to allow the use of ordinary
+// function as Occupancy mutator.
+type OccupancyFunc func(context.Context, *ent.OccupancyMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OccupancyFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OccupancyMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OccupancyMutation", m)
+}
+
+// The TenantFunc type is an adapter to allow the use of ordinary
+// function as Tenant mutator.
+type TenantFunc func(context.Context, *ent.TenantMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TenantFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TenantMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TenantMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
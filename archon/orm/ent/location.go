@@ -0,0 +1,260 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+)
+
+// Location is the model entity for the Location schema.
+type Location struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 层级
+	Kind location.Kind `json:"kind,omitempty"`
+	// 编号, 项目层即项目编号
+	Code string `json:"code,omitempty"`
+	// 名称
+	Name string `json:"name,omitempty"`
+	// 上级
+	ParentID *string `json:"parent_id,omitempty"`
+	// 所在项目编号, 用于按项目授权
+	Project string `json:"project,omitempty"`
+	// 备注
+	Memo string `json:"memo,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the LocationQuery when eager-loading is set.
+	Edges        LocationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// LocationEdges holds the relations/edges for other nodes in the graph.
+type LocationEdges struct {
+	// Parent holds the value of the parent edge.
+	Parent *Location `json:"parent,omitempty"`
+	// Children holds the value of the children edge.
+	Children []*Location `json:"children,omitempty"`
+	// Devices holds the value of the devices edge.
+	Devices []*Device `json:"devices,omitempty"`
+	// Occupancies holds the value of the occupancies edge.
+	Occupancies []*Occupancy `json:"occupancies,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [4]bool
+}
+
+// ParentOrErr returns the Parent value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e LocationEdges) ParentOrErr() (*Location, error) {
+	if e.Parent != nil {
+		return e.Parent, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "parent"}
+}
+
+// ChildrenOrErr returns the Children value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) ChildrenOrErr() ([]*Location, error) {
+	if e.loadedTypes[1] {
+		return e.Children, nil
+	}
+	return nil, &NotLoadedError{edge: "children"}
+}
+
+// DevicesOrErr returns the Devices value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) DevicesOrErr() ([]*Device, error) {
+	if e.loadedTypes[2] {
+		return e.Devices, nil
+	}
+	return nil, &NotLoadedError{edge: "devices"}
+}
+
+// OccupanciesOrErr returns the Occupancies value or an error if the edge
+// was not loaded in eager-loading.
+func (e LocationEdges) OccupanciesOrErr() ([]*Occupancy, error) {
+	if e.loadedTypes[3] {
+		return e.Occupancies, nil
+	}
+	return nil, &NotLoadedError{edge: "occupancies"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Location) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case location.FieldID, location.FieldKind, location.FieldCode, location.FieldName, location.FieldParentID, location.FieldProject, location.FieldMemo:
+			values[i] = new(sql.NullString)
+		case location.FieldCreateTime, location.FieldUpdateTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Location fields.
+func (_m *Location) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case location.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case location.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case location.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case location.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				_m.Kind = location.Kind(value.String)
+			}
+		case location.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				_m.Code = value.String
+			}
+		case location.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case location.FieldParentID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_id", values[i])
+			} else if value.Valid {
+				_m.ParentID = new(string)
+				*_m.ParentID = value.String
+			}
+		case location.FieldProject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project", values[i])
+			} else if value.Valid {
+				_m.Project = value.String
+			}
+		case location.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Location.
+// This includes values selected through modifiers, order, etc.
+func (_m *Location) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryParent queries the "parent" edge of the Location entity.
+func (_m *Location) QueryParent() *LocationQuery {
+	return NewLocationClient(_m.config).QueryParent(_m)
+}
+
+// QueryChildren queries the "children" edge of the Location entity.
+func (_m *Location) QueryChildren() *LocationQuery {
+	return NewLocationClient(_m.config).QueryChildren(_m)
+}
+
+// QueryDevices queries the "devices" edge of the Location entity.
+func (_m *Location) QueryDevices() *DeviceQuery {
+	return NewLocationClient(_m.config).QueryDevices(_m)
+}
+
+// QueryOccupancies queries the "occupancies" edge of the Location entity.
+func (_m *Location) QueryOccupancies() *OccupancyQuery {
+	return NewLocationClient(_m.config).QueryOccupancies(_m)
+}
+
+// Update returns a builder for updating this Location.
+// Note that you need to call Location.Unwrap() before calling this method if this Location
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Location) Update() *LocationUpdateOne {
+	return NewLocationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Location entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Location) Unwrap() *Location {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Location is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Location) String() string {
+	var builder strings.Builder
+	builder.WriteString("Location(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Kind))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(_m.Code)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	if v := _m.ParentID; v != nil {
+		builder.WriteString("parent_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("project=")
+	builder.WriteString(_m.Project)
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Locations is a parsable slice of Location.
+type Locations []*Location
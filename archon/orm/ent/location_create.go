@@ -0,0 +1,1001 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+)
+
+// LocationCreate is the builder for creating a Location entity.
+type LocationCreate struct {
+	config
+	mutation *LocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LocationCreate) SetCreateTime(v time.Time) *LocationCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableCreateTime(v *time.Time) *LocationCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LocationCreate) SetUpdateTime(v time.Time) *LocationCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableUpdateTime(v *time.Time) *LocationCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetKind sets the "kind" field.
+func (_c *LocationCreate) SetKind(v location.Kind) *LocationCreate {
+	_c.mutation.SetKind(v)
+	return _c
+}
+
+// SetCode sets the "code" field.
+func (_c *LocationCreate) SetCode(v string) *LocationCreate {
+	_c.mutation.SetCode(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *LocationCreate) SetName(v string) *LocationCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableName(v *string) *LocationCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetParentID sets the "parent_id" field.
+func (_c *LocationCreate) SetParentID(v string) *LocationCreate {
+	_c.mutation.SetParentID(v)
+	return _c
+}
+
+// SetNillableParentID sets the "parent_id" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableParentID(v *string) *LocationCreate {
+	if v != nil {
+		_c.SetParentID(*v)
+	}
+	return _c
+}
+
+// SetProject sets the "project" field.
+func (_c *LocationCreate) SetProject(v string) *LocationCreate {
+	_c.mutation.SetProject(v)
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *LocationCreate) SetMemo(v string) *LocationCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableMemo(v *string) *LocationCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LocationCreate) SetID(v string) *LocationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LocationCreate) SetNillableID(v *string) *LocationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetParent sets the "parent" edge to the Location entity.
+func (_c *LocationCreate) SetParent(v *Location) *LocationCreate {
+	return _c.SetParentID(v.ID)
+}
+
+// AddChildIDs adds the "children" edge to the Location entity by IDs.
+func (_c *LocationCreate) AddChildIDs(ids ...string) *LocationCreate {
+	_c.mutation.AddChildIDs(ids...)
+	return _c
+}
+
+// AddChildren adds the "children" edges to the Location entity.
+func (_c *LocationCreate) AddChildren(v ...*Location) *LocationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddChildIDs(ids...)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by IDs.
+func (_c *LocationCreate) AddDeviceIDs(ids ...string) *LocationCreate {
+	_c.mutation.AddDeviceIDs(ids...)
+	return _c
+}
+
+// AddDevices adds the "devices" edges to the Device entity.
+func (_c *LocationCreate) AddDevices(v ...*Device) *LocationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddDeviceIDs(ids...)
+}
+
+// AddOccupancyIDs adds the "occupancies" edge to the Occupancy entity by IDs.
+func (_c *LocationCreate) AddOccupancyIDs(ids ...string) *LocationCreate {
+	_c.mutation.AddOccupancyIDs(ids...)
+	return _c
+}
+
+// AddOccupancies adds the "occupancies" edges to the Occupancy entity.
+func (_c *LocationCreate) AddOccupancies(v ...*Occupancy) *LocationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOccupancyIDs(ids...)
+}
+
+// Mutation returns the LocationMutation object of the builder.
+func (_c *LocationCreate) Mutation() *LocationMutation {
+	return _c.mutation
+}
+
+// Save creates the Location in the database.
+func (_c *LocationCreate) Save(ctx context.Context) (*Location, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LocationCreate) SaveX(ctx context.Context) *Location {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LocationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LocationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LocationCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if location.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized location.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := location.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if location.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized location.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := location.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if location.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized location.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := location.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LocationCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Location.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Location.update_time"`)}
+	}
+	if _, ok := _c.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Location.kind"`)}
+	}
+	if v, ok := _c.mutation.Kind(); ok {
+		if err := location.KindValidator(v); err != nil {
+			return &ValidationError{Name: "kind", err: fmt.Errorf(`ent: validator failed for field "Location.kind": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "Location.code"`)}
+	}
+	if v, ok := _c.mutation.Code(); ok {
+		if err := location.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Location.code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Project(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required field "Location.project"`)}
+	}
+	if v, ok := _c.mutation.Project(); ok {
+		if err := location.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "Location.project": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := location.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Location.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LocationCreate) sqlSave(ctx context.Context) (*Location, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Location.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LocationCreate) createSpec() (*Location, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Location{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(location.Table, sqlgraph.NewFieldSpec(location.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(location.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(location.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Kind(); ok {
+		_spec.SetField(location.FieldKind, field.TypeEnum, value)
+		_node.Kind = value
+	}
+	if value, ok := _c.mutation.Code(); ok {
+		_spec.SetField(location.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(location.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Project(); ok {
+		_spec.SetField(location.FieldProject, field.TypeString, value)
+		_node.Project = value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(location.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if nodes := _c.mutation.ParentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   location.ParentTable,
+			Columns: []string{location.ParentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ParentID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ChildrenIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.ChildrenTable,
+			Columns: []string{location.ChildrenColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.DevicesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.DevicesTable,
+			Columns: []string{location.DevicesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(device.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OccupanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   location.OccupanciesTable,
+			Columns: []string{location.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Location.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LocationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LocationCreate) OnConflict(opts ...sql.ConflictOption) *LocationUpsertOne {
+	_c.conflict = opts
+	return &LocationUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LocationCreate) OnConflictColumns(columns ...string) *LocationUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LocationUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LocationUpsertOne is the builder for "upsert"-ing
+	//  one Location node.
+	LocationUpsertOne struct {
+		create *LocationCreate
+	}
+
+	// LocationUpsert is the "OnConflict" setter.
+	LocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LocationUpsert) SetUpdateTime(v time.Time) *LocationUpsert {
+	u.Set(location.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LocationUpsert) UpdateUpdateTime() *LocationUpsert {
+	u.SetExcluded(location.FieldUpdateTime)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *LocationUpsert) SetCode(v string) *LocationUpsert {
+	u.Set(location.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *LocationUpsert) UpdateCode() *LocationUpsert {
+	u.SetExcluded(location.FieldCode)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *LocationUpsert) SetName(v string) *LocationUpsert {
+	u.Set(location.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LocationUpsert) UpdateName() *LocationUpsert {
+	u.SetExcluded(location.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LocationUpsert) ClearName() *LocationUpsert {
+	u.SetNull(location.FieldName)
+	return u
+}
+
+// SetProject sets the "project" field.
+func (u *LocationUpsert) SetProject(v string) *LocationUpsert {
+	u.Set(location.FieldProject, v)
+	return u
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LocationUpsert) UpdateProject() *LocationUpsert {
+	u.SetExcluded(location.FieldProject)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *LocationUpsert) SetMemo(v string) *LocationUpsert {
+	u.Set(location.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *LocationUpsert) UpdateMemo() *LocationUpsert {
+	u.SetExcluded(location.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *LocationUpsert) ClearMemo() *LocationUpsert {
+	u.SetNull(location.FieldMemo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(location.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LocationUpsertOne) UpdateNewValues() *LocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(location.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(location.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.Kind(); exists {
+			s.SetIgnore(location.FieldKind)
+		}
+		if _, exists := u.create.mutation.ParentID(); exists {
+			s.SetIgnore(location.FieldParentID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LocationUpsertOne) Ignore() *LocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LocationUpsertOne) DoNothing() *LocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LocationCreate.OnConflict
+// documentation for more info.
+func (u *LocationUpsertOne) Update(set func(*LocationUpsert)) *LocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LocationUpsertOne) SetUpdateTime(v time.Time) *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LocationUpsertOne) UpdateUpdateTime() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *LocationUpsertOne) SetCode(v string) *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *LocationUpsertOne) UpdateCode() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LocationUpsertOne) SetName(v string) *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LocationUpsertOne) UpdateName() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LocationUpsertOne) ClearName() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *LocationUpsertOne) SetProject(v string) *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LocationUpsertOne) UpdateProject() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *LocationUpsertOne) SetMemo(v string) *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *LocationUpsertOne) UpdateMemo() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *LocationUpsertOne) ClearMemo() *LocationUpsertOne {
+	return u.Update(func(s *LocationUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *LocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LocationUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LocationUpsertOne.ID is not supported by MySQL driver. Use LocationUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LocationUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LocationCreateBulk is the builder for creating many Location entities in bulk.
+type LocationCreateBulk struct {
+	config
+	err      error
+	builders []*LocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Location entities in the database.
+func (_c *LocationCreateBulk) Save(ctx context.Context) ([]*Location, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Location, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LocationCreateBulk) SaveX(ctx context.Context) []*Location {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LocationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Location.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LocationUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *LocationUpsertBulk {
+	_c.conflict = opts
+	return &LocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LocationCreateBulk) OnConflictColumns(columns ...string) *LocationUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LocationUpsertBulk{
+		create: _c,
+	}
+}
+
+// LocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of Location nodes.
+type LocationUpsertBulk struct {
+	create *LocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(location.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LocationUpsertBulk) UpdateNewValues() *LocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(location.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(location.FieldCreateTime)
+			}
+			if _, exists := b.mutation.Kind(); exists {
+				s.SetIgnore(location.FieldKind)
+			}
+			if _, exists := b.mutation.ParentID(); exists {
+				s.SetIgnore(location.FieldParentID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Location.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LocationUpsertBulk) Ignore() *LocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LocationUpsertBulk) DoNothing() *LocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *LocationUpsertBulk) Update(set func(*LocationUpsert)) *LocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LocationUpsertBulk) SetUpdateTime(v time.Time) *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LocationUpsertBulk) UpdateUpdateTime() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *LocationUpsertBulk) SetCode(v string) *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *LocationUpsertBulk) UpdateCode() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *LocationUpsertBulk) SetName(v string) *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *LocationUpsertBulk) UpdateName() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *LocationUpsertBulk) ClearName() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *LocationUpsertBulk) SetProject(v string) *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LocationUpsertBulk) UpdateProject() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *LocationUpsertBulk) SetMemo(v string) *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *LocationUpsertBulk) UpdateMemo() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *LocationUpsertBulk) ClearMemo() *LocationUpsertBulk {
+	return u.Update(func(s *LocationUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *LocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -14,6 +14,8 @@ var (
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
 		{Name: "create_time", Type: field.TypeTime},
 		{Name: "update_time", Type: field.TypeTime},
+		{Name: "is_del", Type: field.TypeInt64, Default: 0},
+		{Name: "delete_time", Type: field.TypeTime, Nullable: true},
 		{Name: "device_code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "device_type", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "device_sn", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
@@ -23,56 +25,373 @@ var (
 		{Name: "pos_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "area_code", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "pcode", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "conn_key", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "model_name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "driver_props", Type: field.TypeJSON, Nullable: true},
 		{Name: "status", Type: field.TypeInt, Default: 0},
 		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
-		{Name: "is_del", Type: field.TypeInt, Default: 0},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "box_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "location_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
 	}
 	// DeviceTable holds the schema information for the "device" table.
 	DeviceTable = &schema.Table{
 		Name:       "device",
 		Columns:    DeviceColumns,
 		PrimaryKey: []*schema.Column{DeviceColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "device_device_children",
+				Columns:    []*schema.Column{DeviceColumns[19]},
+				RefColumns: []*schema.Column{DeviceColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "device_edge_box_devices",
+				Columns:    []*schema.Column{DeviceColumns[20]},
+				RefColumns: []*schema.Column{EdgeBoxColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "device_location_devices",
+				Columns:    []*schema.Column{DeviceColumns[21]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
 		Indexes: []*schema.Index{
 			{
-				Name:    "device_device_code",
+				Name:    "device_device_code_is_del",
 				Unique:  true,
-				Columns: []*schema.Column{DeviceColumns[3]},
+				Columns: []*schema.Column{DeviceColumns[5], DeviceColumns[3]},
 			},
 			{
 				Name:    "device_device_type",
 				Unique:  false,
-				Columns: []*schema.Column{DeviceColumns[4]},
+				Columns: []*schema.Column{DeviceColumns[6]},
 			},
 			{
 				Name:    "device_device_sn",
 				Unique:  false,
-				Columns: []*schema.Column{DeviceColumns[5]},
+				Columns: []*schema.Column{DeviceColumns[7]},
 			},
 			{
 				Name:    "device_pos_code",
 				Unique:  false,
-				Columns: []*schema.Column{DeviceColumns[9]},
+				Columns: []*schema.Column{DeviceColumns[11]},
 			},
 			{
 				Name:    "device_project",
 				Unique:  false,
-				Columns: []*schema.Column{DeviceColumns[8]},
+				Columns: []*schema.Column{DeviceColumns[10]},
 			},
 			{
 				Name:    "device_pcode",
 				Unique:  false,
-				Columns: []*schema.Column{DeviceColumns[11]},
+				Columns: []*schema.Column{DeviceColumns[13]},
+			},
+			{
+				Name:    "device_location_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceColumns[21]},
+			},
+			{
+				Name:    "device_parent_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceColumns[19]},
+			},
+			{
+				Name:    "device_box_id",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceColumns[20]},
+			},
+		},
+	}
+	// DeviceAttrColumns holds the columns for the "device_attr" table.
+	DeviceAttrColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "device_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "device_code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(32)", "postgres": "varchar(32)", "sqlite3": "varchar(32)"}},
+		{Name: "value", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "history_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+	}
+	// DeviceAttrTable holds the schema information for the "device_attr" table.
+	DeviceAttrTable = &schema.Table{
+		Name:       "device_attr",
+		Columns:    DeviceAttrColumns,
+		PrimaryKey: []*schema.Column{DeviceAttrColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceattr_device_id_name_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceAttrColumns[1], DeviceAttrColumns[3], DeviceAttrColumns[5]},
+			},
+			{
+				Name:    "deviceattr_device_code_name_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceAttrColumns[2], DeviceAttrColumns[3], DeviceAttrColumns[5]},
+			},
+		},
+	}
+	// DeviceHistoryColumns holds the columns for the "device_history" table.
+	DeviceHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "device_code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "op", Type: field.TypeEnum, Enums: []string{"create", "update", "remove", "restore", "delete"}},
+		{Name: "fields", Type: field.TypeJSON, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "actor", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "reason", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(256)", "postgres": "varchar(256)", "sqlite3": "varchar(256)"}},
+	}
+	// DeviceHistoryTable holds the schema information for the "device_history" table.
+	DeviceHistoryTable = &schema.Table{
+		Name:       "device_history",
+		Columns:    DeviceHistoryColumns,
+		PrimaryKey: []*schema.Column{DeviceHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "devicehistory_device_id_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceHistoryColumns[2], DeviceHistoryColumns[1]},
+			},
+			{
+				Name:    "devicehistory_device_code_create_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceHistoryColumns[3], DeviceHistoryColumns[1]},
+			},
+			{
+				Name:    "devicehistory_actor",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceHistoryColumns[8]},
+			},
+		},
+	}
+	// DriverModelColumns holds the columns for the "driver_model" table.
+	DriverModelColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "plugin", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "model_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "description", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
+		{Name: "attributes", Type: field.TypeJSON, Nullable: true},
+		{Name: "points", Type: field.TypeJSON},
+	}
+	// DriverModelTable holds the schema information for the "driver_model" table.
+	DriverModelTable = &schema.Table{
+		Name:       "driver_model",
+		Columns:    DriverModelColumns,
+		PrimaryKey: []*schema.Column{DriverModelColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "drivermodel_name",
+				Unique:  true,
+				Columns: []*schema.Column{DriverModelColumns[3]},
+			},
+		},
+	}
+	// EdgeBoxColumns holds the columns for the "edge_box" table.
+	EdgeBoxColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "project", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "url", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(256)", "postgres": "varchar(256)", "sqlite3": "varchar(256)"}},
+		{Name: "token", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(256)", "postgres": "varchar(256)", "sqlite3": "varchar(256)"}},
+		{Name: "connections", Type: field.TypeJSON, Nullable: true},
+		{Name: "push_time", Type: field.TypeTime, Nullable: true},
+		{Name: "push_version", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "push_error", Type: field.TypeString, Nullable: true},
+		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
+	}
+	// EdgeBoxTable holds the schema information for the "edge_box" table.
+	EdgeBoxTable = &schema.Table{
+		Name:       "edge_box",
+		Columns:    EdgeBoxColumns,
+		PrimaryKey: []*schema.Column{EdgeBoxColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "edgebox_code",
+				Unique:  true,
+				Columns: []*schema.Column{EdgeBoxColumns[3]},
+			},
+			{
+				Name:    "edgebox_project",
+				Unique:  false,
+				Columns: []*schema.Column{EdgeBoxColumns[5]},
+			},
+		},
+	}
+	// LocationColumns holds the columns for the "location" table.
+	LocationColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "kind", Type: field.TypeEnum, Enums: []string{"project", "building", "floor", "unit"}},
+		{Name: "code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "name", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "project", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
+		{Name: "parent_id", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+	}
+	// LocationTable holds the schema information for the "location" table.
+	LocationTable = &schema.Table{
+		Name:       "location",
+		Columns:    LocationColumns,
+		PrimaryKey: []*schema.Column{LocationColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "location_location_children",
+				Columns:    []*schema.Column{LocationColumns[8]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "location_parent_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{LocationColumns[8], LocationColumns[4]},
+			},
+			{
+				Name:    "location_kind_code",
+				Unique:  false,
+				Columns: []*schema.Column{LocationColumns[3], LocationColumns[4]},
+			},
+			{
+				Name:    "location_project_code",
+				Unique:  true,
+				Columns: []*schema.Column{LocationColumns[3], LocationColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "kind = 'project'",
+				},
+			},
+			{
+				Name:    "location_project",
+				Unique:  false,
+				Columns: []*schema.Column{LocationColumns[6]},
+			},
+		},
+	}
+	// OccupancyColumns holds the columns for the "occupancy" table.
+	OccupancyColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "role", Type: field.TypeEnum, Enums: []string{"owner", "tenant"}},
+		{Name: "start_at", Type: field.TypeTime},
+		{Name: "end_at", Type: field.TypeTime, Nullable: true},
+		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
+		{Name: "unit_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "tenant_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+	}
+	// OccupancyTable holds the schema information for the "occupancy" table.
+	OccupancyTable = &schema.Table{
+		Name:       "occupancy",
+		Columns:    OccupancyColumns,
+		PrimaryKey: []*schema.Column{OccupancyColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "occupancy_location_occupancies",
+				Columns:    []*schema.Column{OccupancyColumns[7]},
+				RefColumns: []*schema.Column{LocationColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "occupancy_tenant_occupancies",
+				Columns:    []*schema.Column{OccupancyColumns[8]},
+				RefColumns: []*schema.Column{TenantColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "occupancy_unit_id_role_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{OccupancyColumns[7], OccupancyColumns[3], OccupancyColumns[4]},
+			},
+			{
+				Name:    "occupancy_tenant_id_start_at",
+				Unique:  false,
+				Columns: []*schema.Column{OccupancyColumns[8], OccupancyColumns[4]},
+			},
+		},
+	}
+	// TenantColumns holds the columns for the "tenant" table.
+	TenantColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "contact", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "phone", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(32)", "postgres": "varchar(32)", "sqlite3": "varchar(32)"}},
+		{Name: "memo", Type: field.TypeString, Nullable: true, SchemaType: map[string]string{"mysql": "varchar(128)", "postgres": "varchar(128)", "sqlite3": "varchar(128)"}},
+	}
+	// TenantTable holds the schema information for the "tenant" table.
+	TenantTable = &schema.Table{
+		Name:       "tenant",
+		Columns:    TenantColumns,
+		PrimaryKey: []*schema.Column{TenantColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tenant_code",
+				Unique:  true,
+				Columns: []*schema.Column{TenantColumns[3]},
 			},
 		},
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		DeviceTable,
+		DeviceAttrTable,
+		DeviceHistoryTable,
+		DriverModelTable,
+		EdgeBoxTable,
+		LocationTable,
+		OccupancyTable,
+		TenantTable,
 	}
 )
 
 func init() {
+	DeviceTable.ForeignKeys[0].RefTable = DeviceTable
+	DeviceTable.ForeignKeys[1].RefTable = EdgeBoxTable
+	DeviceTable.ForeignKeys[2].RefTable = LocationTable
 	DeviceTable.Annotation = &entsql.Annotation{
 		Table: "device",
 	}
+	DeviceAttrTable.Annotation = &entsql.Annotation{
+		Table: "device_attr",
+	}
+	DeviceHistoryTable.Annotation = &entsql.Annotation{
+		Table: "device_history",
+	}
+	DriverModelTable.Annotation = &entsql.Annotation{
+		Table: "driver_model",
+	}
+	EdgeBoxTable.Annotation = &entsql.Annotation{
+		Table: "edge_box",
+	}
+	LocationTable.ForeignKeys[0].RefTable = LocationTable
+	LocationTable.Annotation = &entsql.Annotation{
+		Table: "location",
+	}
+	OccupancyTable.ForeignKeys[0].RefTable = LocationTable
+	OccupancyTable.ForeignKeys[1].RefTable = TenantTable
+	OccupancyTable.Annotation = &entsql.Annotation{
+		Table: "occupancy",
+	}
+	TenantTable.Annotation = &entsql.Annotation{
+		Table: "tenant",
+	}
 }
@@ -12,7 +12,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
 	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+	"github.com/twiglab/h2o/pkg/provision"
 )
 
 const (
@@ -24,36 +32,56 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDevice = "Device"
+	TypeDevice        = "Device"
+	TypeDeviceAttr    = "DeviceAttr"
+	TypeDeviceHistory = "DeviceHistory"
+	TypeDriverModel   = "DriverModel"
+	TypeEdgeBox       = "EdgeBox"
+	TypeLocation      = "Location"
+	TypeOccupancy     = "Occupancy"
+	TypeTenant        = "Tenant"
 )
 
 // DeviceMutation represents an operation that mutates the Device nodes in the graph.
 type DeviceMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *string
-	create_time   *time.Time
-	update_time   *time.Time
-	device_code   *string
-	device_type   *string
-	device_sn     *string
-	device_name   *string
-	rate          *int
-	addrate       *int
-	project       *string
-	pos_code      *string
-	area_code     *string
-	pcode         *string
-	status        *int
-	addstatus     *int
-	memo          *string
-	is_del        *int
-	addis_del     *int
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Device, error)
-	predicates    []predicate.Device
+	op              Op
+	typ             string
+	id              *string
+	create_time     *time.Time
+	update_time     *time.Time
+	is_del          *int64
+	addis_del       *int64
+	delete_time     *time.Time
+	device_code     *string
+	device_type     *string
+	device_sn       *string
+	device_name     *string
+	rate            *int
+	addrate         *int
+	project         *string
+	pos_code        *string
+	area_code       *string
+	pcode           *string
+	conn_key        *string
+	model_name      *string
+	driver_props    *map[string]string
+	status          *int
+	addstatus       *int
+	memo            *string
+	clearedFields   map[string]struct{}
+	location        *string
+	clearedlocation bool
+	parent          *string
+	clearedparent   bool
+	children        map[string]struct{}
+	removedchildren map[string]struct{}
+	clearedchildren bool
+	box             *string
+	clearedbox      bool
+	done            bool
+	oldValue        func(context.Context) (*Device, error)
+	predicates      []predicate.Device
 }
 
 var _ ent.Mutation = (*DeviceMutation)(nil)
@@ -232,6 +260,111 @@ func (m *DeviceMutation) ResetUpdateTime() {
 	m.update_time = nil
 }
 
+// SetIsDel sets the "is_del" field.
+func (m *DeviceMutation) SetIsDel(i int64) {
+	m.is_del = &i
+	m.addis_del = nil
+}
+
+// IsDel returns the value of the "is_del" field in the mutation.
+func (m *DeviceMutation) IsDel() (r int64, exists bool) {
+	v := m.is_del
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDel returns the old "is_del" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldIsDel(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDel: %w", err)
+	}
+	return oldValue.IsDel, nil
+}
+
+// AddIsDel adds i to the "is_del" field.
+func (m *DeviceMutation) AddIsDel(i int64) {
+	if m.addis_del != nil {
+		*m.addis_del += i
+	} else {
+		m.addis_del = &i
+	}
+}
+
+// AddedIsDel returns the value that was added to the "is_del" field in this mutation.
+func (m *DeviceMutation) AddedIsDel() (r int64, exists bool) {
+	v := m.addis_del
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIsDel resets all changes to the "is_del" field.
+func (m *DeviceMutation) ResetIsDel() {
+	m.is_del = nil
+	m.addis_del = nil
+}
+
+// SetDeleteTime sets the "delete_time" field.
+func (m *DeviceMutation) SetDeleteTime(t time.Time) {
+	m.delete_time = &t
+}
+
+// DeleteTime returns the value of the "delete_time" field in the mutation.
+func (m *DeviceMutation) DeleteTime() (r time.Time, exists bool) {
+	v := m.delete_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeleteTime returns the old "delete_time" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDeleteTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeleteTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeleteTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeleteTime: %w", err)
+	}
+	return oldValue.DeleteTime, nil
+}
+
+// ClearDeleteTime clears the value of the "delete_time" field.
+func (m *DeviceMutation) ClearDeleteTime() {
+	m.delete_time = nil
+	m.clearedFields[device.FieldDeleteTime] = struct{}{}
+}
+
+// DeleteTimeCleared returns if the "delete_time" field was cleared in this mutation.
+func (m *DeviceMutation) DeleteTimeCleared() bool {
+	_, ok := m.clearedFields[device.FieldDeleteTime]
+	return ok
+}
+
+// ResetDeleteTime resets all changes to the "delete_time" field.
+func (m *DeviceMutation) ResetDeleteTime() {
+	m.delete_time = nil
+	delete(m.clearedFields, device.FieldDeleteTime)
+}
+
 // SetDeviceCode sets the "device_code" field.
 func (m *DeviceMutation) SetDeviceCode(s string) {
 	m.device_code = &s
@@ -641,6 +774,300 @@ func (m *DeviceMutation) ResetPcode() {
 	delete(m.clearedFields, device.FieldPcode)
 }
 
+// SetParentID sets the "parent_id" field.
+func (m *DeviceMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *DeviceMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *DeviceMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[device.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *DeviceMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[device.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *DeviceMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, device.FieldParentID)
+}
+
+// SetLocationID sets the "location_id" field.
+func (m *DeviceMutation) SetLocationID(s string) {
+	m.location = &s
+}
+
+// LocationID returns the value of the "location_id" field in the mutation.
+func (m *DeviceMutation) LocationID() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocationID returns the old "location_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLocationID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocationID: %w", err)
+	}
+	return oldValue.LocationID, nil
+}
+
+// ClearLocationID clears the value of the "location_id" field.
+func (m *DeviceMutation) ClearLocationID() {
+	m.location = nil
+	m.clearedFields[device.FieldLocationID] = struct{}{}
+}
+
+// LocationIDCleared returns if the "location_id" field was cleared in this mutation.
+func (m *DeviceMutation) LocationIDCleared() bool {
+	_, ok := m.clearedFields[device.FieldLocationID]
+	return ok
+}
+
+// ResetLocationID resets all changes to the "location_id" field.
+func (m *DeviceMutation) ResetLocationID() {
+	m.location = nil
+	delete(m.clearedFields, device.FieldLocationID)
+}
+
+// SetBoxID sets the "box_id" field.
+func (m *DeviceMutation) SetBoxID(s string) {
+	m.box = &s
+}
+
+// BoxID returns the value of the "box_id" field in the mutation.
+func (m *DeviceMutation) BoxID() (r string, exists bool) {
+	v := m.box
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBoxID returns the old "box_id" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldBoxID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBoxID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBoxID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBoxID: %w", err)
+	}
+	return oldValue.BoxID, nil
+}
+
+// ClearBoxID clears the value of the "box_id" field.
+func (m *DeviceMutation) ClearBoxID() {
+	m.box = nil
+	m.clearedFields[device.FieldBoxID] = struct{}{}
+}
+
+// BoxIDCleared returns if the "box_id" field was cleared in this mutation.
+func (m *DeviceMutation) BoxIDCleared() bool {
+	_, ok := m.clearedFields[device.FieldBoxID]
+	return ok
+}
+
+// ResetBoxID resets all changes to the "box_id" field.
+func (m *DeviceMutation) ResetBoxID() {
+	m.box = nil
+	delete(m.clearedFields, device.FieldBoxID)
+}
+
+// SetConnKey sets the "conn_key" field.
+func (m *DeviceMutation) SetConnKey(s string) {
+	m.conn_key = &s
+}
+
+// ConnKey returns the value of the "conn_key" field in the mutation.
+func (m *DeviceMutation) ConnKey() (r string, exists bool) {
+	v := m.conn_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnKey returns the old "conn_key" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldConnKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnKey: %w", err)
+	}
+	return oldValue.ConnKey, nil
+}
+
+// ClearConnKey clears the value of the "conn_key" field.
+func (m *DeviceMutation) ClearConnKey() {
+	m.conn_key = nil
+	m.clearedFields[device.FieldConnKey] = struct{}{}
+}
+
+// ConnKeyCleared returns if the "conn_key" field was cleared in this mutation.
+func (m *DeviceMutation) ConnKeyCleared() bool {
+	_, ok := m.clearedFields[device.FieldConnKey]
+	return ok
+}
+
+// ResetConnKey resets all changes to the "conn_key" field.
+func (m *DeviceMutation) ResetConnKey() {
+	m.conn_key = nil
+	delete(m.clearedFields, device.FieldConnKey)
+}
+
+// SetModelName sets the "model_name" field.
+func (m *DeviceMutation) SetModelName(s string) {
+	m.model_name = &s
+}
+
+// ModelName returns the value of the "model_name" field in the mutation.
+func (m *DeviceMutation) ModelName() (r string, exists bool) {
+	v := m.model_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelName returns the old "model_name" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldModelName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelName: %w", err)
+	}
+	return oldValue.ModelName, nil
+}
+
+// ClearModelName clears the value of the "model_name" field.
+func (m *DeviceMutation) ClearModelName() {
+	m.model_name = nil
+	m.clearedFields[device.FieldModelName] = struct{}{}
+}
+
+// ModelNameCleared returns if the "model_name" field was cleared in this mutation.
+func (m *DeviceMutation) ModelNameCleared() bool {
+	_, ok := m.clearedFields[device.FieldModelName]
+	return ok
+}
+
+// ResetModelName resets all changes to the "model_name" field.
+func (m *DeviceMutation) ResetModelName() {
+	m.model_name = nil
+	delete(m.clearedFields, device.FieldModelName)
+}
+
+// SetDriverProps sets the "driver_props" field.
+func (m *DeviceMutation) SetDriverProps(value map[string]string) {
+	m.driver_props = &value
+}
+
+// DriverProps returns the value of the "driver_props" field in the mutation.
+func (m *DeviceMutation) DriverProps() (r map[string]string, exists bool) {
+	v := m.driver_props
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDriverProps returns the old "driver_props" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldDriverProps(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDriverProps is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDriverProps requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDriverProps: %w", err)
+	}
+	return oldValue.DriverProps, nil
+}
+
+// ClearDriverProps clears the value of the "driver_props" field.
+func (m *DeviceMutation) ClearDriverProps() {
+	m.driver_props = nil
+	m.clearedFields[device.FieldDriverProps] = struct{}{}
+}
+
+// DriverPropsCleared returns if the "driver_props" field was cleared in this mutation.
+func (m *DeviceMutation) DriverPropsCleared() bool {
+	_, ok := m.clearedFields[device.FieldDriverProps]
+	return ok
+}
+
+// ResetDriverProps resets all changes to the "driver_props" field.
+func (m *DeviceMutation) ResetDriverProps() {
+	m.driver_props = nil
+	delete(m.clearedFields, device.FieldDriverProps)
+}
+
 // SetStatus sets the "status" field.
 func (m *DeviceMutation) SetStatus(i int) {
 	m.status = &i
@@ -746,60 +1173,139 @@ func (m *DeviceMutation) ResetMemo() {
 	delete(m.clearedFields, device.FieldMemo)
 }
 
-// SetIsDel sets the "is_del" field.
-func (m *DeviceMutation) SetIsDel(i int) {
-	m.is_del = &i
-	m.addis_del = nil
+// ClearLocation clears the "location" edge to the Location entity.
+func (m *DeviceMutation) ClearLocation() {
+	m.clearedlocation = true
+	m.clearedFields[device.FieldLocationID] = struct{}{}
 }
 
-// IsDel returns the value of the "is_del" field in the mutation.
-func (m *DeviceMutation) IsDel() (r int, exists bool) {
-	v := m.is_del
-	if v == nil {
-		return
-	}
-	return *v, true
+// LocationCleared reports if the "location" edge to the Location entity was cleared.
+func (m *DeviceMutation) LocationCleared() bool {
+	return m.LocationIDCleared() || m.clearedlocation
 }
 
-// OldIsDel returns the old "is_del" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldIsDel(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDel is only allowed on UpdateOne operations")
+// LocationIDs returns the "location" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LocationID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) LocationIDs() (ids []string) {
+	if id := m.location; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDel requires an ID field in the mutation")
+	return
+}
+
+// ResetLocation resets all changes to the "location" edge.
+func (m *DeviceMutation) ResetLocation() {
+	m.location = nil
+	m.clearedlocation = false
+}
+
+// ClearParent clears the "parent" edge to the Device entity.
+func (m *DeviceMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[device.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Device entity was cleared.
+func (m *DeviceMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDel: %w", err)
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *DeviceMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Device entity by ids.
+func (m *DeviceMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
 	}
-	return oldValue.IsDel, nil
 }
 
-// AddIsDel adds i to the "is_del" field.
-func (m *DeviceMutation) AddIsDel(i int) {
-	if m.addis_del != nil {
-		*m.addis_del += i
-	} else {
-		m.addis_del = &i
+// ClearChildren clears the "children" edge to the Device entity.
+func (m *DeviceMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Device entity was cleared.
+func (m *DeviceMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Device entity by IDs.
+func (m *DeviceMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
 	}
 }
 
-// AddedIsDel returns the value that was added to the "is_del" field in this mutation.
-func (m *DeviceMutation) AddedIsDel() (r int, exists bool) {
-	v := m.addis_del
-	if v == nil {
-		return
+// RemovedChildren returns the removed IDs of the "children" edge to the Device entity.
+func (m *DeviceMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetIsDel resets all changes to the "is_del" field.
-func (m *DeviceMutation) ResetIsDel() {
-	m.is_del = nil
-	m.addis_del = nil
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *DeviceMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *DeviceMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// ClearBox clears the "box" edge to the EdgeBox entity.
+func (m *DeviceMutation) ClearBox() {
+	m.clearedbox = true
+	m.clearedFields[device.FieldBoxID] = struct{}{}
+}
+
+// BoxCleared reports if the "box" edge to the EdgeBox entity was cleared.
+func (m *DeviceMutation) BoxCleared() bool {
+	return m.BoxIDCleared() || m.clearedbox
+}
+
+// BoxIDs returns the "box" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// BoxID instead. It exists only for internal usage by the builders.
+func (m *DeviceMutation) BoxIDs() (ids []string) {
+	if id := m.box; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetBox resets all changes to the "box" edge.
+func (m *DeviceMutation) ResetBox() {
+	m.box = nil
+	m.clearedbox = false
 }
 
 // Where appends a list predicates to the DeviceMutation builder.
@@ -836,13 +1342,19 @@ func (m *DeviceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *DeviceMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+	fields := make([]string, 0, 21)
 	if m.create_time != nil {
 		fields = append(fields, device.FieldCreateTime)
 	}
 	if m.update_time != nil {
 		fields = append(fields, device.FieldUpdateTime)
 	}
+	if m.is_del != nil {
+		fields = append(fields, device.FieldIsDel)
+	}
+	if m.delete_time != nil {
+		fields = append(fields, device.FieldDeleteTime)
+	}
 	if m.device_code != nil {
 		fields = append(fields, device.FieldDeviceCode)
 	}
@@ -870,15 +1382,30 @@ func (m *DeviceMutation) Fields() []string {
 	if m.pcode != nil {
 		fields = append(fields, device.FieldPcode)
 	}
+	if m.parent != nil {
+		fields = append(fields, device.FieldParentID)
+	}
+	if m.location != nil {
+		fields = append(fields, device.FieldLocationID)
+	}
+	if m.box != nil {
+		fields = append(fields, device.FieldBoxID)
+	}
+	if m.conn_key != nil {
+		fields = append(fields, device.FieldConnKey)
+	}
+	if m.model_name != nil {
+		fields = append(fields, device.FieldModelName)
+	}
+	if m.driver_props != nil {
+		fields = append(fields, device.FieldDriverProps)
+	}
 	if m.status != nil {
 		fields = append(fields, device.FieldStatus)
 	}
 	if m.memo != nil {
 		fields = append(fields, device.FieldMemo)
 	}
-	if m.is_del != nil {
-		fields = append(fields, device.FieldIsDel)
-	}
 	return fields
 }
 
@@ -891,6 +1418,10 @@ func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 		return m.CreateTime()
 	case device.FieldUpdateTime:
 		return m.UpdateTime()
+	case device.FieldIsDel:
+		return m.IsDel()
+	case device.FieldDeleteTime:
+		return m.DeleteTime()
 	case device.FieldDeviceCode:
 		return m.DeviceCode()
 	case device.FieldDeviceType:
@@ -909,12 +1440,22 @@ func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 		return m.AreaCode()
 	case device.FieldPcode:
 		return m.Pcode()
+	case device.FieldParentID:
+		return m.ParentID()
+	case device.FieldLocationID:
+		return m.LocationID()
+	case device.FieldBoxID:
+		return m.BoxID()
+	case device.FieldConnKey:
+		return m.ConnKey()
+	case device.FieldModelName:
+		return m.ModelName()
+	case device.FieldDriverProps:
+		return m.DriverProps()
 	case device.FieldStatus:
 		return m.Status()
 	case device.FieldMemo:
 		return m.Memo()
-	case device.FieldIsDel:
-		return m.IsDel()
 	}
 	return nil, false
 }
@@ -928,6 +1469,10 @@ func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldCreateTime(ctx)
 	case device.FieldUpdateTime:
 		return m.OldUpdateTime(ctx)
+	case device.FieldIsDel:
+		return m.OldIsDel(ctx)
+	case device.FieldDeleteTime:
+		return m.OldDeleteTime(ctx)
 	case device.FieldDeviceCode:
 		return m.OldDeviceCode(ctx)
 	case device.FieldDeviceType:
@@ -946,12 +1491,22 @@ func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldAreaCode(ctx)
 	case device.FieldPcode:
 		return m.OldPcode(ctx)
+	case device.FieldParentID:
+		return m.OldParentID(ctx)
+	case device.FieldLocationID:
+		return m.OldLocationID(ctx)
+	case device.FieldBoxID:
+		return m.OldBoxID(ctx)
+	case device.FieldConnKey:
+		return m.OldConnKey(ctx)
+	case device.FieldModelName:
+		return m.OldModelName(ctx)
+	case device.FieldDriverProps:
+		return m.OldDriverProps(ctx)
 	case device.FieldStatus:
 		return m.OldStatus(ctx)
 	case device.FieldMemo:
 		return m.OldMemo(ctx)
-	case device.FieldIsDel:
-		return m.OldIsDel(ctx)
 	}
 	return nil, fmt.Errorf("unknown Device field %s", name)
 }
@@ -975,6 +1530,20 @@ func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUpdateTime(v)
 		return nil
+	case device.FieldIsDel:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDel(v)
+		return nil
+	case device.FieldDeleteTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeleteTime(v)
+		return nil
 	case device.FieldDeviceCode:
 		v, ok := value.(string)
 		if !ok {
@@ -1038,26 +1607,61 @@ func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPcode(v)
 		return nil
-	case device.FieldStatus:
-		v, ok := value.(int)
+	case device.FieldParentID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetParentID(v)
 		return nil
-	case device.FieldMemo:
+	case device.FieldLocationID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMemo(v)
+		m.SetLocationID(v)
 		return nil
-	case device.FieldIsDel:
+	case device.FieldBoxID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBoxID(v)
+		return nil
+	case device.FieldConnKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnKey(v)
+		return nil
+	case device.FieldModelName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelName(v)
+		return nil
+	case device.FieldDriverProps:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDriverProps(v)
+		return nil
+	case device.FieldStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsDel(v)
+		m.SetStatus(v)
+		return nil
+	case device.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Device field %s", name)
@@ -1067,15 +1671,15 @@ func (m *DeviceMutation) SetField(name string, value ent.Value) error {
 // this mutation.
 func (m *DeviceMutation) AddedFields() []string {
 	var fields []string
+	if m.addis_del != nil {
+		fields = append(fields, device.FieldIsDel)
+	}
 	if m.addrate != nil {
 		fields = append(fields, device.FieldRate)
 	}
 	if m.addstatus != nil {
 		fields = append(fields, device.FieldStatus)
 	}
-	if m.addis_del != nil {
-		fields = append(fields, device.FieldIsDel)
-	}
 	return fields
 }
 
@@ -1084,12 +1688,12 @@ func (m *DeviceMutation) AddedFields() []string {
 // was not set, or was not defined in the schema.
 func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case device.FieldIsDel:
+		return m.AddedIsDel()
 	case device.FieldRate:
 		return m.AddedRate()
 	case device.FieldStatus:
 		return m.AddedStatus()
-	case device.FieldIsDel:
-		return m.AddedIsDel()
 	}
 	return nil, false
 }
@@ -1099,26 +1703,26 @@ func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case device.FieldRate:
-		v, ok := value.(int)
+	case device.FieldIsDel:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddRate(v)
+		m.AddIsDel(v)
 		return nil
-	case device.FieldStatus:
+	case device.FieldRate:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddRate(v)
 		return nil
-	case device.FieldIsDel:
+	case device.FieldStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIsDel(v)
+		m.AddStatus(v)
 		return nil
 	}
 	return fmt.Errorf("unknown Device numeric field %s", name)
@@ -1128,6 +1732,9 @@ func (m *DeviceMutation) AddField(name string, value ent.Value) error {
 // mutation.
 func (m *DeviceMutation) ClearedFields() []string {
 	var fields []string
+	if m.FieldCleared(device.FieldDeleteTime) {
+		fields = append(fields, device.FieldDeleteTime)
+	}
 	if m.FieldCleared(device.FieldDeviceSn) {
 		fields = append(fields, device.FieldDeviceSn)
 	}
@@ -1143,6 +1750,24 @@ func (m *DeviceMutation) ClearedFields() []string {
 	if m.FieldCleared(device.FieldPcode) {
 		fields = append(fields, device.FieldPcode)
 	}
+	if m.FieldCleared(device.FieldParentID) {
+		fields = append(fields, device.FieldParentID)
+	}
+	if m.FieldCleared(device.FieldLocationID) {
+		fields = append(fields, device.FieldLocationID)
+	}
+	if m.FieldCleared(device.FieldBoxID) {
+		fields = append(fields, device.FieldBoxID)
+	}
+	if m.FieldCleared(device.FieldConnKey) {
+		fields = append(fields, device.FieldConnKey)
+	}
+	if m.FieldCleared(device.FieldModelName) {
+		fields = append(fields, device.FieldModelName)
+	}
+	if m.FieldCleared(device.FieldDriverProps) {
+		fields = append(fields, device.FieldDriverProps)
+	}
 	if m.FieldCleared(device.FieldMemo) {
 		fields = append(fields, device.FieldMemo)
 	}
@@ -1160,6 +1785,9 @@ func (m *DeviceMutation) FieldCleared(name string) bool {
 // error if the field is not defined in the schema.
 func (m *DeviceMutation) ClearField(name string) error {
 	switch name {
+	case device.FieldDeleteTime:
+		m.ClearDeleteTime()
+		return nil
 	case device.FieldDeviceSn:
 		m.ClearDeviceSn()
 		return nil
@@ -1175,6 +1803,24 @@ func (m *DeviceMutation) ClearField(name string) error {
 	case device.FieldPcode:
 		m.ClearPcode()
 		return nil
+	case device.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case device.FieldLocationID:
+		m.ClearLocationID()
+		return nil
+	case device.FieldBoxID:
+		m.ClearBoxID()
+		return nil
+	case device.FieldConnKey:
+		m.ClearConnKey()
+		return nil
+	case device.FieldModelName:
+		m.ClearModelName()
+		return nil
+	case device.FieldDriverProps:
+		m.ClearDriverProps()
+		return nil
 	case device.FieldMemo:
 		m.ClearMemo()
 		return nil
@@ -1192,6 +1838,12 @@ func (m *DeviceMutation) ResetField(name string) error {
 	case device.FieldUpdateTime:
 		m.ResetUpdateTime()
 		return nil
+	case device.FieldIsDel:
+		m.ResetIsDel()
+		return nil
+	case device.FieldDeleteTime:
+		m.ResetDeleteTime()
+		return nil
 	case device.FieldDeviceCode:
 		m.ResetDeviceCode()
 		return nil
@@ -1219,63 +1871,6439 @@ func (m *DeviceMutation) ResetField(name string) error {
 	case device.FieldPcode:
 		m.ResetPcode()
 		return nil
+	case device.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case device.FieldLocationID:
+		m.ResetLocationID()
+		return nil
+	case device.FieldBoxID:
+		m.ResetBoxID()
+		return nil
+	case device.FieldConnKey:
+		m.ResetConnKey()
+		return nil
+	case device.FieldModelName:
+		m.ResetModelName()
+		return nil
+	case device.FieldDriverProps:
+		m.ResetDriverProps()
+		return nil
 	case device.FieldStatus:
 		m.ResetStatus()
 		return nil
 	case device.FieldMemo:
 		m.ResetMemo()
 		return nil
-	case device.FieldIsDel:
-		m.ResetIsDel()
-		return nil
 	}
 	return fmt.Errorf("unknown Device field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *DeviceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 4)
+	if m.location != nil {
+		edges = append(edges, device.EdgeLocation)
+	}
+	if m.parent != nil {
+		edges = append(edges, device.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, device.EdgeChildren)
+	}
+	if m.box != nil {
+		edges = append(edges, device.EdgeBox)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
 func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case device.EdgeLocation:
+		if id := m.location; id != nil {
+			return []ent.Value{*id}
+		}
+	case device.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case device.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case device.EdgeBox:
+		if id := m.box; id != nil {
+			return []ent.Value{*id}
+		}
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *DeviceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, device.EdgeChildren)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
 func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case device.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *DeviceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+	edges := make([]string, 0, 4)
+	if m.clearedlocation {
+		edges = append(edges, device.EdgeLocation)
+	}
+	if m.clearedparent {
+		edges = append(edges, device.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, device.EdgeChildren)
+	}
+	if m.clearedbox {
+		edges = append(edges, device.EdgeBox)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
 func (m *DeviceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case device.EdgeLocation:
+		return m.clearedlocation
+	case device.EdgeParent:
+		return m.clearedparent
+	case device.EdgeChildren:
+		return m.clearedchildren
+	case device.EdgeBox:
+		return m.clearedbox
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
 func (m *DeviceMutation) ClearEdge(name string) error {
+	switch name {
+	case device.EdgeLocation:
+		m.ClearLocation()
+		return nil
+	case device.EdgeParent:
+		m.ClearParent()
+		return nil
+	case device.EdgeBox:
+		m.ClearBox()
+		return nil
+	}
 	return fmt.Errorf("unknown Device unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
 func (m *DeviceMutation) ResetEdge(name string) error {
+	switch name {
+	case device.EdgeLocation:
+		m.ResetLocation()
+		return nil
+	case device.EdgeParent:
+		m.ResetParent()
+		return nil
+	case device.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case device.EdgeBox:
+		m.ResetBox()
+		return nil
+	}
 	return fmt.Errorf("unknown Device edge %s", name)
 }
+
+// DeviceAttrMutation represents an operation that mutates the DeviceAttr nodes in the graph.
+type DeviceAttrMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	device_id     *string
+	device_code   *string
+	name          *string
+	value         *string
+	valid_from    *time.Time
+	valid_to      *time.Time
+	history_id    *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceAttr, error)
+	predicates    []predicate.DeviceAttr
+}
+
+var _ ent.Mutation = (*DeviceAttrMutation)(nil)
+
+// deviceattrOption allows management of the mutation configuration using functional options.
+type deviceattrOption func(*DeviceAttrMutation)
+
+// newDeviceAttrMutation creates new mutation for the DeviceAttr entity.
+func newDeviceAttrMutation(c config, op Op, opts ...deviceattrOption) *DeviceAttrMutation {
+	m := &DeviceAttrMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceAttr,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceAttrID sets the ID field of the mutation.
+func withDeviceAttrID(id string) deviceattrOption {
+	return func(m *DeviceAttrMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceAttr
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceAttr, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceAttr.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceAttr sets the old DeviceAttr of the mutation.
+func withDeviceAttr(node *DeviceAttr) deviceattrOption {
+	return func(m *DeviceAttrMutation) {
+		m.oldValue = func(context.Context) (*DeviceAttr, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceAttrMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceAttrMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceAttr entities.
+func (m *DeviceAttrMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceAttrMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceAttrMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceAttr.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceAttrMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceAttrMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceAttrMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceAttrMutation) SetDeviceCode(s string) {
+	m.device_code = &s
+}
+
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceAttrMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceCode returns the old "device_code" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+	}
+	return oldValue.DeviceCode, nil
+}
+
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceAttrMutation) ResetDeviceCode() {
+	m.device_code = nil
+}
+
+// SetName sets the "name" field.
+func (m *DeviceAttrMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeviceAttrMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DeviceAttrMutation) ResetName() {
+	m.name = nil
+}
+
+// SetValue sets the "value" field.
+func (m *DeviceAttrMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *DeviceAttrMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ClearValue clears the value of the "value" field.
+func (m *DeviceAttrMutation) ClearValue() {
+	m.value = nil
+	m.clearedFields[deviceattr.FieldValue] = struct{}{}
+}
+
+// ValueCleared returns if the "value" field was cleared in this mutation.
+func (m *DeviceAttrMutation) ValueCleared() bool {
+	_, ok := m.clearedFields[deviceattr.FieldValue]
+	return ok
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *DeviceAttrMutation) ResetValue() {
+	m.value = nil
+	delete(m.clearedFields, deviceattr.FieldValue)
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *DeviceAttrMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *DeviceAttrMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *DeviceAttrMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *DeviceAttrMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *DeviceAttrMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *DeviceAttrMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[deviceattr.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *DeviceAttrMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[deviceattr.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *DeviceAttrMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, deviceattr.FieldValidTo)
+}
+
+// SetHistoryID sets the "history_id" field.
+func (m *DeviceAttrMutation) SetHistoryID(s string) {
+	m.history_id = &s
+}
+
+// HistoryID returns the value of the "history_id" field in the mutation.
+func (m *DeviceAttrMutation) HistoryID() (r string, exists bool) {
+	v := m.history_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHistoryID returns the old "history_id" field's value of the DeviceAttr entity.
+// If the DeviceAttr object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceAttrMutation) OldHistoryID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHistoryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHistoryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHistoryID: %w", err)
+	}
+	return oldValue.HistoryID, nil
+}
+
+// ClearHistoryID clears the value of the "history_id" field.
+func (m *DeviceAttrMutation) ClearHistoryID() {
+	m.history_id = nil
+	m.clearedFields[deviceattr.FieldHistoryID] = struct{}{}
+}
+
+// HistoryIDCleared returns if the "history_id" field was cleared in this mutation.
+func (m *DeviceAttrMutation) HistoryIDCleared() bool {
+	_, ok := m.clearedFields[deviceattr.FieldHistoryID]
+	return ok
+}
+
+// ResetHistoryID resets all changes to the "history_id" field.
+func (m *DeviceAttrMutation) ResetHistoryID() {
+	m.history_id = nil
+	delete(m.clearedFields, deviceattr.FieldHistoryID)
+}
+
+// Where appends a list predicates to the DeviceAttrMutation builder.
+func (m *DeviceAttrMutation) Where(ps ...predicate.DeviceAttr) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceAttrMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceAttrMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceAttr, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceAttrMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceAttrMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceAttr).
+func (m *DeviceAttrMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceAttrMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.device_id != nil {
+		fields = append(fields, deviceattr.FieldDeviceID)
+	}
+	if m.device_code != nil {
+		fields = append(fields, deviceattr.FieldDeviceCode)
+	}
+	if m.name != nil {
+		fields = append(fields, deviceattr.FieldName)
+	}
+	if m.value != nil {
+		fields = append(fields, deviceattr.FieldValue)
+	}
+	if m.valid_from != nil {
+		fields = append(fields, deviceattr.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, deviceattr.FieldValidTo)
+	}
+	if m.history_id != nil {
+		fields = append(fields, deviceattr.FieldHistoryID)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceAttrMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deviceattr.FieldDeviceID:
+		return m.DeviceID()
+	case deviceattr.FieldDeviceCode:
+		return m.DeviceCode()
+	case deviceattr.FieldName:
+		return m.Name()
+	case deviceattr.FieldValue:
+		return m.Value()
+	case deviceattr.FieldValidFrom:
+		return m.ValidFrom()
+	case deviceattr.FieldValidTo:
+		return m.ValidTo()
+	case deviceattr.FieldHistoryID:
+		return m.HistoryID()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceAttrMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deviceattr.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case deviceattr.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case deviceattr.FieldName:
+		return m.OldName(ctx)
+	case deviceattr.FieldValue:
+		return m.OldValue(ctx)
+	case deviceattr.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case deviceattr.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case deviceattr.FieldHistoryID:
+		return m.OldHistoryID(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceAttr field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceAttrMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deviceattr.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case deviceattr.FieldDeviceCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceCode(v)
+		return nil
+	case deviceattr.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case deviceattr.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case deviceattr.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case deviceattr.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case deviceattr.FieldHistoryID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHistoryID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceAttr field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceAttrMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceAttrMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceAttrMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceAttr numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceAttrMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deviceattr.FieldValue) {
+		fields = append(fields, deviceattr.FieldValue)
+	}
+	if m.FieldCleared(deviceattr.FieldValidTo) {
+		fields = append(fields, deviceattr.FieldValidTo)
+	}
+	if m.FieldCleared(deviceattr.FieldHistoryID) {
+		fields = append(fields, deviceattr.FieldHistoryID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceAttrMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceAttrMutation) ClearField(name string) error {
+	switch name {
+	case deviceattr.FieldValue:
+		m.ClearValue()
+		return nil
+	case deviceattr.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case deviceattr.FieldHistoryID:
+		m.ClearHistoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceAttr nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceAttrMutation) ResetField(name string) error {
+	switch name {
+	case deviceattr.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case deviceattr.FieldDeviceCode:
+		m.ResetDeviceCode()
+		return nil
+	case deviceattr.FieldName:
+		m.ResetName()
+		return nil
+	case deviceattr.FieldValue:
+		m.ResetValue()
+		return nil
+	case deviceattr.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case deviceattr.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case deviceattr.FieldHistoryID:
+		m.ResetHistoryID()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceAttr field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceAttrMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceAttrMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceAttrMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceAttrMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceAttrMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceAttrMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceAttrMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceAttr unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceAttrMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceAttr edge %s", name)
+}
+
+// DeviceHistoryMutation represents an operation that mutates the DeviceHistory nodes in the graph.
+type DeviceHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_time   *time.Time
+	device_id     *string
+	device_code   *string
+	_op           *devicehistory.Op
+	fields        *[]string
+	appendfields  []string
+	before        *map[string]interface{}
+	after         *map[string]interface{}
+	actor         *string
+	reason        *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DeviceHistory, error)
+	predicates    []predicate.DeviceHistory
+}
+
+var _ ent.Mutation = (*DeviceHistoryMutation)(nil)
+
+// devicehistoryOption allows management of the mutation configuration using functional options.
+type devicehistoryOption func(*DeviceHistoryMutation)
+
+// newDeviceHistoryMutation creates new mutation for the DeviceHistory entity.
+func newDeviceHistoryMutation(c config, op Op, opts ...devicehistoryOption) *DeviceHistoryMutation {
+	m := &DeviceHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceHistoryID sets the ID field of the mutation.
+func withDeviceHistoryID(id string) devicehistoryOption {
+	return func(m *DeviceHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceHistory
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceHistory sets the old DeviceHistory of the mutation.
+func withDeviceHistory(node *DeviceHistory) devicehistoryOption {
+	return func(m *DeviceHistoryMutation) {
+		m.oldValue = func(context.Context) (*DeviceHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DeviceHistory entities.
+func (m *DeviceHistoryMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceHistoryMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceHistoryMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DeviceHistoryMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DeviceHistoryMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DeviceHistoryMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceHistoryMutation) SetDeviceID(s string) {
+	m.device_id = &s
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceHistoryMutation) DeviceID() (r string, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldDeviceID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceHistoryMutation) ResetDeviceID() {
+	m.device_id = nil
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (m *DeviceHistoryMutation) SetDeviceCode(s string) {
+	m.device_code = &s
+}
+
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *DeviceHistoryMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceCode returns the old "device_code" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+	}
+	return oldValue.DeviceCode, nil
+}
+
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *DeviceHistoryMutation) ResetDeviceCode() {
+	m.device_code = nil
+}
+
+// SetOpField sets the "op" field.
+func (m *DeviceHistoryMutation) SetOpField(d devicehistory.Op) {
+	m._op = &d
+}
+
+// GetOp returns the value of the "op" field in the mutation.
+func (m *DeviceHistoryMutation) GetOp() (r devicehistory.Op, exists bool) {
+	v := m._op
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOp returns the old "op" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldOp(ctx context.Context) (v devicehistory.Op, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOp: %w", err)
+	}
+	return oldValue.Op, nil
+}
+
+// ResetOp resets all changes to the "op" field.
+func (m *DeviceHistoryMutation) ResetOp() {
+	m._op = nil
+}
+
+// SetFields sets the "fields" field.
+func (m *DeviceHistoryMutation) SetFields(s []string) {
+	m.fields = &s
+	m.appendfields = nil
+}
+
+// GetFields returns the value of the "fields" field in the mutation.
+func (m *DeviceHistoryMutation) GetFields() (r []string, exists bool) {
+	v := m.fields
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFields returns the old "fields" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldFields(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFields is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFields requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFields: %w", err)
+	}
+	return oldValue.Fields, nil
+}
+
+// AppendFields adds s to the "fields" field.
+func (m *DeviceHistoryMutation) AppendFields(s []string) {
+	m.appendfields = append(m.appendfields, s...)
+}
+
+// AppendedFields returns the list of values that were appended to the "fields" field in this mutation.
+func (m *DeviceHistoryMutation) AppendedFields() ([]string, bool) {
+	if len(m.appendfields) == 0 {
+		return nil, false
+	}
+	return m.appendfields, true
+}
+
+// ClearFields clears the value of the "fields" field.
+func (m *DeviceHistoryMutation) ClearFields() {
+	m.fields = nil
+	m.appendfields = nil
+	m.clearedFields[devicehistory.FieldFields] = struct{}{}
+}
+
+// FieldsCleared returns if the "fields" field was cleared in this mutation.
+func (m *DeviceHistoryMutation) FieldsCleared() bool {
+	_, ok := m.clearedFields[devicehistory.FieldFields]
+	return ok
+}
+
+// ResetFields resets all changes to the "fields" field.
+func (m *DeviceHistoryMutation) ResetFields() {
+	m.fields = nil
+	m.appendfields = nil
+	delete(m.clearedFields, devicehistory.FieldFields)
+}
+
+// SetBefore sets the "before" field.
+func (m *DeviceHistoryMutation) SetBefore(value map[string]interface{}) {
+	m.before = &value
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *DeviceHistoryMutation) Before() (r map[string]interface{}, exists bool) {
+	v := m.before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBefore returns the old "before" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldBefore(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
+	}
+	return oldValue.Before, nil
+}
+
+// ClearBefore clears the value of the "before" field.
+func (m *DeviceHistoryMutation) ClearBefore() {
+	m.before = nil
+	m.clearedFields[devicehistory.FieldBefore] = struct{}{}
+}
+
+// BeforeCleared returns if the "before" field was cleared in this mutation.
+func (m *DeviceHistoryMutation) BeforeCleared() bool {
+	_, ok := m.clearedFields[devicehistory.FieldBefore]
+	return ok
+}
+
+// ResetBefore resets all changes to the "before" field.
+func (m *DeviceHistoryMutation) ResetBefore() {
+	m.before = nil
+	delete(m.clearedFields, devicehistory.FieldBefore)
+}
+
+// SetAfter sets the "after" field.
+func (m *DeviceHistoryMutation) SetAfter(value map[string]interface{}) {
+	m.after = &value
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *DeviceHistoryMutation) After() (r map[string]interface{}, exists bool) {
+	v := m.after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfter returns the old "after" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldAfter(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
+	}
+	return oldValue.After, nil
+}
+
+// ClearAfter clears the value of the "after" field.
+func (m *DeviceHistoryMutation) ClearAfter() {
+	m.after = nil
+	m.clearedFields[devicehistory.FieldAfter] = struct{}{}
+}
+
+// AfterCleared returns if the "after" field was cleared in this mutation.
+func (m *DeviceHistoryMutation) AfterCleared() bool {
+	_, ok := m.clearedFields[devicehistory.FieldAfter]
+	return ok
+}
+
+// ResetAfter resets all changes to the "after" field.
+func (m *DeviceHistoryMutation) ResetAfter() {
+	m.after = nil
+	delete(m.clearedFields, devicehistory.FieldAfter)
+}
+
+// SetActor sets the "actor" field.
+func (m *DeviceHistoryMutation) SetActor(s string) {
+	m.actor = &s
+}
+
+// Actor returns the value of the "actor" field in the mutation.
+func (m *DeviceHistoryMutation) Actor() (r string, exists bool) {
+	v := m.actor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActor returns the old "actor" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldActor(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
+	}
+	return oldValue.Actor, nil
+}
+
+// ClearActor clears the value of the "actor" field.
+func (m *DeviceHistoryMutation) ClearActor() {
+	m.actor = nil
+	m.clearedFields[devicehistory.FieldActor] = struct{}{}
+}
+
+// ActorCleared returns if the "actor" field was cleared in this mutation.
+func (m *DeviceHistoryMutation) ActorCleared() bool {
+	_, ok := m.clearedFields[devicehistory.FieldActor]
+	return ok
+}
+
+// ResetActor resets all changes to the "actor" field.
+func (m *DeviceHistoryMutation) ResetActor() {
+	m.actor = nil
+	delete(m.clearedFields, devicehistory.FieldActor)
+}
+
+// SetReason sets the "reason" field.
+func (m *DeviceHistoryMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *DeviceHistoryMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the DeviceHistory entity.
+// If the DeviceHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceHistoryMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *DeviceHistoryMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[devicehistory.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *DeviceHistoryMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[devicehistory.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *DeviceHistoryMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, devicehistory.FieldReason)
+}
+
+// Where appends a list predicates to the DeviceHistoryMutation builder.
+func (m *DeviceHistoryMutation) Where(ps ...predicate.DeviceHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceHistory).
+func (m *DeviceHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.create_time != nil {
+		fields = append(fields, devicehistory.FieldCreateTime)
+	}
+	if m.device_id != nil {
+		fields = append(fields, devicehistory.FieldDeviceID)
+	}
+	if m.device_code != nil {
+		fields = append(fields, devicehistory.FieldDeviceCode)
+	}
+	if m._op != nil {
+		fields = append(fields, devicehistory.FieldOp)
+	}
+	if m.fields != nil {
+		fields = append(fields, devicehistory.FieldFields)
+	}
+	if m.before != nil {
+		fields = append(fields, devicehistory.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, devicehistory.FieldAfter)
+	}
+	if m.actor != nil {
+		fields = append(fields, devicehistory.FieldActor)
+	}
+	if m.reason != nil {
+		fields = append(fields, devicehistory.FieldReason)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case devicehistory.FieldCreateTime:
+		return m.CreateTime()
+	case devicehistory.FieldDeviceID:
+		return m.DeviceID()
+	case devicehistory.FieldDeviceCode:
+		return m.DeviceCode()
+	case devicehistory.FieldOp:
+		return m.GetOp()
+	case devicehistory.FieldFields:
+		return m.GetFields()
+	case devicehistory.FieldBefore:
+		return m.Before()
+	case devicehistory.FieldAfter:
+		return m.After()
+	case devicehistory.FieldActor:
+		return m.Actor()
+	case devicehistory.FieldReason:
+		return m.Reason()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case devicehistory.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case devicehistory.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case devicehistory.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case devicehistory.FieldOp:
+		return m.OldOp(ctx)
+	case devicehistory.FieldFields:
+		return m.OldFields(ctx)
+	case devicehistory.FieldBefore:
+		return m.OldBefore(ctx)
+	case devicehistory.FieldAfter:
+		return m.OldAfter(ctx)
+	case devicehistory.FieldActor:
+		return m.OldActor(ctx)
+	case devicehistory.FieldReason:
+		return m.OldReason(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case devicehistory.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case devicehistory.FieldDeviceID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case devicehistory.FieldDeviceCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceCode(v)
+		return nil
+	case devicehistory.FieldOp:
+		v, ok := value.(devicehistory.Op)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpField(v)
+		return nil
+	case devicehistory.FieldFields:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFields(v)
+		return nil
+	case devicehistory.FieldBefore:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBefore(v)
+		return nil
+	case devicehistory.FieldAfter:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case devicehistory.FieldActor:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActor(v)
+		return nil
+	case devicehistory.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceHistoryMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DeviceHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(devicehistory.FieldFields) {
+		fields = append(fields, devicehistory.FieldFields)
+	}
+	if m.FieldCleared(devicehistory.FieldBefore) {
+		fields = append(fields, devicehistory.FieldBefore)
+	}
+	if m.FieldCleared(devicehistory.FieldAfter) {
+		fields = append(fields, devicehistory.FieldAfter)
+	}
+	if m.FieldCleared(devicehistory.FieldActor) {
+		fields = append(fields, devicehistory.FieldActor)
+	}
+	if m.FieldCleared(devicehistory.FieldReason) {
+		fields = append(fields, devicehistory.FieldReason)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceHistoryMutation) ClearField(name string) error {
+	switch name {
+	case devicehistory.FieldFields:
+		m.ClearFields()
+		return nil
+	case devicehistory.FieldBefore:
+		m.ClearBefore()
+		return nil
+	case devicehistory.FieldAfter:
+		m.ClearAfter()
+		return nil
+	case devicehistory.FieldActor:
+		m.ClearActor()
+		return nil
+	case devicehistory.FieldReason:
+		m.ClearReason()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceHistoryMutation) ResetField(name string) error {
+	switch name {
+	case devicehistory.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case devicehistory.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case devicehistory.FieldDeviceCode:
+		m.ResetDeviceCode()
+		return nil
+	case devicehistory.FieldOp:
+		m.ResetOp()
+		return nil
+	case devicehistory.FieldFields:
+		m.ResetFields()
+		return nil
+	case devicehistory.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case devicehistory.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case devicehistory.FieldActor:
+		m.ResetActor()
+		return nil
+	case devicehistory.FieldReason:
+		m.ResetReason()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceHistory edge %s", name)
+}
+
+// DriverModelMutation represents an operation that mutates the DriverModel nodes in the graph.
+type DriverModelMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_time   *time.Time
+	update_time   *time.Time
+	name          *string
+	plugin        *string
+	model_id      *string
+	description   *string
+	attributes    *map[string]interface{}
+	points        *[]map[string]interface{}
+	appendpoints  []map[string]interface{}
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DriverModel, error)
+	predicates    []predicate.DriverModel
+}
+
+var _ ent.Mutation = (*DriverModelMutation)(nil)
+
+// drivermodelOption allows management of the mutation configuration using functional options.
+type drivermodelOption func(*DriverModelMutation)
+
+// newDriverModelMutation creates new mutation for the DriverModel entity.
+func newDriverModelMutation(c config, op Op, opts ...drivermodelOption) *DriverModelMutation {
+	m := &DriverModelMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDriverModel,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDriverModelID sets the ID field of the mutation.
+func withDriverModelID(id string) drivermodelOption {
+	return func(m *DriverModelMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DriverModel
+		)
+		m.oldValue = func(ctx context.Context) (*DriverModel, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DriverModel.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDriverModel sets the old DriverModel of the mutation.
+func withDriverModel(node *DriverModel) drivermodelOption {
+	return func(m *DriverModelMutation) {
+		m.oldValue = func(context.Context) (*DriverModel, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DriverModelMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DriverModelMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of DriverModel entities.
+func (m *DriverModelMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DriverModelMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DriverModelMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DriverModel.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *DriverModelMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *DriverModelMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *DriverModelMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *DriverModelMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *DriverModelMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *DriverModelMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *DriverModelMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DriverModelMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DriverModelMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPlugin sets the "plugin" field.
+func (m *DriverModelMutation) SetPlugin(s string) {
+	m.plugin = &s
+}
+
+// Plugin returns the value of the "plugin" field in the mutation.
+func (m *DriverModelMutation) Plugin() (r string, exists bool) {
+	v := m.plugin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlugin returns the old "plugin" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldPlugin(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlugin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlugin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlugin: %w", err)
+	}
+	return oldValue.Plugin, nil
+}
+
+// ResetPlugin resets all changes to the "plugin" field.
+func (m *DriverModelMutation) ResetPlugin() {
+	m.plugin = nil
+}
+
+// SetModelID sets the "model_id" field.
+func (m *DriverModelMutation) SetModelID(s string) {
+	m.model_id = &s
+}
+
+// ModelID returns the value of the "model_id" field in the mutation.
+func (m *DriverModelMutation) ModelID() (r string, exists bool) {
+	v := m.model_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModelID returns the old "model_id" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldModelID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModelID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModelID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModelID: %w", err)
+	}
+	return oldValue.ModelID, nil
+}
+
+// ClearModelID clears the value of the "model_id" field.
+func (m *DriverModelMutation) ClearModelID() {
+	m.model_id = nil
+	m.clearedFields[drivermodel.FieldModelID] = struct{}{}
+}
+
+// ModelIDCleared returns if the "model_id" field was cleared in this mutation.
+func (m *DriverModelMutation) ModelIDCleared() bool {
+	_, ok := m.clearedFields[drivermodel.FieldModelID]
+	return ok
+}
+
+// ResetModelID resets all changes to the "model_id" field.
+func (m *DriverModelMutation) ResetModelID() {
+	m.model_id = nil
+	delete(m.clearedFields, drivermodel.FieldModelID)
+}
+
+// SetDescription sets the "description" field.
+func (m *DriverModelMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *DriverModelMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *DriverModelMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[drivermodel.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *DriverModelMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[drivermodel.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *DriverModelMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, drivermodel.FieldDescription)
+}
+
+// SetAttributes sets the "attributes" field.
+func (m *DriverModelMutation) SetAttributes(value map[string]interface{}) {
+	m.attributes = &value
+}
+
+// Attributes returns the value of the "attributes" field in the mutation.
+func (m *DriverModelMutation) Attributes() (r map[string]interface{}, exists bool) {
+	v := m.attributes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttributes returns the old "attributes" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldAttributes(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttributes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttributes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttributes: %w", err)
+	}
+	return oldValue.Attributes, nil
+}
+
+// ClearAttributes clears the value of the "attributes" field.
+func (m *DriverModelMutation) ClearAttributes() {
+	m.attributes = nil
+	m.clearedFields[drivermodel.FieldAttributes] = struct{}{}
+}
+
+// AttributesCleared returns if the "attributes" field was cleared in this mutation.
+func (m *DriverModelMutation) AttributesCleared() bool {
+	_, ok := m.clearedFields[drivermodel.FieldAttributes]
+	return ok
+}
+
+// ResetAttributes resets all changes to the "attributes" field.
+func (m *DriverModelMutation) ResetAttributes() {
+	m.attributes = nil
+	delete(m.clearedFields, drivermodel.FieldAttributes)
+}
+
+// SetPoints sets the "points" field.
+func (m *DriverModelMutation) SetPoints(value []map[string]interface{}) {
+	m.points = &value
+	m.appendpoints = nil
+}
+
+// Points returns the value of the "points" field in the mutation.
+func (m *DriverModelMutation) Points() (r []map[string]interface{}, exists bool) {
+	v := m.points
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPoints returns the old "points" field's value of the DriverModel entity.
+// If the DriverModel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DriverModelMutation) OldPoints(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPoints is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPoints requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPoints: %w", err)
+	}
+	return oldValue.Points, nil
+}
+
+// AppendPoints adds value to the "points" field.
+func (m *DriverModelMutation) AppendPoints(value []map[string]interface{}) {
+	m.appendpoints = append(m.appendpoints, value...)
+}
+
+// AppendedPoints returns the list of values that were appended to the "points" field in this mutation.
+func (m *DriverModelMutation) AppendedPoints() ([]map[string]interface{}, bool) {
+	if len(m.appendpoints) == 0 {
+		return nil, false
+	}
+	return m.appendpoints, true
+}
+
+// ResetPoints resets all changes to the "points" field.
+func (m *DriverModelMutation) ResetPoints() {
+	m.points = nil
+	m.appendpoints = nil
+}
+
+// Where appends a list predicates to the DriverModelMutation builder.
+func (m *DriverModelMutation) Where(ps ...predicate.DriverModel) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DriverModelMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DriverModelMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DriverModel, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DriverModelMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DriverModelMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DriverModel).
+func (m *DriverModelMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DriverModelMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, drivermodel.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, drivermodel.FieldUpdateTime)
+	}
+	if m.name != nil {
+		fields = append(fields, drivermodel.FieldName)
+	}
+	if m.plugin != nil {
+		fields = append(fields, drivermodel.FieldPlugin)
+	}
+	if m.model_id != nil {
+		fields = append(fields, drivermodel.FieldModelID)
+	}
+	if m.description != nil {
+		fields = append(fields, drivermodel.FieldDescription)
+	}
+	if m.attributes != nil {
+		fields = append(fields, drivermodel.FieldAttributes)
+	}
+	if m.points != nil {
+		fields = append(fields, drivermodel.FieldPoints)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DriverModelMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case drivermodel.FieldCreateTime:
+		return m.CreateTime()
+	case drivermodel.FieldUpdateTime:
+		return m.UpdateTime()
+	case drivermodel.FieldName:
+		return m.Name()
+	case drivermodel.FieldPlugin:
+		return m.Plugin()
+	case drivermodel.FieldModelID:
+		return m.ModelID()
+	case drivermodel.FieldDescription:
+		return m.Description()
+	case drivermodel.FieldAttributes:
+		return m.Attributes()
+	case drivermodel.FieldPoints:
+		return m.Points()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DriverModelMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case drivermodel.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case drivermodel.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case drivermodel.FieldName:
+		return m.OldName(ctx)
+	case drivermodel.FieldPlugin:
+		return m.OldPlugin(ctx)
+	case drivermodel.FieldModelID:
+		return m.OldModelID(ctx)
+	case drivermodel.FieldDescription:
+		return m.OldDescription(ctx)
+	case drivermodel.FieldAttributes:
+		return m.OldAttributes(ctx)
+	case drivermodel.FieldPoints:
+		return m.OldPoints(ctx)
+	}
+	return nil, fmt.Errorf("unknown DriverModel field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverModelMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case drivermodel.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case drivermodel.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case drivermodel.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case drivermodel.FieldPlugin:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlugin(v)
+		return nil
+	case drivermodel.FieldModelID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModelID(v)
+		return nil
+	case drivermodel.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case drivermodel.FieldAttributes:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttributes(v)
+		return nil
+	case drivermodel.FieldPoints:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPoints(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DriverModel field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DriverModelMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DriverModelMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DriverModelMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DriverModel numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DriverModelMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(drivermodel.FieldModelID) {
+		fields = append(fields, drivermodel.FieldModelID)
+	}
+	if m.FieldCleared(drivermodel.FieldDescription) {
+		fields = append(fields, drivermodel.FieldDescription)
+	}
+	if m.FieldCleared(drivermodel.FieldAttributes) {
+		fields = append(fields, drivermodel.FieldAttributes)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DriverModelMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DriverModelMutation) ClearField(name string) error {
+	switch name {
+	case drivermodel.FieldModelID:
+		m.ClearModelID()
+		return nil
+	case drivermodel.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case drivermodel.FieldAttributes:
+		m.ClearAttributes()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverModel nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DriverModelMutation) ResetField(name string) error {
+	switch name {
+	case drivermodel.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case drivermodel.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case drivermodel.FieldName:
+		m.ResetName()
+		return nil
+	case drivermodel.FieldPlugin:
+		m.ResetPlugin()
+		return nil
+	case drivermodel.FieldModelID:
+		m.ResetModelID()
+		return nil
+	case drivermodel.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case drivermodel.FieldAttributes:
+		m.ResetAttributes()
+		return nil
+	case drivermodel.FieldPoints:
+		m.ResetPoints()
+		return nil
+	}
+	return fmt.Errorf("unknown DriverModel field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DriverModelMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DriverModelMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DriverModelMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DriverModelMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DriverModelMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DriverModelMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DriverModelMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DriverModel unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DriverModelMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DriverModel edge %s", name)
+}
+
+// EdgeBoxMutation represents an operation that mutates the EdgeBox nodes in the graph.
+type EdgeBoxMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *string
+	create_time    *time.Time
+	update_time    *time.Time
+	code           *string
+	name           *string
+	project        *string
+	url            *string
+	token          *string
+	connections    *map[string]provision.Connection
+	push_time      *time.Time
+	push_version   *string
+	push_error     *string
+	memo           *string
+	clearedFields  map[string]struct{}
+	devices        map[string]struct{}
+	removeddevices map[string]struct{}
+	cleareddevices bool
+	done           bool
+	oldValue       func(context.Context) (*EdgeBox, error)
+	predicates     []predicate.EdgeBox
+}
+
+var _ ent.Mutation = (*EdgeBoxMutation)(nil)
+
+// edgeboxOption allows management of the mutation configuration using functional options.
+type edgeboxOption func(*EdgeBoxMutation)
+
+// newEdgeBoxMutation creates new mutation for the EdgeBox entity.
+func newEdgeBoxMutation(c config, op Op, opts ...edgeboxOption) *EdgeBoxMutation {
+	m := &EdgeBoxMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEdgeBox,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEdgeBoxID sets the ID field of the mutation.
+func withEdgeBoxID(id string) edgeboxOption {
+	return func(m *EdgeBoxMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EdgeBox
+		)
+		m.oldValue = func(ctx context.Context) (*EdgeBox, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EdgeBox.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEdgeBox sets the old EdgeBox of the mutation.
+func withEdgeBox(node *EdgeBox) edgeboxOption {
+	return func(m *EdgeBoxMutation) {
+		m.oldValue = func(context.Context) (*EdgeBox, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EdgeBoxMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EdgeBoxMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EdgeBox entities.
+func (m *EdgeBoxMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EdgeBoxMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EdgeBoxMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EdgeBox.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *EdgeBoxMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *EdgeBoxMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *EdgeBoxMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *EdgeBoxMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *EdgeBoxMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *EdgeBoxMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetCode sets the "code" field.
+func (m *EdgeBoxMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *EdgeBoxMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *EdgeBoxMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *EdgeBoxMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EdgeBoxMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *EdgeBoxMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[edgebox.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *EdgeBoxMutation) NameCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EdgeBoxMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, edgebox.FieldName)
+}
+
+// SetProject sets the "project" field.
+func (m *EdgeBoxMutation) SetProject(s string) {
+	m.project = &s
+}
+
+// Project returns the value of the "project" field in the mutation.
+func (m *EdgeBoxMutation) Project() (r string, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProject returns the old "project" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldProject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+	}
+	return oldValue.Project, nil
+}
+
+// ResetProject resets all changes to the "project" field.
+func (m *EdgeBoxMutation) ResetProject() {
+	m.project = nil
+}
+
+// SetURL sets the "url" field.
+func (m *EdgeBoxMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *EdgeBoxMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *EdgeBoxMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetToken sets the "token" field.
+func (m *EdgeBoxMutation) SetToken(s string) {
+	m.token = &s
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *EdgeBoxMutation) Token() (r string, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ClearToken clears the value of the "token" field.
+func (m *EdgeBoxMutation) ClearToken() {
+	m.token = nil
+	m.clearedFields[edgebox.FieldToken] = struct{}{}
+}
+
+// TokenCleared returns if the "token" field was cleared in this mutation.
+func (m *EdgeBoxMutation) TokenCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldToken]
+	return ok
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *EdgeBoxMutation) ResetToken() {
+	m.token = nil
+	delete(m.clearedFields, edgebox.FieldToken)
+}
+
+// SetConnections sets the "connections" field.
+func (m *EdgeBoxMutation) SetConnections(value map[string]provision.Connection) {
+	m.connections = &value
+}
+
+// Connections returns the value of the "connections" field in the mutation.
+func (m *EdgeBoxMutation) Connections() (r map[string]provision.Connection, exists bool) {
+	v := m.connections
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldConnections returns the old "connections" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldConnections(ctx context.Context) (v map[string]provision.Connection, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldConnections is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldConnections requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldConnections: %w", err)
+	}
+	return oldValue.Connections, nil
+}
+
+// ClearConnections clears the value of the "connections" field.
+func (m *EdgeBoxMutation) ClearConnections() {
+	m.connections = nil
+	m.clearedFields[edgebox.FieldConnections] = struct{}{}
+}
+
+// ConnectionsCleared returns if the "connections" field was cleared in this mutation.
+func (m *EdgeBoxMutation) ConnectionsCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldConnections]
+	return ok
+}
+
+// ResetConnections resets all changes to the "connections" field.
+func (m *EdgeBoxMutation) ResetConnections() {
+	m.connections = nil
+	delete(m.clearedFields, edgebox.FieldConnections)
+}
+
+// SetPushTime sets the "push_time" field.
+func (m *EdgeBoxMutation) SetPushTime(t time.Time) {
+	m.push_time = &t
+}
+
+// PushTime returns the value of the "push_time" field in the mutation.
+func (m *EdgeBoxMutation) PushTime() (r time.Time, exists bool) {
+	v := m.push_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushTime returns the old "push_time" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldPushTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushTime: %w", err)
+	}
+	return oldValue.PushTime, nil
+}
+
+// ClearPushTime clears the value of the "push_time" field.
+func (m *EdgeBoxMutation) ClearPushTime() {
+	m.push_time = nil
+	m.clearedFields[edgebox.FieldPushTime] = struct{}{}
+}
+
+// PushTimeCleared returns if the "push_time" field was cleared in this mutation.
+func (m *EdgeBoxMutation) PushTimeCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldPushTime]
+	return ok
+}
+
+// ResetPushTime resets all changes to the "push_time" field.
+func (m *EdgeBoxMutation) ResetPushTime() {
+	m.push_time = nil
+	delete(m.clearedFields, edgebox.FieldPushTime)
+}
+
+// SetPushVersion sets the "push_version" field.
+func (m *EdgeBoxMutation) SetPushVersion(s string) {
+	m.push_version = &s
+}
+
+// PushVersion returns the value of the "push_version" field in the mutation.
+func (m *EdgeBoxMutation) PushVersion() (r string, exists bool) {
+	v := m.push_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushVersion returns the old "push_version" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldPushVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushVersion: %w", err)
+	}
+	return oldValue.PushVersion, nil
+}
+
+// ClearPushVersion clears the value of the "push_version" field.
+func (m *EdgeBoxMutation) ClearPushVersion() {
+	m.push_version = nil
+	m.clearedFields[edgebox.FieldPushVersion] = struct{}{}
+}
+
+// PushVersionCleared returns if the "push_version" field was cleared in this mutation.
+func (m *EdgeBoxMutation) PushVersionCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldPushVersion]
+	return ok
+}
+
+// ResetPushVersion resets all changes to the "push_version" field.
+func (m *EdgeBoxMutation) ResetPushVersion() {
+	m.push_version = nil
+	delete(m.clearedFields, edgebox.FieldPushVersion)
+}
+
+// SetPushError sets the "push_error" field.
+func (m *EdgeBoxMutation) SetPushError(s string) {
+	m.push_error = &s
+}
+
+// PushError returns the value of the "push_error" field in the mutation.
+func (m *EdgeBoxMutation) PushError() (r string, exists bool) {
+	v := m.push_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPushError returns the old "push_error" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldPushError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPushError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPushError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPushError: %w", err)
+	}
+	return oldValue.PushError, nil
+}
+
+// ClearPushError clears the value of the "push_error" field.
+func (m *EdgeBoxMutation) ClearPushError() {
+	m.push_error = nil
+	m.clearedFields[edgebox.FieldPushError] = struct{}{}
+}
+
+// PushErrorCleared returns if the "push_error" field was cleared in this mutation.
+func (m *EdgeBoxMutation) PushErrorCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldPushError]
+	return ok
+}
+
+// ResetPushError resets all changes to the "push_error" field.
+func (m *EdgeBoxMutation) ResetPushError() {
+	m.push_error = nil
+	delete(m.clearedFields, edgebox.FieldPushError)
+}
+
+// SetMemo sets the "memo" field.
+func (m *EdgeBoxMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *EdgeBoxMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the EdgeBox entity.
+// If the EdgeBox object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EdgeBoxMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *EdgeBoxMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[edgebox.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *EdgeBoxMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[edgebox.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *EdgeBoxMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, edgebox.FieldMemo)
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by ids.
+func (m *EdgeBoxMutation) AddDeviceIDs(ids ...string) {
+	if m.devices == nil {
+		m.devices = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.devices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDevices clears the "devices" edge to the Device entity.
+func (m *EdgeBoxMutation) ClearDevices() {
+	m.cleareddevices = true
+}
+
+// DevicesCleared reports if the "devices" edge to the Device entity was cleared.
+func (m *EdgeBoxMutation) DevicesCleared() bool {
+	return m.cleareddevices
+}
+
+// RemoveDeviceIDs removes the "devices" edge to the Device entity by IDs.
+func (m *EdgeBoxMutation) RemoveDeviceIDs(ids ...string) {
+	if m.removeddevices == nil {
+		m.removeddevices = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.devices, ids[i])
+		m.removeddevices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDevices returns the removed IDs of the "devices" edge to the Device entity.
+func (m *EdgeBoxMutation) RemovedDevicesIDs() (ids []string) {
+	for id := range m.removeddevices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DevicesIDs returns the "devices" edge IDs in the mutation.
+func (m *EdgeBoxMutation) DevicesIDs() (ids []string) {
+	for id := range m.devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDevices resets all changes to the "devices" edge.
+func (m *EdgeBoxMutation) ResetDevices() {
+	m.devices = nil
+	m.cleareddevices = false
+	m.removeddevices = nil
+}
+
+// Where appends a list predicates to the EdgeBoxMutation builder.
+func (m *EdgeBoxMutation) Where(ps ...predicate.EdgeBox) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EdgeBoxMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EdgeBoxMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EdgeBox, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EdgeBoxMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EdgeBoxMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EdgeBox).
+func (m *EdgeBoxMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EdgeBoxMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.create_time != nil {
+		fields = append(fields, edgebox.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, edgebox.FieldUpdateTime)
+	}
+	if m.code != nil {
+		fields = append(fields, edgebox.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, edgebox.FieldName)
+	}
+	if m.project != nil {
+		fields = append(fields, edgebox.FieldProject)
+	}
+	if m.url != nil {
+		fields = append(fields, edgebox.FieldURL)
+	}
+	if m.token != nil {
+		fields = append(fields, edgebox.FieldToken)
+	}
+	if m.connections != nil {
+		fields = append(fields, edgebox.FieldConnections)
+	}
+	if m.push_time != nil {
+		fields = append(fields, edgebox.FieldPushTime)
+	}
+	if m.push_version != nil {
+		fields = append(fields, edgebox.FieldPushVersion)
+	}
+	if m.push_error != nil {
+		fields = append(fields, edgebox.FieldPushError)
+	}
+	if m.memo != nil {
+		fields = append(fields, edgebox.FieldMemo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EdgeBoxMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case edgebox.FieldCreateTime:
+		return m.CreateTime()
+	case edgebox.FieldUpdateTime:
+		return m.UpdateTime()
+	case edgebox.FieldCode:
+		return m.Code()
+	case edgebox.FieldName:
+		return m.Name()
+	case edgebox.FieldProject:
+		return m.Project()
+	case edgebox.FieldURL:
+		return m.URL()
+	case edgebox.FieldToken:
+		return m.Token()
+	case edgebox.FieldConnections:
+		return m.Connections()
+	case edgebox.FieldPushTime:
+		return m.PushTime()
+	case edgebox.FieldPushVersion:
+		return m.PushVersion()
+	case edgebox.FieldPushError:
+		return m.PushError()
+	case edgebox.FieldMemo:
+		return m.Memo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EdgeBoxMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case edgebox.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case edgebox.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case edgebox.FieldCode:
+		return m.OldCode(ctx)
+	case edgebox.FieldName:
+		return m.OldName(ctx)
+	case edgebox.FieldProject:
+		return m.OldProject(ctx)
+	case edgebox.FieldURL:
+		return m.OldURL(ctx)
+	case edgebox.FieldToken:
+		return m.OldToken(ctx)
+	case edgebox.FieldConnections:
+		return m.OldConnections(ctx)
+	case edgebox.FieldPushTime:
+		return m.OldPushTime(ctx)
+	case edgebox.FieldPushVersion:
+		return m.OldPushVersion(ctx)
+	case edgebox.FieldPushError:
+		return m.OldPushError(ctx)
+	case edgebox.FieldMemo:
+		return m.OldMemo(ctx)
+	}
+	return nil, fmt.Errorf("unknown EdgeBox field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EdgeBoxMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case edgebox.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case edgebox.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case edgebox.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case edgebox.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case edgebox.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case edgebox.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case edgebox.FieldToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case edgebox.FieldConnections:
+		v, ok := value.(map[string]provision.Connection)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConnections(v)
+		return nil
+	case edgebox.FieldPushTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushTime(v)
+		return nil
+	case edgebox.FieldPushVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushVersion(v)
+		return nil
+	case edgebox.FieldPushError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPushError(v)
+		return nil
+	case edgebox.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EdgeBox field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EdgeBoxMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EdgeBoxMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EdgeBoxMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EdgeBox numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EdgeBoxMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(edgebox.FieldName) {
+		fields = append(fields, edgebox.FieldName)
+	}
+	if m.FieldCleared(edgebox.FieldToken) {
+		fields = append(fields, edgebox.FieldToken)
+	}
+	if m.FieldCleared(edgebox.FieldConnections) {
+		fields = append(fields, edgebox.FieldConnections)
+	}
+	if m.FieldCleared(edgebox.FieldPushTime) {
+		fields = append(fields, edgebox.FieldPushTime)
+	}
+	if m.FieldCleared(edgebox.FieldPushVersion) {
+		fields = append(fields, edgebox.FieldPushVersion)
+	}
+	if m.FieldCleared(edgebox.FieldPushError) {
+		fields = append(fields, edgebox.FieldPushError)
+	}
+	if m.FieldCleared(edgebox.FieldMemo) {
+		fields = append(fields, edgebox.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EdgeBoxMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EdgeBoxMutation) ClearField(name string) error {
+	switch name {
+	case edgebox.FieldName:
+		m.ClearName()
+		return nil
+	case edgebox.FieldToken:
+		m.ClearToken()
+		return nil
+	case edgebox.FieldConnections:
+		m.ClearConnections()
+		return nil
+	case edgebox.FieldPushTime:
+		m.ClearPushTime()
+		return nil
+	case edgebox.FieldPushVersion:
+		m.ClearPushVersion()
+		return nil
+	case edgebox.FieldPushError:
+		m.ClearPushError()
+		return nil
+	case edgebox.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown EdgeBox nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EdgeBoxMutation) ResetField(name string) error {
+	switch name {
+	case edgebox.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case edgebox.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case edgebox.FieldCode:
+		m.ResetCode()
+		return nil
+	case edgebox.FieldName:
+		m.ResetName()
+		return nil
+	case edgebox.FieldProject:
+		m.ResetProject()
+		return nil
+	case edgebox.FieldURL:
+		m.ResetURL()
+		return nil
+	case edgebox.FieldToken:
+		m.ResetToken()
+		return nil
+	case edgebox.FieldConnections:
+		m.ResetConnections()
+		return nil
+	case edgebox.FieldPushTime:
+		m.ResetPushTime()
+		return nil
+	case edgebox.FieldPushVersion:
+		m.ResetPushVersion()
+		return nil
+	case edgebox.FieldPushError:
+		m.ResetPushError()
+		return nil
+	case edgebox.FieldMemo:
+		m.ResetMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown EdgeBox field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EdgeBoxMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.devices != nil {
+		edges = append(edges, edgebox.EdgeDevices)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EdgeBoxMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case edgebox.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.devices))
+		for id := range m.devices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EdgeBoxMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removeddevices != nil {
+		edges = append(edges, edgebox.EdgeDevices)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EdgeBoxMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case edgebox.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.removeddevices))
+		for id := range m.removeddevices {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EdgeBoxMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareddevices {
+		edges = append(edges, edgebox.EdgeDevices)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EdgeBoxMutation) EdgeCleared(name string) bool {
+	switch name {
+	case edgebox.EdgeDevices:
+		return m.cleareddevices
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EdgeBoxMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EdgeBox unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EdgeBoxMutation) ResetEdge(name string) error {
+	switch name {
+	case edgebox.EdgeDevices:
+		m.ResetDevices()
+		return nil
+	}
+	return fmt.Errorf("unknown EdgeBox edge %s", name)
+}
+
+// LocationMutation represents an operation that mutates the Location nodes in the graph.
+type LocationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	create_time        *time.Time
+	update_time        *time.Time
+	kind               *location.Kind
+	code               *string
+	name               *string
+	project            *string
+	memo               *string
+	clearedFields      map[string]struct{}
+	parent             *string
+	clearedparent      bool
+	children           map[string]struct{}
+	removedchildren    map[string]struct{}
+	clearedchildren    bool
+	devices            map[string]struct{}
+	removeddevices     map[string]struct{}
+	cleareddevices     bool
+	occupancies        map[string]struct{}
+	removedoccupancies map[string]struct{}
+	clearedoccupancies bool
+	done               bool
+	oldValue           func(context.Context) (*Location, error)
+	predicates         []predicate.Location
+}
+
+var _ ent.Mutation = (*LocationMutation)(nil)
+
+// locationOption allows management of the mutation configuration using functional options.
+type locationOption func(*LocationMutation)
+
+// newLocationMutation creates new mutation for the Location entity.
+func newLocationMutation(c config, op Op, opts ...locationOption) *LocationMutation {
+	m := &LocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLocationID sets the ID field of the mutation.
+func withLocationID(id string) locationOption {
+	return func(m *LocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Location
+		)
+		m.oldValue = func(ctx context.Context) (*Location, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Location.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLocation sets the old Location of the mutation.
+func withLocation(node *Location) locationOption {
+	return func(m *LocationMutation) {
+		m.oldValue = func(context.Context) (*Location, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Location entities.
+func (m *LocationMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LocationMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LocationMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Location.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *LocationMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *LocationMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *LocationMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *LocationMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *LocationMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *LocationMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetKind sets the "kind" field.
+func (m *LocationMutation) SetKind(l location.Kind) {
+	m.kind = &l
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *LocationMutation) Kind() (r location.Kind, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldKind(ctx context.Context) (v location.Kind, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *LocationMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetCode sets the "code" field.
+func (m *LocationMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *LocationMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *LocationMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *LocationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *LocationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *LocationMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[location.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *LocationMutation) NameCleared() bool {
+	_, ok := m.clearedFields[location.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *LocationMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, location.FieldName)
+}
+
+// SetParentID sets the "parent_id" field.
+func (m *LocationMutation) SetParentID(s string) {
+	m.parent = &s
+}
+
+// ParentID returns the value of the "parent_id" field in the mutation.
+func (m *LocationMutation) ParentID() (r string, exists bool) {
+	v := m.parent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentID returns the old "parent_id" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldParentID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentID: %w", err)
+	}
+	return oldValue.ParentID, nil
+}
+
+// ClearParentID clears the value of the "parent_id" field.
+func (m *LocationMutation) ClearParentID() {
+	m.parent = nil
+	m.clearedFields[location.FieldParentID] = struct{}{}
+}
+
+// ParentIDCleared returns if the "parent_id" field was cleared in this mutation.
+func (m *LocationMutation) ParentIDCleared() bool {
+	_, ok := m.clearedFields[location.FieldParentID]
+	return ok
+}
+
+// ResetParentID resets all changes to the "parent_id" field.
+func (m *LocationMutation) ResetParentID() {
+	m.parent = nil
+	delete(m.clearedFields, location.FieldParentID)
+}
+
+// SetProject sets the "project" field.
+func (m *LocationMutation) SetProject(s string) {
+	m.project = &s
+}
+
+// Project returns the value of the "project" field in the mutation.
+func (m *LocationMutation) Project() (r string, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProject returns the old "project" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldProject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+	}
+	return oldValue.Project, nil
+}
+
+// ResetProject resets all changes to the "project" field.
+func (m *LocationMutation) ResetProject() {
+	m.project = nil
+}
+
+// SetMemo sets the "memo" field.
+func (m *LocationMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *LocationMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the Location entity.
+// If the Location object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LocationMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *LocationMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[location.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *LocationMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[location.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *LocationMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, location.FieldMemo)
+}
+
+// ClearParent clears the "parent" edge to the Location entity.
+func (m *LocationMutation) ClearParent() {
+	m.clearedparent = true
+	m.clearedFields[location.FieldParentID] = struct{}{}
+}
+
+// ParentCleared reports if the "parent" edge to the Location entity was cleared.
+func (m *LocationMutation) ParentCleared() bool {
+	return m.ParentIDCleared() || m.clearedparent
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *LocationMutation) ParentIDs() (ids []string) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *LocationMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddChildIDs adds the "children" edge to the Location entity by ids.
+func (m *LocationMutation) AddChildIDs(ids ...string) {
+	if m.children == nil {
+		m.children = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.children[ids[i]] = struct{}{}
+	}
+}
+
+// ClearChildren clears the "children" edge to the Location entity.
+func (m *LocationMutation) ClearChildren() {
+	m.clearedchildren = true
+}
+
+// ChildrenCleared reports if the "children" edge to the Location entity was cleared.
+func (m *LocationMutation) ChildrenCleared() bool {
+	return m.clearedchildren
+}
+
+// RemoveChildIDs removes the "children" edge to the Location entity by IDs.
+func (m *LocationMutation) RemoveChildIDs(ids ...string) {
+	if m.removedchildren == nil {
+		m.removedchildren = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.children, ids[i])
+		m.removedchildren[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedChildren returns the removed IDs of the "children" edge to the Location entity.
+func (m *LocationMutation) RemovedChildrenIDs() (ids []string) {
+	for id := range m.removedchildren {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ChildrenIDs returns the "children" edge IDs in the mutation.
+func (m *LocationMutation) ChildrenIDs() (ids []string) {
+	for id := range m.children {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetChildren resets all changes to the "children" edge.
+func (m *LocationMutation) ResetChildren() {
+	m.children = nil
+	m.clearedchildren = false
+	m.removedchildren = nil
+}
+
+// AddDeviceIDs adds the "devices" edge to the Device entity by ids.
+func (m *LocationMutation) AddDeviceIDs(ids ...string) {
+	if m.devices == nil {
+		m.devices = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.devices[ids[i]] = struct{}{}
+	}
+}
+
+// ClearDevices clears the "devices" edge to the Device entity.
+func (m *LocationMutation) ClearDevices() {
+	m.cleareddevices = true
+}
+
+// DevicesCleared reports if the "devices" edge to the Device entity was cleared.
+func (m *LocationMutation) DevicesCleared() bool {
+	return m.cleareddevices
+}
+
+// RemoveDeviceIDs removes the "devices" edge to the Device entity by IDs.
+func (m *LocationMutation) RemoveDeviceIDs(ids ...string) {
+	if m.removeddevices == nil {
+		m.removeddevices = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.devices, ids[i])
+		m.removeddevices[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedDevices returns the removed IDs of the "devices" edge to the Device entity.
+func (m *LocationMutation) RemovedDevicesIDs() (ids []string) {
+	for id := range m.removeddevices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// DevicesIDs returns the "devices" edge IDs in the mutation.
+func (m *LocationMutation) DevicesIDs() (ids []string) {
+	for id := range m.devices {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetDevices resets all changes to the "devices" edge.
+func (m *LocationMutation) ResetDevices() {
+	m.devices = nil
+	m.cleareddevices = false
+	m.removeddevices = nil
+}
+
+// AddOccupancyIDs adds the "occupancies" edge to the Occupancy entity by ids.
+func (m *LocationMutation) AddOccupancyIDs(ids ...string) {
+	if m.occupancies == nil {
+		m.occupancies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.occupancies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOccupancies clears the "occupancies" edge to the Occupancy entity.
+func (m *LocationMutation) ClearOccupancies() {
+	m.clearedoccupancies = true
+}
+
+// OccupanciesCleared reports if the "occupancies" edge to the Occupancy entity was cleared.
+func (m *LocationMutation) OccupanciesCleared() bool {
+	return m.clearedoccupancies
+}
+
+// RemoveOccupancyIDs removes the "occupancies" edge to the Occupancy entity by IDs.
+func (m *LocationMutation) RemoveOccupancyIDs(ids ...string) {
+	if m.removedoccupancies == nil {
+		m.removedoccupancies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.occupancies, ids[i])
+		m.removedoccupancies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOccupancies returns the removed IDs of the "occupancies" edge to the Occupancy entity.
+func (m *LocationMutation) RemovedOccupanciesIDs() (ids []string) {
+	for id := range m.removedoccupancies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OccupanciesIDs returns the "occupancies" edge IDs in the mutation.
+func (m *LocationMutation) OccupanciesIDs() (ids []string) {
+	for id := range m.occupancies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOccupancies resets all changes to the "occupancies" edge.
+func (m *LocationMutation) ResetOccupancies() {
+	m.occupancies = nil
+	m.clearedoccupancies = false
+	m.removedoccupancies = nil
+}
+
+// Where appends a list predicates to the LocationMutation builder.
+func (m *LocationMutation) Where(ps ...predicate.Location) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Location, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Location).
+func (m *LocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LocationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, location.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, location.FieldUpdateTime)
+	}
+	if m.kind != nil {
+		fields = append(fields, location.FieldKind)
+	}
+	if m.code != nil {
+		fields = append(fields, location.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, location.FieldName)
+	}
+	if m.parent != nil {
+		fields = append(fields, location.FieldParentID)
+	}
+	if m.project != nil {
+		fields = append(fields, location.FieldProject)
+	}
+	if m.memo != nil {
+		fields = append(fields, location.FieldMemo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case location.FieldCreateTime:
+		return m.CreateTime()
+	case location.FieldUpdateTime:
+		return m.UpdateTime()
+	case location.FieldKind:
+		return m.Kind()
+	case location.FieldCode:
+		return m.Code()
+	case location.FieldName:
+		return m.Name()
+	case location.FieldParentID:
+		return m.ParentID()
+	case location.FieldProject:
+		return m.Project()
+	case location.FieldMemo:
+		return m.Memo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case location.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case location.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case location.FieldKind:
+		return m.OldKind(ctx)
+	case location.FieldCode:
+		return m.OldCode(ctx)
+	case location.FieldName:
+		return m.OldName(ctx)
+	case location.FieldParentID:
+		return m.OldParentID(ctx)
+	case location.FieldProject:
+		return m.OldProject(ctx)
+	case location.FieldMemo:
+		return m.OldMemo(ctx)
+	}
+	return nil, fmt.Errorf("unknown Location field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case location.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case location.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case location.FieldKind:
+		v, ok := value.(location.Kind)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case location.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case location.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case location.FieldParentID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentID(v)
+		return nil
+	case location.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case location.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LocationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LocationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Location numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(location.FieldName) {
+		fields = append(fields, location.FieldName)
+	}
+	if m.FieldCleared(location.FieldParentID) {
+		fields = append(fields, location.FieldParentID)
+	}
+	if m.FieldCleared(location.FieldMemo) {
+		fields = append(fields, location.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LocationMutation) ClearField(name string) error {
+	switch name {
+	case location.FieldName:
+		m.ClearName()
+		return nil
+	case location.FieldParentID:
+		m.ClearParentID()
+		return nil
+	case location.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Location nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LocationMutation) ResetField(name string) error {
+	switch name {
+	case location.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case location.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case location.FieldKind:
+		m.ResetKind()
+		return nil
+	case location.FieldCode:
+		m.ResetCode()
+		return nil
+	case location.FieldName:
+		m.ResetName()
+		return nil
+	case location.FieldParentID:
+		m.ResetParentID()
+		return nil
+	case location.FieldProject:
+		m.ResetProject()
+		return nil
+	case location.FieldMemo:
+		m.ResetMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Location field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.parent != nil {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.children != nil {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.devices != nil {
+		edges = append(edges, location.EdgeDevices)
+	}
+	if m.occupancies != nil {
+		edges = append(edges, location.EdgeOccupancies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LocationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.children))
+		for id := range m.children {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.devices))
+		for id := range m.devices {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOccupancies:
+		ids := make([]ent.Value, 0, len(m.occupancies))
+		for id := range m.occupancies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedchildren != nil {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.removeddevices != nil {
+		edges = append(edges, location.EdgeDevices)
+	}
+	if m.removedoccupancies != nil {
+		edges = append(edges, location.EdgeOccupancies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LocationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case location.EdgeChildren:
+		ids := make([]ent.Value, 0, len(m.removedchildren))
+		for id := range m.removedchildren {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeDevices:
+		ids := make([]ent.Value, 0, len(m.removeddevices))
+		for id := range m.removeddevices {
+			ids = append(ids, id)
+		}
+		return ids
+	case location.EdgeOccupancies:
+		ids := make([]ent.Value, 0, len(m.removedoccupancies))
+		for id := range m.removedoccupancies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedparent {
+		edges = append(edges, location.EdgeParent)
+	}
+	if m.clearedchildren {
+		edges = append(edges, location.EdgeChildren)
+	}
+	if m.cleareddevices {
+		edges = append(edges, location.EdgeDevices)
+	}
+	if m.clearedoccupancies {
+		edges = append(edges, location.EdgeOccupancies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LocationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case location.EdgeParent:
+		return m.clearedparent
+	case location.EdgeChildren:
+		return m.clearedchildren
+	case location.EdgeDevices:
+		return m.cleareddevices
+	case location.EdgeOccupancies:
+		return m.clearedoccupancies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LocationMutation) ClearEdge(name string) error {
+	switch name {
+	case location.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Location unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LocationMutation) ResetEdge(name string) error {
+	switch name {
+	case location.EdgeParent:
+		m.ResetParent()
+		return nil
+	case location.EdgeChildren:
+		m.ResetChildren()
+		return nil
+	case location.EdgeDevices:
+		m.ResetDevices()
+		return nil
+	case location.EdgeOccupancies:
+		m.ResetOccupancies()
+		return nil
+	}
+	return fmt.Errorf("unknown Location edge %s", name)
+}
+
+// OccupancyMutation represents an operation that mutates the Occupancy nodes in the graph.
+type OccupancyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *string
+	create_time   *time.Time
+	update_time   *time.Time
+	role          *occupancy.Role
+	start_at      *time.Time
+	end_at        *time.Time
+	memo          *string
+	clearedFields map[string]struct{}
+	tenant        *string
+	clearedtenant bool
+	unit          *string
+	clearedunit   bool
+	done          bool
+	oldValue      func(context.Context) (*Occupancy, error)
+	predicates    []predicate.Occupancy
+}
+
+var _ ent.Mutation = (*OccupancyMutation)(nil)
+
+// occupancyOption allows management of the mutation configuration using functional options.
+type occupancyOption func(*OccupancyMutation)
+
+// newOccupancyMutation creates new mutation for the Occupancy entity.
+func newOccupancyMutation(c config, op Op, opts ...occupancyOption) *OccupancyMutation {
+	m := &OccupancyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOccupancy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOccupancyID sets the ID field of the mutation.
+func withOccupancyID(id string) occupancyOption {
+	return func(m *OccupancyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Occupancy
+		)
+		m.oldValue = func(ctx context.Context) (*Occupancy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Occupancy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOccupancy sets the old Occupancy of the mutation.
+func withOccupancy(node *Occupancy) occupancyOption {
+	return func(m *OccupancyMutation) {
+		m.oldValue = func(context.Context) (*Occupancy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OccupancyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OccupancyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Occupancy entities.
+func (m *OccupancyMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OccupancyMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OccupancyMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Occupancy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *OccupancyMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *OccupancyMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *OccupancyMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *OccupancyMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *OccupancyMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *OccupancyMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (m *OccupancyMutation) SetTenantID(s string) {
+	m.tenant = &s
+}
+
+// TenantID returns the value of the "tenant_id" field in the mutation.
+func (m *OccupancyMutation) TenantID() (r string, exists bool) {
+	v := m.tenant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTenantID returns the old "tenant_id" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldTenantID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTenantID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTenantID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTenantID: %w", err)
+	}
+	return oldValue.TenantID, nil
+}
+
+// ResetTenantID resets all changes to the "tenant_id" field.
+func (m *OccupancyMutation) ResetTenantID() {
+	m.tenant = nil
+}
+
+// SetUnitID sets the "unit_id" field.
+func (m *OccupancyMutation) SetUnitID(s string) {
+	m.unit = &s
+}
+
+// UnitID returns the value of the "unit_id" field in the mutation.
+func (m *OccupancyMutation) UnitID() (r string, exists bool) {
+	v := m.unit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitID returns the old "unit_id" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldUnitID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitID: %w", err)
+	}
+	return oldValue.UnitID, nil
+}
+
+// ResetUnitID resets all changes to the "unit_id" field.
+func (m *OccupancyMutation) ResetUnitID() {
+	m.unit = nil
+}
+
+// SetRole sets the "role" field.
+func (m *OccupancyMutation) SetRole(o occupancy.Role) {
+	m.role = &o
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *OccupancyMutation) Role() (r occupancy.Role, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldRole(ctx context.Context) (v occupancy.Role, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *OccupancyMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetStartAt sets the "start_at" field.
+func (m *OccupancyMutation) SetStartAt(t time.Time) {
+	m.start_at = &t
+}
+
+// StartAt returns the value of the "start_at" field in the mutation.
+func (m *OccupancyMutation) StartAt() (r time.Time, exists bool) {
+	v := m.start_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartAt returns the old "start_at" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldStartAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartAt: %w", err)
+	}
+	return oldValue.StartAt, nil
+}
+
+// ResetStartAt resets all changes to the "start_at" field.
+func (m *OccupancyMutation) ResetStartAt() {
+	m.start_at = nil
+}
+
+// SetEndAt sets the "end_at" field.
+func (m *OccupancyMutation) SetEndAt(t time.Time) {
+	m.end_at = &t
+}
+
+// EndAt returns the value of the "end_at" field in the mutation.
+func (m *OccupancyMutation) EndAt() (r time.Time, exists bool) {
+	v := m.end_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndAt returns the old "end_at" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldEndAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndAt: %w", err)
+	}
+	return oldValue.EndAt, nil
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (m *OccupancyMutation) ClearEndAt() {
+	m.end_at = nil
+	m.clearedFields[occupancy.FieldEndAt] = struct{}{}
+}
+
+// EndAtCleared returns if the "end_at" field was cleared in this mutation.
+func (m *OccupancyMutation) EndAtCleared() bool {
+	_, ok := m.clearedFields[occupancy.FieldEndAt]
+	return ok
+}
+
+// ResetEndAt resets all changes to the "end_at" field.
+func (m *OccupancyMutation) ResetEndAt() {
+	m.end_at = nil
+	delete(m.clearedFields, occupancy.FieldEndAt)
+}
+
+// SetMemo sets the "memo" field.
+func (m *OccupancyMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *OccupancyMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the Occupancy entity.
+// If the Occupancy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OccupancyMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *OccupancyMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[occupancy.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *OccupancyMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[occupancy.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *OccupancyMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, occupancy.FieldMemo)
+}
+
+// ClearTenant clears the "tenant" edge to the Tenant entity.
+func (m *OccupancyMutation) ClearTenant() {
+	m.clearedtenant = true
+	m.clearedFields[occupancy.FieldTenantID] = struct{}{}
+}
+
+// TenantCleared reports if the "tenant" edge to the Tenant entity was cleared.
+func (m *OccupancyMutation) TenantCleared() bool {
+	return m.clearedtenant
+}
+
+// TenantIDs returns the "tenant" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TenantID instead. It exists only for internal usage by the builders.
+func (m *OccupancyMutation) TenantIDs() (ids []string) {
+	if id := m.tenant; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTenant resets all changes to the "tenant" edge.
+func (m *OccupancyMutation) ResetTenant() {
+	m.tenant = nil
+	m.clearedtenant = false
+}
+
+// ClearUnit clears the "unit" edge to the Location entity.
+func (m *OccupancyMutation) ClearUnit() {
+	m.clearedunit = true
+	m.clearedFields[occupancy.FieldUnitID] = struct{}{}
+}
+
+// UnitCleared reports if the "unit" edge to the Location entity was cleared.
+func (m *OccupancyMutation) UnitCleared() bool {
+	return m.clearedunit
+}
+
+// UnitIDs returns the "unit" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UnitID instead. It exists only for internal usage by the builders.
+func (m *OccupancyMutation) UnitIDs() (ids []string) {
+	if id := m.unit; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUnit resets all changes to the "unit" edge.
+func (m *OccupancyMutation) ResetUnit() {
+	m.unit = nil
+	m.clearedunit = false
+}
+
+// Where appends a list predicates to the OccupancyMutation builder.
+func (m *OccupancyMutation) Where(ps ...predicate.Occupancy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OccupancyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OccupancyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Occupancy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OccupancyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OccupancyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Occupancy).
+func (m *OccupancyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OccupancyMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.create_time != nil {
+		fields = append(fields, occupancy.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, occupancy.FieldUpdateTime)
+	}
+	if m.tenant != nil {
+		fields = append(fields, occupancy.FieldTenantID)
+	}
+	if m.unit != nil {
+		fields = append(fields, occupancy.FieldUnitID)
+	}
+	if m.role != nil {
+		fields = append(fields, occupancy.FieldRole)
+	}
+	if m.start_at != nil {
+		fields = append(fields, occupancy.FieldStartAt)
+	}
+	if m.end_at != nil {
+		fields = append(fields, occupancy.FieldEndAt)
+	}
+	if m.memo != nil {
+		fields = append(fields, occupancy.FieldMemo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OccupancyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case occupancy.FieldCreateTime:
+		return m.CreateTime()
+	case occupancy.FieldUpdateTime:
+		return m.UpdateTime()
+	case occupancy.FieldTenantID:
+		return m.TenantID()
+	case occupancy.FieldUnitID:
+		return m.UnitID()
+	case occupancy.FieldRole:
+		return m.Role()
+	case occupancy.FieldStartAt:
+		return m.StartAt()
+	case occupancy.FieldEndAt:
+		return m.EndAt()
+	case occupancy.FieldMemo:
+		return m.Memo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OccupancyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case occupancy.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case occupancy.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case occupancy.FieldTenantID:
+		return m.OldTenantID(ctx)
+	case occupancy.FieldUnitID:
+		return m.OldUnitID(ctx)
+	case occupancy.FieldRole:
+		return m.OldRole(ctx)
+	case occupancy.FieldStartAt:
+		return m.OldStartAt(ctx)
+	case occupancy.FieldEndAt:
+		return m.OldEndAt(ctx)
+	case occupancy.FieldMemo:
+		return m.OldMemo(ctx)
+	}
+	return nil, fmt.Errorf("unknown Occupancy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OccupancyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case occupancy.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case occupancy.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case occupancy.FieldTenantID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTenantID(v)
+		return nil
+	case occupancy.FieldUnitID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitID(v)
+		return nil
+	case occupancy.FieldRole:
+		v, ok := value.(occupancy.Role)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case occupancy.FieldStartAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartAt(v)
+		return nil
+	case occupancy.FieldEndAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndAt(v)
+		return nil
+	case occupancy.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Occupancy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OccupancyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OccupancyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OccupancyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Occupancy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OccupancyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(occupancy.FieldEndAt) {
+		fields = append(fields, occupancy.FieldEndAt)
+	}
+	if m.FieldCleared(occupancy.FieldMemo) {
+		fields = append(fields, occupancy.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OccupancyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OccupancyMutation) ClearField(name string) error {
+	switch name {
+	case occupancy.FieldEndAt:
+		m.ClearEndAt()
+		return nil
+	case occupancy.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Occupancy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OccupancyMutation) ResetField(name string) error {
+	switch name {
+	case occupancy.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case occupancy.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case occupancy.FieldTenantID:
+		m.ResetTenantID()
+		return nil
+	case occupancy.FieldUnitID:
+		m.ResetUnitID()
+		return nil
+	case occupancy.FieldRole:
+		m.ResetRole()
+		return nil
+	case occupancy.FieldStartAt:
+		m.ResetStartAt()
+		return nil
+	case occupancy.FieldEndAt:
+		m.ResetEndAt()
+		return nil
+	case occupancy.FieldMemo:
+		m.ResetMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Occupancy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OccupancyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.tenant != nil {
+		edges = append(edges, occupancy.EdgeTenant)
+	}
+	if m.unit != nil {
+		edges = append(edges, occupancy.EdgeUnit)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OccupancyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case occupancy.EdgeTenant:
+		if id := m.tenant; id != nil {
+			return []ent.Value{*id}
+		}
+	case occupancy.EdgeUnit:
+		if id := m.unit; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OccupancyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OccupancyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OccupancyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedtenant {
+		edges = append(edges, occupancy.EdgeTenant)
+	}
+	if m.clearedunit {
+		edges = append(edges, occupancy.EdgeUnit)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OccupancyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case occupancy.EdgeTenant:
+		return m.clearedtenant
+	case occupancy.EdgeUnit:
+		return m.clearedunit
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OccupancyMutation) ClearEdge(name string) error {
+	switch name {
+	case occupancy.EdgeTenant:
+		m.ClearTenant()
+		return nil
+	case occupancy.EdgeUnit:
+		m.ClearUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown Occupancy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OccupancyMutation) ResetEdge(name string) error {
+	switch name {
+	case occupancy.EdgeTenant:
+		m.ResetTenant()
+		return nil
+	case occupancy.EdgeUnit:
+		m.ResetUnit()
+		return nil
+	}
+	return fmt.Errorf("unknown Occupancy edge %s", name)
+}
+
+// TenantMutation represents an operation that mutates the Tenant nodes in the graph.
+type TenantMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *string
+	create_time        *time.Time
+	update_time        *time.Time
+	code               *string
+	name               *string
+	contact            *string
+	phone              *string
+	memo               *string
+	clearedFields      map[string]struct{}
+	occupancies        map[string]struct{}
+	removedoccupancies map[string]struct{}
+	clearedoccupancies bool
+	done               bool
+	oldValue           func(context.Context) (*Tenant, error)
+	predicates         []predicate.Tenant
+}
+
+var _ ent.Mutation = (*TenantMutation)(nil)
+
+// tenantOption allows management of the mutation configuration using functional options.
+type tenantOption func(*TenantMutation)
+
+// newTenantMutation creates new mutation for the Tenant entity.
+func newTenantMutation(c config, op Op, opts ...tenantOption) *TenantMutation {
+	m := &TenantMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTenant,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTenantID sets the ID field of the mutation.
+func withTenantID(id string) tenantOption {
+	return func(m *TenantMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Tenant
+		)
+		m.oldValue = func(ctx context.Context) (*Tenant, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Tenant.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTenant sets the old Tenant of the mutation.
+func withTenant(node *Tenant) tenantOption {
+	return func(m *TenantMutation) {
+		m.oldValue = func(context.Context) (*Tenant, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TenantMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TenantMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Tenant entities.
+func (m *TenantMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TenantMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TenantMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Tenant.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *TenantMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *TenantMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *TenantMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *TenantMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *TenantMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *TenantMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetCode sets the "code" field.
+func (m *TenantMutation) SetCode(s string) {
+	m.code = &s
+}
+
+// Code returns the value of the "code" field in the mutation.
+func (m *TenantMutation) Code() (r string, exists bool) {
+	v := m.code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCode returns the old "code" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
+	}
+	return oldValue.Code, nil
+}
+
+// ResetCode resets all changes to the "code" field.
+func (m *TenantMutation) ResetCode() {
+	m.code = nil
+}
+
+// SetName sets the "name" field.
+func (m *TenantMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *TenantMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *TenantMutation) ResetName() {
+	m.name = nil
+}
+
+// SetContact sets the "contact" field.
+func (m *TenantMutation) SetContact(s string) {
+	m.contact = &s
+}
+
+// Contact returns the value of the "contact" field in the mutation.
+func (m *TenantMutation) Contact() (r string, exists bool) {
+	v := m.contact
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldContact returns the old "contact" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldContact(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldContact is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldContact requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldContact: %w", err)
+	}
+	return oldValue.Contact, nil
+}
+
+// ClearContact clears the value of the "contact" field.
+func (m *TenantMutation) ClearContact() {
+	m.contact = nil
+	m.clearedFields[tenant.FieldContact] = struct{}{}
+}
+
+// ContactCleared returns if the "contact" field was cleared in this mutation.
+func (m *TenantMutation) ContactCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldContact]
+	return ok
+}
+
+// ResetContact resets all changes to the "contact" field.
+func (m *TenantMutation) ResetContact() {
+	m.contact = nil
+	delete(m.clearedFields, tenant.FieldContact)
+}
+
+// SetPhone sets the "phone" field.
+func (m *TenantMutation) SetPhone(s string) {
+	m.phone = &s
+}
+
+// Phone returns the value of the "phone" field in the mutation.
+func (m *TenantMutation) Phone() (r string, exists bool) {
+	v := m.phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhone returns the old "phone" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhone: %w", err)
+	}
+	return oldValue.Phone, nil
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (m *TenantMutation) ClearPhone() {
+	m.phone = nil
+	m.clearedFields[tenant.FieldPhone] = struct{}{}
+}
+
+// PhoneCleared returns if the "phone" field was cleared in this mutation.
+func (m *TenantMutation) PhoneCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldPhone]
+	return ok
+}
+
+// ResetPhone resets all changes to the "phone" field.
+func (m *TenantMutation) ResetPhone() {
+	m.phone = nil
+	delete(m.clearedFields, tenant.FieldPhone)
+}
+
+// SetMemo sets the "memo" field.
+func (m *TenantMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *TenantMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the Tenant entity.
+// If the Tenant object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TenantMutation) OldMemo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *TenantMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[tenant.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *TenantMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[tenant.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *TenantMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, tenant.FieldMemo)
+}
+
+// AddOccupancyIDs adds the "occupancies" edge to the Occupancy entity by ids.
+func (m *TenantMutation) AddOccupancyIDs(ids ...string) {
+	if m.occupancies == nil {
+		m.occupancies = make(map[string]struct{})
+	}
+	for i := range ids {
+		m.occupancies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOccupancies clears the "occupancies" edge to the Occupancy entity.
+func (m *TenantMutation) ClearOccupancies() {
+	m.clearedoccupancies = true
+}
+
+// OccupanciesCleared reports if the "occupancies" edge to the Occupancy entity was cleared.
+func (m *TenantMutation) OccupanciesCleared() bool {
+	return m.clearedoccupancies
+}
+
+// RemoveOccupancyIDs removes the "occupancies" edge to the Occupancy entity by IDs.
+func (m *TenantMutation) RemoveOccupancyIDs(ids ...string) {
+	if m.removedoccupancies == nil {
+		m.removedoccupancies = make(map[string]struct{})
+	}
+	for i := range ids {
+		delete(m.occupancies, ids[i])
+		m.removedoccupancies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOccupancies returns the removed IDs of the "occupancies" edge to the Occupancy entity.
+func (m *TenantMutation) RemovedOccupanciesIDs() (ids []string) {
+	for id := range m.removedoccupancies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OccupanciesIDs returns the "occupancies" edge IDs in the mutation.
+func (m *TenantMutation) OccupanciesIDs() (ids []string) {
+	for id := range m.occupancies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOccupancies resets all changes to the "occupancies" edge.
+func (m *TenantMutation) ResetOccupancies() {
+	m.occupancies = nil
+	m.clearedoccupancies = false
+	m.removedoccupancies = nil
+}
+
+// Where appends a list predicates to the TenantMutation builder.
+func (m *TenantMutation) Where(ps ...predicate.Tenant) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TenantMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TenantMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Tenant, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TenantMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TenantMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Tenant).
+func (m *TenantMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TenantMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.create_time != nil {
+		fields = append(fields, tenant.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, tenant.FieldUpdateTime)
+	}
+	if m.code != nil {
+		fields = append(fields, tenant.FieldCode)
+	}
+	if m.name != nil {
+		fields = append(fields, tenant.FieldName)
+	}
+	if m.contact != nil {
+		fields = append(fields, tenant.FieldContact)
+	}
+	if m.phone != nil {
+		fields = append(fields, tenant.FieldPhone)
+	}
+	if m.memo != nil {
+		fields = append(fields, tenant.FieldMemo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TenantMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tenant.FieldCreateTime:
+		return m.CreateTime()
+	case tenant.FieldUpdateTime:
+		return m.UpdateTime()
+	case tenant.FieldCode:
+		return m.Code()
+	case tenant.FieldName:
+		return m.Name()
+	case tenant.FieldContact:
+		return m.Contact()
+	case tenant.FieldPhone:
+		return m.Phone()
+	case tenant.FieldMemo:
+		return m.Memo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TenantMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tenant.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case tenant.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case tenant.FieldCode:
+		return m.OldCode(ctx)
+	case tenant.FieldName:
+		return m.OldName(ctx)
+	case tenant.FieldContact:
+		return m.OldContact(ctx)
+	case tenant.FieldPhone:
+		return m.OldPhone(ctx)
+	case tenant.FieldMemo:
+		return m.OldMemo(ctx)
+	}
+	return nil, fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tenant.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case tenant.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case tenant.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case tenant.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case tenant.FieldContact:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContact(v)
+		return nil
+	case tenant.FieldPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhone(v)
+		return nil
+	case tenant.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TenantMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TenantMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TenantMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tenant numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TenantMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tenant.FieldContact) {
+		fields = append(fields, tenant.FieldContact)
+	}
+	if m.FieldCleared(tenant.FieldPhone) {
+		fields = append(fields, tenant.FieldPhone)
+	}
+	if m.FieldCleared(tenant.FieldMemo) {
+		fields = append(fields, tenant.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TenantMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TenantMutation) ClearField(name string) error {
+	switch name {
+	case tenant.FieldContact:
+		m.ClearContact()
+		return nil
+	case tenant.FieldPhone:
+		m.ClearPhone()
+		return nil
+	case tenant.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TenantMutation) ResetField(name string) error {
+	switch name {
+	case tenant.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case tenant.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case tenant.FieldCode:
+		m.ResetCode()
+		return nil
+	case tenant.FieldName:
+		m.ResetName()
+		return nil
+	case tenant.FieldContact:
+		m.ResetContact()
+		return nil
+	case tenant.FieldPhone:
+		m.ResetPhone()
+		return nil
+	case tenant.FieldMemo:
+		m.ResetMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TenantMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.occupancies != nil {
+		edges = append(edges, tenant.EdgeOccupancies)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TenantMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case tenant.EdgeOccupancies:
+		ids := make([]ent.Value, 0, len(m.occupancies))
+		for id := range m.occupancies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TenantMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedoccupancies != nil {
+		edges = append(edges, tenant.EdgeOccupancies)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TenantMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case tenant.EdgeOccupancies:
+		ids := make([]ent.Value, 0, len(m.removedoccupancies))
+		for id := range m.removedoccupancies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TenantMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedoccupancies {
+		edges = append(edges, tenant.EdgeOccupancies)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TenantMutation) EdgeCleared(name string) bool {
+	switch name {
+	case tenant.EdgeOccupancies:
+		return m.clearedoccupancies
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TenantMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Tenant unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TenantMutation) ResetEdge(name string) error {
+	switch name {
+	case tenant.EdgeOccupancies:
+		m.ResetOccupancies()
+		return nil
+	}
+	return fmt.Errorf("unknown Tenant edge %s", name)
+}
@@ -0,0 +1,232 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+)
+
+// Occupancy is the model entity for the Occupancy schema.
+type Occupancy struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 业主或租户
+	TenantID string `json:"tenant_id,omitempty"`
+	// 单元
+	UnitID string `json:"unit_id,omitempty"`
+	// 业主或租户
+	Role occupancy.Role `json:"role,omitempty"`
+	// 开始, 包含
+	StartAt time.Time `json:"start_at,omitempty"`
+	// 结束, 不包含
+	EndAt *time.Time `json:"end_at,omitempty"`
+	// 备注
+	Memo string `json:"memo,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OccupancyQuery when eager-loading is set.
+	Edges        OccupancyEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OccupancyEdges holds the relations/edges for other nodes in the graph.
+type OccupancyEdges struct {
+	// Tenant holds the value of the tenant edge.
+	Tenant *Tenant `json:"tenant,omitempty"`
+	// Unit holds the value of the unit edge.
+	Unit *Location `json:"unit,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// TenantOrErr returns the Tenant value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OccupancyEdges) TenantOrErr() (*Tenant, error) {
+	if e.Tenant != nil {
+		return e.Tenant, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: tenant.Label}
+	}
+	return nil, &NotLoadedError{edge: "tenant"}
+}
+
+// UnitOrErr returns the Unit value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OccupancyEdges) UnitOrErr() (*Location, error) {
+	if e.Unit != nil {
+		return e.Unit, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: location.Label}
+	}
+	return nil, &NotLoadedError{edge: "unit"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Occupancy) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case occupancy.FieldID, occupancy.FieldTenantID, occupancy.FieldUnitID, occupancy.FieldRole, occupancy.FieldMemo:
+			values[i] = new(sql.NullString)
+		case occupancy.FieldCreateTime, occupancy.FieldUpdateTime, occupancy.FieldStartAt, occupancy.FieldEndAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Occupancy fields.
+func (_m *Occupancy) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case occupancy.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case occupancy.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case occupancy.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case occupancy.FieldTenantID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field tenant_id", values[i])
+			} else if value.Valid {
+				_m.TenantID = value.String
+			}
+		case occupancy.FieldUnitID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_id", values[i])
+			} else if value.Valid {
+				_m.UnitID = value.String
+			}
+		case occupancy.FieldRole:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field role", values[i])
+			} else if value.Valid {
+				_m.Role = occupancy.Role(value.String)
+			}
+		case occupancy.FieldStartAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_at", values[i])
+			} else if value.Valid {
+				_m.StartAt = value.Time
+			}
+		case occupancy.FieldEndAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_at", values[i])
+			} else if value.Valid {
+				_m.EndAt = new(time.Time)
+				*_m.EndAt = value.Time
+			}
+		case occupancy.FieldMemo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field memo", values[i])
+			} else if value.Valid {
+				_m.Memo = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Occupancy.
+// This includes values selected through modifiers, order, etc.
+func (_m *Occupancy) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryTenant queries the "tenant" edge of the Occupancy entity.
+func (_m *Occupancy) QueryTenant() *TenantQuery {
+	return NewOccupancyClient(_m.config).QueryTenant(_m)
+}
+
+// QueryUnit queries the "unit" edge of the Occupancy entity.
+func (_m *Occupancy) QueryUnit() *LocationQuery {
+	return NewOccupancyClient(_m.config).QueryUnit(_m)
+}
+
+// Update returns a builder for updating this Occupancy.
+// Note that you need to call Occupancy.Unwrap() before calling this method if this Occupancy
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Occupancy) Update() *OccupancyUpdateOne {
+	return NewOccupancyClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Occupancy entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Occupancy) Unwrap() *Occupancy {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Occupancy is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Occupancy) String() string {
+	var builder strings.Builder
+	builder.WriteString("Occupancy(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("tenant_id=")
+	builder.WriteString(_m.TenantID)
+	builder.WriteString(", ")
+	builder.WriteString("unit_id=")
+	builder.WriteString(_m.UnitID)
+	builder.WriteString(", ")
+	builder.WriteString("role=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(", ")
+	builder.WriteString("start_at=")
+	builder.WriteString(_m.StartAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.EndAt; v != nil {
+		builder.WriteString("end_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("memo=")
+	builder.WriteString(_m.Memo)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Occupancies is a parsable slice of Occupancy.
+type Occupancies []*Occupancy
@@ -0,0 +1,859 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+)
+
+// OccupancyCreate is the builder for creating a Occupancy entity.
+type OccupancyCreate struct {
+	config
+	mutation *OccupancyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *OccupancyCreate) SetCreateTime(v time.Time) *OccupancyCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *OccupancyCreate) SetNillableCreateTime(v *time.Time) *OccupancyCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *OccupancyCreate) SetUpdateTime(v time.Time) *OccupancyCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *OccupancyCreate) SetNillableUpdateTime(v *time.Time) *OccupancyCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetTenantID sets the "tenant_id" field.
+func (_c *OccupancyCreate) SetTenantID(v string) *OccupancyCreate {
+	_c.mutation.SetTenantID(v)
+	return _c
+}
+
+// SetUnitID sets the "unit_id" field.
+func (_c *OccupancyCreate) SetUnitID(v string) *OccupancyCreate {
+	_c.mutation.SetUnitID(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *OccupancyCreate) SetRole(v occupancy.Role) *OccupancyCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetStartAt sets the "start_at" field.
+func (_c *OccupancyCreate) SetStartAt(v time.Time) *OccupancyCreate {
+	_c.mutation.SetStartAt(v)
+	return _c
+}
+
+// SetEndAt sets the "end_at" field.
+func (_c *OccupancyCreate) SetEndAt(v time.Time) *OccupancyCreate {
+	_c.mutation.SetEndAt(v)
+	return _c
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_c *OccupancyCreate) SetNillableEndAt(v *time.Time) *OccupancyCreate {
+	if v != nil {
+		_c.SetEndAt(*v)
+	}
+	return _c
+}
+
+// SetMemo sets the "memo" field.
+func (_c *OccupancyCreate) SetMemo(v string) *OccupancyCreate {
+	_c.mutation.SetMemo(v)
+	return _c
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_c *OccupancyCreate) SetNillableMemo(v *string) *OccupancyCreate {
+	if v != nil {
+		_c.SetMemo(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OccupancyCreate) SetID(v string) *OccupancyCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OccupancyCreate) SetNillableID(v *string) *OccupancyCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetTenant sets the "tenant" edge to the Tenant entity.
+func (_c *OccupancyCreate) SetTenant(v *Tenant) *OccupancyCreate {
+	return _c.SetTenantID(v.ID)
+}
+
+// SetUnit sets the "unit" edge to the Location entity.
+func (_c *OccupancyCreate) SetUnit(v *Location) *OccupancyCreate {
+	return _c.SetUnitID(v.ID)
+}
+
+// Mutation returns the OccupancyMutation object of the builder.
+func (_c *OccupancyCreate) Mutation() *OccupancyMutation {
+	return _c.mutation
+}
+
+// Save creates the Occupancy in the database.
+func (_c *OccupancyCreate) Save(ctx context.Context) (*Occupancy, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OccupancyCreate) SaveX(ctx context.Context) *Occupancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OccupancyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OccupancyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OccupancyCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if occupancy.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized occupancy.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := occupancy.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if occupancy.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized occupancy.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := occupancy.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if occupancy.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized occupancy.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := occupancy.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OccupancyCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "Occupancy.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "Occupancy.update_time"`)}
+	}
+	if _, ok := _c.mutation.TenantID(); !ok {
+		return &ValidationError{Name: "tenant_id", err: errors.New(`ent: missing required field "Occupancy.tenant_id"`)}
+	}
+	if v, ok := _c.mutation.TenantID(); ok {
+		if err := occupancy.TenantIDValidator(v); err != nil {
+			return &ValidationError{Name: "tenant_id", err: fmt.Errorf(`ent: validator failed for field "Occupancy.tenant_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.UnitID(); !ok {
+		return &ValidationError{Name: "unit_id", err: errors.New(`ent: missing required field "Occupancy.unit_id"`)}
+	}
+	if v, ok := _c.mutation.UnitID(); ok {
+		if err := occupancy.UnitIDValidator(v); err != nil {
+			return &ValidationError{Name: "unit_id", err: fmt.Errorf(`ent: validator failed for field "Occupancy.unit_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "Occupancy.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := occupancy.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "Occupancy.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartAt(); !ok {
+		return &ValidationError{Name: "start_at", err: errors.New(`ent: missing required field "Occupancy.start_at"`)}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := occupancy.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "Occupancy.id": %w`, err)}
+		}
+	}
+	if len(_c.mutation.TenantIDs()) == 0 {
+		return &ValidationError{Name: "tenant", err: errors.New(`ent: missing required edge "Occupancy.tenant"`)}
+	}
+	if len(_c.mutation.UnitIDs()) == 0 {
+		return &ValidationError{Name: "unit", err: errors.New(`ent: missing required edge "Occupancy.unit"`)}
+	}
+	return nil
+}
+
+func (_c *OccupancyCreate) sqlSave(ctx context.Context) (*Occupancy, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected Occupancy.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OccupancyCreate) createSpec() (*Occupancy, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Occupancy{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(occupancy.Table, sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(occupancy.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(occupancy.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(occupancy.FieldRole, field.TypeEnum, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.StartAt(); ok {
+		_spec.SetField(occupancy.FieldStartAt, field.TypeTime, value)
+		_node.StartAt = value
+	}
+	if value, ok := _c.mutation.EndAt(); ok {
+		_spec.SetField(occupancy.FieldEndAt, field.TypeTime, value)
+		_node.EndAt = &value
+	}
+	if value, ok := _c.mutation.Memo(); ok {
+		_spec.SetField(occupancy.FieldMemo, field.TypeString, value)
+		_node.Memo = value
+	}
+	if nodes := _c.mutation.TenantIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   occupancy.TenantTable,
+			Columns: []string{occupancy.TenantColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.TenantID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.UnitIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   occupancy.UnitTable,
+			Columns: []string{occupancy.UnitColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(location.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UnitID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Occupancy.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OccupancyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OccupancyCreate) OnConflict(opts ...sql.ConflictOption) *OccupancyUpsertOne {
+	_c.conflict = opts
+	return &OccupancyUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OccupancyCreate) OnConflictColumns(columns ...string) *OccupancyUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OccupancyUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// OccupancyUpsertOne is the builder for "upsert"-ing
+	//  one Occupancy node.
+	OccupancyUpsertOne struct {
+		create *OccupancyCreate
+	}
+
+	// OccupancyUpsert is the "OnConflict" setter.
+	OccupancyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OccupancyUpsert) SetUpdateTime(v time.Time) *OccupancyUpsert {
+	u.Set(occupancy.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OccupancyUpsert) UpdateUpdateTime() *OccupancyUpsert {
+	u.SetExcluded(occupancy.FieldUpdateTime)
+	return u
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *OccupancyUpsert) SetEndAt(v time.Time) *OccupancyUpsert {
+	u.Set(occupancy.FieldEndAt, v)
+	return u
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *OccupancyUpsert) UpdateEndAt() *OccupancyUpsert {
+	u.SetExcluded(occupancy.FieldEndAt)
+	return u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *OccupancyUpsert) ClearEndAt() *OccupancyUpsert {
+	u.SetNull(occupancy.FieldEndAt)
+	return u
+}
+
+// SetMemo sets the "memo" field.
+func (u *OccupancyUpsert) SetMemo(v string) *OccupancyUpsert {
+	u.Set(occupancy.FieldMemo, v)
+	return u
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *OccupancyUpsert) UpdateMemo() *OccupancyUpsert {
+	u.SetExcluded(occupancy.FieldMemo)
+	return u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *OccupancyUpsert) ClearMemo() *OccupancyUpsert {
+	u.SetNull(occupancy.FieldMemo)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(occupancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OccupancyUpsertOne) UpdateNewValues() *OccupancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(occupancy.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(occupancy.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.TenantID(); exists {
+			s.SetIgnore(occupancy.FieldTenantID)
+		}
+		if _, exists := u.create.mutation.UnitID(); exists {
+			s.SetIgnore(occupancy.FieldUnitID)
+		}
+		if _, exists := u.create.mutation.Role(); exists {
+			s.SetIgnore(occupancy.FieldRole)
+		}
+		if _, exists := u.create.mutation.StartAt(); exists {
+			s.SetIgnore(occupancy.FieldStartAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *OccupancyUpsertOne) Ignore() *OccupancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OccupancyUpsertOne) DoNothing() *OccupancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OccupancyCreate.OnConflict
+// documentation for more info.
+func (u *OccupancyUpsertOne) Update(set func(*OccupancyUpsert)) *OccupancyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OccupancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OccupancyUpsertOne) SetUpdateTime(v time.Time) *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OccupancyUpsertOne) UpdateUpdateTime() *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *OccupancyUpsertOne) SetEndAt(v time.Time) *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *OccupancyUpsertOne) UpdateEndAt() *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *OccupancyUpsertOne) ClearEndAt() *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *OccupancyUpsertOne) SetMemo(v string) *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *OccupancyUpsertOne) UpdateMemo() *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *OccupancyUpsertOne) ClearMemo() *OccupancyUpsertOne {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *OccupancyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OccupancyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OccupancyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *OccupancyUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: OccupancyUpsertOne.ID is not supported by MySQL driver. Use OccupancyUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *OccupancyUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// OccupancyCreateBulk is the builder for creating many Occupancy entities in bulk.
+type OccupancyCreateBulk struct {
+	config
+	err      error
+	builders []*OccupancyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Occupancy entities in the database.
+func (_c *OccupancyCreateBulk) Save(ctx context.Context) ([]*Occupancy, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Occupancy, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OccupancyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OccupancyCreateBulk) SaveX(ctx context.Context) []*Occupancy {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OccupancyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OccupancyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Occupancy.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.OccupancyUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *OccupancyCreateBulk) OnConflict(opts ...sql.ConflictOption) *OccupancyUpsertBulk {
+	_c.conflict = opts
+	return &OccupancyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *OccupancyCreateBulk) OnConflictColumns(columns ...string) *OccupancyUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &OccupancyUpsertBulk{
+		create: _c,
+	}
+}
+
+// OccupancyUpsertBulk is the builder for "upsert"-ing
+// a bulk of Occupancy nodes.
+type OccupancyUpsertBulk struct {
+	create *OccupancyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(occupancy.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *OccupancyUpsertBulk) UpdateNewValues() *OccupancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(occupancy.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(occupancy.FieldCreateTime)
+			}
+			if _, exists := b.mutation.TenantID(); exists {
+				s.SetIgnore(occupancy.FieldTenantID)
+			}
+			if _, exists := b.mutation.UnitID(); exists {
+				s.SetIgnore(occupancy.FieldUnitID)
+			}
+			if _, exists := b.mutation.Role(); exists {
+				s.SetIgnore(occupancy.FieldRole)
+			}
+			if _, exists := b.mutation.StartAt(); exists {
+				s.SetIgnore(occupancy.FieldStartAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Occupancy.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *OccupancyUpsertBulk) Ignore() *OccupancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *OccupancyUpsertBulk) DoNothing() *OccupancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the OccupancyCreateBulk.OnConflict
+// documentation for more info.
+func (u *OccupancyUpsertBulk) Update(set func(*OccupancyUpsert)) *OccupancyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&OccupancyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *OccupancyUpsertBulk) SetUpdateTime(v time.Time) *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *OccupancyUpsertBulk) UpdateUpdateTime() *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetEndAt sets the "end_at" field.
+func (u *OccupancyUpsertBulk) SetEndAt(v time.Time) *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetEndAt(v)
+	})
+}
+
+// UpdateEndAt sets the "end_at" field to the value that was provided on create.
+func (u *OccupancyUpsertBulk) UpdateEndAt() *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateEndAt()
+	})
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (u *OccupancyUpsertBulk) ClearEndAt() *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.ClearEndAt()
+	})
+}
+
+// SetMemo sets the "memo" field.
+func (u *OccupancyUpsertBulk) SetMemo(v string) *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.SetMemo(v)
+	})
+}
+
+// UpdateMemo sets the "memo" field to the value that was provided on create.
+func (u *OccupancyUpsertBulk) UpdateMemo() *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.UpdateMemo()
+	})
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (u *OccupancyUpsertBulk) ClearMemo() *OccupancyUpsertBulk {
+	return u.Update(func(s *OccupancyUpsert) {
+		s.ClearMemo()
+	})
+}
+
+// Exec executes the query.
+func (u *OccupancyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the OccupancyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for OccupancyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *OccupancyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
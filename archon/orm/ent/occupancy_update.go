@@ -0,0 +1,356 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+)
+
+// OccupancyUpdate is the builder for updating Occupancy entities.
+type OccupancyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OccupancyMutation
+}
+
+// Where appends a list predicates to the OccupancyUpdate builder.
+func (_u *OccupancyUpdate) Where(ps ...predicate.Occupancy) *OccupancyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OccupancyUpdate) SetUpdateTime(v time.Time) *OccupancyUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *OccupancyUpdate) SetEndAt(v time.Time) *OccupancyUpdate {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *OccupancyUpdate) SetNillableEndAt(v *time.Time) *OccupancyUpdate {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *OccupancyUpdate) ClearEndAt() *OccupancyUpdate {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *OccupancyUpdate) SetMemo(v string) *OccupancyUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *OccupancyUpdate) SetNillableMemo(v *string) *OccupancyUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *OccupancyUpdate) ClearMemo() *OccupancyUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// Mutation returns the OccupancyMutation object of the builder.
+func (_u *OccupancyUpdate) Mutation() *OccupancyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OccupancyUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OccupancyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OccupancyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OccupancyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OccupancyUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if occupancy.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized occupancy.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := occupancy.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OccupancyUpdate) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Occupancy.tenant"`)
+	}
+	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Occupancy.unit"`)
+	}
+	return nil
+}
+
+func (_u *OccupancyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(occupancy.Table, occupancy.Columns, sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(occupancy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(occupancy.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(occupancy.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(occupancy.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(occupancy.FieldMemo, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{occupancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OccupancyUpdateOne is the builder for updating a single Occupancy entity.
+type OccupancyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OccupancyMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *OccupancyUpdateOne) SetUpdateTime(v time.Time) *OccupancyUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetEndAt sets the "end_at" field.
+func (_u *OccupancyUpdateOne) SetEndAt(v time.Time) *OccupancyUpdateOne {
+	_u.mutation.SetEndAt(v)
+	return _u
+}
+
+// SetNillableEndAt sets the "end_at" field if the given value is not nil.
+func (_u *OccupancyUpdateOne) SetNillableEndAt(v *time.Time) *OccupancyUpdateOne {
+	if v != nil {
+		_u.SetEndAt(*v)
+	}
+	return _u
+}
+
+// ClearEndAt clears the value of the "end_at" field.
+func (_u *OccupancyUpdateOne) ClearEndAt() *OccupancyUpdateOne {
+	_u.mutation.ClearEndAt()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *OccupancyUpdateOne) SetMemo(v string) *OccupancyUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *OccupancyUpdateOne) SetNillableMemo(v *string) *OccupancyUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *OccupancyUpdateOne) ClearMemo() *OccupancyUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// Mutation returns the OccupancyMutation object of the builder.
+func (_u *OccupancyUpdateOne) Mutation() *OccupancyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the OccupancyUpdate builder.
+func (_u *OccupancyUpdateOne) Where(ps ...predicate.Occupancy) *OccupancyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OccupancyUpdateOne) Select(field string, fields ...string) *OccupancyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Occupancy entity.
+func (_u *OccupancyUpdateOne) Save(ctx context.Context) (*Occupancy, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OccupancyUpdateOne) SaveX(ctx context.Context) *Occupancy {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OccupancyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OccupancyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OccupancyUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if occupancy.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized occupancy.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := occupancy.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OccupancyUpdateOne) check() error {
+	if _u.mutation.TenantCleared() && len(_u.mutation.TenantIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Occupancy.tenant"`)
+	}
+	if _u.mutation.UnitCleared() && len(_u.mutation.UnitIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Occupancy.unit"`)
+	}
+	return nil
+}
+
+func (_u *OccupancyUpdateOne) sqlSave(ctx context.Context) (_node *Occupancy, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(occupancy.Table, occupancy.Columns, sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Occupancy.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, occupancy.FieldID)
+		for _, f := range fields {
+			if !occupancy.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != occupancy.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(occupancy.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.EndAt(); ok {
+		_spec.SetField(occupancy.FieldEndAt, field.TypeTime, value)
+	}
+	if _u.mutation.EndAtCleared() {
+		_spec.ClearField(occupancy.FieldEndAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(occupancy.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(occupancy.FieldMemo, field.TypeString)
+	}
+	_node = &Occupancy{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{occupancy.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,3 +8,24 @@ import (
 
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
+
+// DeviceAttr is the predicate function for deviceattr builders.
+type DeviceAttr func(*sql.Selector)
+
+// DeviceHistory is the predicate function for devicehistory builders.
+type DeviceHistory func(*sql.Selector)
+
+// DriverModel is the predicate function for drivermodel builders.
+type DriverModel func(*sql.Selector)
+
+// EdgeBox is the predicate function for edgebox builders.
+type EdgeBox func(*sql.Selector)
+
+// Location is the predicate function for location builders.
+type Location func(*sql.Selector)
+
+// Occupancy is the predicate function for occupancy builders.
+type Occupancy func(*sql.Selector)
+
+// Tenant is the predicate function for tenant builders.
+type Tenant func(*sql.Selector)
@@ -133,3 +133,171 @@ func (f DeviceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation
 	}
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceMutation", m)
 }
+
+// The DeviceAttrQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeviceAttrQueryRuleFunc func(context.Context, *ent.DeviceAttrQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeviceAttrQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeviceAttrQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeviceAttrQuery", q)
+}
+
+// The DeviceAttrMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeviceAttrMutationRuleFunc func(context.Context, *ent.DeviceAttrMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeviceAttrMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeviceAttrMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceAttrMutation", m)
+}
+
+// The DeviceHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeviceHistoryQueryRuleFunc func(context.Context, *ent.DeviceHistoryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeviceHistoryQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeviceHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeviceHistoryQuery", q)
+}
+
+// The DeviceHistoryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeviceHistoryMutationRuleFunc func(context.Context, *ent.DeviceHistoryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeviceHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeviceHistoryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceHistoryMutation", m)
+}
+
+// The DriverModelQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DriverModelQueryRuleFunc func(context.Context, *ent.DriverModelQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DriverModelQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DriverModelQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DriverModelQuery", q)
+}
+
+// The DriverModelMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DriverModelMutationRuleFunc func(context.Context, *ent.DriverModelMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DriverModelMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DriverModelMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DriverModelMutation", m)
+}
+
+// The EdgeBoxQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type EdgeBoxQueryRuleFunc func(context.Context, *ent.EdgeBoxQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f EdgeBoxQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EdgeBoxQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.EdgeBoxQuery", q)
+}
+
+// The EdgeBoxMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type EdgeBoxMutationRuleFunc func(context.Context, *ent.EdgeBoxMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f EdgeBoxMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.EdgeBoxMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.EdgeBoxMutation", m)
+}
+
+// The LocationQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LocationQueryRuleFunc func(context.Context, *ent.LocationQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LocationQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LocationQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LocationQuery", q)
+}
+
+// The LocationMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LocationMutationRuleFunc func(context.Context, *ent.LocationMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LocationMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LocationMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LocationMutation", m)
+}
+
+// The OccupancyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type OccupancyQueryRuleFunc func(context.Context, *ent.OccupancyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f OccupancyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.OccupancyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.OccupancyQuery", q)
+}
+
+// The OccupancyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type OccupancyMutationRuleFunc func(context.Context, *ent.OccupancyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f OccupancyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.OccupancyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.OccupancyMutation", m)
+}
+
+// The TenantQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type TenantQueryRuleFunc func(context.Context, *ent.TenantQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f TenantQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.TenantQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.TenantQuery", q)
+}
+
+// The TenantMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type TenantMutationRuleFunc func(context.Context, *ent.TenantMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f TenantMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.TenantMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.TenantMutation", m)
+}
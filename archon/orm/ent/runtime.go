@@ -2,60 +2,4 @@
 
 package ent
 
-import (
-	"time"
-
-	"github.com/twiglab/h2o/archon/orm/ent/device"
-	"github.com/twiglab/h2o/archon/orm/schema"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	deviceMixin := schema.Device{}.Mixin()
-	deviceMixinFields0 := deviceMixin[0].Fields()
-	_ = deviceMixinFields0
-	deviceFields := schema.Device{}.Fields()
-	_ = deviceFields
-	// deviceDescCreateTime is the schema descriptor for create_time field.
-	deviceDescCreateTime := deviceMixinFields0[0].Descriptor()
-	// device.DefaultCreateTime holds the default value on creation for the create_time field.
-	device.DefaultCreateTime = deviceDescCreateTime.Default.(func() time.Time)
-	// deviceDescUpdateTime is the schema descriptor for update_time field.
-	deviceDescUpdateTime := deviceMixinFields0[1].Descriptor()
-	// device.DefaultUpdateTime holds the default value on creation for the update_time field.
-	device.DefaultUpdateTime = deviceDescUpdateTime.Default.(func() time.Time)
-	// device.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	device.UpdateDefaultUpdateTime = deviceDescUpdateTime.UpdateDefault.(func() time.Time)
-	// deviceDescDeviceCode is the schema descriptor for device_code field.
-	deviceDescDeviceCode := deviceFields[1].Descriptor()
-	// device.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
-	device.DeviceCodeValidator = deviceDescDeviceCode.Validators[0].(func(string) error)
-	// deviceDescDeviceType is the schema descriptor for device_type field.
-	deviceDescDeviceType := deviceFields[2].Descriptor()
-	// device.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
-	device.DeviceTypeValidator = deviceDescDeviceType.Validators[0].(func(string) error)
-	// deviceDescRate is the schema descriptor for rate field.
-	deviceDescRate := deviceFields[5].Descriptor()
-	// device.DefaultRate holds the default value on creation for the rate field.
-	device.DefaultRate = deviceDescRate.Default.(int)
-	// deviceDescProject is the schema descriptor for project field.
-	deviceDescProject := deviceFields[6].Descriptor()
-	// device.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
-	device.ProjectValidator = deviceDescProject.Validators[0].(func(string) error)
-	// deviceDescStatus is the schema descriptor for status field.
-	deviceDescStatus := deviceFields[10].Descriptor()
-	// device.DefaultStatus holds the default value on creation for the status field.
-	device.DefaultStatus = deviceDescStatus.Default.(int)
-	// deviceDescIsDel is the schema descriptor for is_del field.
-	deviceDescIsDel := deviceFields[12].Descriptor()
-	// device.DefaultIsDel holds the default value on creation for the is_del field.
-	device.DefaultIsDel = deviceDescIsDel.Default.(int)
-	// deviceDescID is the schema descriptor for id field.
-	deviceDescID := deviceFields[0].Descriptor()
-	// device.DefaultID holds the default value on creation for the id field.
-	device.DefaultID = deviceDescID.Default.(func() string)
-	// device.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	device.IDValidator = deviceDescID.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in github.com/twiglab/h2o/archon/orm/ent/runtime/runtime.go
@@ -2,7 +2,342 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/twiglab/h2o/archon/orm/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/drivermodel"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+	"github.com/twiglab/h2o/archon/orm/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	deviceMixin := schema.Device{}.Mixin()
+	device.Policy = privacy.NewPolicies(schema.Device{})
+	device.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := device.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	deviceMixinInters1 := deviceMixin[1].Interceptors()
+	device.Interceptors[0] = deviceMixinInters1[0]
+	deviceMixinFields0 := deviceMixin[0].Fields()
+	_ = deviceMixinFields0
+	deviceMixinFields1 := deviceMixin[1].Fields()
+	_ = deviceMixinFields1
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescCreateTime is the schema descriptor for create_time field.
+	deviceDescCreateTime := deviceMixinFields0[0].Descriptor()
+	// device.DefaultCreateTime holds the default value on creation for the create_time field.
+	device.DefaultCreateTime = deviceDescCreateTime.Default.(func() time.Time)
+	// deviceDescUpdateTime is the schema descriptor for update_time field.
+	deviceDescUpdateTime := deviceMixinFields0[1].Descriptor()
+	// device.DefaultUpdateTime holds the default value on creation for the update_time field.
+	device.DefaultUpdateTime = deviceDescUpdateTime.Default.(func() time.Time)
+	// device.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	device.UpdateDefaultUpdateTime = deviceDescUpdateTime.UpdateDefault.(func() time.Time)
+	// deviceDescIsDel is the schema descriptor for is_del field.
+	deviceDescIsDel := deviceMixinFields1[0].Descriptor()
+	// device.DefaultIsDel holds the default value on creation for the is_del field.
+	device.DefaultIsDel = deviceDescIsDel.Default.(int64)
+	// deviceDescDeviceCode is the schema descriptor for device_code field.
+	deviceDescDeviceCode := deviceFields[1].Descriptor()
+	// device.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	device.DeviceCodeValidator = deviceDescDeviceCode.Validators[0].(func(string) error)
+	// deviceDescDeviceType is the schema descriptor for device_type field.
+	deviceDescDeviceType := deviceFields[2].Descriptor()
+	// device.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	device.DeviceTypeValidator = deviceDescDeviceType.Validators[0].(func(string) error)
+	// deviceDescRate is the schema descriptor for rate field.
+	deviceDescRate := deviceFields[5].Descriptor()
+	// device.DefaultRate holds the default value on creation for the rate field.
+	device.DefaultRate = deviceDescRate.Default.(int)
+	// deviceDescProject is the schema descriptor for project field.
+	deviceDescProject := deviceFields[6].Descriptor()
+	// device.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	device.ProjectValidator = deviceDescProject.Validators[0].(func(string) error)
+	// deviceDescStatus is the schema descriptor for status field.
+	deviceDescStatus := deviceFields[16].Descriptor()
+	// device.DefaultStatus holds the default value on creation for the status field.
+	device.DefaultStatus = deviceDescStatus.Default.(int)
+	// deviceDescID is the schema descriptor for id field.
+	deviceDescID := deviceFields[0].Descriptor()
+	// device.DefaultID holds the default value on creation for the id field.
+	device.DefaultID = deviceDescID.Default.(func() string)
+	// device.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	device.IDValidator = deviceDescID.Validators[0].(func(string) error)
+	deviceattr.Policy = privacy.NewPolicies(schema.DeviceAttr{})
+	deviceattr.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := deviceattr.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	deviceattrFields := schema.DeviceAttr{}.Fields()
+	_ = deviceattrFields
+	// deviceattrDescDeviceID is the schema descriptor for device_id field.
+	deviceattrDescDeviceID := deviceattrFields[1].Descriptor()
+	// deviceattr.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	deviceattr.DeviceIDValidator = deviceattrDescDeviceID.Validators[0].(func(string) error)
+	// deviceattrDescDeviceCode is the schema descriptor for device_code field.
+	deviceattrDescDeviceCode := deviceattrFields[2].Descriptor()
+	// deviceattr.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	deviceattr.DeviceCodeValidator = deviceattrDescDeviceCode.Validators[0].(func(string) error)
+	// deviceattrDescName is the schema descriptor for name field.
+	deviceattrDescName := deviceattrFields[3].Descriptor()
+	// deviceattr.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deviceattr.NameValidator = deviceattrDescName.Validators[0].(func(string) error)
+	// deviceattrDescID is the schema descriptor for id field.
+	deviceattrDescID := deviceattrFields[0].Descriptor()
+	// deviceattr.DefaultID holds the default value on creation for the id field.
+	deviceattr.DefaultID = deviceattrDescID.Default.(func() string)
+	// deviceattr.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	deviceattr.IDValidator = deviceattrDescID.Validators[0].(func(string) error)
+	devicehistoryMixin := schema.DeviceHistory{}.Mixin()
+	devicehistory.Policy = privacy.NewPolicies(schema.DeviceHistory{})
+	devicehistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := devicehistory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	devicehistoryMixinFields0 := devicehistoryMixin[0].Fields()
+	_ = devicehistoryMixinFields0
+	devicehistoryFields := schema.DeviceHistory{}.Fields()
+	_ = devicehistoryFields
+	// devicehistoryDescCreateTime is the schema descriptor for create_time field.
+	devicehistoryDescCreateTime := devicehistoryMixinFields0[0].Descriptor()
+	// devicehistory.DefaultCreateTime holds the default value on creation for the create_time field.
+	devicehistory.DefaultCreateTime = devicehistoryDescCreateTime.Default.(func() time.Time)
+	// devicehistoryDescDeviceID is the schema descriptor for device_id field.
+	devicehistoryDescDeviceID := devicehistoryFields[1].Descriptor()
+	// devicehistory.DeviceIDValidator is a validator for the "device_id" field. It is called by the builders before save.
+	devicehistory.DeviceIDValidator = devicehistoryDescDeviceID.Validators[0].(func(string) error)
+	// devicehistoryDescDeviceCode is the schema descriptor for device_code field.
+	devicehistoryDescDeviceCode := devicehistoryFields[2].Descriptor()
+	// devicehistory.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	devicehistory.DeviceCodeValidator = devicehistoryDescDeviceCode.Validators[0].(func(string) error)
+	// devicehistoryDescID is the schema descriptor for id field.
+	devicehistoryDescID := devicehistoryFields[0].Descriptor()
+	// devicehistory.DefaultID holds the default value on creation for the id field.
+	devicehistory.DefaultID = devicehistoryDescID.Default.(func() string)
+	// devicehistory.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	devicehistory.IDValidator = devicehistoryDescID.Validators[0].(func(string) error)
+	drivermodelMixin := schema.DriverModel{}.Mixin()
+	drivermodel.Policy = privacy.NewPolicies(schema.DriverModel{})
+	drivermodel.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := drivermodel.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	drivermodelMixinFields0 := drivermodelMixin[0].Fields()
+	_ = drivermodelMixinFields0
+	drivermodelFields := schema.DriverModel{}.Fields()
+	_ = drivermodelFields
+	// drivermodelDescCreateTime is the schema descriptor for create_time field.
+	drivermodelDescCreateTime := drivermodelMixinFields0[0].Descriptor()
+	// drivermodel.DefaultCreateTime holds the default value on creation for the create_time field.
+	drivermodel.DefaultCreateTime = drivermodelDescCreateTime.Default.(func() time.Time)
+	// drivermodelDescUpdateTime is the schema descriptor for update_time field.
+	drivermodelDescUpdateTime := drivermodelMixinFields0[1].Descriptor()
+	// drivermodel.DefaultUpdateTime holds the default value on creation for the update_time field.
+	drivermodel.DefaultUpdateTime = drivermodelDescUpdateTime.Default.(func() time.Time)
+	// drivermodel.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	drivermodel.UpdateDefaultUpdateTime = drivermodelDescUpdateTime.UpdateDefault.(func() time.Time)
+	// drivermodelDescName is the schema descriptor for name field.
+	drivermodelDescName := drivermodelFields[1].Descriptor()
+	// drivermodel.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	drivermodel.NameValidator = drivermodelDescName.Validators[0].(func(string) error)
+	// drivermodelDescPlugin is the schema descriptor for plugin field.
+	drivermodelDescPlugin := drivermodelFields[2].Descriptor()
+	// drivermodel.PluginValidator is a validator for the "plugin" field. It is called by the builders before save.
+	drivermodel.PluginValidator = drivermodelDescPlugin.Validators[0].(func(string) error)
+	// drivermodelDescID is the schema descriptor for id field.
+	drivermodelDescID := drivermodelFields[0].Descriptor()
+	// drivermodel.DefaultID holds the default value on creation for the id field.
+	drivermodel.DefaultID = drivermodelDescID.Default.(func() string)
+	// drivermodel.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	drivermodel.IDValidator = drivermodelDescID.Validators[0].(func(string) error)
+	edgeboxMixin := schema.EdgeBox{}.Mixin()
+	edgebox.Policy = privacy.NewPolicies(schema.EdgeBox{})
+	edgebox.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := edgebox.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	edgeboxMixinFields0 := edgeboxMixin[0].Fields()
+	_ = edgeboxMixinFields0
+	edgeboxFields := schema.EdgeBox{}.Fields()
+	_ = edgeboxFields
+	// edgeboxDescCreateTime is the schema descriptor for create_time field.
+	edgeboxDescCreateTime := edgeboxMixinFields0[0].Descriptor()
+	// edgebox.DefaultCreateTime holds the default value on creation for the create_time field.
+	edgebox.DefaultCreateTime = edgeboxDescCreateTime.Default.(func() time.Time)
+	// edgeboxDescUpdateTime is the schema descriptor for update_time field.
+	edgeboxDescUpdateTime := edgeboxMixinFields0[1].Descriptor()
+	// edgebox.DefaultUpdateTime holds the default value on creation for the update_time field.
+	edgebox.DefaultUpdateTime = edgeboxDescUpdateTime.Default.(func() time.Time)
+	// edgebox.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	edgebox.UpdateDefaultUpdateTime = edgeboxDescUpdateTime.UpdateDefault.(func() time.Time)
+	// edgeboxDescCode is the schema descriptor for code field.
+	edgeboxDescCode := edgeboxFields[1].Descriptor()
+	// edgebox.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	edgebox.CodeValidator = edgeboxDescCode.Validators[0].(func(string) error)
+	// edgeboxDescProject is the schema descriptor for project field.
+	edgeboxDescProject := edgeboxFields[3].Descriptor()
+	// edgebox.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	edgebox.ProjectValidator = edgeboxDescProject.Validators[0].(func(string) error)
+	// edgeboxDescURL is the schema descriptor for url field.
+	edgeboxDescURL := edgeboxFields[4].Descriptor()
+	// edgebox.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	edgebox.URLValidator = edgeboxDescURL.Validators[0].(func(string) error)
+	// edgeboxDescID is the schema descriptor for id field.
+	edgeboxDescID := edgeboxFields[0].Descriptor()
+	// edgebox.DefaultID holds the default value on creation for the id field.
+	edgebox.DefaultID = edgeboxDescID.Default.(func() string)
+	// edgebox.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	edgebox.IDValidator = edgeboxDescID.Validators[0].(func(string) error)
+	locationMixin := schema.Location{}.Mixin()
+	location.Policy = privacy.NewPolicies(schema.Location{})
+	location.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := location.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	locationMixinFields0 := locationMixin[0].Fields()
+	_ = locationMixinFields0
+	locationFields := schema.Location{}.Fields()
+	_ = locationFields
+	// locationDescCreateTime is the schema descriptor for create_time field.
+	locationDescCreateTime := locationMixinFields0[0].Descriptor()
+	// location.DefaultCreateTime holds the default value on creation for the create_time field.
+	location.DefaultCreateTime = locationDescCreateTime.Default.(func() time.Time)
+	// locationDescUpdateTime is the schema descriptor for update_time field.
+	locationDescUpdateTime := locationMixinFields0[1].Descriptor()
+	// location.DefaultUpdateTime holds the default value on creation for the update_time field.
+	location.DefaultUpdateTime = locationDescUpdateTime.Default.(func() time.Time)
+	// location.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	location.UpdateDefaultUpdateTime = locationDescUpdateTime.UpdateDefault.(func() time.Time)
+	// locationDescCode is the schema descriptor for code field.
+	locationDescCode := locationFields[2].Descriptor()
+	// location.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	location.CodeValidator = locationDescCode.Validators[0].(func(string) error)
+	// locationDescProject is the schema descriptor for project field.
+	locationDescProject := locationFields[5].Descriptor()
+	// location.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	location.ProjectValidator = locationDescProject.Validators[0].(func(string) error)
+	// locationDescID is the schema descriptor for id field.
+	locationDescID := locationFields[0].Descriptor()
+	// location.DefaultID holds the default value on creation for the id field.
+	location.DefaultID = locationDescID.Default.(func() string)
+	// location.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	location.IDValidator = locationDescID.Validators[0].(func(string) error)
+	occupancyMixin := schema.Occupancy{}.Mixin()
+	occupancy.Policy = privacy.NewPolicies(schema.Occupancy{})
+	occupancy.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := occupancy.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	occupancyMixinFields0 := occupancyMixin[0].Fields()
+	_ = occupancyMixinFields0
+	occupancyFields := schema.Occupancy{}.Fields()
+	_ = occupancyFields
+	// occupancyDescCreateTime is the schema descriptor for create_time field.
+	occupancyDescCreateTime := occupancyMixinFields0[0].Descriptor()
+	// occupancy.DefaultCreateTime holds the default value on creation for the create_time field.
+	occupancy.DefaultCreateTime = occupancyDescCreateTime.Default.(func() time.Time)
+	// occupancyDescUpdateTime is the schema descriptor for update_time field.
+	occupancyDescUpdateTime := occupancyMixinFields0[1].Descriptor()
+	// occupancy.DefaultUpdateTime holds the default value on creation for the update_time field.
+	occupancy.DefaultUpdateTime = occupancyDescUpdateTime.Default.(func() time.Time)
+	// occupancy.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	occupancy.UpdateDefaultUpdateTime = occupancyDescUpdateTime.UpdateDefault.(func() time.Time)
+	// occupancyDescTenantID is the schema descriptor for tenant_id field.
+	occupancyDescTenantID := occupancyFields[1].Descriptor()
+	// occupancy.TenantIDValidator is a validator for the "tenant_id" field. It is called by the builders before save.
+	occupancy.TenantIDValidator = occupancyDescTenantID.Validators[0].(func(string) error)
+	// occupancyDescUnitID is the schema descriptor for unit_id field.
+	occupancyDescUnitID := occupancyFields[2].Descriptor()
+	// occupancy.UnitIDValidator is a validator for the "unit_id" field. It is called by the builders before save.
+	occupancy.UnitIDValidator = occupancyDescUnitID.Validators[0].(func(string) error)
+	// occupancyDescID is the schema descriptor for id field.
+	occupancyDescID := occupancyFields[0].Descriptor()
+	// occupancy.DefaultID holds the default value on creation for the id field.
+	occupancy.DefaultID = occupancyDescID.Default.(func() string)
+	// occupancy.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	occupancy.IDValidator = occupancyDescID.Validators[0].(func(string) error)
+	tenantMixin := schema.Tenant{}.Mixin()
+	tenant.Policy = privacy.NewPolicies(schema.Tenant{})
+	tenant.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := tenant.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	tenantMixinFields0 := tenantMixin[0].Fields()
+	_ = tenantMixinFields0
+	tenantFields := schema.Tenant{}.Fields()
+	_ = tenantFields
+	// tenantDescCreateTime is the schema descriptor for create_time field.
+	tenantDescCreateTime := tenantMixinFields0[0].Descriptor()
+	// tenant.DefaultCreateTime holds the default value on creation for the create_time field.
+	tenant.DefaultCreateTime = tenantDescCreateTime.Default.(func() time.Time)
+	// tenantDescUpdateTime is the schema descriptor for update_time field.
+	tenantDescUpdateTime := tenantMixinFields0[1].Descriptor()
+	// tenant.DefaultUpdateTime holds the default value on creation for the update_time field.
+	tenant.DefaultUpdateTime = tenantDescUpdateTime.Default.(func() time.Time)
+	// tenant.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	tenant.UpdateDefaultUpdateTime = tenantDescUpdateTime.UpdateDefault.(func() time.Time)
+	// tenantDescCode is the schema descriptor for code field.
+	tenantDescCode := tenantFields[1].Descriptor()
+	// tenant.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	tenant.CodeValidator = tenantDescCode.Validators[0].(func(string) error)
+	// tenantDescName is the schema descriptor for name field.
+	tenantDescName := tenantFields[2].Descriptor()
+	// tenant.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	tenant.NameValidator = tenantDescName.Validators[0].(func(string) error)
+	// tenantDescID is the schema descriptor for id field.
+	tenantDescID := tenantFields[0].Descriptor()
+	// tenant.DefaultID holds the default value on creation for the id field.
+	tenant.DefaultID = tenantDescID.Default.(func() string)
+	// tenant.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	tenant.IDValidator = tenantDescID.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.6"                                         // Version of ent codegen.
@@ -0,0 +1,647 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+	"github.com/twiglab/h2o/archon/orm/ent/tenant"
+)
+
+// TenantUpdate is the builder for updating Tenant entities.
+type TenantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TenantMutation
+}
+
+// Where appends a list predicates to the TenantUpdate builder.
+func (_u *TenantUpdate) Where(ps ...predicate.Tenant) *TenantUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantUpdate) SetUpdateTime(v time.Time) *TenantUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *TenantUpdate) SetCode(v string) *TenantUpdate {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableCode(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantUpdate) SetName(v string) *TenantUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableName(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *TenantUpdate) SetContact(v string) *TenantUpdate {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableContact(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetContact(*v)
+	}
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *TenantUpdate) ClearContact() *TenantUpdate {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *TenantUpdate) SetPhone(v string) *TenantUpdate {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillablePhone(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *TenantUpdate) ClearPhone() *TenantUpdate {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TenantUpdate) SetMemo(v string) *TenantUpdate {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TenantUpdate) SetNillableMemo(v *string) *TenantUpdate {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TenantUpdate) ClearMemo() *TenantUpdate {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// AddOccupancyIDs adds the "occupancies" edge to the Occupancy entity by IDs.
+func (_u *TenantUpdate) AddOccupancyIDs(ids ...string) *TenantUpdate {
+	_u.mutation.AddOccupancyIDs(ids...)
+	return _u
+}
+
+// AddOccupancies adds the "occupancies" edges to the Occupancy entity.
+func (_u *TenantUpdate) AddOccupancies(v ...*Occupancy) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccupancyIDs(ids...)
+}
+
+// Mutation returns the TenantMutation object of the builder.
+func (_u *TenantUpdate) Mutation() *TenantMutation {
+	return _u.mutation
+}
+
+// ClearOccupancies clears all "occupancies" edges to the Occupancy entity.
+func (_u *TenantUpdate) ClearOccupancies() *TenantUpdate {
+	_u.mutation.ClearOccupancies()
+	return _u
+}
+
+// RemoveOccupancyIDs removes the "occupancies" edge to Occupancy entities by IDs.
+func (_u *TenantUpdate) RemoveOccupancyIDs(ids ...string) *TenantUpdate {
+	_u.mutation.RemoveOccupancyIDs(ids...)
+	return _u
+}
+
+// RemoveOccupancies removes "occupancies" edges to Occupancy entities.
+func (_u *TenantUpdate) RemoveOccupancies(v ...*Occupancy) *TenantUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccupancyIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TenantUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TenantUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantUpdate) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := tenant.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Tenant.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TenantUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(tenant.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(tenant.FieldContact, field.TypeString, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(tenant.FieldContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(tenant.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(tenant.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(tenant.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(tenant.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.OccupanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccupanciesIDs(); len(nodes) > 0 && !_u.mutation.OccupanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccupanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TenantUpdateOne is the builder for updating a single Tenant entity.
+type TenantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TenantMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *TenantUpdateOne) SetUpdateTime(v time.Time) *TenantUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetCode sets the "code" field.
+func (_u *TenantUpdateOne) SetCode(v string) *TenantUpdateOne {
+	_u.mutation.SetCode(v)
+	return _u
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableCode(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetCode(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *TenantUpdateOne) SetName(v string) *TenantUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableName(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetContact sets the "contact" field.
+func (_u *TenantUpdateOne) SetContact(v string) *TenantUpdateOne {
+	_u.mutation.SetContact(v)
+	return _u
+}
+
+// SetNillableContact sets the "contact" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableContact(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetContact(*v)
+	}
+	return _u
+}
+
+// ClearContact clears the value of the "contact" field.
+func (_u *TenantUpdateOne) ClearContact() *TenantUpdateOne {
+	_u.mutation.ClearContact()
+	return _u
+}
+
+// SetPhone sets the "phone" field.
+func (_u *TenantUpdateOne) SetPhone(v string) *TenantUpdateOne {
+	_u.mutation.SetPhone(v)
+	return _u
+}
+
+// SetNillablePhone sets the "phone" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillablePhone(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetPhone(*v)
+	}
+	return _u
+}
+
+// ClearPhone clears the value of the "phone" field.
+func (_u *TenantUpdateOne) ClearPhone() *TenantUpdateOne {
+	_u.mutation.ClearPhone()
+	return _u
+}
+
+// SetMemo sets the "memo" field.
+func (_u *TenantUpdateOne) SetMemo(v string) *TenantUpdateOne {
+	_u.mutation.SetMemo(v)
+	return _u
+}
+
+// SetNillableMemo sets the "memo" field if the given value is not nil.
+func (_u *TenantUpdateOne) SetNillableMemo(v *string) *TenantUpdateOne {
+	if v != nil {
+		_u.SetMemo(*v)
+	}
+	return _u
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (_u *TenantUpdateOne) ClearMemo() *TenantUpdateOne {
+	_u.mutation.ClearMemo()
+	return _u
+}
+
+// AddOccupancyIDs adds the "occupancies" edge to the Occupancy entity by IDs.
+func (_u *TenantUpdateOne) AddOccupancyIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.AddOccupancyIDs(ids...)
+	return _u
+}
+
+// AddOccupancies adds the "occupancies" edges to the Occupancy entity.
+func (_u *TenantUpdateOne) AddOccupancies(v ...*Occupancy) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccupancyIDs(ids...)
+}
+
+// Mutation returns the TenantMutation object of the builder.
+func (_u *TenantUpdateOne) Mutation() *TenantMutation {
+	return _u.mutation
+}
+
+// ClearOccupancies clears all "occupancies" edges to the Occupancy entity.
+func (_u *TenantUpdateOne) ClearOccupancies() *TenantUpdateOne {
+	_u.mutation.ClearOccupancies()
+	return _u
+}
+
+// RemoveOccupancyIDs removes the "occupancies" edge to Occupancy entities by IDs.
+func (_u *TenantUpdateOne) RemoveOccupancyIDs(ids ...string) *TenantUpdateOne {
+	_u.mutation.RemoveOccupancyIDs(ids...)
+	return _u
+}
+
+// RemoveOccupancies removes "occupancies" edges to Occupancy entities.
+func (_u *TenantUpdateOne) RemoveOccupancies(v ...*Occupancy) *TenantUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccupancyIDs(ids...)
+}
+
+// Where appends a list predicates to the TenantUpdate builder.
+func (_u *TenantUpdateOne) Where(ps ...predicate.Tenant) *TenantUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TenantUpdateOne) Select(field string, fields ...string) *TenantUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Tenant entity.
+func (_u *TenantUpdateOne) Save(ctx context.Context) (*Tenant, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TenantUpdateOne) SaveX(ctx context.Context) *Tenant {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TenantUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TenantUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TenantUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if tenant.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized tenant.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := tenant.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TenantUpdateOne) check() error {
+	if v, ok := _u.mutation.Code(); ok {
+		if err := tenant.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "Tenant.code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := tenant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Tenant.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TenantUpdateOne) sqlSave(ctx context.Context) (_node *Tenant, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tenant.Table, tenant.Columns, sqlgraph.NewFieldSpec(tenant.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Tenant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tenant.FieldID)
+		for _, f := range fields {
+			if !tenant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tenant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(tenant.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Code(); ok {
+		_spec.SetField(tenant.FieldCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(tenant.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Contact(); ok {
+		_spec.SetField(tenant.FieldContact, field.TypeString, value)
+	}
+	if _u.mutation.ContactCleared() {
+		_spec.ClearField(tenant.FieldContact, field.TypeString)
+	}
+	if value, ok := _u.mutation.Phone(); ok {
+		_spec.SetField(tenant.FieldPhone, field.TypeString, value)
+	}
+	if _u.mutation.PhoneCleared() {
+		_spec.ClearField(tenant.FieldPhone, field.TypeString)
+	}
+	if value, ok := _u.mutation.Memo(); ok {
+		_spec.SetField(tenant.FieldMemo, field.TypeString, value)
+	}
+	if _u.mutation.MemoCleared() {
+		_spec.ClearField(tenant.FieldMemo, field.TypeString)
+	}
+	if _u.mutation.OccupanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccupanciesIDs(); len(nodes) > 0 && !_u.mutation.OccupanciesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccupanciesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   tenant.OccupanciesTable,
+			Columns: []string{tenant.OccupanciesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(occupancy.FieldID, field.TypeString),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Tenant{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tenant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -16,6 +16,20 @@ type Tx struct {
 	config
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceAttr is the client for interacting with the DeviceAttr builders.
+	DeviceAttr *DeviceAttrClient
+	// DeviceHistory is the client for interacting with the DeviceHistory builders.
+	DeviceHistory *DeviceHistoryClient
+	// DriverModel is the client for interacting with the DriverModel builders.
+	DriverModel *DriverModelClient
+	// EdgeBox is the client for interacting with the EdgeBox builders.
+	EdgeBox *EdgeBoxClient
+	// Location is the client for interacting with the Location builders.
+	Location *LocationClient
+	// Occupancy is the client for interacting with the Occupancy builders.
+	Occupancy *OccupancyClient
+	// Tenant is the client for interacting with the Tenant builders.
+	Tenant *TenantClient
 
 	// lazily loaded.
 	client     *Client
@@ -148,6 +162,13 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.Device = NewDeviceClient(tx.config)
+	tx.DeviceAttr = NewDeviceAttrClient(tx.config)
+	tx.DeviceHistory = NewDeviceHistoryClient(tx.config)
+	tx.DriverModel = NewDriverModelClient(tx.config)
+	tx.EdgeBox = NewEdgeBoxClient(tx.config)
+	tx.Location = NewLocationClient(tx.config)
+	tx.Occupancy = NewOccupancyClient(tx.config)
+	tx.Tenant = NewTenantClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -0,0 +1,240 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+var (
+	ErrLocationKind   = errors.New("invalid location level")
+	ErrLocationInUse  = errors.New("location in use")
+	ErrProjectChanged = errors.New("device can not move to another project")
+	ErrProjectExists  = errors.New("project already exists")
+)
+
+// 每一层的上级, 项目没有上级
+var parentKind = map[location.Kind]location.Kind{
+	location.KindBuilding: location.KindProject,
+	location.KindFloor:    location.KindBuilding,
+	location.KindUnit:     location.KindFloor,
+}
+
+type LocationInput struct {
+	Kind     location.Kind
+	Code     string
+	Name     string
+	Memo     string
+	ParentID *string
+}
+
+// CreateLocation 检查层级, 项目编号全局唯一, 其他层在同一上级下唯一(都由索引保证)
+func (x DBx) CreateLocation(ctx context.Context, in LocationInput) (*ent.Location, error) {
+	cr := x.Client.Location.Create().
+		SetKind(in.Kind).
+		SetCode(in.Code).
+		SetName(in.Name).
+		SetMemo(in.Memo)
+
+	if in.Kind == location.KindProject {
+		if in.ParentID != nil {
+			return nil, fmt.Errorf("%w: project has no parent", ErrLocationKind)
+		}
+		loc, err := cr.SetProject(in.Code).Save(ctx)
+		if ent.IsConstraintError(err) {
+			return nil, fmt.Errorf("%w: %s", ErrProjectExists, in.Code)
+		}
+		return loc, err
+	}
+
+	if in.ParentID == nil {
+		return nil, fmt.Errorf("%w: %s needs a parent", ErrLocationKind, in.Kind)
+	}
+	parent, err := x.Client.Location.Get(ctx, *in.ParentID)
+	if err != nil {
+		return nil, err
+	}
+	if parentKind[in.Kind] != parent.Kind {
+		return nil, fmt.Errorf("%w: %s can not be under %s", ErrLocationKind, in.Kind, parent.Kind)
+	}
+	return cr.SetParent(parent).SetProject(parent.Project).Save(ctx)
+}
+
+type LocationPatch struct {
+	Code *string
+	Name *string
+	Memo *string
+}
+
+// ModifyLocation 设备的 pos_code 跟随位置编号
+// 项目改成已有的编号时返回 ErrProjectExists, 项目下的位置, 设备(包括软删除的)和网关的项目编号一起修改
+func (x DBx) ModifyLocation(ctx context.Context, id string, p LocationPatch) (*ent.Location, error) {
+	var loc *ent.Location
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		old, err := tx.Location.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		update := tx.Location.UpdateOne(old)
+		if p.Name != nil {
+			update.SetName(*p.Name)
+		}
+		if p.Memo != nil {
+			update.SetMemo(*p.Memo)
+		}
+		renamed := p.Code != nil && *p.Code != old.Code
+		if !renamed {
+			loc, err = update.Save(ctx)
+			return err
+		}
+
+		code := *p.Code
+		update.SetCode(code)
+		if old.Kind == location.KindProject {
+			if err := renameProject(ctx, tx, old.Code, code); err != nil {
+				return err
+			}
+		}
+
+		if loc, err = update.Save(ctx); err != nil {
+			if old.Kind == location.KindProject && ent.IsConstraintError(err) {
+				return fmt.Errorf("%w: %s", ErrProjectExists, code)
+			}
+			return err
+		}
+		return tx.Device.Update().
+			Where(device.LocationID(loc.ID), device.PosCodeNEQ(code)).
+			SetPosCode(code).
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return loc.Unwrap(), nil
+}
+
+// renameProject 项目编号冗余在位置, 设备和网关上, 用于按项目授权
+func renameProject(ctx context.Context, tx *ent.Tx, from, to string) error {
+	if err := tx.Location.Update().Where(location.Project(from)).SetProject(to).Exec(ctx); err != nil {
+		return err
+	}
+	err := tx.Device.Update().Where(device.Project(from)).SetProject(to).Exec(schema.SkipSoftDelete(ctx))
+	if err != nil {
+		return err
+	}
+	return tx.EdgeBox.Update().Where(edgebox.Project(from)).SetProject(to).Exec(ctx)
+}
+
+// RemoveLocation 只能删除没有下级, 没有设备, 没有占用记录的位置
+func (x DBx) RemoveLocation(ctx context.Context, id string) (*ent.Location, error) {
+	loc, err := x.Client.Location.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	checks := []struct {
+		name  string
+		exist func(context.Context) (bool, error)
+	}{
+		{"children", loc.QueryChildren().Exist},
+		{"devices", loc.QueryDevices().Exist},
+		{"occupancies", loc.QueryOccupancies().Exist},
+	}
+	for _, c := range checks {
+		exist, err := c.exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			return nil, fmt.Errorf("%w: %s %s has %s", ErrLocationInUse, loc.Kind, loc.Code, c.name)
+		}
+	}
+
+	return loc, x.Client.Location.DeleteOne(loc).Exec(ctx)
+}
+
+// ProjectOf 向上找到所在的项目
+func (x DBx) ProjectOf(ctx context.Context, loc *ent.Location) (*ent.Location, error) {
+	for loc.Kind != location.KindProject {
+		if loc.ParentID == nil {
+			return nil, fmt.Errorf("%w: %s %s has no parent", ErrLocationKind, loc.Kind, loc.Code)
+		}
+		parent, err := x.Client.Location.Get(ctx, *loc.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		loc = parent
+	}
+	return loc, nil
+}
+
+// Tree 项目下的全部位置, 按层级排序, 上级一定在下级之前
+func (x DBx) Tree(ctx context.Context, project string) ([]*ent.Location, error) {
+	root, err := x.Client.Location.Query().
+		Where(location.KindEQ(location.KindProject), location.CodeEQ(project)).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := []*ent.Location{root}
+	level := []string{root.ID}
+	for len(level) > 0 {
+		children, err := x.Client.Location.Query().
+			Where(location.ParentIDIn(level...)).
+			Order(ent.Asc(location.FieldCode)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		level = level[:0]
+		for _, c := range children {
+			tree = append(tree, c)
+			level = append(level, c.ID)
+		}
+	}
+	return tree, nil
+}
+
+// MoveDevice 设备移到新的位置, pos_code 同步为位置的编号
+// 设备只能装在楼层或者单元上, 项目不能改变, 新位置必须在同一个项目下
+func (x DBx) MoveDevice(ctx context.Context, deviceID, locationID string) (*ent.Device, error) {
+	var moved *ent.Device
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		d, err := tx.Device.Query().Where(device.ID(deviceID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+		loc, err := tx.Location.Get(ctx, locationID)
+		if err != nil {
+			return err
+		}
+		if loc.Kind != location.KindFloor && loc.Kind != location.KindUnit {
+			return fmt.Errorf("%w: device can not be placed on %s %s", ErrLocationKind, loc.Kind, loc.Code)
+		}
+		project, err := DBx{Client: tx.Client()}.ProjectOf(ctx, loc)
+		if err != nil {
+			return err
+		}
+		if project.Code != d.Project {
+			return fmt.Errorf("%w: %s -> %s", ErrProjectChanged, d.Project, project.Code)
+		}
+
+		moved, err = tx.Device.UpdateOne(d).
+			SetLocation(loc).
+			SetPosCode(loc.Code).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return moved.Unwrap(), nil
+}
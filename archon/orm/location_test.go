@@ -0,0 +1,232 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/archon/audit"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+)
+
+func testDBx(t *testing.T) DBx {
+	t.Helper()
+	cli, err := OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	if err := cli.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	cli.Device.Use(audit.Hook())
+	return DBx{Client: cli}
+}
+
+func mustLocation(t *testing.T, x DBx, kind location.Kind, code string, parent *ent.Location) *ent.Location {
+	t.Helper()
+	in := LocationInput{Kind: kind, Code: code}
+	if parent != nil {
+		in.ParentID = &parent.ID
+	}
+	loc, err := x.CreateLocation(context.Background(), in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return loc
+}
+
+// mustTx 设备变更必须在事务中, 和审计记录一起提交
+func mustTx(t *testing.T, ctx context.Context, x DBx, fn func(tx *ent.Tx) error) {
+	t.Helper()
+	if err := WithTx(ctx, x.Client, fn); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func mustDevice(t *testing.T, ctx context.Context, x DBx, code string) *ent.Device {
+	t.Helper()
+	var d *ent.Device
+	mustTx(t, ctx, x, func(tx *ent.Tx) (err error) {
+		d, err = tx.Device.Create().SetDeviceCode(code).SetDeviceType("E").SetProject("P1").Save(ctx)
+		return
+	})
+	return d.Unwrap()
+}
+
+func TestCreateProjectUnique(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	p1 := mustLocation(t, x, location.KindProject, "P1", nil)
+	p2 := mustLocation(t, x, location.KindProject, "P2", nil)
+
+	if _, err := x.CreateLocation(ctx, LocationInput{Kind: location.KindProject, Code: "P1"}); !errors.Is(err, ErrProjectExists) {
+		t.Fatalf("duplicate project: err = %v, want ErrProjectExists", err)
+	}
+	// 唯一索引只约束项目, 不同项目下的楼栋可以同名
+	mustLocation(t, x, location.KindBuilding, "P1", p1)
+	mustLocation(t, x, location.KindBuilding, "P1", p2)
+}
+
+func TestModifyLocationRenamesProject(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	p1 := mustLocation(t, x, location.KindProject, "P1", nil)
+	b1 := mustLocation(t, x, location.KindBuilding, "B1", p1)
+	mustLocation(t, x, location.KindProject, "P3", nil)
+	d := mustDevice(t, ctx, x, "D1")
+	box := x.Client.EdgeBox.Create().SetCode("BOX1").SetProject("P1").SetURL("http://box1").SaveX(ctx)
+
+	code := "P3"
+	if _, err := x.ModifyLocation(ctx, p1.ID, LocationPatch{Code: &code}); !errors.Is(err, ErrProjectExists) {
+		t.Fatalf("rename to existing project: err = %v, want ErrProjectExists", err)
+	}
+
+	code = "P2"
+	loc, err := x.ModifyLocation(ctx, p1.ID, LocationPatch{Code: &code})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loc.Code != "P2" || loc.Project != "P2" {
+		t.Errorf("project = %s/%s, want P2/P2", loc.Code, loc.Project)
+	}
+	if got := x.Client.Location.GetX(ctx, b1.ID).Project; got != "P2" {
+		t.Errorf("building project = %s, want P2", got)
+	}
+	if got := x.Client.Device.GetX(ctx, d.ID).Project; got != "P2" {
+		t.Errorf("device project = %s, want P2", got)
+	}
+	if got := x.Client.EdgeBox.GetX(ctx, box.ID).Project; got != "P2" {
+		t.Errorf("edge box project = %s, want P2", got)
+	}
+}
+
+func TestMoveDeviceOnlyToFloorOrUnit(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	p := mustLocation(t, x, location.KindProject, "P1", nil)
+	b := mustLocation(t, x, location.KindBuilding, "B1", p)
+	f := mustLocation(t, x, location.KindFloor, "F1", b)
+	u := mustLocation(t, x, location.KindUnit, "101", f)
+	d := mustDevice(t, ctx, x, "D1")
+
+	for _, loc := range []*ent.Location{p, b} {
+		if _, err := x.MoveDevice(ctx, d.ID, loc.ID); !errors.Is(err, ErrLocationKind) {
+			t.Errorf("move to %s: err = %v, want ErrLocationKind", loc.Kind, err)
+		}
+	}
+	for _, loc := range []*ent.Location{f, u} {
+		moved, err := x.MoveDevice(ctx, d.ID, loc.ID)
+		if err != nil {
+			t.Fatalf("move to %s: %v", loc.Kind, err)
+		}
+		if moved.PosCode != loc.Code {
+			t.Errorf("pos_code = %s, want %s", moved.PosCode, loc.Code)
+		}
+	}
+}
+
+func TestTenantMetersFollowsDeviceMoves(t *testing.T) {
+	ctx := context.Background()
+	x := testDBx(t)
+	p := mustLocation(t, x, location.KindProject, "P1", nil)
+	b := mustLocation(t, x, location.KindBuilding, "B1", p)
+	f := mustLocation(t, x, location.KindFloor, "F1", b)
+	u1 := mustLocation(t, x, location.KindUnit, "101", f)
+	u2 := mustLocation(t, x, location.KindUnit, "102", f)
+
+	base := time.Now().UTC().Truncate(time.Second).AddDate(0, 0, -10)
+	day := func(n int) time.Time { return base.AddDate(0, 0, n) }
+
+	// 第1天装在101, 第3天移到102, 第5天换了一块新表装在101
+	d1 := mustDevice(t, audit.WithEffective(ctx, day(0)), x, "D1")
+	if _, err := x.MoveDevice(audit.WithEffective(ctx, day(1)), d1.ID, u1.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := x.MoveDevice(audit.WithEffective(ctx, day(3)), d1.ID, u2.ID); err != nil {
+		t.Fatal(err)
+	}
+	d2 := mustDevice(t, audit.WithEffective(ctx, day(5)), x, "D2")
+	if _, err := x.MoveDevice(audit.WithEffective(ctx, day(5)), d2.ID, u1.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	tenant := x.Client.Tenant.Create().SetCode("T1").SetName("tenant").SaveX(ctx)
+	if _, err := x.CreateOccupancy(ctx, OccupancyInput{
+		TenantID: tenant.ID, UnitID: u1.ID, Role: occupancy.RoleTenant, StartAt: day(2),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ms, err := x.TenantMeters(ctx, tenant.ID, day(0), day(8))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		code     string
+		from, to time.Time
+	}{
+		{"D1", day(2), day(3)},
+		{"D2", day(5), day(8)},
+	}
+	if len(ms) != len(want) {
+		t.Fatalf("got %d meters, want %d", len(ms), len(want))
+	}
+	for i, w := range want {
+		m := ms[i]
+		if m.Device.DeviceCode != w.code || !m.From.Equal(w.from) || m.To == nil || !m.To.Equal(w.to) {
+			t.Errorf("meter %d = %s [%v, %v), want %s [%v, %v)", i, m.Device.DeviceCode, m.From, m.To, w.code, w.from, w.to)
+		}
+	}
+}
+
+func TestCreateOccupancyConcurrent(t *testing.T) {
+	ctx := context.Background()
+	// 内存库的共享缓存遇到锁直接报错, 用文件库, immediate 事务排队执行
+	cli, err := OpenEntClient("sqlite3", "file:"+filepath.Join(t.TempDir(), "archon.db")+"?_fk=1&_txlock=immediate&_busy_timeout=5000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	x := DBx{Client: cli}
+	p := mustLocation(t, x, location.KindProject, "P1", nil)
+	b := mustLocation(t, x, location.KindBuilding, "B1", p)
+	f := mustLocation(t, x, location.KindFloor, "F1", b)
+	u := mustLocation(t, x, location.KindUnit, "101", f)
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		tenant := x.Client.Tenant.Create().SetCode(fmt.Sprintf("T%d", i)).SetName("tenant").SaveX(ctx)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = x.CreateOccupancy(ctx, OccupancyInput{
+				TenantID: tenant.ID, UnitID: u.ID, Role: occupancy.RoleTenant, StartAt: start.AddDate(0, 0, i),
+			})
+		}()
+	}
+	wg.Wait()
+
+	if (errs[0] == nil) == (errs[1] == nil) {
+		t.Fatalf("errs = %v, want exactly one success", errs)
+	}
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, ErrOverlap) {
+			t.Fatalf("err = %v, want ErrOverlap", err)
+		}
+	}
+	if n := x.Client.Occupancy.Query().CountX(ctx); n != 1 {
+		t.Fatalf("%d occupancies, want 1", n)
+	}
+}
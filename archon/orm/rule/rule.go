@@ -0,0 +1,276 @@
+// Package rule ent 的权限规则
+// context 中没有 auth.Claims 的是服务内部的调用, 不做限制
+// 查询按调用方的项目过滤, 修改需要对应的角色, 并且只能修改自己项目的数据
+package rule
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/devicehistory"
+	"github.com/twiglab/h2o/archon/orm/ent/edgebox"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+// 维护档案的角色
+var editors = []string{auth.RoleOperator}
+
+// 维护业主, 租户和占用期间的角色
+var tenantEditors = []string{auth.RoleOperator, auth.RoleFinance}
+
+// 物理删除软删除的设备的角色
+var purgers = []string{auth.RoleAdmin}
+
+// 维护 driver-box 设备模型的角色, 模型不属于某个项目, 所有网关共用
+var modelEditors = []string{auth.RoleAdmin}
+
+// read 返回 nil 表示不限项目
+func read(ctx context.Context) ([]string, bool, error) {
+	c, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, true, nil
+	}
+	if !c.CanRead() {
+		return nil, false, privacy.Denyf("%s: no role", c.Subject)
+	}
+	ps, all := c.Scope()
+	return ps, all, nil
+}
+
+// write 返回 nil 表示不限项目
+func write(ctx context.Context, roles []string) ([]string, bool, error) {
+	c, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, true, nil
+	}
+	if !c.Has(roles...) {
+		return nil, false, privacy.Denyf("%s: read only", c.Subject)
+	}
+	ps, all := c.Scope()
+	return ps, all, nil
+}
+
+func inScope(ctx context.Context, project string) error {
+	if c, ok := auth.FromContext(ctx); ok && !c.InScope(project) {
+		return privacy.Denyf("%s: project %s", c.Subject, project)
+	}
+	return nil
+}
+
+// DeviceQuery 只能查到自己项目的设备
+func DeviceQuery() privacy.QueryRule {
+	return privacy.DeviceQueryRuleFunc(func(ctx context.Context, q *ent.DeviceQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(device.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+// DeviceMutation 新建的设备必须属于自己的项目, 修改和删除只作用于自己项目的设备
+// 平时只做软删除, 物理删除只用于清理
+func DeviceMutation() privacy.MutationRule {
+	return privacy.DeviceMutationRuleFunc(func(ctx context.Context, m *ent.DeviceMutation) error {
+		roles := editors
+		if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+			roles = purgers
+		}
+		ps, all, err := write(ctx, roles)
+		if err != nil || all {
+			return decide(err)
+		}
+		p, set := m.Project()
+		if m.Op().Is(ent.OpCreate) {
+			return decide(inScope(ctx, p))
+		}
+		// 修改项目编号时, 新的项目也要在范围内
+		if set {
+			if err := inScope(ctx, p); err != nil {
+				return err
+			}
+		}
+		m.Where(device.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+func LocationQuery() privacy.QueryRule {
+	return privacy.LocationQueryRuleFunc(func(ctx context.Context, q *ent.LocationQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(location.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+func LocationMutation() privacy.MutationRule {
+	return privacy.LocationMutationRuleFunc(func(ctx context.Context, m *ent.LocationMutation) error {
+		ps, all, err := write(ctx, editors)
+		if err != nil || all {
+			return decide(err)
+		}
+		p, set := m.Project()
+		if m.Op().Is(ent.OpCreate) {
+			return decide(inScope(ctx, p))
+		}
+		// 修改项目编号时, 新的项目也要在范围内
+		if set {
+			if err := inScope(ctx, p); err != nil {
+				return err
+			}
+		}
+		m.Where(location.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+// TenantQuery 业主和租户不属于某个项目, 有角色即可查询
+func TenantQuery() privacy.QueryRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		_, _, err := read(ctx)
+		return decide(err)
+	})
+}
+
+func TenantMutation() privacy.MutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		_, _, err := write(ctx, tenantEditors)
+		return decide(err)
+	})
+}
+
+// OccupancyQuery 按单元所在的项目过滤
+func OccupancyQuery() privacy.QueryRule {
+	return privacy.OccupancyQueryRuleFunc(func(ctx context.Context, q *ent.OccupancyQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(occupancy.HasUnitWith(location.ProjectIn(ps...)))
+		return privacy.Allow
+	})
+}
+
+// OccupancyMutation 新建时单元必须能查到, 即属于自己的项目
+func OccupancyMutation() privacy.MutationRule {
+	return privacy.OccupancyMutationRuleFunc(func(ctx context.Context, m *ent.OccupancyMutation) error {
+		ps, all, err := write(ctx, tenantEditors)
+		if err != nil || all {
+			return decide(err)
+		}
+		if m.Op().Is(ent.OpCreate) {
+			id, _ := m.UnitID()
+			exist, err := m.Client().Location.Query().Where(location.ID(id)).Exist(ctx)
+			if err != nil {
+				return err
+			}
+			if !exist {
+				return privacy.Denyf("unit %s not in scope", id)
+			}
+			return privacy.Allow
+		}
+		m.Where(occupancy.HasUnitWith(location.ProjectIn(ps...)))
+		return privacy.Allow
+	})
+}
+
+func EdgeBoxQuery() privacy.QueryRule {
+	return privacy.EdgeBoxQueryRuleFunc(func(ctx context.Context, q *ent.EdgeBoxQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(edgebox.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+func EdgeBoxMutation() privacy.MutationRule {
+	return privacy.EdgeBoxMutationRuleFunc(func(ctx context.Context, m *ent.EdgeBoxMutation) error {
+		ps, all, err := write(ctx, editors)
+		if err != nil || all {
+			return decide(err)
+		}
+		p, set := m.Project()
+		if m.Op().Is(ent.OpCreate) {
+			return decide(inScope(ctx, p))
+		}
+		// 修改项目编号时, 新的项目也要在范围内
+		if set {
+			if err := inScope(ctx, p); err != nil {
+				return err
+			}
+		}
+		m.Where(edgebox.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+func DriverModelQuery() privacy.QueryRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		_, _, err := read(ctx)
+		return decide(err)
+	})
+}
+
+func DriverModelMutation() privacy.MutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		_, _, err := write(ctx, modelEditors)
+		return decide(err)
+	})
+}
+
+// DeviceHistoryQuery 变更记录由 audit.Hook 写入, 只限制查询
+func DeviceHistoryQuery() privacy.QueryRule {
+	return privacy.DeviceHistoryQueryRuleFunc(func(ctx context.Context, q *ent.DeviceHistoryQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(predicate.DeviceHistory(deviceIn(devicehistory.FieldDeviceID, ps)))
+		return privacy.Allow
+	})
+}
+
+func DeviceAttrQuery() privacy.QueryRule {
+	return privacy.DeviceAttrQueryRuleFunc(func(ctx context.Context, q *ent.DeviceAttrQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(predicate.DeviceAttr(deviceIn(deviceattr.FieldDeviceID, ps)))
+		return privacy.Allow
+	})
+}
+
+// deviceIn 记录的设备属于指定的项目, 包括已经删除的设备
+func deviceIn(column string, projects []string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		t := sql.Table(device.Table)
+		vs := make([]any, len(projects))
+		for i, p := range projects {
+			vs[i] = p
+		}
+		s.Where(sql.In(s.C(column),
+			sql.Select(t.C(device.FieldID)).From(t).Where(sql.In(t.C(device.FieldProject), vs...)),
+		))
+	}
+}
+
+func decide(err error) error {
+	if err != nil {
+		return err
+	}
+	return privacy.Allow
+}
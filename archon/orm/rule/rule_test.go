@@ -0,0 +1,132 @@
+package rule_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/twiglab/h2o/archon/orm"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+func testClient(t *testing.T) *ent.Client {
+	t.Helper()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { cli.Close() })
+	if err := cli.Schema.Create(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return cli
+}
+
+func as(roles []string, projects ...string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Claims{Subject: "u", Roles: roles, Projects: projects})
+}
+
+var (
+	operator = []string{auth.RoleOperator}
+	readOnly = []string{auth.RoleReadOnly}
+	admin    = []string{auth.RoleAdmin}
+)
+
+func TestDeviceRules(t *testing.T) {
+	cli := testClient(t)
+	// 没有登录信息的是服务内部调用, 不限制
+	internal := context.Background()
+	for _, p := range []string{"P1", "P2"} {
+		cli.Device.Create().SetDeviceCode("D-" + p).SetDeviceType("E").SetProject(p).SaveX(internal)
+	}
+
+	reads := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"内部调用", internal, []string{"D-P1", "D-P2"}},
+		{"只读 P1", as(readOnly, "P1"), []string{"D-P1"}},
+		{"全部项目", as(readOnly, auth.AllProjects), []string{"D-P1", "D-P2"}},
+		{"没有项目", as(readOnly), nil},
+	}
+	for _, tt := range reads {
+		got, err := cli.Device.Query().Order(device.ByDeviceCode()).Select(device.FieldDeviceCode).Strings(tt.ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := cli.Device.Query().All(as(nil, "P1")); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("query without role: err = %v, want deny", err)
+	}
+
+	writes := []struct {
+		name string
+		ctx  context.Context
+		deny bool
+	}{
+		{"只读不能新建", as(readOnly, "P1"), true},
+		{"不能建在别的项目", as(operator, "P2"), true},
+		{"维护自己的项目", as(operator, "P1"), false},
+	}
+	for i, tt := range writes {
+		_, err := cli.Device.Create().SetDeviceCode(tt.name).SetDeviceType("E").SetProject("P1").Save(tt.ctx)
+		if errors.Is(err, privacy.Deny) != tt.deny || (!tt.deny && err != nil) {
+			t.Fatalf("%d %s: err = %v, want deny %v", i, tt.name, err, tt.deny)
+		}
+	}
+
+	// 批量修改只作用于自己项目的设备
+	n, err := cli.Device.Update().SetMemo("m").Save(as(operator, "P1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Fatalf("updated %d, want the 2 devices of P1", n)
+	}
+	// 不能把设备改到别的项目
+	if _, err := cli.Device.Update().Where(device.DeviceCode("D-P1")).SetProject("P2").Save(as(operator, "P1")); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("move to P2: err = %v, want deny", err)
+	}
+
+	// 物理删除只给 admin
+	if _, err := cli.Device.Delete().Where(device.DeviceCode("D-P1")).Exec(as(operator, "P1")); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("purge by operator: err = %v, want deny", err)
+	}
+	if n, err := cli.Device.Delete().Where(device.DeviceCode("D-P1")).Exec(as(admin, "P1")); err != nil || n != 1 {
+		t.Fatalf("purge by admin: %d %v", n, err)
+	}
+}
+
+func TestLocationAndTenantRules(t *testing.T) {
+	cli := testClient(t)
+	internal := context.Background()
+	cli.Location.Create().SetKind(location.KindProject).SetCode("P1").SetProject("P1").SaveX(internal)
+	cli.Location.Create().SetKind(location.KindProject).SetCode("P2").SetProject("P2").SaveX(internal)
+
+	if n := cli.Location.Query().CountX(as(readOnly, "P2")); n != 1 {
+		t.Fatalf("read-only P2 sees %d locations, want 1", n)
+	}
+	if _, err := cli.Location.Create().SetKind(location.KindProject).SetCode("P3").SetProject("P3").Save(as(readOnly, auth.AllProjects)); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("create by read-only: err = %v, want deny", err)
+	}
+
+	// 业主租户不分项目, 财务可以维护, 只读不行
+	if _, err := cli.Tenant.Create().SetCode("T1").SetName("t").Save(as([]string{auth.RoleFinance})); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Tenant.Create().SetCode("T2").SetName("t").Save(as(readOnly)); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("tenant by read-only: err = %v, want deny", err)
+	}
+	if n := cli.Tenant.Query().CountX(as(readOnly)); n != 1 {
+		t.Fatalf("read-only sees %d tenants, want 1", n)
+	}
+}
@@ -7,10 +7,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/archon/orm/rule"
 )
 
 func id() string {
@@ -49,34 +52,53 @@ func (Device) Fields() []ent.Field {
 
 		field.Int("rate").Default(1).Comment("当前倍率"),
 
-		field.String("project").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("项目编号"),
+		field.String("project").NotEmpty().SchemaType(varchar(64)).Comment("项目编号"),
 		field.String("pos_code").Optional().SchemaType(varchar(64)).Comment("位置编号"),
 		field.String("area_code").Optional().SchemaType(varchar(64)).Comment("区域编号"),
 		field.String("pcode").Optional().SchemaType(varchar(64)).Comment("对外位置编号"),
 
+		field.String("parent_id").Optional().Nillable().SchemaType(char(36)).Comment("上级表, 总表"),
+
+		field.String("location_id").Optional().Nillable().SchemaType(char(36)).Comment("所在位置, pos_code 与位置编号一致"),
+
+		field.String("box_id").Optional().Nillable().SchemaType(char(36)).Comment("接入的边缘网关"),
+		field.String("conn_key").Optional().SchemaType(varchar(64)).Comment("网关上的连接"),
+		field.String("model_name").Optional().SchemaType(varchar(64)).Comment("driver-box 设备模型"),
+		field.JSON("driver_props", map[string]string{}).Optional().Comment("协议参数, 如 modbus 的 unitID"),
+
 		field.Int("status").Default(0).Comment("状态"),
 
 		field.String("memo").Optional().SchemaType(varchar(128)).Comment("备注"),
+	}
+}
 
-		field.Int("is_del").Default(0).Comment("软删除"),
+func (Device) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("location", Location.Type).Ref("devices").Unique().Field("location_id"),
+		edge.To("children", Device.Type).From("parent").Unique().Field("parent_id"),
+		edge.From("box", EdgeBox.Type).Ref("devices").Unique().Field("box_id"),
 	}
 }
 
 func (Device) Mixin() []ent.Mixin {
 	return []ent.Mixin{
 		mixin.Time{},
+		SoftDelete{},
 	}
 }
 
 func (Device) Indexes() []ent.Index {
 	return []ent.Index{
-		index.Fields("device_code").Unique(),
+		index.Fields("device_code", "is_del").Unique(),
 		index.Fields("device_type"),
 		index.Fields("device_sn"),
 
 		index.Fields("pos_code"),
 		index.Fields("project"),
 		index.Fields("pcode"),
+		index.Fields("location_id"),
+		index.Fields("parent_id"),
+		index.Fields("box_id"),
 	}
 }
 
@@ -85,3 +107,10 @@ func (Device) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "device"},
 	}
 }
+
+func (Device) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.DeviceQuery()},
+		Mutation: privacy.MutationPolicy{rule.DeviceMutation()},
+	}
+}
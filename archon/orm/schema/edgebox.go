@@ -0,0 +1,116 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/archon/orm/rule"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// EdgeBox 现场的边缘网关(nab), 设备通过它的连接接入
+type EdgeBox struct {
+	ent.Schema
+}
+
+func (EdgeBox) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("code").NotEmpty().SchemaType(varchar(64)).Comment("编号"),
+		field.String("name").Optional().SchemaType(varchar(64)).Comment("名称"),
+		field.String("project").NotEmpty().SchemaType(varchar(64)).Comment("项目编号"),
+
+		field.String("url").NotEmpty().SchemaType(varchar(256)).Comment("REST 接口地址"),
+		field.String("token").Optional().Sensitive().SchemaType(varchar(256)).Comment("下发配置的 token"),
+
+		field.JSON("connections", map[string]provision.Connection{}).Optional().Comment("连接, key 为 connectionKey"),
+
+		field.Time("push_time").Optional().Nillable().Comment("最后下发时间"),
+		field.String("push_version").Optional().SchemaType(varchar(64)).Comment("最后下发成功的版本"),
+		field.String("push_error").Optional().Comment("最后下发的错误"),
+
+		field.String("memo").Optional().SchemaType(varchar(128)).Comment("备注"),
+	}
+}
+
+func (EdgeBox) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("devices", Device.Type),
+	}
+}
+
+func (EdgeBox) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (EdgeBox) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique(),
+		index.Fields("project"),
+	}
+}
+
+func (EdgeBox) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "edge_box"},
+	}
+}
+
+func (EdgeBox) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.EdgeBoxQuery()},
+		Mutation: privacy.MutationPolicy{rule.EdgeBoxMutation()},
+	}
+}
+
+// DriverModel driver-box 的设备模型, 点位的格式由插件决定, 原样下发
+type DriverModel struct {
+	ent.Schema
+}
+
+func (DriverModel) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("name").NotEmpty().SchemaType(varchar(64)).Comment("模型名称"),
+		field.String("plugin").NotEmpty().SchemaType(varchar(64)).Comment("插件, 如 modbus"),
+		field.String("model_id").Optional().SchemaType(varchar(64)).Comment("云端模型 ID"),
+		field.String("description").Optional().SchemaType(varchar(128)).Comment("描述"),
+
+		field.JSON("attributes", map[string]any{}).Optional().Comment("扩展属性"),
+		field.JSON("points", []map[string]any{}).Comment("点位"),
+	}
+}
+
+func (DriverModel) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (DriverModel) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").Unique(),
+	}
+}
+
+func (DriverModel) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "driver_model"},
+	}
+}
+
+func (DriverModel) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.DriverModelQuery()},
+		Mutation: privacy.MutationPolicy{rule.DriverModelMutation()},
+	}
+}
@@ -0,0 +1,103 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/archon/orm/rule"
+)
+
+// DeviceHistory 设备的变更记录, 只增不改
+type DeviceHistory struct {
+	ent.Schema
+}
+
+func (DeviceHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("device_id").Immutable().NotEmpty().SchemaType(char(36)).Comment("设备"),
+		field.String("device_code").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("设备号"),
+
+		field.Enum("op").Values("create", "update", "remove", "restore", "delete").Immutable().Comment("操作"),
+		field.Strings("fields").Immutable().Optional().Comment("变化的字段"),
+
+		field.JSON("before", map[string]any{}).Immutable().Optional().Comment("变更前"),
+		field.JSON("after", map[string]any{}).Immutable().Optional().Comment("变更后"),
+
+		field.String("actor").Immutable().Optional().SchemaType(varchar(64)).Comment("操作人"),
+		field.String("reason").Immutable().Optional().SchemaType(varchar(256)).Comment("原因"),
+	}
+}
+
+func (DeviceHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreateTime{},
+	}
+}
+
+func (DeviceHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "create_time"),
+		index.Fields("device_code", "create_time"),
+		index.Fields("actor"),
+	}
+}
+
+func (DeviceHistory) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "device_history"},
+	}
+}
+
+func (DeviceHistory) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{rule.DeviceHistoryQuery()},
+	}
+}
+
+// DeviceAttr 按时间生效的设备属性, 如倍率, 位置
+// 有效期 [valid_from, valid_to), valid_to 为空表示当前值
+type DeviceAttr struct {
+	ent.Schema
+}
+
+func (DeviceAttr) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("device_id").Immutable().NotEmpty().SchemaType(char(36)).Comment("设备"),
+		field.String("device_code").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("设备号"),
+
+		field.String("name").Immutable().NotEmpty().SchemaType(varchar(32)).Comment("属性名"),
+		field.String("value").Immutable().Optional().SchemaType(varchar(64)).Comment("属性值"),
+
+		field.Time("valid_from").Immutable().Comment("生效, 包含"),
+		field.Time("valid_to").Optional().Nillable().Comment("失效, 不包含"),
+
+		field.String("history_id").Immutable().Optional().SchemaType(char(36)).Comment("对应的变更记录"),
+	}
+}
+
+func (DeviceAttr) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "name", "valid_from"),
+		index.Fields("device_code", "name", "valid_from"),
+	}
+}
+
+func (DeviceAttr) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "device_attr"},
+	}
+}
+
+func (DeviceAttr) Policy() ent.Policy {
+	return privacy.Policy{
+		Query: privacy.QueryPolicy{rule.DeviceAttrQuery()},
+	}
+}
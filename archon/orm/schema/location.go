@@ -0,0 +1,73 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/archon/orm/rule"
+)
+
+// Location 位置树, 项目 -> 楼栋 -> 楼层 -> 单元
+type Location struct {
+	ent.Schema
+}
+
+func (Location) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.Enum("kind").Values("project", "building", "floor", "unit").Immutable().Comment("层级"),
+		field.String("code").NotEmpty().SchemaType(varchar(64)).Comment("编号, 项目层即项目编号"),
+		field.String("name").Optional().SchemaType(varchar(64)).Comment("名称"),
+
+		field.String("parent_id").Optional().Nillable().Immutable().SchemaType(char(36)).Comment("上级"),
+		field.String("project").NotEmpty().SchemaType(varchar(64)).Comment("所在项目编号, 用于按项目授权"),
+
+		field.String("memo").Optional().SchemaType(varchar(128)).Comment("备注"),
+	}
+}
+
+func (Location) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("children", Location.Type).
+			From("parent").Unique().Immutable().Field("parent_id"),
+		edge.To("devices", Device.Type),
+		edge.To("occupancies", Occupancy.Type),
+	}
+}
+
+func (Location) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Location) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("parent_id", "code").Unique(),
+		index.Fields("kind", "code"),
+		// 项目编号全局唯一, 其他层只在同一上级下唯一
+		index.Fields("kind", "code").Unique().
+			Annotations(entsql.IndexWhere("kind = 'project'")).
+			StorageKey("location_project_code"),
+		index.Fields("project"),
+	}
+}
+
+func (Location) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "location"},
+	}
+}
+
+func (Location) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.LocationQuery()},
+		Mutation: privacy.MutationPolicy{rule.LocationMutation()},
+	}
+}
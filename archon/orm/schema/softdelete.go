@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/archon/orm/ent/intercept"
+)
+
+type softDeleteKey struct{}
+
+// SkipSoftDelete 查询时包括已经软删除的, 用于恢复, 清理和变更记录
+func SkipSoftDelete(ctx context.Context) context.Context {
+	return context.WithValue(ctx, softDeleteKey{}, true)
+}
+
+func skipSoftDelete(ctx context.Context) bool {
+	skip, _ := ctx.Value(softDeleteKey{}).(bool)
+	return skip
+}
+
+// SoftDelete 软删除, is_del 为 0 是在用的, 所有查询默认只查在用的
+// 删除时 is_del 写入删除时的纳秒时间戳, 和业务编号组成唯一索引, 删除后编号可以再用
+type SoftDelete struct {
+	mixin.Schema
+}
+
+func (SoftDelete) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("is_del").Default(0).Comment("软删除"),
+		field.Time("delete_time").Optional().Nillable().Comment("删除时间"),
+	}
+}
+
+func (SoftDelete) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{
+		intercept.TraverseFunc(func(ctx context.Context, q intercept.Query) error {
+			if !skipSoftDelete(ctx) {
+				q.WhereP(sql.FieldEQ("is_del", 0))
+			}
+			return nil
+		}),
+	}
+}
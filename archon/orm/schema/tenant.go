@@ -0,0 +1,119 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/archon/orm/ent/privacy"
+	"github.com/twiglab/h2o/archon/orm/rule"
+)
+
+// Tenant 业主或者租户
+type Tenant struct {
+	ent.Schema
+}
+
+func (Tenant) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("code").NotEmpty().SchemaType(varchar(64)).Comment("编号"),
+		field.String("name").NotEmpty().SchemaType(varchar(64)).Comment("名称"),
+
+		field.String("contact").Optional().SchemaType(varchar(64)).Comment("联系人"),
+		field.String("phone").Optional().SchemaType(varchar(32)).Comment("电话"),
+
+		field.String("memo").Optional().SchemaType(varchar(128)).Comment("备注"),
+	}
+}
+
+func (Tenant) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("occupancies", Occupancy.Type),
+	}
+}
+
+func (Tenant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Tenant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("code").Unique(),
+	}
+}
+
+func (Tenant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "tenant"},
+	}
+}
+
+func (Tenant) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.TenantQuery()},
+		Mutation: privacy.MutationPolicy{rule.TenantMutation()},
+	}
+}
+
+// Occupancy 业主或者租户占用单元的期间, [start_at, end_at), end_at 为空表示至今
+type Occupancy struct {
+	ent.Schema
+}
+
+func (Occupancy) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(id).SchemaType(char(36)),
+
+		field.String("tenant_id").Immutable().NotEmpty().SchemaType(char(36)).Comment("业主或租户"),
+		field.String("unit_id").Immutable().NotEmpty().SchemaType(char(36)).Comment("单元"),
+
+		field.Enum("role").Values("owner", "tenant").Immutable().Comment("业主或租户"),
+
+		field.Time("start_at").Immutable().Comment("开始, 包含"),
+		field.Time("end_at").Optional().Nillable().Comment("结束, 不包含"),
+
+		field.String("memo").Optional().SchemaType(varchar(128)).Comment("备注"),
+	}
+}
+
+func (Occupancy) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("tenant", Tenant.Type).Ref("occupancies").
+			Unique().Required().Immutable().Field("tenant_id"),
+		edge.From("unit", Location.Type).Ref("occupancies").
+			Unique().Required().Immutable().Field("unit_id"),
+	}
+}
+
+func (Occupancy) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (Occupancy) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("unit_id", "role", "start_at"),
+		index.Fields("tenant_id", "start_at"),
+	}
+}
+
+func (Occupancy) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "occupancy"},
+	}
+}
+
+func (Occupancy) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.OccupancyQuery()},
+		Mutation: privacy.MutationPolicy{rule.OccupancyMutation()},
+	}
+}
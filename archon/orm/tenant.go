@@ -0,0 +1,252 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/ent/deviceattr"
+	"github.com/twiglab/h2o/archon/orm/ent/location"
+	"github.com/twiglab/h2o/archon/orm/ent/occupancy"
+	"github.com/twiglab/h2o/archon/orm/ent/predicate"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+var (
+	ErrPeriod  = errors.New("invalid period")
+	ErrOverlap = errors.New("occupancy overlaps")
+)
+
+// overlaps 与 [from, to) 有交集的占用, to 为零值表示至今
+func overlaps(from, to time.Time) predicate.Occupancy {
+	ps := []predicate.Occupancy{
+		occupancy.Or(occupancy.EndAtIsNil(), occupancy.EndAtGT(from)),
+	}
+	if !to.IsZero() {
+		ps = append(ps, occupancy.StartAtLT(to))
+	}
+	return occupancy.And(ps...)
+}
+
+func endOf(o *ent.Occupancy) time.Time {
+	if o.EndAt == nil {
+		return time.Time{}
+	}
+	return *o.EndAt
+}
+
+// lockUnit 锁住单元, 同一单元的重叠检查和写入串行执行
+// sqlite 不支持 FOR UPDATE, 写事务本身是串行的
+func lockUnit(ctx context.Context, tx *ent.Tx, id string) (*ent.Location, error) {
+	return tx.Location.Query().
+		Where(location.ID(id), func(s *sql.Selector) {
+			if s.Dialect() != dialect.SQLite {
+				s.ForUpdate()
+			}
+		}).
+		Only(ctx)
+}
+
+type OccupancyInput struct {
+	TenantID string
+	UnitID   string
+	Role     occupancy.Role
+	StartAt  time.Time
+	EndAt    *time.Time
+	Memo     string
+}
+
+// CreateOccupancy 同一单元同一时间只能有一个业主和一个租户
+func (x DBx) CreateOccupancy(ctx context.Context, in OccupancyInput) (*ent.Occupancy, error) {
+	if in.EndAt != nil && !in.EndAt.After(in.StartAt) {
+		return nil, fmt.Errorf("%w: end %s not after start %s", ErrPeriod, in.EndAt.Format(time.DateTime), in.StartAt.Format(time.DateTime))
+	}
+
+	var occ *ent.Occupancy
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		unit, err := lockUnit(ctx, tx, in.UnitID)
+		if err != nil {
+			return err
+		}
+		if unit.Kind != location.KindUnit {
+			return fmt.Errorf("%w: %s %s is not a unit", ErrLocationKind, unit.Kind, unit.Code)
+		}
+
+		var to time.Time
+		if in.EndAt != nil {
+			to = *in.EndAt
+		}
+		exist, err := tx.Occupancy.Query().
+			Where(
+				occupancy.UnitID(in.UnitID),
+				occupancy.RoleEQ(in.Role),
+				overlaps(in.StartAt, to),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("%w: unit %s already has a %s in this period", ErrOverlap, unit.Code, in.Role)
+		}
+
+		occ, err = tx.Occupancy.Create().
+			SetTenantID(in.TenantID).
+			SetUnit(unit).
+			SetRole(in.Role).
+			SetStartAt(in.StartAt).
+			SetNillableEndAt(in.EndAt).
+			SetMemo(in.Memo).
+			Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return occ.Unwrap(), nil
+}
+
+// EndOccupancy 迁出, 结束时间不能早于开始, 也不能和之后的占用重叠
+func (x DBx) EndOccupancy(ctx context.Context, id string, end time.Time) (*ent.Occupancy, error) {
+	var occ *ent.Occupancy
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		o, err := tx.Occupancy.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !end.After(o.StartAt) {
+			return fmt.Errorf("%w: end %s not after start %s", ErrPeriod, end.Format(time.DateTime), o.StartAt.Format(time.DateTime))
+		}
+		if _, err := lockUnit(ctx, tx, o.UnitID); err != nil {
+			return err
+		}
+
+		exist, err := tx.Occupancy.Query().
+			Where(
+				occupancy.IDNEQ(o.ID),
+				occupancy.UnitID(o.UnitID),
+				occupancy.RoleEQ(o.Role),
+				overlaps(o.StartAt, end),
+			).
+			Exist(ctx)
+		if err != nil {
+			return err
+		}
+		if exist {
+			return fmt.Errorf("%w: end %s", ErrOverlap, end.Format(time.DateTime))
+		}
+
+		occ, err = tx.Occupancy.UpdateOne(o).SetEndAt(end).Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return occ.Unwrap(), nil
+}
+
+// TenantMeter 租户在某个期间使用的表, From/To 是占用期间, 查询期间和表挂在单元上的期间的交集, 结算按这个期间
+// To 为空表示至今
+type TenantMeter struct {
+	Device    *ent.Device
+	Unit      *ent.Location
+	Occupancy *ent.Occupancy
+	From      time.Time
+	To        *time.Time
+}
+
+// TenantMeters 租户在 [from, to) 期间占用单元上的表, to 为零值表示至今
+// 按设备位置属性的有效期找当时挂在单元上的表, 中途换表或者移走的表只算挂着的那一段
+func (x DBx) TenantMeters(ctx context.Context, tenantID string, from, to time.Time) ([]*TenantMeter, error) {
+	if !to.IsZero() && !to.After(from) {
+		return nil, fmt.Errorf("%w: to %s not after from %s", ErrPeriod, to.Format(time.DateTime), from.Format(time.DateTime))
+	}
+
+	occs, err := x.Client.Occupancy.Query().
+		Where(occupancy.TenantID(tenantID), overlaps(from, to)).
+		WithUnit().
+		Order(ent.Asc(occupancy.FieldStartAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var ms []*TenantMeter
+	for _, o := range occs {
+		start, end := clip(o.StartAt, endOf(o), from, to)
+
+		attrs, err := x.Client.DeviceAttr.Query().
+			Where(
+				deviceattr.Name(device.FieldLocationID),
+				deviceattr.Value(o.UnitID),
+				validDuring(start, end),
+			).
+			Order(ent.Asc(deviceattr.FieldDeviceCode), ent.Asc(deviceattr.FieldValidFrom)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if len(attrs) == 0 {
+			continue
+		}
+
+		ids := make([]string, 0, len(attrs))
+		for _, a := range attrs {
+			ids = append(ids, a.DeviceID)
+		}
+		// 已经拆除的表在当时也要结算
+		ds, err := x.Client.Device.Query().Where(device.IDIn(ids...)).All(schema.SkipSoftDelete(ctx))
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[string]*ent.Device, len(ds))
+		for _, d := range ds {
+			byID[d.ID] = d
+		}
+
+		for _, a := range attrs {
+			d, ok := byID[a.DeviceID]
+			if !ok {
+				continue
+			}
+			var validTo time.Time
+			if a.ValidTo != nil {
+				validTo = *a.ValidTo
+			}
+			s, e := clip(a.ValidFrom, validTo, start, end)
+			m := &TenantMeter{Device: d, Unit: o.Edges.Unit, Occupancy: o, From: s}
+			if !e.IsZero() {
+				m.To = &e
+			}
+			ms = append(ms, m)
+		}
+	}
+	return ms, nil
+}
+
+// validDuring 有效期与 [from, to) 有交集的属性, to 为零值表示至今
+func validDuring(from, to time.Time) predicate.DeviceAttr {
+	ps := []predicate.DeviceAttr{
+		deviceattr.Or(deviceattr.ValidToIsNil(), deviceattr.ValidToGT(from)),
+	}
+	if !to.IsZero() {
+		ps = append(ps, deviceattr.ValidFromLT(to))
+	}
+	return deviceattr.And(ps...)
+}
+
+// clip 两个期间的交集, 结束为零值表示至今
+func clip(start, end, from, to time.Time) (time.Time, time.Time) {
+	if from.After(start) {
+		start = from
+	}
+	if end.IsZero() || (!to.IsZero() && to.Before(end)) {
+		end = to
+	}
+	return start, end
+}
@@ -0,0 +1,61 @@
+package orm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/twiglab/h2o/archon/orm/ent"
+	"github.com/twiglab/h2o/archon/orm/ent/device"
+	"github.com/twiglab/h2o/archon/orm/schema"
+)
+
+var ErrTopology = errors.New("invalid meter topology")
+
+// SetParent 设置上级表, parentID 为空时清除
+// 上下级必须同一项目同一类型, 不能成环
+func (x DBx) SetParent(ctx context.Context, id string, parentID *string) (*ent.Device, error) {
+	var d *ent.Device
+	err := WithTx(ctx, x.Client, func(tx *ent.Tx) error {
+		var err error
+		d, err = tx.Device.Query().Where(device.ID(id)).Only(ctx)
+		if err != nil {
+			return err
+		}
+
+		if parentID == nil {
+			d, err = tx.Device.UpdateOne(d).ClearParent().Save(ctx)
+			return err
+		}
+
+		parent, err := tx.Device.Query().Where(device.ID(*parentID)).Only(ctx)
+		if err != nil {
+			return err
+		}
+		if parent.Project != d.Project || parent.DeviceType != d.DeviceType {
+			return fmt.Errorf("%w: %s(%s %s) can not feed %s(%s %s)", ErrTopology,
+				parent.DeviceCode, parent.Project, parent.DeviceType,
+				d.DeviceCode, d.Project, d.DeviceType)
+		}
+
+		// 从新的上级往上找, 遇到自己就是成环, 软删除的也要找, 恢复以后还在链上
+		for p := parent; ; {
+			if p.ID == d.ID {
+				return fmt.Errorf("%w: %s is already upstream of %s", ErrTopology, d.DeviceCode, parent.DeviceCode)
+			}
+			if p.ParentID == nil {
+				break
+			}
+			if p, err = tx.Device.Get(schema.SkipSoftDelete(ctx), *p.ParentID); err != nil {
+				return err
+			}
+		}
+
+		d, err = tx.Device.UpdateOne(d).SetParent(parent).Save(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return d.Unwrap(), nil
+}
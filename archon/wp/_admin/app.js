@@ -0,0 +1,649 @@
+// archon 设备管理页面, 不依赖外部资源, 随 archon 一起嵌入发布
+
+const TYPE_MAP = { E: '电表', W: '水表' };
+const PAGE_SIZE = 50;
+const LIVE_INTERVAL = 30 * 1000;
+const TOKEN_KEY = 'archon.token';
+
+// 导出文件的列, 和 bulk.Columns 一致, 导出的文件可以直接导入
+const COLUMNS = [
+  ['device_code', 'deviceCode'],
+  ['device_type', 'deviceType'],
+  ['device_sn', 'deviceSN'],
+  ['device_name', 'deviceName'],
+  ['rate', 'rate'],
+  ['project', 'project'],
+  ['pos_code', 'posCode'],
+  ['area_code', 'areaCode'],
+  ['pcode', 'pcode'],
+  ['status', 'status'],
+  ['memo', 'memo'],
+];
+
+const DEVICE_FIELDS = `id deviceCode deviceType deviceSN deviceName rate posCode areaCode project pcode status memo`;
+
+const state = {
+  conf: { gql: '/gql/query', upload: '/gql/query', export: '/device/export', live: false, stale: 7200 },
+  devices: [],
+  selected: new Set(),
+  page: 0,
+  editing: null,
+  detail: null,
+  removing: [],
+};
+
+const $ = (id) => document.getElementById(id);
+
+// h 创建元素, 文本一律用 textContent, 不拼 html
+function h(tag, attrs, ...children) {
+  const el = document.createElement(tag);
+  for (const [k, v] of Object.entries(attrs || {})) {
+    if (v == null || v === false) continue;
+    if (k.startsWith('on')) el.addEventListener(k.slice(2), v);
+    else if (k === 'class') el.className = v;
+    else el.setAttribute(k, v === true ? '' : v);
+  }
+  for (const c of children.flat()) {
+    if (c == null || c === false) continue;
+    el.append(c instanceof Node ? c : String(c));
+  }
+  return el;
+}
+
+function toast(msg, error) {
+  const t = $('toast');
+  t.textContent = msg;
+  t.className = error ? 'toast error' : 'toast';
+  t.hidden = false;
+  clearTimeout(toast.timer);
+  toast.timer = setTimeout(() => (t.hidden = true), error ? 6000 : 3000);
+}
+
+function fmtTime(v) {
+  if (!v) return '';
+  const d = new Date(v);
+  if (isNaN(d)) return v;
+  const p = (n) => String(n).padStart(2, '0');
+  return `${d.getFullYear()}-${p(d.getMonth() + 1)}-${p(d.getDate())} ${p(d.getHours())}:${p(d.getMinutes())}:${p(d.getSeconds())}`;
+}
+
+// datetime-local 的值转成 RFC3339
+function toRFC3339(v) {
+  return v ? new Date(v).toISOString() : undefined;
+}
+
+// ---------------------------------------------------------------- 认证
+
+function token() {
+  return localStorage.getItem(TOKEN_KEY) || '';
+}
+
+function authHeaders() {
+  const t = token();
+  return t ? { Authorization: `Bearer ${t}` } : {};
+}
+
+// showWho 显示令牌中的用户, 只是展示, 不校验
+function showWho() {
+  const t = token();
+  let who = '';
+  if (t) {
+    try {
+      const b = t.split('.')[1].replace(/-/g, '+').replace(/_/g, '/');
+      const c = JSON.parse(decodeURIComponent(escape(atob(b))));
+      who = [c.name || c.sub, (c.roles || []).join(',')].filter(Boolean).join(' · ');
+    } catch {
+      who = '令牌无法解析';
+    }
+  }
+  $('who').textContent = who;
+}
+
+class HttpError extends Error {
+  constructor(status, msg) {
+    super(msg);
+    this.status = status;
+  }
+}
+
+async function check(resp) {
+  if (resp.ok) return resp;
+  const text = (await resp.text()).trim();
+  if (resp.status === 401) {
+    $('tokenDialog').showModal();
+    throw new HttpError(401, '未认证或令牌已过期');
+  }
+  if (resp.status === 403) throw new HttpError(403, text || '没有权限');
+  throw new HttpError(resp.status, text || resp.statusText);
+}
+
+// ---------------------------------------------------------------- graphql
+
+async function gql(query, variables, url) {
+  const resp = await fetch(url || state.conf.gql, {
+    method: 'POST',
+    headers: { 'Content-Type': 'application/json', ...authHeaders() },
+    body: JSON.stringify({ query, variables }),
+  });
+  await check(resp);
+  const r = await resp.json();
+  if (r.errors && r.errors.length) {
+    const err = new Error(r.errors.map((e) => e.message).join('; '));
+    err.data = r.data;
+    throw err;
+  }
+  return r.data;
+}
+
+// upload graphql multipart request, 只有一个文件
+async function upload(query, variables, path, file) {
+  const form = new FormData();
+  form.append('operations', JSON.stringify({ query, variables }));
+  form.append('map', JSON.stringify({ 0: [`variables.${path}`] }));
+  form.append('0', file, file.name);
+  const resp = await fetch(state.conf.upload, { method: 'POST', headers: authHeaders(), body: form });
+  await check(resp);
+  const r = await resp.json();
+  if (r.errors && r.errors.length) throw new Error(r.errors.map((e) => e.message).join('; '));
+  return r.data;
+}
+
+// ---------------------------------------------------------------- 列表
+
+function filters() {
+  const input = {};
+  for (const k of ['type', 'project', 'posCode']) {
+    const v = $(k).value.trim();
+    if (v) input[k] = v;
+  }
+  return input;
+}
+
+async function load() {
+  const live = state.conf.live ? ' latestReading { dataValue dataTime }' : '';
+  try {
+    const data = await gql(`query ($input: DeviceListInput!) {
+  deviceQuery(input: $input) { ${DEVICE_FIELDS}${live} }
+}`, { input: filters() });
+    state.devices = (data.deviceQuery || []).filter(Boolean);
+  } catch (e) {
+    // 网关的部分错误, vigil 不可用时仍然显示档案
+    if (e.data && e.data.deviceQuery) {
+      state.devices = e.data.deviceQuery.filter(Boolean);
+      toast(`读数获取失败: ${e.message}`, true);
+    } else {
+      state.devices = [];
+      toast(e.message, true);
+    }
+  }
+  const codes = new Set(state.devices.map((d) => d.id));
+  for (const id of state.selected) if (!codes.has(id)) state.selected.delete(id);
+  render();
+}
+
+// liveStatus online, stale 或 none
+function liveStatus(d) {
+  const r = d.latestReading;
+  if (!r || !r.dataTime) return 'none';
+  const age = (Date.now() - new Date(r.dataTime).getTime()) / 1000;
+  return age > state.conf.stale ? 'stale' : 'online';
+}
+
+const LIVE_TEXT = { online: '在线', stale: '离线', none: '无读数' };
+
+function visible() {
+  const kw = $('keyword').value.trim().toLowerCase();
+  const live = $('live').value;
+  return state.devices.filter((d) => {
+    if (live && liveStatus(d) !== live) return false;
+    if (!kw) return true;
+    return [d.deviceCode, d.deviceName, d.deviceSN, d.posCode, d.pcode, d.memo]
+      .some((v) => v && v.toLowerCase().includes(kw));
+  });
+}
+
+function render() {
+  const list = visible();
+  const pages = Math.max(1, Math.ceil(list.length / PAGE_SIZE));
+  state.page = Math.min(state.page, pages - 1);
+  const rows = list.slice(state.page * PAGE_SIZE, (state.page + 1) * PAGE_SIZE);
+
+  const tbody = $('rows');
+  tbody.replaceChildren();
+  if (rows.length === 0) {
+    tbody.append(h('tr', null, h('td', { class: 'empty', colspan: 10 }, state.devices.length ? '没有匹配的设备' : '暂无设备')));
+  }
+  for (const d of rows) tbody.append(row(d));
+
+  const all = $('checkAll');
+  all.checked = rows.length > 0 && rows.every((d) => state.selected.has(d.id));
+  all.indeterminate = !all.checked && rows.some((d) => state.selected.has(d.id));
+
+  $('summary').textContent = list.length === state.devices.length
+    ? `共 ${list.length} 台`
+    : `${list.length} / ${state.devices.length} 台`;
+  renderPager(pages);
+  renderBulk();
+}
+
+function row(d) {
+  const checked = state.selected.has(d.id);
+  const status = liveStatus(d);
+  const r = d.latestReading;
+  return h('tr', { class: checked ? 'selected' : null },
+    h('td', { class: 'check' }, h('input', {
+      type: 'checkbox', checked,
+      onchange: (e) => { e.target.checked ? state.selected.add(d.id) : state.selected.delete(d.id); render(); },
+    })),
+    h('td', { class: 'mono' }, h('a', { onclick: () => openDetail(d) }, d.deviceCode)),
+    h('td', null, h('span', { class: `tag ${d.deviceType}` }, TYPE_MAP[d.deviceType] || d.deviceType)),
+    h('td', null, d.deviceName),
+    h('td', null, d.project),
+    h('td', null, d.posCode),
+    h('td', null, d.rate),
+    h('td', { class: 'live-col' }, h('span', { class: `badge ${status}`, title: r ? fmtTime(r.dataTime) : '' }, LIVE_TEXT[status])),
+    h('td', { class: 'live-col mono' }, r ? r.dataValue : '', r ? h('div', { class: 'muted' }, fmtTime(r.dataTime)) : null),
+    h('td', { class: 'actions' },
+      h('button', { class: 'btn small link', onclick: () => openForm(d) }, '修改'),
+      h('button', { class: 'btn small link danger', onclick: () => openRemove([d]) }, '删除')),
+  );
+}
+
+function renderPager(pages) {
+  const p = $('pager');
+  p.replaceChildren();
+  if (pages <= 1) return;
+  const go = (n) => () => { state.page = n; render(); };
+  p.append(
+    h('button', { class: 'btn small', disabled: state.page === 0, onclick: go(state.page - 1) }, '上一页'),
+    h('span', null, `${state.page + 1} / ${pages}`),
+    h('button', { class: 'btn small', disabled: state.page >= pages - 1, onclick: go(state.page + 1) }, '下一页'),
+  );
+}
+
+function selectedDevices() {
+  return state.devices.filter((d) => state.selected.has(d.id));
+}
+
+function renderBulk() {
+  const n = state.selected.size;
+  $('bulkbar').hidden = n === 0;
+  $('bulkCount').textContent = `已选 ${n} 台`;
+}
+
+// ---------------------------------------------------------------- 新增, 修改
+
+const CREATE_ONLY = ['deviceCode', 'deviceType', 'project'];
+const EDITABLE = ['deviceSN', 'deviceName', 'posCode', 'areaCode', 'pcode', 'memo'];
+
+function openForm(d) {
+  state.editing = d || null;
+  const f = $('deviceForm');
+  f.reset();
+  $('formError').textContent = '';
+  $('formTitle').textContent = d ? `修改 ${d.deviceCode}` : '新增设备';
+  $('auditFields').hidden = !d;
+  for (const k of CREATE_ONLY) f.elements[k].disabled = !!d;
+  if (d) {
+    for (const k of [...CREATE_ONLY, ...EDITABLE, 'rate']) f.elements[k].value = d[k] ?? '';
+  } else {
+    f.elements.project.value = $('project').value.trim();
+    f.elements.deviceType.value = $('type').value || 'E';
+  }
+  closeDialog('detailDialog');
+  $('formDialog').showModal();
+}
+
+async function saveForm() {
+  const f = $('deviceForm');
+  const d = state.editing;
+  const v = (k) => f.elements[k].value.trim();
+  const rate = parseInt(v('rate'), 10);
+
+  let query, input;
+  if (d) {
+    // 只提交修改过的字段, 变更历史只记录实际的差异
+    input = { id: d.id };
+    for (const k of EDITABLE) if (v(k) !== (d[k] ?? '')) input[k] = v(k);
+    if (!isNaN(rate) && rate !== d.rate) input.rate = rate;
+    if (Object.keys(input).length === 1) return true;
+    if (v('reason')) input.reason = v('reason');
+    if (v('effectiveAt')) input.effectiveAt = toRFC3339(v('effectiveAt'));
+    query = `mutation ($input: DeviceModifyInput!) { deviceModify(input: $input) { id } }`;
+  } else {
+    input = { deviceCode: v('deviceCode'), deviceType: v('deviceType'), project: v('project') };
+    for (const k of EDITABLE) if (v(k)) input[k] = v(k);
+    if (!isNaN(rate)) input.rate = rate;
+    query = `mutation ($input: DeviceCreateInput!) { deviceCreate(input: $input) { id } }`;
+  }
+  await gql(query, { input });
+  toast(d ? '已修改' : '已新增');
+  return true;
+}
+
+// ---------------------------------------------------------------- 删除
+
+function openRemove(list) {
+  state.removing = list;
+  $('removeForm').reset();
+  $('removeError').textContent = '';
+  $('removeText').textContent = list.length === 1
+    ? `确定删除 ${list[0].deviceCode} ${list[0].deviceName || ''}?`
+    : `确定删除选中的 ${list.length} 台设备?`;
+  $('removeDialog').showModal();
+}
+
+async function doRemove() {
+  const reason = $('removeForm').elements.reason.value.trim();
+  const inputs = state.removing.map((d) => (reason ? { id: d.id, reason } : { id: d.id }));
+  const { failed } = await batch('deviceRemove', 'DeviceRemoveInput', inputs);
+  for (const d of state.removing) state.selected.delete(d.id);
+  if (failed.length) throw new Error(failed.join('; '));
+  toast(`已删除 ${inputs.length} 台`);
+  return true;
+}
+
+// batch 多个同名 mutation 用别名合并成一个请求, 单个失败不影响其他
+async function batch(field, type, inputs) {
+  const vars = {};
+  const defs = [];
+  const sels = [];
+  inputs.forEach((input, i) => {
+    vars[`i${i}`] = input;
+    defs.push(`$i${i}: ${type}!`);
+    sels.push(`m${i}: ${field}(input: $i${i}) { id }`);
+  });
+  const query = `mutation (${defs.join(', ')}) {\n  ${sels.join('\n  ')}\n}`;
+  try {
+    await gql(query, vars);
+    return { failed: [] };
+  } catch (e) {
+    if (!e.data) throw e;
+    return { failed: [e.message] };
+  }
+}
+
+// ---------------------------------------------------------------- 批量修改
+
+function openBulkModify() {
+  $('bulkForm').reset();
+  $('bulkError').textContent = '';
+  $('bulkDialogCount').textContent = `(${state.selected.size} 台)`;
+  $('bulkDialog').showModal();
+}
+
+async function doBulkModify() {
+  const f = $('bulkForm');
+  const v = (k) => f.elements[k].value.trim();
+  const patch = {};
+  if (v('rate')) patch.rate = parseInt(v('rate'), 10);
+  if (v('areaCode')) patch.areaCode = v('areaCode');
+  if (v('memo')) patch.memo = v('memo');
+  if (Object.keys(patch).length === 0) throw new Error('没有填写要修改的字段');
+  if (v('reason')) patch.reason = v('reason');
+  if (v('effectiveAt')) patch.effectiveAt = toRFC3339(v('effectiveAt'));
+
+  const inputs = selectedDevices().map((d) => ({ id: d.id, ...patch }));
+  const { failed } = await batch('deviceModify', 'DeviceModifyInput', inputs);
+  if (failed.length) throw new Error(failed.join('; '));
+  toast(`已修改 ${inputs.length} 台`);
+  return true;
+}
+
+// ---------------------------------------------------------------- 导入导出
+
+function csvCell(v) {
+  const s = v == null ? '' : String(v);
+  return /[",\r\n]/.test(s) ? `"${s.replace(/"/g, '""')}"` : s;
+}
+
+function exportSelected() {
+  const lines = [COLUMNS.map(([c]) => c).join(',')];
+  for (const d of selectedDevices()) lines.push(COLUMNS.map(([, k]) => csvCell(d[k])).join(','));
+  // BOM, excel 打开不乱码
+  save(new Blob(['\uFEFF' + lines.join('\r\n') + '\r\n'], { type: 'text/csv' }), `device-selected.csv`);
+}
+
+async function exportAll() {
+  const q = new URLSearchParams({ format: 'xlsx', ...filters() });
+  try {
+    const resp = await check(await fetch(`${state.conf.export}?${q}`, { headers: authHeaders() }));
+    const cd = resp.headers.get('Content-Disposition') || '';
+    const m = cd.match(/filename="?([^";]+)"?/);
+    save(await resp.blob(), m ? m[1] : 'device.xlsx');
+  } catch (e) {
+    toast(e.message, true);
+  }
+}
+
+function save(blob, name) {
+  const a = h('a', { href: URL.createObjectURL(blob), download: name });
+  document.body.append(a);
+  a.click();
+  a.remove();
+  setTimeout(() => URL.revokeObjectURL(a.href), 1000);
+}
+
+function openImport() {
+  $('importForm').reset();
+  $('importForm').elements.project.value = $('project').value.trim();
+  $('importReport').replaceChildren();
+  $('importError').textContent = '';
+  $('importDialog').showModal();
+}
+
+async function doImport() {
+  const f = $('importForm');
+  const file = f.elements.file.files[0];
+  if (!file) throw new Error('请选择文件');
+  const input = { file: null, dryRun: f.elements.dryRun.checked };
+  const project = f.elements.project.value.trim();
+  if (project) input.project = project;
+
+  const data = await upload(`mutation ($input: DeviceImportInput!) {
+  deviceImport(input: $input) { total created dryRun errors { line code column message } }
+}`, { input }, 'input.file', file);
+  const r = data.deviceImport;
+  renderReport(r);
+  if (!r.dryRun && r.created > 0) {
+    toast(`已导入 ${r.created} 台`);
+    load();
+  }
+  // 保持对话框打开, 查看报告
+  return false;
+}
+
+function renderReport(r) {
+  const box = $('importReport');
+  box.replaceChildren();
+  const ok = r.errors.length === 0;
+  const text = r.dryRun
+    ? (ok ? `校验通过, 共 ${r.total} 行, 取消 "只校验不写入" 后提交导入` : `共 ${r.total} 行, ${r.errors.length} 个错误`)
+    : (ok ? `共 ${r.total} 行, 新增 ${r.created} 台` : `共 ${r.total} 行, ${r.errors.length} 个错误, 没有写入`);
+  box.append(h('div', { class: ok ? 'report-summary' : 'report-summary error' }, text));
+  if (ok) return;
+  box.append(h('div', { class: 'report' }, h('table', { class: 'mini' },
+    h('thead', null, h('tr', null, h('th', null, '行'), h('th', null, '设备号'), h('th', null, '列'), h('th', null, '错误'))),
+    h('tbody', null, r.errors.map((e) => h('tr', null,
+      h('td', null, e.line || ''), h('td', { class: 'mono' }, e.code), h('td', null, e.column), h('td', null, e.message)))),
+  )));
+}
+
+// ---------------------------------------------------------------- 详情
+
+const PROPS = [
+  ['deviceCode', '设备号'], ['deviceType', '类型'], ['deviceName', '名称'], ['deviceSN', '序列号'],
+  ['project', '项目'], ['posCode', '位置'], ['areaCode', '区域'], ['pcode', '对外位置'],
+  ['rate', '倍率'], ['status', '状态'], ['memo', '备注'],
+];
+
+const OP_TEXT = { create: '新增', update: '修改', remove: '删除', restore: '恢复', delete: '清除' };
+
+async function openDetail(d) {
+  state.detail = d;
+  $('detailTitle').textContent = d.deviceCode;
+  $('detailProps').replaceChildren(...PROPS.flatMap(([k, label]) => [
+    h('dt', null, label),
+    h('dd', null, k === 'deviceType' ? (TYPE_MAP[d[k]] || d[k]) : (d[k] ?? '')),
+  ]));
+  $('detailHistory').replaceChildren(h('li', { class: 'muted' }, '加载中...'));
+  $('detailReadings').replaceChildren();
+  $('detailDialog').showModal();
+
+  loadHistory(d);
+  if (state.conf.live) loadReadings(d);
+}
+
+async function loadHistory(d) {
+  const ol = $('detailHistory');
+  try {
+    const data = await gql(`query ($input: DeviceHistoryInput!) {
+  deviceHistory(input: $input) { op fields before after actor reason createTime }
+}`, { input: { deviceCode: d.deviceCode } });
+    if (state.detail !== d) return;
+    const list = data.deviceHistory || [];
+    ol.replaceChildren(...(list.length ? list.map(historyItem) : [h('li', { class: 'muted' }, '没有变更记录')]));
+  } catch (e) {
+    ol.replaceChildren(h('li', { class: 'error' }, e.message));
+  }
+}
+
+function historyItem(e) {
+  const before = e.before || {};
+  const after = e.after || {};
+  return h('li', null,
+    h('div', null, h('strong', null, OP_TEXT[e.op] || e.op), e.reason ? ` · ${e.reason}` : ''),
+    h('div', { class: 'meta' }, `${fmtTime(e.createTime)} · ${e.actor || '-'}`),
+    e.op === 'update'
+      ? (e.fields || []).map((k) => h('div', { class: 'diff' },
+        `${k}: `, h('del', null, before[k] ?? ''), ' → ', h('ins', null, after[k] ?? '')))
+      : null,
+  );
+}
+
+async function loadReadings(d) {
+  const tbody = $('detailReadings');
+  try {
+    // 没有按设备号查询的入口, 按位置查再过滤
+    const data = await gql(`query ($input: DeviceListInput!) {
+  deviceQuery(input: $input) { deviceCode readings(limit: 20) { dataValue dataTime } }
+}`, { input: { project: d.project, posCode: d.posCode, type: d.deviceType } });
+    if (state.detail !== d) return;
+    const found = (data.deviceQuery || []).find((x) => x && x.deviceCode === d.deviceCode);
+    const list = (found && found.readings) || [];
+    tbody.replaceChildren(...(list.length
+      ? list.map((r) => h('tr', null, h('td', null, fmtTime(r.dataTime)), h('td', { class: 'mono' }, r.dataValue)))
+      : [h('tr', null, h('td', { class: 'muted', colspan: 2 }, '没有读数'))]));
+  } catch (e) {
+    tbody.replaceChildren(h('tr', null, h('td', { class: 'error', colspan: 2 }, e.message)));
+  }
+}
+
+// ---------------------------------------------------------------- 对话框
+
+function closeDialog(id) {
+  const d = $(id);
+  if (d.open) d.close();
+}
+
+// bindForm 按钮值为 cancel 之外时执行 action, 返回 true 关闭并刷新列表
+function bindForm(dialogId, formId, errorId, action) {
+  const dialog = $(dialogId);
+  $(formId).addEventListener('submit', async (ev) => {
+    const value = ev.submitter ? ev.submitter.value : '';
+    if (value === 'cancel') return;
+    ev.preventDefault();
+    $(errorId).textContent = '';
+    const buttons = dialog.querySelectorAll('button');
+    buttons.forEach((b) => (b.disabled = true));
+    try {
+      if (await action(value)) {
+        dialog.close();
+        load();
+      }
+    } catch (e) {
+      $(errorId).textContent = e.message;
+    } finally {
+      buttons.forEach((b) => (b.disabled = false));
+    }
+  });
+}
+
+function bindToken() {
+  $('tokenBtn').addEventListener('click', () => {
+    $('tokenForm').elements.token.value = token();
+    $('tokenDialog').showModal();
+  });
+  $('tokenForm').addEventListener('submit', (ev) => {
+    const value = ev.submitter ? ev.submitter.value : '';
+    if (value === 'cancel') return;
+    const t = value === 'clear' ? '' : $('tokenForm').elements.token.value.trim();
+    t ? localStorage.setItem(TOKEN_KEY, t) : localStorage.removeItem(TOKEN_KEY);
+    showWho();
+    load();
+  });
+}
+
+// ---------------------------------------------------------------- 启动
+
+async function init() {
+  try {
+    const resp = await fetch('config.json', { cache: 'no-cache' });
+    if (resp.ok) Object.assign(state.conf, await resp.json());
+  } catch {
+    // 使用默认配置
+  }
+  if (state.conf.live) {
+    document.body.classList.add('live');
+    $('live').hidden = false;
+    $('subtitle').textContent = `档案, 状态和最新读数, 超过 ${Math.round(state.conf.stale / 60)} 分钟没有读数为离线`;
+  } else {
+    $('subtitle').textContent = '档案管理';
+  }
+
+  let timer;
+  const reload = () => { state.page = 0; load(); };
+  $('keyword').addEventListener('input', () => { state.page = 0; render(); });
+  $('live').addEventListener('change', () => { state.page = 0; render(); });
+  $('type').addEventListener('change', reload);
+  for (const id of ['project', 'posCode']) {
+    $(id).addEventListener('input', () => { clearTimeout(timer); timer = setTimeout(reload, 400); });
+  }
+  $('refreshBtn').addEventListener('click', load);
+  $('addBtn').addEventListener('click', () => openForm(null));
+  $('importBtn').addEventListener('click', openImport);
+  $('exportBtn').addEventListener('click', exportAll);
+
+  $('checkAll').addEventListener('change', (e) => {
+    const list = visible().slice(state.page * PAGE_SIZE, (state.page + 1) * PAGE_SIZE);
+    for (const d of list) e.target.checked ? state.selected.add(d.id) : state.selected.delete(d.id);
+    render();
+  });
+  $('bulkClearBtn').addEventListener('click', () => { state.selected.clear(); render(); });
+  $('bulkModifyBtn').addEventListener('click', openBulkModify);
+  $('bulkRemoveBtn').addEventListener('click', () => openRemove(selectedDevices()));
+  $('bulkExportBtn').addEventListener('click', exportSelected);
+
+  $('detailClose').addEventListener('click', () => closeDialog('detailDialog'));
+  $('detailEdit').addEventListener('click', () => openForm(state.detail));
+  $('detailRemove').addEventListener('click', () => { closeDialog('detailDialog'); openRemove([state.detail]); });
+
+  bindForm('formDialog', 'deviceForm', 'formError', saveForm);
+  bindForm('removeDialog', 'removeForm', 'removeError', doRemove);
+  bindForm('bulkDialog', 'bulkForm', 'bulkError', doBulkModify);
+  bindForm('importDialog', 'importForm', 'importError', doImport);
+  bindToken();
+
+  showWho();
+  await load();
+
+  // 定时刷新状态, 页面不可见时跳过
+  if (state.conf.live) {
+    setInterval(() => {
+      const busy = document.querySelector('dialog[open]');
+      if (!document.hidden && !busy) load();
+    }, LIVE_INTERVAL);
+  }
+}
+
+init();
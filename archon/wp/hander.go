@@ -1,13 +1,78 @@
 package wp
 
 import (
+	"cmp"
 	"embed"
+	"encoding/json/v2"
 	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"time"
 )
 
 //go:embed _admin
 var adminFs embed.FS
 
-func AdminPage() (string, http.Handler) {
-	return "_admin", http.FileServer(http.FS(adminFs))
+const (
+	configPath = "/_admin/config.json"
+	livePath   = "/_admin/gql"
+)
+
+// Conf 管理页面的配置
+type Conf struct {
+	// Gateway federation 网关的地址, 如 http://127.0.0.1:10009/gql/query
+	// 配置后列表带上 vigil 的最新读数, 请求由 archon 转发, 页面不需要跨域
+	Gateway string
+	// Stale 最新读数超过这个时间认为离线
+	Stale time.Duration
+}
+
+type config struct {
+	// GQL 列表和编辑使用的地址, 配置了网关时为网关
+	GQL string `json:"gql"`
+	// Upload 导入文件, 网关不支持 multipart, 总是直接访问 archon
+	Upload string `json:"upload"`
+	Export string `json:"export"`
+
+	Live  bool  `json:"live"`
+	Stale int64 `json:"stale"` // 秒
+}
+
+// AdminPage 嵌入的管理页面, 在 /_admin/ 下
+func AdminPage(c Conf) (string, http.Handler) {
+	conf := config{
+		GQL:    "/gql/query",
+		Upload: "/gql/query",
+		Export: "/device/export",
+		Stale:  int64(cmp.Or(c.Stale, 2*time.Hour) / time.Second),
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/", http.FileServer(http.FS(adminFs)))
+	mux.HandleFunc(configPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-cache")
+		_ = json.MarshalWrite(w, conf)
+	})
+
+	if c.Gateway != "" {
+		if target, err := url.Parse(c.Gateway); err == nil {
+			conf.GQL = livePath
+			conf.Live = true
+			mux.Handle(livePath, proxy(target))
+		}
+	}
+	return "_admin", mux
+}
+
+// proxy 转发到网关, 保留认证的请求头
+func proxy(target *url.URL) http.Handler {
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			u := *target
+			u.RawQuery = r.In.URL.RawQuery
+			r.Out.URL = &u
+			r.Out.Host = target.Host
+		},
+	}
 }
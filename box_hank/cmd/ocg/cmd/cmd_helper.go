@@ -2,23 +2,27 @@ package cmd
 
 import (
 	"cmp"
+	"context"
 	"log"
 	"log/slog"
-	"time"
+	"sync"
 
-	"github.com/simonvetter/modbus"
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/viper"
 	"github.com/twiglab/h2o/box"
+	"github.com/twiglab/h2o/box/ocg"
 	"github.com/twiglab/h2o/clog"
-	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
-func rootLog() *slog.Logger {
+// rootLog level 可以由远程命令调整
+func rootLog(level *slog.LevelVar) *slog.Logger {
 	rlogF := viper.GetString("ocg.log.root.file")
 	rlogL := viper.GetString("ocg.log.root.level")
 
 	hlogL := viper.GetString("ocg.log.level")
-	level := clog.Level(cmp.Or(rlogL, hlogL))
+	level.Set(clog.Level(cmp.Or(rlogL, hlogL)))
 
 	log := clog.NewLog(rlogF, level)
 	slog.SetDefault(log)
@@ -35,28 +39,109 @@ func serverLog() *slog.Logger {
 	return l
 }
 
-func wallog() *wal.WAL {
-	logf := viper.GetString("ocg.wal.file")
-	if logf == "" {
-		log.Fatalln("wal file is null. ***MUST*** set ocg.wal.file")
+// spool 发送前先落盘, 没有配置 ocg.spool.dir 时直接发送
+//
+//	[ocg.spool]
+//	dir = "/var/lib/ocg/spool"
+//	max_size = "64MB"
+func spool() *box.Spool {
+	dir := viper.GetString("ocg.spool.dir")
+	if dir == "" {
+		return nil
 	}
-	log.Println("wal file:", logf)
-	return wal.New(wal.Conf{Filename: logf})
+	size := int64(viper.GetSizeInBytes("ocg.spool.max_size"))
+	log.Println("spool dir:", dir, "max size:", size)
+
+	sp, err := box.OpenSpool(dir, size)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return sp
 }
 
-func mqtt() *box.MQTTAction {
+// clientID 命令的主题由 ocg.box.id 得出, 每个盒子要配置不同的 id
+var clientID = sync.OnceValue(func() string {
+	id := box.ClientID(cmp.Or(viper.GetString("ocg.box.id"), "ocg"))
+	log.Println("clientID", id)
+	return id
+})
+
+// subs 在第一次创建 mqtt 客户端之前设置
+var subs = box.Subscriptions{}
+
+// mqttClient 发送数据和远程命令共用一个连接
+var mqttClient = sync.OnceValue(func() paho.Client {
 	broker := viper.GetString("ocg.sender.mqtt.broker")
-	clientID := box.ClientID("ocg")
-	log.Println("clientID", clientID)
+	cli, err := box.NewMQTTClient(clientID(), subs, broker)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return cli
+})
+
+func mqtt() *box.MQTTAction {
+	return box.NewMQTTAction(mqttClient())
+}
 
-	cli, err := box.NewMQTTClient(clientID, broker)
+// control 通过 mqtt 接收远程命令, 写寄存器只允许 write 中列出的范围, 必须配置 ocg.box.id
+//
+//	[ocg.box]
+//	id = "box-1"
+//
+//	[ocg.control]
+//	enabled = true
+//
+//	[[ocg.control.write]]
+//	endpoint = "tcp1"
+//	unit_id = 1
+//	addr = 100
+//	count = 2
+func control(col *ocg.Collector, level *slog.LevelVar) {
+	if !viper.GetBool("ocg.control.enabled") {
+		return
+	}
+	// 命令的主题由 id 得出, 用默认的 id 会收到其他盒子的命令
+	if viper.GetString("ocg.box.id") == "" {
+		log.Fatal("ocg.box.id is required when ocg.control.enabled")
+	}
+	var writes []ocg.WriteRule
+	if err := viper.UnmarshalKey("ocg.control.write", &writes); err != nil {
+		log.Fatal(err)
+	}
+
+	ctl := &ocg.Control{
+		Collector: col,
+		Level:     level,
+		Version:   versionString(),
+		Writes:    writes,
+	}
+	topic := box.CommandTopic(clientID())
+	subs[topic] = ctl.Handle(box.ReplyTopic(clientID()))
+	log.Println("control topic:", topic, "writes:", len(writes))
+
+	_ = mqttClient()
+}
+
+// sender 编码以后落盘, 再由 spool 发给 mqtt
+func sender(ctx context.Context, sp *box.Spool) box.Sender {
+	s := useSender()
+	if sp != nil {
+		go sp.Run(ctx, s)
+		s = sp
+	}
+	enc := viper.GetString("ocg.sender.encoding")
+	if enc == "" {
+		return s
+	}
+	c, err := common.CodecOf(enc)
 	if err != nil {
 		log.Fatal(err)
 	}
-	return box.NewMQTTAction(cli)
+	log.Println("sender encoding:", c.ContentType())
+	return box.CodecSender{Codec: c, Sender: s}
 }
 
-func sender() box.Sender {
+func useSender() box.Sender {
 	use := viper.GetString("ocg.sender.use")
 	switch use {
 	case "mqtt":
@@ -67,17 +152,23 @@ func sender() box.Sender {
 	return box.LogAction{}
 }
 
-func modbusClient() *modbus.ModbusClient {
-	url := viper.GetString("ocg.connect.modbus.tcp.url")
-	log.Println("ocg.connect.modbus.tcp.url:", url)
-	cfg := &modbus.ClientConfiguration{
-		URL:     url,
-		Timeout: 3 * time.Second,
-	}
-	cli, err := box.NewModbusClient(cfg)
-	if err != nil {
-		log.Fatal(err)
-	}
+// collectConf 采集的连接和表, 见 ocg.Conf
+func collectConf() (ocg.Conf, error) {
+	var conf ocg.Conf
+	err := viper.UnmarshalKey("ocg.collect", &conf)
+	return conf, err
+}
 
-	return cli
+// watch 配置文件修改后重新加载采集配置, 配置有错时继续按原来的采集
+func watch(c *ocg.Collector) {
+	viper.OnConfigChange(func(e fsnotify.Event) {
+		conf, err := collectConf()
+		if err == nil {
+			err = c.Load(conf)
+		}
+		if err != nil {
+			slog.Error("reload config", slog.String("file", e.Name), slog.Any("error", err))
+		}
+	})
+	viper.WatchConfig()
 }
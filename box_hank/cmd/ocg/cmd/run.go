@@ -6,11 +6,8 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 
-	"github.com/simonvetter/modbus"
 	"github.com/spf13/cobra"
-	"github.com/twiglab/h2o/box"
 	"github.com/twiglab/h2o/box/ocg"
-	"github.com/twiglab/h2o/pkg/common"
 )
 
 // runCmd represents the run command
@@ -33,47 +30,32 @@ func init() {
 }
 
 func run() error {
+	level := new(slog.LevelVar)
+	_ = rootLog(level)
 
-	_ = rootLog()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
-	mc := modbusClient()
-	defer mc.Close()
-
-	s := sender()
-
-	t1 := &ocg.TaskX{
-		Client:  mc,
-		Addr:    2,
-		RegType: modbus.INPUT_REGISTER,
-		Sender:  s,
-
-		Code: "PT-1-IN",
-		Type: common.ELECTRICITY,
-
-		Project: "1006",
+	sp := spool()
+	if sp != nil {
+		defer sp.Close()
+		http.Handle("/spool", sp)
 	}
 
-	t2 := &ocg.TaskX{
-		Client:  mc,
-		Addr:    16,
-		RegType: modbus.INPUT_REGISTER,
-		Sender:  s,
+	// 远程命令要在创建 mqtt 客户端之前订阅, 加载配置之前收到的命令找不到表
+	col := ocg.NewCollector(nil)
+	defer col.Stop()
+	control(col, level)
+	col.Sender = sender(ctx, sp)
 
-		Code: "PT-2-IN",
-		Type: common.ELECTRICITY,
-
-		Project: "1006",
+	conf, err := collectConf()
+	if err != nil {
+		return err
 	}
-
-	cron := box.NewCron()
-
-	cron.AddFunc("@every 15m", func() {
-		var err error
-		if err = ocg.TaskChain(context.Background(), t1, t2); err != nil {
-			slog.Error("task error", slog.Any("error", err))
-		}
-	})
-	cron.Start()
+	if err := col.Load(conf); err != nil {
+		return err
+	}
+	watch(col)
 
 	return http.ListenAndServe(":10000", nil)
 }
@@ -0,0 +1,42 @@
+package cron
+
+import "time"
+
+// AlignedSchedule activates on multiples of Interval counted from local
+// midnight, e.g. "every 15 minutes" fires at :00, :15, :30 and :45 no matter
+// when the cron was started. Unlike ConstantDelaySchedule it does not drift.
+// Intervals that do not divide 24 hours restart from midnight each day.
+type AlignedSchedule struct {
+	Interval time.Duration
+
+	// Override location for this schedule, nil means the location of t.
+	Location *time.Location
+}
+
+// Align returns a Schedule that activates on wall-clock multiples of duration.
+// Durations of less than a second are not supported (will round up to 1 second).
+// Any fields less than a Second are truncated.
+func Align(duration time.Duration) AlignedSchedule {
+	if duration < time.Second {
+		duration = time.Second
+	}
+	return AlignedSchedule{
+		Interval: duration - time.Duration(duration.Nanoseconds())%time.Second,
+	}
+}
+
+// Next returns the next aligned time strictly after t.
+func (schedule AlignedSchedule) Next(t time.Time) time.Time {
+	if schedule.Location != nil {
+		t = t.In(schedule.Location)
+	}
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	next := midnight.Add((t.Sub(midnight)/schedule.Interval + 1) * schedule.Interval)
+
+	tomorrow := time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	if next.After(tomorrow) {
+		return tomorrow
+	}
+	return next
+}
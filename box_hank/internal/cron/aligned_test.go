@@ -0,0 +1,52 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlignedNext(t *testing.T) {
+	tests := []struct {
+		time     string
+		interval time.Duration
+		expected string
+	}{
+		// Simple cases
+		{"Mon Jul 9 14:45 2012", 15 * time.Minute, "Mon Jul 9 15:00 2012"},
+		{"Mon Jul 9 14:44:59 2012", 15 * time.Minute, "Mon Jul 9 14:45 2012"},
+		{"Mon Jul 9 14:52:13 2012", 15 * time.Minute, "Mon Jul 9 15:00 2012"},
+		{"Mon Jul 9 14:52:13 2012", 5 * time.Second, "Mon Jul 9 14:52:15 2012"},
+
+		// Wrap around days
+		{"Mon Jul 9 23:59:59 2012", 15 * time.Minute, "Tue Jul 10 00:00 2012"},
+		{"Mon Jul 9 23:50 2012", time.Hour, "Tue Jul 10 00:00 2012"},
+
+		// Intervals not dividing a day restart from midnight
+		{"Mon Jul 9 23:50 2012", 7 * time.Hour, "Tue Jul 10 00:00 2012"},
+		{"Tue Jul 10 00:00 2012", 7 * time.Hour, "Tue Jul 10 07:00 2012"},
+
+		// Round to nearest second on the interval
+		{"Mon Jul 9 14:45 2012", 15*time.Minute + 50*time.Nanosecond, "Mon Jul 9 15:00 2012"},
+	}
+
+	for _, c := range tests {
+		actual := Align(c.interval).Next(getTime(c.time))
+		expected := getTime(c.expected)
+		if actual != expected {
+			t.Errorf("%s, \"%s\": (expected) %v != %v (actual)", c.time, c.interval, expected, actual)
+		}
+	}
+}
+
+func TestParseAlign(t *testing.T) {
+	s, err := ParseStandard("@align 15m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != Align(15*time.Minute) {
+		t.Errorf("got %#v", s)
+	}
+	if _, err := ParseStandard("@align x"); err == nil {
+		t.Error("expected an error for a bad duration")
+	}
+}
@@ -430,5 +430,18 @@ func parseDescriptor(descriptor string, loc *time.Location) (Schedule, error) {
 		return Every(duration), nil
 	}
 
+	const align = "@align "
+	if strings.HasPrefix(descriptor, align) {
+		duration, err := time.ParseDuration(descriptor[len(align):])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse duration %s: %s", descriptor, err)
+		}
+		s := Align(duration)
+		if loc != time.Local {
+			s.Location = loc
+		}
+		return s, nil
+	}
+
 	return nil, fmt.Errorf("unrecognized descriptor: %s", descriptor)
 }
@@ -2,21 +2,25 @@ package box
 
 import (
 	"context"
-	"encoding"
+	"errors"
+	"log/slog"
+	"strings"
 	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/twiglab/h2o/pkg/common"
 )
 
-type SendObject interface {
-	encoding.BinaryMarshaler
-	Topic() string
-}
+type SendObject = common.SendObject
 
-type Sender interface {
-	SendData(ctx context.Context, obj SendObject) error
-}
+type Sender = common.Sender
+
+var (
+	ErrNotConnected   = errors.New("mqtt not connected")
+	ErrPublishTimeout = errors.New("mqtt publish timeout")
+)
+
+const publishTimeout = 30 * time.Second
 
 type MQTTAction struct {
 	client mqtt.Client
@@ -26,21 +30,45 @@ func NewMQTTAction(client mqtt.Client) *MQTTAction {
 	return &MQTTAction{client: client}
 }
 
+// SendData 没有连上 broker 时直接返回错误, 由 Spool 稍后重发
 func (c *MQTTAction) SendData(ctx context.Context, obj SendObject) error {
 	bb, err := obj.MarshalBinary()
 	if err != nil {
 		return err
 	}
 
+	if !c.client.IsConnectionOpen() {
+		return ErrNotConnected
+	}
+
 	pubToken := c.client.Publish(obj.Topic(), 0x01, false, bb)
-	pubToken.Wait()
+	if !pubToken.WaitTimeout(publishTimeout) {
+		return ErrPublishTimeout
+	}
 
 	return pubToken.Error()
 }
 
-func NewMQTTClient(clientID string, broker string, others ...string) (mqtt.Client, error) {
+// Subscriptions 每次连上 broker 后订阅, 断线重连后 clean session 不保留原来的订阅
+type Subscriptions map[string]mqtt.MessageHandler
+
+func (s Subscriptions) OnConnect(cli mqtt.Client) {
+	for topic, h := range s {
+		t := cli.Subscribe(topic, 0x01, h)
+		if t.WaitTimeout(publishTimeout) && t.Error() != nil {
+			slog.Error("mqtt subscribe", slog.String("topic", topic), slog.Any("error", t.Error()))
+		}
+	}
+}
+
+// NewMQTTClient 第一次连不上时在后台重连, 不影响启动
+func NewMQTTClient(clientID string, subs Subscriptions, broker string, others ...string) (mqtt.Client, error) {
 	opts := mqtt.NewClientOptions()
 	opts.SetClientID(clientID)
+	opts.SetConnectRetry(true)
+	if len(subs) > 0 {
+		opts.SetOnConnectHandler(subs.OnConnect)
+	}
 
 	opts.AddBroker(broker)
 	for _, b := range others {
@@ -48,7 +76,8 @@ func NewMQTTClient(clientID string, broker string, others ...string) (mqtt.Clien
 	}
 
 	client := mqtt.NewClient(opts)
-	if token := client.Connect(); token.Wait() && token.Error() != nil {
+	token := client.Connect()
+	if token.WaitTimeout(publishTimeout) && token.Error() != nil {
 		return nil, token.Error()
 	}
 
@@ -62,3 +91,18 @@ func ClientID(code string) string {
 	ts := common.Ts(now)
 	return code + "@" + ts
 }
+
+func boxID(clientID string) string {
+	id, _, _ := strings.Cut(clientID, "@")
+	return id
+}
+
+// CommandTopic 盒子接收命令的主题 h2o/box/<id>/cmd, id 是 ClientID 中 @ 之前的部分
+func CommandTopic(clientID string) string {
+	return common.H2O + "/box/" + boxID(clientID) + "/cmd"
+}
+
+// ReplyTopic 命令执行结果的主题 h2o/box/<id>/reply
+func ReplyTopic(clientID string) string {
+	return common.H2O + "/box/" + boxID(clientID) + "/reply"
+}
@@ -0,0 +1,191 @@
+package ocg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/simonvetter/modbus"
+	"github.com/twiglab/h2o/box"
+)
+
+var (
+	ErrNotConnected = errors.New("modbus not connected")
+	ErrBusClosed    = errors.New("modbus bus closed")
+)
+
+// redial 连接失败后, 至少隔这么久再重连, 避免每个请求都等一次连接超时
+const redial = 5 * time.Second
+
+// exceptions 从站回了异常应答, 连接本身是好的
+var exceptions = []error{
+	modbus.ErrIllegalFunction,
+	modbus.ErrIllegalDataAddress,
+	modbus.ErrIllegalDataValue,
+	modbus.ErrServerDeviceFailure,
+	modbus.ErrAcknowledge,
+	modbus.ErrServerDeviceBusy,
+	modbus.ErrMemoryParityError,
+	modbus.ErrGWPathUnavailable,
+	modbus.ErrGWTargetFailedToRespond,
+}
+
+func isException(err error) bool {
+	return slices.ContainsFunc(exceptions, func(e error) bool { return errors.Is(err, e) })
+}
+
+var schemes = []string{"tcp", "udp", "rtu", "rtuovertcp", "rtuoverudp"}
+
+func scheme(url string) string {
+	s, _, _ := strings.Cut(url, "://")
+	return s
+}
+
+func parity(s string) (uint, error) {
+	switch s {
+	case "", "none":
+		return modbus.PARITY_NONE, nil
+	case "even":
+		return modbus.PARITY_EVEN, nil
+	case "odd":
+		return modbus.PARITY_ODD, nil
+	}
+	return 0, fmt.Errorf("%w: parity %q", ErrConf, s)
+}
+
+type request struct {
+	ctx    context.Context
+	unitID uint8
+	fn     func(*modbus.ModbusClient) error
+	res    chan error
+}
+
+// Bus 一条串口总线或一个 TCP 连接, 请求由一个 goroutine 按顺序执行
+// 连接断了在下一个请求时重连, 从站不应答不影响同一总线上的其他从站
+type Bus struct {
+	Endpoint
+
+	reqs chan request
+	quit chan struct{}
+	done chan struct{}
+
+	client *modbus.ModbusClient
+	dialAt time.Time
+}
+
+func NewBus(e Endpoint) *Bus {
+	return &Bus{
+		Endpoint: e,
+		reqs:     make(chan request),
+		quit:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+}
+
+func (b *Bus) Start() {
+	go b.loop()
+}
+
+// Close 等当前的请求执行完后关闭连接
+func (b *Bus) Close() {
+	close(b.quit)
+	<-b.done
+}
+
+// Do 在总线的 goroutine 中设置从站地址后执行 fn
+func (b *Bus) Do(ctx context.Context, unitID uint8, fn func(*modbus.ModbusClient) error) error {
+	r := request{ctx: ctx, unitID: unitID, fn: fn, res: make(chan error, 1)}
+	select {
+	case b.reqs <- r:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-b.quit:
+		return fmt.Errorf("%s: %w", b.Name, ErrBusClosed)
+	}
+	select {
+	case err := <-r.res:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Bus) loop() {
+	defer close(b.done)
+	for {
+		select {
+		case <-b.quit:
+			b.hangup()
+			return
+		case r := <-b.reqs:
+			if err := r.ctx.Err(); err != nil {
+				r.res <- err
+				continue
+			}
+			r.res <- b.exec(r)
+			if b.Delay > 0 {
+				time.Sleep(b.Delay)
+			}
+		}
+	}
+}
+
+func (b *Bus) exec(r request) error {
+	if err := b.dial(); err != nil {
+		return err
+	}
+	if err := b.client.SetUnitId(r.unitID); err != nil {
+		return err
+	}
+
+	err := r.fn(b.client)
+	if err != nil && b.broken(err) {
+		slog.Warn("modbus reconnect", slog.String("bus", b.Name), slog.Any("error", err))
+		b.hangup()
+	}
+	return err
+}
+
+// broken 串口上是从站不应答, 不用重开串口; 网络连接超时就重连
+func (b *Bus) broken(err error) bool {
+	if isException(err) {
+		return false
+	}
+	return !(scheme(b.URL) == "rtu" && errors.Is(err, modbus.ErrRequestTimedOut))
+}
+
+func (b *Bus) dial() error {
+	if b.client != nil {
+		return nil
+	}
+	if time.Since(b.dialAt) < redial {
+		return fmt.Errorf("%s: %w", b.Name, ErrNotConnected)
+	}
+	b.dialAt = time.Now()
+
+	p, _ := parity(b.Parity)
+	cli, err := box.NewModbusClient(&modbus.ClientConfiguration{
+		URL:      b.URL,
+		Timeout:  b.Timeout,
+		Speed:    b.Speed,
+		DataBits: b.DataBits,
+		Parity:   p,
+		StopBits: b.StopBits,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w: %w", b.Name, ErrNotConnected, err)
+	}
+	b.client = cli
+	return nil
+}
+
+func (b *Bus) hangup() {
+	if b.client != nil {
+		_ = b.client.Close()
+		b.client = nil
+	}
+}
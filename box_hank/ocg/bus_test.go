@@ -0,0 +1,248 @@
+package ocg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/simonvetter/modbus"
+	"github.com/twiglab/h2o/box"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// fakeBus 已经连上的总线, 客户端没有打开, 只给 fn 用
+func fakeBus(t *testing.T, url string) *Bus {
+	t.Helper()
+	cli, err := modbus.NewClient(&modbus.ClientConfiguration{URL: "tcp://127.0.0.1:502"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := NewBus(Endpoint{Name: "bus1", URL: url})
+	b.client = cli
+	return b
+}
+
+func TestBusSerial(t *testing.T) {
+	b := fakeBus(t, "tcp://127.0.0.1:502")
+	b.Start()
+	defer b.Close()
+
+	var active, peak, done atomic.Int32
+	fn := func(*modbus.ModbusClient) error {
+		n := active.Add(1)
+		defer active.Add(-1)
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+		time.Sleep(time.Millisecond)
+		done.Add(1)
+		return nil
+	}
+
+	var wg sync.WaitGroup
+	for i := range 20 {
+		wg.Go(func() {
+			if err := b.Do(context.Background(), uint8(i), fn); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+	wg.Wait()
+	if done.Load() != 20 || peak.Load() != 1 {
+		t.Fatalf("done %d, peak %d, want 20 requests one at a time", done.Load(), peak.Load())
+	}
+}
+
+func TestBusBroken(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		err  error
+		kept bool
+	}{
+		{"串口从站不应答", "rtu:///dev/ttyS0", modbus.ErrRequestTimedOut, true},
+		{"串口异常应答", "rtu:///dev/ttyS0", modbus.ErrIllegalDataAddress, true},
+		{"串口校验错误", "rtu:///dev/ttyS0", modbus.ErrBadCRC, false},
+		{"网络超时", "tcp://127.0.0.1:502", modbus.ErrRequestTimedOut, false},
+		{"串口服务器超时", "rtuovertcp://127.0.0.1:4001", modbus.ErrRequestTimedOut, false},
+		{"网络异常应答", "tcp://127.0.0.1:502", modbus.ErrGWTargetFailedToRespond, true},
+		{"网络断开", "tcp://127.0.0.1:502", io.EOF, false},
+		{"成功", "tcp://127.0.0.1:502", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := fakeBus(t, tt.url)
+			err := b.exec(request{ctx: context.Background(), unitID: 1, fn: func(*modbus.ModbusClient) error { return tt.err }})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if kept := b.client != nil; kept != tt.kept {
+				t.Fatalf("connection kept %v, want %v", kept, tt.kept)
+			}
+		})
+	}
+}
+
+func TestBusRedial(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := ln.Addr().String()
+	ln.Close()
+
+	b := NewBus(Endpoint{Name: "tcp1", URL: "tcp://" + addr, Timeout: 200 * time.Millisecond})
+	err = b.dial()
+	if !errors.Is(err, ErrNotConnected) || !strings.Contains(err.Error(), addr) {
+		t.Fatalf("first dial: err = %v, want connect error", err)
+	}
+
+	// 从站上线了, 间隔不到 redial 也不重连
+	ln, err = net.Listen("tcp", addr)
+	if err != nil {
+		t.Skip(err)
+	}
+	defer ln.Close()
+	if err := b.dial(); !errors.Is(err, ErrNotConnected) || err.Error() != "tcp1: modbus not connected" {
+		t.Fatalf("dial within redial: err = %v, want ErrNotConnected without dialing", err)
+	}
+
+	b.dialAt = time.Now().Add(-redial)
+	if err := b.dial(); err != nil || b.client == nil {
+		t.Fatalf("dial after redial: err = %v", err)
+	}
+	// 已经连上时不再连接
+	at := b.dialAt
+	if err := b.dial(); err != nil || b.dialAt != at {
+		t.Fatalf("dial when connected: err = %v", err)
+	}
+	b.hangup()
+}
+
+func TestBusClose(t *testing.T) {
+	b := fakeBus(t, "tcp://127.0.0.1:502")
+	b.Start()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	errRead := errors.New("read")
+	var first error
+	var wg sync.WaitGroup
+	wg.Go(func() {
+		first = b.Do(context.Background(), 1, func(*modbus.ModbusClient) error {
+			close(started)
+			<-release
+			return errRead
+		})
+	})
+	<-started
+
+	// 排队的请求可以取消
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := b.Do(ctx, 2, func(*modbus.ModbusClient) error { return nil }); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("queued: err = %v, want deadline exceeded", err)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		b.Close()
+		close(closed)
+	}()
+	// 排队的请求在关闭后返回 ErrBusClosed, 不会执行
+	if err := b.Do(context.Background(), 3, func(*modbus.ModbusClient) error {
+		t.Error("request executed after close")
+		return nil
+	}); !errors.Is(err, ErrBusClosed) {
+		t.Fatalf("after close: err = %v, want ErrBusClosed", err)
+	}
+
+	select {
+	case <-closed:
+		t.Fatal("Close returned before the pending request finished")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(release)
+	<-closed
+	wg.Wait()
+	if !errors.Is(first, errRead) {
+		t.Fatalf("pending request: err = %v, want its own result", first)
+	}
+}
+
+func TestPerBus(t *testing.T) {
+	b1, b2 := NewBus(Endpoint{Name: "bus1"}), NewBus(Endpoint{Name: "bus2"})
+	tasks := []*TaskX{{Code: "E1", Bus: b1}, {Code: "E2", Bus: b2}, {Code: "E3", Bus: b1}}
+
+	dead := make(chan struct{})
+	errDead := errors.New("no response")
+	var mu sync.Mutex
+	var order []string
+	errs := perBus(tasks, func(x *TaskX) error {
+		if x.Code == "E1" {
+			// 其他总线的表读完之前 E1 一直不应答
+			<-dead
+			return errDead
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		order = append(order, x.Code)
+		if x.Code == "E2" {
+			close(dead)
+		}
+		return nil
+	})
+
+	if !errors.Is(errs[0], errDead) || errs[1] != nil || errs[2] != nil {
+		t.Fatalf("errs = %v", errs)
+	}
+	// 同一总线按顺序, E1 失败后 E3 照常执行
+	if !slices.Equal(order, []string{"E2", "E3"}) {
+		t.Fatalf("order = %v, want E2 E3", order)
+	}
+}
+
+type recorder struct {
+	mu    sync.Mutex
+	codes []string
+}
+
+func (r *recorder) SendData(_ context.Context, obj box.SendObject) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.codes = append(r.codes, obj.(box.ElectricityMeter).Code)
+	return nil
+}
+
+func TestTaskChainDeadMeter(t *testing.T) {
+	regs := map[uint16]uint16{0: 0, 1: 100}
+	s1 := &slave{
+		regs: map[uint8]map[uint16]uint16{1: regs, 2: regs},
+		mute: map[uint8]time.Duration{1: 400 * time.Millisecond},
+	}
+	s2 := &slave{regs: map[uint8]map[uint16]uint16{3: regs}}
+	b1, b2 := testBus(t, serve(t, s1)), testBus(t, serve(t, s2))
+
+	rec := &recorder{}
+	meter := func(code string, unitID uint8, b *Bus) *TaskX {
+		return NewTask(Conf{}, MeterConf{Code: code, Type: common.ELECTRICITY, UnitID: unitID, Addr: 0}, b, rec)
+	}
+
+	err := TaskChain(context.Background(), meter("E1", 1, b1), meter("E2", 2, b1), meter("E3", 3, b2))
+	if !errors.Is(err, modbus.ErrRequestTimedOut) || !strings.Contains(err.Error(), "E1") {
+		t.Fatalf("err = %v, want E1 timed out", err)
+	}
+	// 网络连接超时后断开重连, E2 在重连间隔内读不到
+	if !errors.Is(err, ErrNotConnected) || !strings.Contains(err.Error(), "E2") {
+		t.Fatalf("err = %v, want E2 not connected", err)
+	}
+	if strings.Contains(err.Error(), "E3") || !slices.Equal(rec.codes, []string{"E3"}) {
+		t.Fatalf("err = %v, sent %v, want E3 sent", err, rec.codes)
+	}
+}
@@ -0,0 +1,176 @@
+package ocg
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+	"sync"
+
+	"github.com/twiglab/h2o/box"
+	"github.com/twiglab/h2o/box/internal/cron"
+)
+
+var (
+	ErrUnknownMeter = errors.New("unknown meter")
+	ErrUnknownBus   = errors.New("unknown endpoint")
+)
+
+// Collector 按配置定时采集, 配置变化时整体替换
+type Collector struct {
+	Sender box.Sender
+
+	mu     sync.Mutex
+	conf   Conf
+	cron   *cron.Cron
+	cancel context.CancelFunc
+	buses  map[string]*Bus
+	tasks  []*TaskX
+}
+
+func NewCollector(s box.Sender) *Collector {
+	return &Collector{Sender: s}
+}
+
+// Load 先检查配置和 schedule, 有错时保持原来的采集不变
+func (c *Collector) Load(conf Conf) error {
+	if err := conf.Check(); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.cron != nil && reflect.DeepEqual(c.conf, conf) {
+		return nil
+	}
+
+	buses := make(map[string]*Bus, len(conf.Endpoints))
+	for _, e := range conf.Endpoints {
+		buses[e.Name] = NewBus(e)
+	}
+
+	groups := make(map[string][]*TaskX)
+	var (
+		specs []string
+		all   []*TaskX
+	)
+	for _, m := range conf.Meters {
+		spec := cmp.Or(m.Schedule, conf.Schedule, DefaultSchedule)
+		if _, ok := groups[spec]; !ok {
+			specs = append(specs, spec)
+		}
+		t := NewTask(conf, m, buses[m.Endpoint], c.Sender)
+		groups[spec] = append(groups[spec], t)
+		all = append(all, t)
+	}
+
+	// 重新加载或停止时取消正在执行的任务
+	ctx, cancel := context.WithCancel(context.Background())
+
+	cr := box.NewCron()
+	for _, spec := range specs {
+		tasks := groups[spec]
+		_, err := cr.AddFunc(spec, func() {
+			if err := TaskChain(ctx, tasks...); err != nil {
+				slog.Error("task error", slog.String("schedule", spec), slog.Any("error", err))
+			}
+		})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("%w: schedule %q: %w", ErrConf, spec, err)
+		}
+	}
+
+	if freeze := cmp.Or(conf.Freeze, DefaultFreeze); freeze != FreezeOff {
+		window := cmp.Or(conf.FreezeWindow, DefaultFreezeWindow)
+		_, err := cr.AddFunc(freeze, func() {
+			Freeze(ctx, window, all...)
+		})
+		if err != nil {
+			cancel()
+			return fmt.Errorf("%w: freeze %q: %w", ErrConf, freeze, err)
+		}
+	}
+
+	c.stop()
+
+	for _, b := range buses {
+		b.Start()
+	}
+	c.conf = conf
+	c.cron = cr
+	c.cancel = cancel
+	c.buses = buses
+	c.tasks = all
+	cr.Start()
+
+	slog.Info("ocg config loaded",
+		slog.Int("endpoints", len(conf.Endpoints)),
+		slog.Int("meters", len(conf.Meters)),
+		slog.Any("schedules", specs))
+	return nil
+}
+
+// Stop 等正在执行的任务结束后关闭总线
+func (c *Collector) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stop()
+}
+
+func (c *Collector) stop() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	if c.cron != nil {
+		<-c.cron.Stop().Done()
+	}
+	for _, b := range c.buses {
+		b.Close()
+	}
+	c.cron = nil
+	c.cancel = nil
+	c.buses = nil
+	c.tasks = nil
+}
+
+// Conf 当前生效的配置
+func (c *Collector) Conf() Conf {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.conf
+}
+
+// Tasks 按表号取任务, codes 为空时是全部
+func (c *Collector) Tasks(codes ...string) ([]*TaskX, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(codes) == 0 {
+		return slices.Clone(c.tasks), nil
+	}
+	ts := make([]*TaskX, 0, len(codes))
+	for _, code := range codes {
+		i := slices.IndexFunc(c.tasks, func(t *TaskX) bool { return t.Code == code })
+		if i < 0 {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownMeter, code)
+		}
+		ts = append(ts, c.tasks[i])
+	}
+	return ts, nil
+}
+
+func (c *Collector) Bus(name string) (*Bus, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.buses[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownBus, name)
+	}
+	return b, nil
+}
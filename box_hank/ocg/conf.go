@@ -0,0 +1,219 @@
+package ocg
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/simonvetter/modbus"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+var ErrConf = errors.New("invalid ocg config")
+
+const (
+	// DefaultSchedule 按整刻钟采集, @every 会随启动时间漂移
+	DefaultSchedule = "@align 15m"
+	// DefaultFreeze 零点冻结
+	DefaultFreeze = "0 0 0 * * *"
+	// DefaultFreezeWindow 冻结读数失败的表在这段时间内重试
+	DefaultFreezeWindow = 10 * time.Minute
+
+	// FreezeOff 不做冻结读数
+	FreezeOff = "off"
+)
+
+// Endpoint 一条 modbus 总线, url 如 tcp://10.0.0.1:502, rtu:///dev/ttyS0, rtuovertcp://10.0.0.2:4001
+type Endpoint struct {
+	Name    string        `mapstructure:"name"`
+	URL     string        `mapstructure:"url"`
+	Timeout time.Duration `mapstructure:"timeout"` // 一次请求的超时
+	Delay   time.Duration `mapstructure:"delay"`   // 两次请求之间的间隔
+
+	// 串口参数, 只用于 rtu
+	Speed    uint   `mapstructure:"speed"`
+	DataBits uint   `mapstructure:"data_bits"`
+	Parity   string `mapstructure:"parity"` // none, even, odd
+	StopBits uint   `mapstructure:"stop_bits"`
+}
+
+// MeterConf 一块表的采集定义
+type MeterConf struct {
+	Code    string `mapstructure:"code"`
+	Type    string `mapstructure:"type"`
+	Project string `mapstructure:"project"`
+	PosCode string `mapstructure:"pos_code"`
+
+	Endpoint string        `mapstructure:"endpoint"`
+	UnitID   uint8         `mapstructure:"unit_id"`
+	Timeout  time.Duration `mapstructure:"timeout"` // 读一块表的期限, 包括排队和重试
+	Retries  int           `mapstructure:"retries"` // 从站不应答时重试的次数
+
+	RegType   string `mapstructure:"reg_type"`   // input, holding
+	WordOrder string `mapstructure:"word_order"` // low_first, high_first
+	RegMap    string `mapstructure:"regmap"`     // 寄存器表, 为空时只读 addr 上的表显读数
+
+	Addr     uint16  `mapstructure:"addr"`
+	DataType string  `mapstructure:"data_type"` // uint16, int16, uint32, int32, float32, float64
+	Scale    float64 `mapstructure:"scale"`     // 读数乘以倍数, 为 0 时是 1
+
+	Schedule string `mapstructure:"schedule"` // 为空时用 Conf.Schedule
+}
+
+// Conf ocg 的采集配置
+//
+//	[ocg.collect]
+//	schedule = "@align 15m"
+//	freeze = "0 0 0 * * *"
+//	freeze_window = "10m"
+//
+//	[[ocg.collect.endpoint]]
+//	name = "tcp1"
+//	url = "tcp://192.168.1.10:502"
+//
+//	[[ocg.collect.endpoint]]
+//	name = "com1"
+//	url = "rtu:///dev/ttyS0"
+//	speed = 9600
+//	delay = "50ms"
+//
+//	[[ocg.collect.meter]]
+//	code = "PT-1-IN"
+//	type = "E"
+//	project = "1006"
+//	endpoint = "tcp1"
+//	unit_id = 1
+//	addr = 2
+//	reg_type = "input"
+//	data_type = "uint32"
+//	word_order = "low_first"
+//	regmap = "dtsu666"
+type Conf struct {
+	Schedule     string        `mapstructure:"schedule"`
+	Freeze       string        `mapstructure:"freeze"` // 冻结读数的 schedule, off 表示不做
+	FreezeWindow time.Duration `mapstructure:"freeze_window"`
+	Endpoints    []Endpoint    `mapstructure:"endpoint"`
+	RegMaps      []RegMap      `mapstructure:"regmap"`
+	Meters       []MeterConf   `mapstructure:"meter"`
+}
+
+// fields 表要读的字段, 没有寄存器表时只有表显读数
+func (c Conf) fields(m MeterConf) []FieldConf {
+	if m.RegMap == "" {
+		return []FieldConf{{Name: "data_value", Addr: m.Addr, DataType: m.DataType, Scale: m.Scale}}
+	}
+	for _, rm := range c.RegMaps {
+		if rm.Name == m.RegMap {
+			return rm.Fields
+		}
+	}
+	return nil
+}
+
+func (c Conf) wordOrder(m MeterConf) string {
+	for _, rm := range c.RegMaps {
+		if rm.Name == m.RegMap && rm.WordOrder != "" {
+			return rm.WordOrder
+		}
+	}
+	return m.WordOrder
+}
+
+func regType(s string) (modbus.RegType, error) {
+	switch s {
+	case "", "input":
+		return modbus.INPUT_REGISTER, nil
+	case "holding":
+		return modbus.HOLDING_REGISTER, nil
+	}
+	return 0, fmt.Errorf("%w: reg_type %q", ErrConf, s)
+}
+
+func wordOrder(s string) (modbus.WordOrder, error) {
+	switch s {
+	case "", "low_first":
+		return modbus.LOW_WORD_FIRST, nil
+	case "high_first":
+		return modbus.HIGH_WORD_FIRST, nil
+	}
+	return 0, fmt.Errorf("%w: word_order %q", ErrConf, s)
+}
+
+// Check 检查配置, 所有错误一起返回
+func (c Conf) Check() error {
+	var errs []error
+	names := make(map[string]bool, len(c.Endpoints))
+	for _, e := range c.Endpoints {
+		switch {
+		case e.Name == "" || e.URL == "":
+			errs = append(errs, fmt.Errorf("%w: endpoint %q needs name and url", ErrConf, e.Name))
+		case names[e.Name]:
+			errs = append(errs, fmt.Errorf("%w: endpoint %q duplicated", ErrConf, e.Name))
+		case !slices.Contains(schemes, scheme(e.URL)):
+			errs = append(errs, fmt.Errorf("%w: endpoint %s url %q", ErrConf, e.Name, e.URL))
+		}
+		names[e.Name] = true
+		if _, err := parity(e.Parity); err != nil {
+			errs = append(errs, fmt.Errorf("endpoint %s: %w", e.Name, err))
+		}
+	}
+
+	maps := make(map[string]bool, len(c.RegMaps))
+	for _, rm := range c.RegMaps {
+		switch {
+		case rm.Name == "":
+			errs = append(errs, fmt.Errorf("%w: regmap name is empty", ErrConf))
+		case maps[rm.Name]:
+			errs = append(errs, fmt.Errorf("%w: regmap %q duplicated", ErrConf, rm.Name))
+		case len(rm.Fields) == 0:
+			errs = append(errs, fmt.Errorf("%w: regmap %q has no field", ErrConf, rm.Name))
+		}
+		maps[rm.Name] = true
+		if _, err := wordOrder(rm.WordOrder); err != nil {
+			errs = append(errs, fmt.Errorf("regmap %s: %w", rm.Name, err))
+		}
+	}
+
+	codes := make(map[string]bool, len(c.Meters))
+	for _, m := range c.Meters {
+		if m.Code == "" {
+			errs = append(errs, fmt.Errorf("%w: meter code is empty", ErrConf))
+			continue
+		}
+		if codes[m.Code] {
+			errs = append(errs, fmt.Errorf("%w: meter %s duplicated", ErrConf, m.Code))
+		}
+		codes[m.Code] = true
+
+		if m.Type != common.ELECTRICITY && m.Type != common.WATER {
+			errs = append(errs, fmt.Errorf("%w: meter %s type %q", ErrConf, m.Code, m.Type))
+		}
+		if !names[m.Endpoint] {
+			errs = append(errs, fmt.Errorf("%w: meter %s endpoint %q not found", ErrConf, m.Code, m.Endpoint))
+		}
+		if m.RegMap != "" && !maps[m.RegMap] {
+			errs = append(errs, fmt.Errorf("%w: meter %s regmap %q not found", ErrConf, m.Code, m.RegMap))
+		}
+		fields := make(map[string]bool)
+		for _, f := range c.fields(m) {
+			if fields[f.Name] {
+				errs = append(errs, fmt.Errorf("%w: meter %s field %s duplicated", ErrConf, m.Code, f.Name))
+			}
+			fields[f.Name] = true
+			if err := f.check(m.Type); err != nil {
+				errs = append(errs, fmt.Errorf("meter %s: %w", m.Code, err))
+			}
+		}
+		if maps[m.RegMap] && !fields["data_value"] {
+			errs = append(errs, fmt.Errorf("%w: meter %s regmap %s has no data_value", ErrConf, m.Code, m.RegMap))
+		}
+		if _, err := regType(m.RegType); err != nil {
+			errs = append(errs, fmt.Errorf("meter %s: %w", m.Code, err))
+		}
+		if _, err := wordOrder(m.WordOrder); err != nil {
+			errs = append(errs, fmt.Errorf("meter %s: %w", m.Code, err))
+		}
+	}
+	return errors.Join(errs...)
+}
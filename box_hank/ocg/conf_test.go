@@ -0,0 +1,109 @@
+package ocg
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func testConf() Conf {
+	return Conf{
+		Freeze:    FreezeOff,
+		Endpoints: []Endpoint{{Name: "tcp1", URL: "tcp://127.0.0.1:1502"}},
+		RegMaps: []RegMap{{Name: "dtsu666", WordOrder: "high_first", Fields: []FieldConf{
+			{Name: "data_value", Addr: 0x401E, DataType: "float32", Scale: 100},
+			{Name: "voltage_a", Addr: 0x2006, DataType: "float32", Scale: 10},
+		}}},
+		Meters: []MeterConf{
+			{Code: "E1", Type: "E", Endpoint: "tcp1", UnitID: 1, RegMap: "dtsu666"},
+			{Code: "W1", Type: "W", Endpoint: "tcp1", UnitID: 2, Addr: 2, DataType: "uint32"},
+		},
+	}
+}
+
+func TestConfCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*Conf)
+		want   string
+	}{
+		{"正常", func(*Conf) {}, ""},
+		{"未知数据类型", func(c *Conf) { c.Meters[1].DataType = "uint64" }, `field data_value data_type "uint64"`},
+		{"寄存器表未知数据类型", func(c *Conf) { c.RegMaps[0].Fields[1].DataType = "bcd" }, `field voltage_a data_type "bcd"`},
+		{"寄存器表没有表显读数", func(c *Conf) { c.RegMaps[0].Fields = c.RegMaps[0].Fields[1:] }, "regmap dtsu666 has no data_value"},
+		{"表号重复", func(c *Conf) { c.Meters[1].Code = "E1" }, "meter E1 duplicated"},
+		{"表号为空", func(c *Conf) { c.Meters[1].Code = "" }, "meter code is empty"},
+		{"总线重复", func(c *Conf) { c.Endpoints = append(c.Endpoints, c.Endpoints[0]) }, `endpoint "tcp1" duplicated`},
+		{"总线不存在", func(c *Conf) { c.Meters[0].Endpoint = "com1" }, `endpoint "com1" not found`},
+		{"不支持的地址", func(c *Conf) { c.Endpoints[0].URL = "http://127.0.0.1" }, `url "http://127.0.0.1"`},
+		{"寄存器表不存在", func(c *Conf) { c.Meters[0].RegMap = "pd194" }, `regmap "pd194" not found`},
+		{"水表字段", func(c *Conf) { c.Meters[0].Type = "W" }, `field "voltage_a" for type W`},
+		{"寄存器类型", func(c *Conf) { c.Meters[1].RegType = "coil" }, `reg_type "coil"`},
+		{"字序", func(c *Conf) { c.RegMaps[0].WordOrder = "middle" }, `word_order "middle"`},
+		{"校验位", func(c *Conf) { c.Endpoints[0].Parity = "mark" }, `parity "mark"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := testConf()
+			tt.modify(&c)
+			err := c.Check()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrConf) || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("err = %v, want %s", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectorReload(t *testing.T) {
+	c := NewCollector(nil)
+	defer c.Stop()
+	if err := c.Load(testConf()); err != nil {
+		t.Fatal(err)
+	}
+	old, err := c.Bus("tcp1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 配置有错时保持原来的采集
+	bad := testConf()
+	bad.Meters[0].Endpoint = "com1"
+	if err := c.Load(bad); err == nil {
+		t.Fatal("load bad config: got nil error")
+	}
+	if b, _ := c.Bus("tcp1"); b != old {
+		t.Fatal("bus replaced by bad config")
+	}
+
+	conf := testConf()
+	conf.Endpoints = append(conf.Endpoints, Endpoint{Name: "tcp2", URL: "tcp://127.0.0.1:2502"})
+	conf.Meters = []MeterConf{{Code: "E2", Type: "E", Endpoint: "tcp2", UnitID: 1, Addr: 0, DataType: "uint32"}}
+	if err := c.Load(conf); err != nil {
+		t.Fatal(err)
+	}
+
+	ts, err := c.Tasks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ts) != 1 || ts[0].Code != "E2" {
+		t.Fatalf("tasks = %v, want E2", ts)
+	}
+	if _, err := c.Tasks("E1"); !errors.Is(err, ErrUnknownMeter) {
+		t.Fatalf("E1 after reload: err = %v, want ErrUnknownMeter", err)
+	}
+	if b, _ := c.Bus("tcp1"); b == old {
+		t.Fatal("bus tcp1 not replaced")
+	}
+	// 原来的总线已经关闭
+	if err := old.Do(context.Background(), 1, nil); !errors.Is(err, ErrBusClosed) {
+		t.Fatalf("old bus: err = %v, want ErrBusClosed", err)
+	}
+}
@@ -0,0 +1,234 @@
+package ocg
+
+import (
+	"context"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/simonvetter/modbus"
+)
+
+var (
+	ErrUnknownCmd = errors.New("unknown command")
+	ErrWriteDeny  = errors.New("write not allowed")
+	ErrLogLevel   = errors.New("unknown log level")
+)
+
+// 远程命令
+const (
+	CmdRead     = "read"      // 读一块表, 读数只回复不发送
+	CmdRun      = "run"       // 立即采集 codes 中的表, 为空时全部, 读数照常发送
+	CmdConfig   = "config"    // 当前配置和版本
+	CmdLogLevel = "log_level" // 调整日志级别
+	CmdWrite    = "write"     // 写寄存器, 必须在 WriteRule 允许的范围内
+)
+
+// cmdTimeout 一条命令最长执行的时间
+const cmdTimeout = 5 * time.Minute
+
+// recentCmds 记住最近这么多条命令的结果, 用于识别 QoS 1 重发的命令
+const recentCmds = 64
+
+// Command 通过 broker 下发的命令, ID 原样带回到回复中
+//
+//	{"id":"1","cmd":"read","code":"PT-1-IN"}
+//	{"id":"2","cmd":"write","endpoint":"tcp1","unit_id":1,"addr":100,"values":[1]}
+type Command struct {
+	ID  string `json:"id"`
+	Cmd string `json:"cmd"`
+
+	Code  string   `json:"code,omitempty"`
+	Codes []string `json:"codes,omitempty"`
+	Level string   `json:"level,omitempty"`
+
+	Endpoint string   `json:"endpoint,omitempty"`
+	UnitID   uint8    `json:"unit_id,omitempty"`
+	Addr     uint16   `json:"addr,omitempty"`
+	Values   []uint16 `json:"values,omitempty"`
+}
+
+type Reply struct {
+	ID    string `json:"id"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+	Data  any    `json:"data,omitempty"`
+}
+
+// RunFailure 立即采集时读或者发送失败的表
+type RunFailure struct {
+	Code  string `json:"code"`
+	Error string `json:"error"`
+}
+
+// WriteRule 允许写的寄存器 [addr, addr+count)
+//
+//	[[ocg.control.write]]
+//	endpoint = "tcp1"
+//	unit_id = 1
+//	addr = 100
+//	count = 2
+type WriteRule struct {
+	Endpoint string `mapstructure:"endpoint"`
+	UnitID   uint8  `mapstructure:"unit_id"`
+	Addr     uint16 `mapstructure:"addr"`
+	Count    uint16 `mapstructure:"count"`
+}
+
+func (w WriteRule) allow(c Command) bool {
+	end := int(c.Addr) + len(c.Values)
+	return w.Endpoint == c.Endpoint && w.UnitID == c.UnitID &&
+		c.Addr >= w.Addr && end <= int(w.Addr)+int(w.Count)
+}
+
+// Control 执行远程命令
+type Control struct {
+	Collector *Collector
+	Level     *slog.LevelVar
+	Version   string
+	Writes    []WriteRule
+
+	mu    sync.Mutex
+	ids   []string // 按到达顺序, 最多 recentCmds 个
+	calls map[string]*call
+}
+
+type call struct {
+	done  chan struct{}
+	reply Reply
+}
+
+// Exec 执行命令, ID 和最近的命令相同时不再执行, 回复第一次的结果
+// broker 按 QoS 1 重发的命令可能到达多次, 写寄存器这样的命令不能重复执行
+func (c *Control) Exec(ctx context.Context, cmd Command) Reply {
+	if cmd.ID == "" {
+		return c.do(ctx, cmd)
+	}
+
+	c.mu.Lock()
+	if cl, ok := c.calls[cmd.ID]; ok {
+		c.mu.Unlock()
+		slog.Info("duplicate command", slog.String("id", cmd.ID), slog.String("cmd", cmd.Cmd))
+		<-cl.done
+		return cl.reply
+	}
+	if c.calls == nil {
+		c.calls = make(map[string]*call)
+	}
+	cl := &call{done: make(chan struct{})}
+	c.calls[cmd.ID] = cl
+	c.ids = append(c.ids, cmd.ID)
+	if len(c.ids) > recentCmds {
+		delete(c.calls, c.ids[0])
+		c.ids = slices.Delete(c.ids, 0, 1)
+	}
+	c.mu.Unlock()
+
+	cl.reply = c.do(ctx, cmd)
+	close(cl.done)
+	return cl.reply
+}
+
+func (c *Control) do(ctx context.Context, cmd Command) Reply {
+	data, err := c.exec(ctx, cmd)
+	if err != nil {
+		return Reply{ID: cmd.ID, Error: err.Error()}
+	}
+	return Reply{ID: cmd.ID, OK: true, Data: data}
+}
+
+func (c *Control) exec(ctx context.Context, cmd Command) (any, error) {
+	switch cmd.Cmd {
+	case CmdRead:
+		ts, err := c.Collector.Tasks(cmd.Code)
+		if err != nil {
+			return nil, err
+		}
+		return ts[0].Read(ctx, 0)
+
+	case CmdRun:
+		ts, err := c.Collector.Tasks(cmd.Codes...)
+		if err != nil {
+			return nil, err
+		}
+		errs := perBus(ts, func(t *TaskX) error {
+			return t.Run(ctx)
+		})
+		failed := []RunFailure{}
+		for i, err := range errs {
+			if err != nil {
+				failed = append(failed, RunFailure{Code: ts[i].Code, Error: err.Error()})
+			}
+		}
+		return map[string]any{"total": len(ts), "failed": failed}, nil
+
+	case CmdConfig:
+		return map[string]any{"version": c.Version, "level": c.Level.Level().String(), "conf": c.Collector.Conf()}, nil
+
+	case CmdLogLevel:
+		// debug, info, warn, error, 不区分大小写
+		var level slog.Level
+		if err := level.UnmarshalText([]byte(cmd.Level)); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrLogLevel, cmd.Level)
+		}
+		c.Level.Set(level)
+		slog.Info("log level changed", slog.String("level", c.Level.Level().String()))
+		return c.Level.Level().String(), nil
+
+	case CmdWrite:
+		if len(cmd.Values) == 0 || !slices.ContainsFunc(c.Writes, func(w WriteRule) bool { return w.allow(cmd) }) {
+			return nil, fmt.Errorf("%w: %s unit %d addr %d count %d", ErrWriteDeny, cmd.Endpoint, cmd.UnitID, cmd.Addr, len(cmd.Values))
+		}
+		b, err := c.Collector.Bus(cmd.Endpoint)
+		if err != nil {
+			return nil, err
+		}
+		err = b.Do(ctx, cmd.UnitID, func(cli *modbus.ModbusClient) error {
+			if err := cli.SetEncoding(modbus.BIG_ENDIAN, modbus.HIGH_WORD_FIRST); err != nil {
+				return err
+			}
+			return cli.WriteRegisters(cmd.Addr, cmd.Values)
+		})
+		if err == nil {
+			slog.Warn("register written", slog.String("endpoint", cmd.Endpoint), slog.Any("unit", cmd.UnitID),
+				slog.Any("addr", cmd.Addr), slog.Any("values", cmd.Values))
+		}
+		return nil, err
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownCmd, cmd.Cmd)
+}
+
+// Handle 收到命令后执行, 结果发到 reply 主题
+// 命令可能要读很多表, 不阻塞 mqtt 的消息处理
+func (c *Control) Handle(reply string) mqtt.MessageHandler {
+	return func(cli mqtt.Client, msg mqtt.Message) {
+		payload := msg.Payload()
+		go func() {
+			var cmd Command
+			if err := json.Unmarshal(payload, &cmd); err != nil {
+				c.publish(cli, reply, Reply{Error: err.Error()})
+				return
+			}
+			slog.Info("command", slog.String("id", cmd.ID), slog.String("cmd", cmd.Cmd))
+
+			ctx, cancel := context.WithTimeout(context.Background(), cmdTimeout)
+			defer cancel()
+			c.publish(cli, reply, c.Exec(ctx, cmd))
+		}()
+	}
+}
+
+func (c *Control) publish(cli mqtt.Client, topic string, r Reply) {
+	bs, err := json.Marshal(r)
+	if err != nil {
+		bs, _ = json.Marshal(Reply{ID: r.ID, Error: err.Error()})
+	}
+	if t := cli.Publish(topic, 0x01, false, bs); t.WaitTimeout(cmdTimeout) && t.Error() != nil {
+		slog.Error("command reply", slog.String("id", r.ID), slog.Any("error", t.Error()))
+	}
+}
@@ -0,0 +1,124 @@
+package ocg
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestControlLogLevel(t *testing.T) {
+	level := new(slog.LevelVar)
+	c := &Control{Level: level}
+	ctx := context.Background()
+
+	for in, want := range map[string]slog.Level{"debug": slog.LevelDebug, "WARN": slog.LevelWarn, "error": slog.LevelError} {
+		if r := c.Exec(ctx, Command{Cmd: CmdLogLevel, Level: in}); !r.OK || level.Level() != want {
+			t.Errorf("level %s: reply %+v, level %s", in, r, level.Level())
+		}
+	}
+
+	level.Set(slog.LevelInfo)
+	for _, in := range []string{"", "verbose", "warm"} {
+		if r := c.Exec(ctx, Command{Cmd: CmdLogLevel, Level: in}); r.OK || level.Level() != slog.LevelInfo {
+			t.Errorf("level %q: reply %+v, level %s, want rejected", in, r, level.Level())
+		}
+	}
+}
+
+func TestWriteRuleAllow(t *testing.T) {
+	rule := WriteRule{Endpoint: "tcp1", UnitID: 1, Addr: 100, Count: 2}
+	top := WriteRule{Endpoint: "tcp1", UnitID: 1, Addr: 65534, Count: 2}
+	tests := []struct {
+		name string
+		rule WriteRule
+		cmd  Command
+		want bool
+	}{
+		{"整个范围", rule, Command{Endpoint: "tcp1", UnitID: 1, Addr: 100, Values: []uint16{1, 2}}, true},
+		{"最后一个寄存器", rule, Command{Endpoint: "tcp1", UnitID: 1, Addr: 101, Values: []uint16{1}}, true},
+		{"超出一个", rule, Command{Endpoint: "tcp1", UnitID: 1, Addr: 101, Values: []uint16{1, 2}}, false},
+		{"在范围之后", rule, Command{Endpoint: "tcp1", UnitID: 1, Addr: 102, Values: []uint16{1}}, false},
+		{"在范围之前", rule, Command{Endpoint: "tcp1", UnitID: 1, Addr: 99, Values: []uint16{1, 2}}, false},
+		{"从站不同", rule, Command{Endpoint: "tcp1", UnitID: 2, Addr: 100, Values: []uint16{1}}, false},
+		{"总线不同", rule, Command{Endpoint: "tcp2", UnitID: 1, Addr: 100, Values: []uint16{1}}, false},
+		{"地址末尾", top, Command{Endpoint: "tcp1", UnitID: 1, Addr: 65535, Values: []uint16{1}}, true},
+		// addr + len 超过 uint16 不能回绕到允许的范围
+		{"地址溢出", top, Command{Endpoint: "tcp1", UnitID: 1, Addr: 65535, Values: []uint16{1, 2}}, false},
+		{"没有允许的寄存器", WriteRule{Endpoint: "tcp1", UnitID: 1, Addr: 100}, Command{Endpoint: "tcp1", UnitID: 1, Addr: 100, Values: []uint16{1}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.allow(tt.cmd); got != tt.want {
+				t.Fatalf("allow = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	c := &Control{Writes: []WriteRule{rule}}
+	for _, cmd := range []Command{
+		{Cmd: CmdWrite, Endpoint: "tcp1", UnitID: 1, Addr: 100},
+		{Cmd: CmdWrite, Endpoint: "tcp1", UnitID: 1, Addr: 101, Values: []uint16{1, 2}},
+	} {
+		if r := c.Exec(context.Background(), cmd); r.OK || !strings.Contains(r.Error, ErrWriteDeny.Error()) {
+			t.Fatalf("write %+v: reply %+v, want denied", cmd, r)
+		}
+	}
+}
+
+func TestExecDuplicate(t *testing.T) {
+	s := &slave{regs: map[uint8]map[uint16]uint16{}}
+	b := testBus(t, serve(t, s))
+	c := &Control{
+		Collector: &Collector{buses: map[string]*Bus{"tcp1": b}},
+		Level:     new(slog.LevelVar),
+		Writes:    []WriteRule{{Endpoint: "tcp1", UnitID: 1, Addr: 100, Count: 2}},
+	}
+	ctx := context.Background()
+	write := Command{ID: "w1", Cmd: CmdWrite, Endpoint: "tcp1", UnitID: 1, Addr: 100, Values: []uint16{7}}
+
+	// 重发的命令和第一次同时到达, 只写一次
+	replies := make([]Reply, 3)
+	var wg sync.WaitGroup
+	for i := range replies {
+		wg.Go(func() { replies[i] = c.Exec(ctx, write) })
+	}
+	wg.Wait()
+	for _, r := range replies {
+		if !r.OK || r.ID != "w1" {
+			t.Fatalf("reply %+v", r)
+		}
+	}
+	if s.written() != 1 {
+		t.Fatalf("%d writes, want 1", s.written())
+	}
+
+	// 已经执行过的命令回复上次的结果
+	level := Command{ID: "l1", Cmd: CmdLogLevel, Level: "debug"}
+	if r := c.Exec(ctx, level); !r.OK || r.Data != "DEBUG" {
+		t.Fatalf("reply %+v", r)
+	}
+	c.Level.Set(slog.LevelInfo)
+	if r := c.Exec(ctx, level); !r.OK || r.Data != "DEBUG" || c.Level.Level() != slog.LevelInfo {
+		t.Fatalf("duplicate: reply %+v, level %s, want cached reply", r, c.Level.Level())
+	}
+
+	// 只记住最近的命令, 没有 ID 的命令每次都执行
+	for i := range recentCmds {
+		c.Exec(ctx, Command{ID: fmt.Sprint("x", i), Cmd: CmdConfig})
+	}
+	if r := c.Exec(ctx, write); !r.OK || s.written() != 2 {
+		t.Fatalf("after %d commands: reply %+v, %d writes, want written again", recentCmds, r, s.written())
+	}
+	write.ID = ""
+	c.Exec(ctx, write)
+	c.Exec(ctx, write)
+	if s.written() != 4 {
+		t.Fatalf("%d writes, want 4", s.written())
+	}
+	if len(c.ids) != recentCmds || len(c.calls) != recentCmds {
+		t.Fatalf("remember %d ids %d calls, want %d", len(c.ids), len(c.calls), recentCmds)
+	}
+}
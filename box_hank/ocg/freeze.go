@@ -0,0 +1,58 @@
+package ocg
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// freezeRetry 冻结读数失败后隔多久再读
+const freezeRetry = 30 * time.Second
+
+// FreezeFlag 离 at 最近的零点是冻结的日期, 23:59:59 和 00:00:00 的冻结读数都算第二天零点
+// 每月1日零点同时是月冻结
+func FreezeFlag(at time.Time) common.Flag {
+	y, m, d := at.Add(12 * time.Hour).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, at.Location())
+	if day.Day() == 1 {
+		return common.FlagFreeze | common.FlagMonthFreeze
+	}
+	return common.FlagFreeze
+}
+
+// Freeze 所有表读一次冻结读数, 读失败的表在 window 内重试, 尽量保证每块表都有冻结读数
+// 各总线并行读, 冻结读数的时间尽量靠近零点
+func Freeze(ctx context.Context, window time.Duration, tasks ...*TaskX) {
+	start := time.Now()
+	flag := FreezeFlag(start)
+	deadline := start.Add(window)
+
+	pending := tasks
+	for {
+		errs := perBus(pending, func(t *TaskX) error {
+			return t.RunFlag(ctx, flag)
+		})
+		var failed []*TaskX
+		for i, err := range errs {
+			if err != nil {
+				slog.Warn("freeze error", slog.String("code", pending[i].Code), slog.Any("error", err))
+				failed = append(failed, pending[i])
+			}
+		}
+		pending = failed
+		if len(pending) == 0 || time.Now().Add(freezeRetry).After(deadline) {
+			break
+		}
+		select {
+		case <-time.After(freezeRetry):
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	for _, t := range pending {
+		slog.Error("freeze missed", slog.String("code", t.Code), slog.Time("start", start))
+	}
+}
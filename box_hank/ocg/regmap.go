@@ -0,0 +1,189 @@
+package ocg
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/simonvetter/modbus"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// FieldConf 寄存器表中的一项, 对应 common.Electricity 或 common.Water 的一个字段
+type FieldConf struct {
+	Name     string  `mapstructure:"name"` // data_value, voltage_a, ... 和 json 字段名一致
+	Addr     uint16  `mapstructure:"addr"`
+	RegType  string  `mapstructure:"reg_type"`  // 为空时用表的 reg_type
+	DataType string  `mapstructure:"data_type"` // uint16, int16, uint32, int32, float32, float64
+	Scale    float64 `mapstructure:"scale"`     // 为 0 时是 1
+}
+
+// RegMap 一种表的寄存器表, 同型号的表共用
+//
+//	[[ocg.collect.regmap]]
+//	name = "dtsu666"
+//	word_order = "high_first"
+//
+//	[[ocg.collect.regmap.field]]
+//	name = "data_value"
+//	addr = 0x401E
+//	data_type = "float32"
+//	scale = 100
+type RegMap struct {
+	Name      string      `mapstructure:"name"`
+	WordOrder string      `mapstructure:"word_order"` // 为空时用表的 word_order
+	Fields    []FieldConf `mapstructure:"field"`
+}
+
+// ErrValue 寄存器的值不是有效的读数, 如浮点数的 NaN, Inf
+var ErrValue = errors.New("invalid register value")
+
+const (
+	// maxBlock 一次最多读的寄存器数, modbus 协议的限制
+	maxBlock = 125
+	// maxGap 两个字段之间空出的寄存器不多于这个数时合成一次读
+	maxGap = 8
+)
+
+// reading 采集到的数据, 按表的类型取其中一个
+type reading struct {
+	e common.Electricity
+	w common.Water
+}
+
+var setters = map[string]map[string]func(*reading, int64){
+	common.ELECTRICITY: {
+		"data_value":         func(r *reading, v int64) { r.e.DataValue = v },
+		"voltage_a":          func(r *reading, v int64) { r.e.VoltageA = v },
+		"voltage_b":          func(r *reading, v int64) { r.e.VoltageB = v },
+		"voltage_c":          func(r *reading, v int64) { r.e.VoltageC = v },
+		"current_a":          func(r *reading, v int64) { r.e.CurrentA = v },
+		"current_b":          func(r *reading, v int64) { r.e.CurrentB = v },
+		"current_c":          func(r *reading, v int64) { r.e.CurrentC = v },
+		"active_power_total": func(r *reading, v int64) { r.e.ActivePowerTotal = v },
+		"frequency":          func(r *reading, v int64) { r.e.Frequency = v },
+		"opt_status":         func(r *reading, v int64) { r.e.OptStatus = v },
+	},
+	common.WATER: {
+		"data_value": func(r *reading, v int64) { r.w.DataValue = v },
+		"opt_status": func(r *reading, v int64) { r.w.OptStatus = v },
+	},
+}
+
+var dataWords = map[string]uint16{
+	"uint16":  1,
+	"int16":   1,
+	"uint32":  2,
+	"int32":   2,
+	"float32": 2,
+	"float64": 4,
+}
+
+// Field 解析后的字段
+type Field struct {
+	Name     string
+	Addr     uint16
+	RegType  modbus.RegType
+	DataType string
+	Scale    float64
+}
+
+func (f Field) words() uint16 {
+	return dataWords[f.DataType]
+}
+
+func (f Field) end() uint16 {
+	return f.Addr + f.words()
+}
+
+func (c FieldConf) field(def modbus.RegType) Field {
+	rt := def
+	if c.RegType != "" {
+		rt, _ = regType(c.RegType)
+	}
+	return Field{
+		Name:     c.Name,
+		Addr:     c.Addr,
+		RegType:  rt,
+		DataType: cmp.Or(c.DataType, "uint32"),
+		Scale:    cmp.Or(c.Scale, 1),
+	}
+}
+
+func (c FieldConf) check(typ string) error {
+	if _, ok := setters[typ][c.Name]; !ok {
+		return fmt.Errorf("%w: field %q for type %s", ErrConf, c.Name, typ)
+	}
+	if _, ok := dataWords[cmp.Or(c.DataType, "uint32")]; !ok {
+		return fmt.Errorf("%w: field %s data_type %q", ErrConf, c.Name, c.DataType)
+	}
+	if _, err := regType(c.RegType); err != nil {
+		return fmt.Errorf("field %s: %w", c.Name, err)
+	}
+	return nil
+}
+
+// block 一次连续读出的寄存器
+type block struct {
+	RegType modbus.RegType
+	Addr    uint16
+	Count   uint16
+	Fields  []Field
+}
+
+// plan 按寄存器类型和地址排序, 相邻的字段合成尽量少的块
+func plan(fields []Field) []block {
+	sorted := slices.Clone(fields)
+	slices.SortFunc(sorted, func(a, b Field) int {
+		return cmp.Or(cmp.Compare(a.RegType, b.RegType), cmp.Compare(a.Addr, b.Addr))
+	})
+
+	var blocks []block
+	for _, f := range sorted {
+		if n := len(blocks); n > 0 {
+			b := &blocks[n-1]
+			end := b.Addr + b.Count
+			if b.RegType == f.RegType && f.Addr <= end+maxGap && f.end()-b.Addr <= maxBlock {
+				b.Count = max(end, f.end()) - b.Addr
+				b.Fields = append(b.Fields, f)
+				continue
+			}
+		}
+		blocks = append(blocks, block{RegType: f.RegType, Addr: f.Addr, Count: f.words(), Fields: []Field{f}})
+	}
+	return blocks
+}
+
+// decode regs 从 f.Addr 开始, 结果乘以倍数后取整, 超出 int64 范围时返回 ErrValue
+func decode(f Field, regs []uint16, order modbus.WordOrder) (int64, error) {
+	words := slices.Clone(regs[:f.words()])
+	if order == modbus.LOW_WORD_FIRST {
+		slices.Reverse(words)
+	}
+	var raw uint64
+	for _, w := range words {
+		raw = raw<<16 | uint64(w)
+	}
+
+	var val float64
+	switch f.DataType {
+	case "uint16", "uint32":
+		val = float64(raw)
+	case "int16":
+		val = float64(int16(raw))
+	case "int32":
+		val = float64(int32(raw))
+	case "float32":
+		val = float64(math.Float32frombits(uint32(raw)))
+	case "float64":
+		val = math.Float64frombits(raw)
+	}
+	v := math.Round(val * f.Scale)
+	// NaN 和任何数比较都是 false
+	if !(v >= math.MinInt64 && v < math.MaxInt64) {
+		return 0, fmt.Errorf("%w: %s = %v", ErrValue, f.Name, val)
+	}
+	return int64(v), nil
+}
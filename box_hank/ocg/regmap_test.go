@@ -0,0 +1,149 @@
+package ocg
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/simonvetter/modbus"
+)
+
+const (
+	input   = modbus.INPUT_REGISTER
+	holding = modbus.HOLDING_REGISTER
+)
+
+func field(addr uint16, rt modbus.RegType, dt string) Field {
+	return Field{Name: "f", Addr: addr, RegType: rt, DataType: dt, Scale: 1}
+}
+
+// span 块的寄存器类型, 起始地址, 数量和字段数
+type span struct {
+	rt     modbus.RegType
+	addr   uint16
+	count  uint16
+	fields int
+}
+
+func TestPlan(t *testing.T) {
+	// 每隔 8 个寄存器一个字段, 空出 7 个, 可以合并
+	var chain []Field
+	for addr := uint16(0); addr <= 120; addr += 8 {
+		chain = append(chain, field(addr, input, "uint16"))
+	}
+
+	tests := []struct {
+		name   string
+		fields []Field
+		want   []span
+	}{
+		{"连续", []Field{field(2, input, "uint32"), field(0, input, "uint32")},
+			[]span{{input, 0, 4, 2}}},
+		{"空出不多于 8 个合并", []Field{field(0, input, "uint32"), field(10, input, "uint16")},
+			[]span{{input, 0, 11, 2}}},
+		{"空出多于 8 个分开", []Field{field(0, input, "uint32"), field(11, input, "uint16")},
+			[]span{{input, 0, 2, 1}, {input, 11, 1, 1}}},
+		{"重叠", []Field{field(0, input, "float64"), field(2, input, "uint16")},
+			[]span{{input, 0, 4, 2}}},
+		{"正好 125 个", append(slices.Clone(chain), field(123, input, "uint32")),
+			[]span{{input, 0, 125, 17}}},
+		{"超过 125 个分开", append(slices.Clone(chain), field(123, input, "uint32"), field(125, input, "uint16")),
+			[]span{{input, 0, 125, 17}, {input, 125, 1, 1}}},
+		{"寄存器类型不同分开", []Field{field(0, input, "uint32"), field(2, holding, "uint16"), field(2, input, "uint16")},
+			[]span{{holding, 2, 1, 1}, {input, 0, 3, 2}}},
+		{"没有字段", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []span
+			for _, b := range plan(tt.fields) {
+				got = append(got, span{b.RegType, b.Addr, b.Count, len(b.Fields)})
+				if b.Count > maxBlock {
+					t.Fatalf("block %+v over %d registers", b, maxBlock)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// words 按高字在前拆成寄存器
+func words(v uint64, n int) []uint16 {
+	regs := make([]uint16, n)
+	for i := range regs {
+		regs[n-1-i] = uint16(v >> (16 * i))
+	}
+	return regs
+}
+
+func reversed(regs []uint16) []uint16 {
+	regs = slices.Clone(regs)
+	slices.Reverse(regs)
+	return regs
+}
+
+func TestDecode(t *testing.T) {
+	f32 := words(uint64(math.Float32bits(12.5)), 2)
+	f64 := words(math.Float64bits(1234.5), 4)
+
+	tests := []struct {
+		name  string
+		dt    string
+		scale float64
+		regs  []uint16
+		order modbus.WordOrder
+		want  int64
+	}{
+		{"uint16", "uint16", 1, []uint16{0x1234}, modbus.HIGH_WORD_FIRST, 0x1234},
+		{"uint16 多余的寄存器", "uint16", 1, []uint16{7, 0xFFFF}, modbus.HIGH_WORD_FIRST, 7},
+		{"uint16 低字在前", "uint16", 1, []uint16{7}, modbus.LOW_WORD_FIRST, 7},
+		{"int16", "int16", 1, []uint16{0xFFFE}, modbus.HIGH_WORD_FIRST, -2},
+		{"uint32 高字在前", "uint32", 1, []uint16{0x0001, 0x0002}, modbus.HIGH_WORD_FIRST, 0x10002},
+		{"uint32 低字在前", "uint32", 1, []uint16{0x0002, 0x0001}, modbus.LOW_WORD_FIRST, 0x10002},
+		{"int32 高字在前", "int32", 1, []uint16{0xFFFF, 0xFFFE}, modbus.HIGH_WORD_FIRST, -2},
+		{"int32 低字在前", "int32", 1, []uint16{0xFFFE, 0xFFFF}, modbus.LOW_WORD_FIRST, -2},
+		{"float32 高字在前", "float32", 100, f32, modbus.HIGH_WORD_FIRST, 1250},
+		{"float32 低字在前", "float32", 100, reversed(f32), modbus.LOW_WORD_FIRST, 1250},
+		{"float64 高字在前", "float64", 10, f64, modbus.HIGH_WORD_FIRST, 12345},
+		{"float64 低字在前", "float64", 10, reversed(f64), modbus.LOW_WORD_FIRST, 12345},
+		{"倍数后四舍五入", "uint16", 0.1, []uint16{15}, modbus.HIGH_WORD_FIRST, 2},
+		{"负数四舍五入", "int16", 0.1, []uint16{0xFFF1}, modbus.HIGH_WORD_FIRST, -2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Field{Name: "data_value", DataType: tt.dt, Scale: tt.scale}
+			got, err := decode(f, tt.regs, tt.order)
+			if err != nil || got != tt.want {
+				t.Fatalf("got %d %v, want %d", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		dt    string
+		scale float64
+		regs  []uint16
+	}{
+		{"float32 NaN", "float32", 1, words(uint64(math.Float32bits(float32(math.NaN()))), 2)},
+		{"float32 +Inf", "float32", 1, words(uint64(math.Float32bits(float32(math.Inf(1)))), 2)},
+		{"float32 -Inf", "float32", 1, words(uint64(math.Float32bits(float32(math.Inf(-1)))), 2)},
+		{"float64 NaN", "float64", 1, words(math.Float64bits(math.NaN()), 4)},
+		{"float64 -Inf", "float64", 1, words(math.Float64bits(math.Inf(-1)), 4)},
+		{"超出 int64", "float64", 1, words(math.Float64bits(1e300), 4)},
+		{"倍数后超出 int64", "float32", 1e10, words(uint64(math.Float32bits(1e10)), 2)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := Field{Name: "data_value", DataType: tt.dt, Scale: tt.scale}
+			if got, err := decode(f, tt.regs, modbus.HIGH_WORD_FIRST); !errors.Is(err, ErrValue) {
+				t.Fatalf("got %d %v, want ErrValue", got, err)
+			}
+		})
+	}
+}
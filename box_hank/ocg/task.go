@@ -2,6 +2,10 @@ package ocg
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
 	"time"
 
 	"github.com/simonvetter/modbus"
@@ -9,49 +13,199 @@ import (
 	"github.com/twiglab/h2o/pkg/common"
 )
 
-type TaskX struct {
-	Client  *modbus.ModbusClient
-	Addr    uint16
-	RegType modbus.RegType
-	Sender  box.Sender
+// StatusPartial 部分字段没有读到, 表显读数是好的
+const StatusPartial = 1
 
-	Code string
-	Type string
+type TaskX struct {
+	Bus       *Bus
+	UnitID    uint8
+	Timeout   time.Duration
+	Retries   int
+	WordOrder modbus.WordOrder
+	Fields    []Field
+	Sender    box.Sender
 
+	Code    string
+	Type    string
 	Project string
+	PosCode string
+
+	blocks []block
 }
 
-func (t *TaskX) Run(ctx context.Context) error {
-	if err := t.Client.SetEncoding(modbus.BIG_ENDIAN, modbus.LOW_WORD_FIRST); err != nil {
+// NewTask 按配置生成任务, 配置需要先 Check
+func NewTask(c Conf, m MeterConf, bus *Bus, s box.Sender) *TaskX {
+	rt, _ := regType(m.RegType)
+	wo, _ := wordOrder(c.wordOrder(m))
+
+	var fields []Field
+	for _, f := range c.fields(m) {
+		fields = append(fields, f.field(rt))
+	}
+
+	return &TaskX{
+		Bus:       bus,
+		UnitID:    m.UnitID,
+		Timeout:   m.Timeout,
+		Retries:   m.Retries,
+		WordOrder: wo,
+		Fields:    fields,
+		Sender:    s,
+
+		Code:    m.Code,
+		Type:    m.Type,
+		Project: m.Project,
+		PosCode: m.PosCode,
+
+		blocks: plan(fields),
+	}
+}
+
+// readRegs 从站不应答时重试, 异常应答不重试
+func (t *TaskX) readRegs(ctx context.Context, addr, count uint16, rt modbus.RegType) ([]uint16, error) {
+	var regs []uint16
+	read := func(c *modbus.ModbusClient) error {
+		if err := c.SetEncoding(modbus.BIG_ENDIAN, t.WordOrder); err != nil {
+			return err
+		}
+		var err error
+		regs, err = c.ReadRegisters(addr, count, rt)
 		return err
 	}
 
-	val, err := t.Client.ReadUint32(t.Addr, t.RegType)
+	var err error
+	for range t.Retries + 1 {
+		if err = t.Bus.Do(ctx, t.UnitID, read); err == nil || isException(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	return regs, err
+}
+
+// read 按块读, 一块有异常应答时这块的字段再逐个读, 读不到或者值无效的字段放到 errs 中
+// 从站不应答时不再读后面的块, 少占总线
+func (t *TaskX) read(ctx context.Context) (map[string]int64, []error, error) {
+	if t.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t.Timeout)
+		defer cancel()
+	}
+
+	vals := make(map[string]int64, len(t.Fields))
+	var errs []error
+	for _, b := range t.blocks {
+		regs, err := t.readRegs(ctx, b.Addr, b.Count, b.RegType)
+		if err == nil {
+			for _, f := range b.Fields {
+				v, err := decode(f, regs[f.Addr-b.Addr:], t.WordOrder)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", t.Code, err))
+					continue
+				}
+				vals[f.Name] = v
+			}
+			continue
+		}
+		if !isException(err) {
+			return nil, nil, fmt.Errorf("%s: %w", t.Code, err)
+		}
+		if len(b.Fields) == 1 {
+			errs = append(errs, fmt.Errorf("%s %s: %w", t.Code, b.Fields[0].Name, err))
+			continue
+		}
+		for _, f := range b.Fields {
+			regs, err := t.readRegs(ctx, f.Addr, f.words(), f.RegType)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s %s: %w", t.Code, f.Name, err))
+				continue
+			}
+			v, err := decode(f, regs, t.WordOrder)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", t.Code, err))
+				continue
+			}
+			vals[f.Name] = v
+		}
+	}
+	return vals, errs, nil
+}
+
+func (t *TaskX) Run(ctx context.Context) error {
+	return t.RunFlag(ctx, 0)
+}
+
+// RunFlag 读数带上标志后发送
+func (t *TaskX) RunFlag(ctx context.Context, flag common.Flag) error {
+	obj, err := t.Read(ctx, flag)
 	if err != nil {
 		return err
 	}
+	return t.Sender.SendData(ctx, obj)
+}
+
+// Read 表显读数读不到时返回错误, 其他字段读不到时标记 StatusPartial
+func (t *TaskX) Read(ctx context.Context, flag common.Flag) (box.SendObject, error) {
+	vals, errs, err := t.read(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := vals["data_value"]; !ok {
+		return nil, errors.Join(errs...)
+	}
 
 	now := time.Now()
 
-	var em box.ElectricityMeter
-	em.Data.DataValue = int64(val)
-	em.DataCode = common.NewDataCode()
-	em.DataTime = now
-	em.DataTs = common.Ts(now)
-	em.Code = t.Code
-	em.Type = t.Type
+	m := box.Meter{
+		Device: common.Device{
+			Code:     t.Code,
+			Type:     t.Type,
+			DataTime: now,
+			DataTs:   common.Ts(now),
+			DataCode: common.NewDataCode(),
+		},
+		Pos:  common.Pos{Project: t.Project, PosCode: t.PosCode},
+		Flag: flag,
+	}
+	if len(errs) > 0 {
+		m.Status = StatusPartial
+		slog.WarnContext(ctx, "partial reading", slog.String("code", t.Code), slog.Any("error", errors.Join(errs...)))
+	}
 
-	em.Pos.Project = t.Project
+	var r reading
+	for name, v := range vals {
+		setters[t.Type][name](&r, v)
+	}
 
-	return t.Sender.SendData(ctx, em)
+	if t.Type == common.WATER {
+		return box.WaterMeter{Meter: m, Data: r.w}, nil
+	}
+	return box.ElectricityMeter{Meter: m, Data: r.e}, nil
 }
 
+// TaskChain 每条总线一个链, 各总线并行, 同一总线上按顺序执行, 一个失败不影响后面的
 func TaskChain(ctx context.Context, t ...*TaskX) error {
-	var err error
-	for _, x := range t {
-		if err = x.Run(ctx); err != nil {
-			return err
-		}
+	return errors.Join(perBus(t, func(x *TaskX) error {
+		return x.Run(ctx)
+	})...)
+}
+
+// perBus 按总线分组后每组一个 goroutine 按顺序执行 run, 返回和 tasks 一一对应的错误
+// 总线上的请求本来就是串行的, 一条总线上的表不应答不应该拖慢其他总线
+func perBus(tasks []*TaskX, run func(*TaskX) error) []error {
+	groups := make(map[*Bus][]int)
+	for i, t := range tasks {
+		groups[t.Bus] = append(groups[t.Bus], i)
+	}
+
+	errs := make([]error, len(tasks))
+	var wg sync.WaitGroup
+	for _, idx := range groups {
+		wg.Go(func() {
+			for _, i := range idx {
+				errs[i] = run(tasks[i])
+			}
+		})
 	}
-	return nil
+	wg.Wait()
+	return errs
 }
@@ -0,0 +1,150 @@
+package ocg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"math"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/simonvetter/modbus"
+	"github.com/twiglab/h2o/box"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// slave 模拟的从站, 输入寄存器和保持寄存器共用 regs, 没有的地址回异常应答
+// mute 中的从站不应答, 等这么久之后才处理, 客户端先超时
+type slave struct {
+	mu     sync.Mutex
+	regs   map[uint8]map[uint16]uint16
+	mute   map[uint8]time.Duration
+	writes int
+}
+
+func (s *slave) read(unitID uint8, addr, n uint16) ([]uint16, error) {
+	s.mu.Lock()
+	mute := s.mute[unitID]
+	s.mu.Unlock()
+	time.Sleep(mute)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	regs := make([]uint16, n)
+	for i := range regs {
+		v, ok := s.regs[unitID][addr+uint16(i)]
+		if !ok {
+			return nil, modbus.ErrIllegalDataAddress
+		}
+		regs[i] = v
+	}
+	return regs, nil
+}
+
+func (s *slave) written() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.writes
+}
+
+func (s *slave) HandleCoils(*modbus.CoilsRequest) ([]bool, error) {
+	return nil, modbus.ErrIllegalFunction
+}
+
+func (s *slave) HandleDiscreteInputs(*modbus.DiscreteInputsRequest) ([]bool, error) {
+	return nil, modbus.ErrIllegalFunction
+}
+
+func (s *slave) HandleHoldingRegisters(r *modbus.HoldingRegistersRequest) ([]uint16, error) {
+	if r.IsWrite {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.writes++
+		if s.regs[r.UnitId] == nil {
+			s.regs[r.UnitId] = make(map[uint16]uint16)
+		}
+		for i, v := range r.Args {
+			s.regs[r.UnitId][r.Addr+uint16(i)] = v
+		}
+		return nil, nil
+	}
+	return s.read(r.UnitId, r.Addr, r.Quantity)
+}
+
+func (s *slave) HandleInputRegisters(r *modbus.InputRegistersRequest) ([]uint16, error) {
+	return s.read(r.UnitId, r.Addr, r.Quantity)
+}
+
+// serve 在本机的空闲端口上启动从站, 返回 tcp:// 地址
+func serve(t *testing.T, s *slave) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "tcp://" + ln.Addr().String()
+	ln.Close()
+
+	srv, err := modbus.NewServer(&modbus.ServerConfiguration{
+		URL:        url,
+		Timeout:    time.Minute,
+		MaxClients: 4,
+		Logger:     log.New(io.Discard, "", 0),
+	}, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { srv.Stop() })
+	return url
+}
+
+func testBus(t *testing.T, url string) *Bus {
+	t.Helper()
+	b := NewBus(Endpoint{Name: "tcp1", URL: url, Timeout: 200 * time.Millisecond})
+	b.Start()
+	t.Cleanup(b.Close)
+	return b
+}
+
+func TestReadInvalidValue(t *testing.T) {
+	nan := math.Float32bits(float32(math.NaN()))
+	s := &slave{regs: map[uint8]map[uint16]uint16{1: {
+		0: 0, 1: 1234, // data_value uint32 高字在前
+		2: uint16(nan >> 16), 3: uint16(nan), // voltage_a float32
+	}}}
+	conf := Conf{
+		RegMaps: []RegMap{{Name: "m", WordOrder: "high_first", Fields: []FieldConf{
+			{Name: "data_value", Addr: 0},
+			{Name: "voltage_a", Addr: 2, DataType: "float32", Scale: 10},
+		}}},
+	}
+	task := NewTask(conf, MeterConf{Code: "E1", Type: common.ELECTRICITY, UnitID: 1, RegMap: "m"}, testBus(t, serve(t, s)), nil)
+
+	obj, err := task.Read(context.Background(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := obj.(box.ElectricityMeter)
+	if !ok {
+		t.Fatalf("got %T", obj)
+	}
+	if m.Status != StatusPartial || m.Data.DataValue != 1234 || m.Data.VoltageA != 0 {
+		t.Fatalf("got status %d data %+v, want partial with data_value 1234", m.Status, m.Data)
+	}
+
+	// 表显读数无效时没有读数
+	conf.RegMaps[0].Fields = []FieldConf{
+		{Name: "data_value", Addr: 2, DataType: "float32"},
+		{Name: "voltage_a", Addr: 0},
+	}
+	task = NewTask(conf, MeterConf{Code: "E1", Type: common.ELECTRICITY, UnitID: 1, RegMap: "m"}, task.Bus, nil)
+	if _, err := task.Read(context.Background(), 0); !errors.Is(err, ErrValue) {
+		t.Fatalf("got %v, want ErrValue", err)
+	}
+}
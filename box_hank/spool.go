@@ -0,0 +1,439 @@
+package box
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+var ErrSpoolCorrupt = errors.New("spool record corrupt")
+
+const (
+	segExt  = ".seg"
+	ackFile = "ack"
+
+	// 记录头: 长度(4) crc32(4) topic 长度(2)
+	headSize = 10
+)
+
+// Spool 先落盘再发送, 上行断开时数据积压在本地, 恢复后按顺序补发
+// 数据按段文件保存, ack 文件记录下一条要发的位置, 重启后从这里接着发
+// 总大小超过 MaxBytes 时丢弃最早的段
+type Spool struct {
+	Dir      string
+	MaxBytes int64
+
+	segBytes int64
+
+	mu     sync.Mutex
+	segs   []uint64         // 段序号, 从小到大, 最后一段在写
+	sizes  map[uint64]int64 // 段的有效长度
+	counts map[uint64]int   // 段中还没发的记录数
+	w      *os.File
+	r      *os.File
+	rseg   uint64
+	roff   int64
+
+	dropped int
+	notify  chan struct{}
+}
+
+// SpoolStats 队列状态
+type SpoolStats struct {
+	Depth    int   `json:"depth"`
+	Bytes    int64 `json:"bytes"`
+	Segments int   `json:"segments"`
+	Dropped  int   `json:"dropped"`
+}
+
+// OpenSpool 打开目录中已有的段, 最后一段末尾不完整的记录截掉
+func OpenSpool(dir string, maxBytes int64) (*Spool, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	s := &Spool{
+		Dir:      dir,
+		MaxBytes: maxBytes,
+		segBytes: min(max(maxBytes/8, 64<<10), 8<<20),
+		sizes:    make(map[uint64]int64),
+		counts:   make(map[uint64]int),
+		notify:   make(chan struct{}, 1),
+	}
+
+	names, err := filepath.Glob(filepath.Join(dir, "*"+segExt))
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range names {
+		seq, err := strconv.ParseUint(strings.TrimSuffix(filepath.Base(name), segExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		s.segs = append(s.segs, seq)
+	}
+	slices.Sort(s.segs)
+
+	s.rseg, s.roff = s.readAck()
+	switch {
+	case len(s.segs) == 0 || s.rseg > s.segs[len(s.segs)-1]:
+		// 没有段, 或者 ack 在所有段之后: 段都已经发完, 从 ack 的段接着写
+		for _, seq := range slices.Clone(s.segs) {
+			s.remove(seq)
+		}
+		s.rseg, s.roff = max(s.rseg, 1), 0
+		s.segs = []uint64{s.rseg}
+		if err := s.writeAck(); err != nil {
+			return nil, err
+		}
+	case !slices.Contains(s.segs, s.rseg):
+		s.rseg, s.roff = s.segs[0], 0
+	}
+
+	// 已经发完的段删掉, 其余的数一下还有多少条
+	for _, seq := range slices.Clone(s.segs) {
+		if seq < s.rseg {
+			s.remove(seq)
+			continue
+		}
+		size, count, err := s.scan(seq, 0)
+		if err != nil {
+			return nil, err
+		}
+		if seq == s.rseg {
+			// 末尾不完整的记录截掉后, ack 可能超出有效长度
+			s.roff = min(s.roff, size)
+			if _, count, err = s.scan(seq, s.roff); err != nil {
+				return nil, err
+			}
+		}
+		s.sizes[seq] = size
+		s.counts[seq] = count
+	}
+
+	last := s.segs[len(s.segs)-1]
+	if s.w, err = os.OpenFile(s.path(last), os.O_CREATE|os.O_WRONLY, 0o644); err != nil {
+		return nil, err
+	}
+	if err := s.w.Truncate(s.sizes[last]); err != nil {
+		return nil, err
+	}
+	if _, err := s.w.Seek(s.sizes[last], io.SeekStart); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Spool) path(seq uint64) string {
+	return filepath.Join(s.Dir, fmt.Sprintf("%020d%s", seq, segExt))
+}
+
+func (s *Spool) readAck() (uint64, int64) {
+	bs, err := os.ReadFile(filepath.Join(s.Dir, ackFile))
+	if err != nil || len(bs) != 16 {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint64(bs), int64(binary.BigEndian.Uint64(bs[8:]))
+}
+
+func (s *Spool) writeAck() error {
+	bs := binary.BigEndian.AppendUint64(nil, s.rseg)
+	bs = binary.BigEndian.AppendUint64(bs, uint64(s.roff))
+	tmp := filepath.Join(s.Dir, ackFile+".tmp")
+	if err := os.WriteFile(tmp, bs, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(s.Dir, ackFile))
+}
+
+// scan 从 from 开始数完整的记录, 返回有效长度
+func (s *Spool) scan(seq uint64, from int64) (int64, int, error) {
+	f, err := os.Open(s.path(seq))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	}
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	off, count := from, 0
+	for {
+		_, _, n, err := readRecord(f, off)
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				slog.Warn("spool truncated", slog.String("segment", s.path(seq)), slog.Int64("offset", off), slog.Any("error", err))
+			}
+			return off, count, nil
+		}
+		off += n
+		count++
+	}
+}
+
+func readRecord(f *os.File, off int64) (string, []byte, int64, error) {
+	head := make([]byte, headSize)
+	if _, err := f.ReadAt(head, off); err != nil {
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			err = io.EOF
+		}
+		return "", nil, 0, err
+	}
+	size := binary.BigEndian.Uint32(head)
+	sum := binary.BigEndian.Uint32(head[4:])
+	tlen := binary.BigEndian.Uint16(head[8:])
+	if int64(size) < int64(tlen) || size > 64<<20 {
+		return "", nil, 0, ErrSpoolCorrupt
+	}
+
+	body := make([]byte, size)
+	if _, err := f.ReadAt(body, off+headSize); err != nil {
+		return "", nil, 0, fmt.Errorf("%w: %w", ErrSpoolCorrupt, err)
+	}
+	if crc32.ChecksumIEEE(body) != sum {
+		return "", nil, 0, ErrSpoolCorrupt
+	}
+	return string(body[:tlen]), body[tlen:], headSize + int64(size), nil
+}
+
+// SendData 写入段文件并 sync 后返回, 由 Run 发送
+func (s *Spool) SendData(ctx context.Context, obj SendObject) error {
+	data, err := obj.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	topic := obj.Topic()
+
+	body := make([]byte, 0, len(topic)+len(data))
+	body = append(body, topic...)
+	body = append(body, data...)
+	rec := make([]byte, headSize, headSize+len(body))
+	binary.BigEndian.PutUint32(rec, uint32(len(body)))
+	binary.BigEndian.PutUint32(rec[4:], crc32.ChecksumIEEE(body))
+	binary.BigEndian.PutUint16(rec[8:], uint16(len(topic)))
+	rec = append(rec, body...)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	last := s.segs[len(s.segs)-1]
+	if s.sizes[last] > 0 && s.sizes[last]+int64(len(rec)) > s.segBytes {
+		if err := s.rotate(); err != nil {
+			return err
+		}
+		last = s.segs[len(s.segs)-1]
+	}
+
+	if _, err := s.w.Write(rec); err != nil {
+		return err
+	}
+	if err := s.w.Sync(); err != nil {
+		return err
+	}
+	s.sizes[last] += int64(len(rec))
+	s.counts[last]++
+	s.trim()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (s *Spool) rotate() error {
+	next := s.segs[len(s.segs)-1] + 1
+	w, err := os.OpenFile(s.path(next), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	_ = s.w.Close()
+	s.w = w
+	s.segs = append(s.segs, next)
+	return nil
+}
+
+// trim 超过 MaxBytes 时丢弃最早的段, 在写的段不丢
+func (s *Spool) trim() {
+	for s.MaxBytes > 0 && s.bytes() > s.MaxBytes && len(s.segs) > 1 {
+		seq := s.segs[0]
+		s.dropped += s.counts[seq]
+		slog.Warn("spool full, drop segment", slog.String("segment", s.path(seq)), slog.Int("records", s.counts[seq]))
+		s.remove(seq)
+		if seq == s.rseg {
+			s.rseg, s.roff = s.segs[0], 0
+			s.closeReader()
+			if err := s.writeAck(); err != nil {
+				slog.Error("spool ack", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+func (s *Spool) remove(seq uint64) {
+	_ = os.Remove(s.path(seq))
+	s.segs = slices.DeleteFunc(s.segs, func(x uint64) bool { return x == seq })
+	delete(s.sizes, seq)
+	delete(s.counts, seq)
+}
+
+// bytes 还没发的字节数, 读的段要去掉已经发过的部分
+func (s *Spool) bytes() int64 {
+	var n int64
+	for _, size := range s.sizes {
+		n += size
+	}
+	return n - s.roff
+}
+
+func (s *Spool) closeReader() {
+	if s.r != nil {
+		_ = s.r.Close()
+		s.r = nil
+	}
+}
+
+type spoolPos struct {
+	seg uint64
+	off int64
+}
+
+// peek 读下一条, 当前段读完并且后面还有段时换到下一段
+func (s *Spool) peek() (common.RawObject, spoolPos, int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for {
+		pos := spoolPos{seg: s.rseg, off: s.roff}
+		if s.roff < s.sizes[s.rseg] {
+			if s.r == nil {
+				var err error
+				if s.r, err = os.Open(s.path(s.rseg)); err != nil {
+					return common.RawObject{}, pos, 0, false, err
+				}
+			}
+			topic, data, n, err := readRecord(s.r, s.roff)
+			if err != nil {
+				return common.RawObject{}, pos, 0, false, err
+			}
+			return common.RawObject{T: topic, Data: data}, pos, n, true, nil
+		}
+		if s.rseg == s.segs[len(s.segs)-1] {
+			return common.RawObject{}, pos, 0, false, nil
+		}
+		s.closeReader()
+		s.remove(s.rseg)
+		s.rseg, s.roff = s.segs[0], 0
+	}
+}
+
+// ack 发送成功后前移读位置, 发送期间段被丢弃时位置已经变了, 不用再动
+func (s *Spool) ack(pos spoolPos, n int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pos.seg != s.rseg || pos.off != s.roff {
+		return nil
+	}
+	s.roff += n
+	s.counts[s.rseg]--
+	return s.writeAck()
+}
+
+// skip 读不出的记录跳过这一段剩下的部分
+func (s *Spool) skip(pos spoolPos) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pos.seg != s.rseg || pos.off != s.roff {
+		return
+	}
+	s.dropped += s.counts[s.rseg]
+	s.counts[s.rseg] = 0
+	s.roff = s.sizes[s.rseg]
+	if s.rseg == s.segs[len(s.segs)-1] {
+		// 在写的段, 后面写入的记录从新的段开始
+		if err := s.rotate(); err != nil {
+			slog.Error("spool rotate", slog.Any("error", err))
+		}
+	}
+}
+
+// Run 按顺序发送到 next, 发送失败时等一会儿重发同一条, ctx 结束时返回
+func (s *Spool) Run(ctx context.Context, next Sender) {
+	const (
+		minWait = time.Second
+		maxWait = time.Minute
+	)
+	wait := minWait
+	for {
+		obj, pos, n, ok, err := s.peek()
+		if err != nil {
+			slog.Error("spool read, skip segment", slog.Uint64("segment", pos.seg), slog.Int64("offset", pos.off), slog.Any("error", err))
+			s.skip(pos)
+			continue
+		}
+		if !ok {
+			select {
+			case <-s.notify:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		if err := next.SendData(ctx, obj); err != nil {
+			slog.Warn("spool send", slog.String("topic", obj.T), slog.Duration("retry", wait), slog.Any("error", err))
+			select {
+			case <-time.After(wait):
+				wait = min(wait*2, maxWait)
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+		wait = minWait
+
+		if err := s.ack(pos, n); err != nil {
+			slog.Error("spool ack", slog.Any("error", err))
+		}
+	}
+}
+
+func (s *Spool) Stats() SpoolStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := SpoolStats{Bytes: s.bytes(), Segments: len(s.segs), Dropped: s.dropped}
+	for _, c := range s.counts {
+		st.Depth += c
+	}
+	return st
+}
+
+func (s *Spool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.MarshalWrite(w, s.Stats())
+}
+
+func (s *Spool) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closeReader()
+	return s.w.Close()
+}
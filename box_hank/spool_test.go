@@ -0,0 +1,262 @@
+package box
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+func openSpool(t *testing.T, dir string, maxBytes int64) *Spool {
+	t.Helper()
+	s, err := OpenSpool(dir, maxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+	return s
+}
+
+func put(t *testing.T, s *Spool, data ...string) {
+	t.Helper()
+	for _, d := range data {
+		if err := s.SendData(context.Background(), common.RawObject{T: "t", Data: []byte(d)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// take 读出并确认 n 条
+func take(t *testing.T, s *Spool, n int) []string {
+	t.Helper()
+	var got []string
+	for range n {
+		obj, pos, size, ok, err := s.peek()
+		if err != nil || !ok {
+			t.Fatalf("peek = %v %v after %v", ok, err, got)
+		}
+		if obj.T != "t" {
+			t.Fatalf("topic = %s, want t", obj.T)
+		}
+		got = append(got, string(obj.Data))
+		if err := s.ack(pos, size); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return got
+}
+
+func empty(t *testing.T, s *Spool) {
+	t.Helper()
+	if obj, _, _, ok, err := s.peek(); ok || err != nil {
+		t.Fatalf("peek = %s %v %v, want empty", obj.Data, ok, err)
+	}
+}
+
+func equal(t *testing.T, got []string, want ...string) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestSpoolRestart(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 0)
+	put(t, s, "a", "b", "c")
+	equal(t, take(t, s, 1), "a")
+	s.Close()
+
+	s = openSpool(t, dir, 0)
+	if st := s.Stats(); st.Depth != 2 {
+		t.Fatalf("depth = %d, want 2", st.Depth)
+	}
+	put(t, s, "d")
+	equal(t, take(t, s, 3), "b", "c", "d")
+	empty(t, s)
+}
+
+func TestSpoolStatsBytes(t *testing.T) {
+	s := openSpool(t, t.TempDir(), 0)
+	put(t, s, "a", "b")
+	all := s.Stats().Bytes
+	take(t, s, 1)
+	// 每条记录一样长, 发完一条剩一半
+	if got := s.Stats().Bytes; got != all/2 {
+		t.Fatalf("bytes = %d, want %d", got, all/2)
+	}
+	take(t, s, 1)
+	if got := s.Stats().Bytes; got != 0 {
+		t.Fatalf("bytes = %d, want 0", got)
+	}
+}
+
+func TestSpoolTruncatedLastRecord(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 0)
+	put(t, s, "a", "b")
+	size := s.Stats().Bytes
+	seg := s.path(s.segs[len(s.segs)-1])
+	s.Close()
+
+	// 写到一半断电: 记录头完整, 内容不完整
+	f, err := os.OpenFile(seg, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head := binary.BigEndian.AppendUint32(nil, 100)
+	head = binary.BigEndian.AppendUint32(head, 0)
+	head = binary.BigEndian.AppendUint16(head, 1)
+	if _, err := f.Write(append(head, "tc"...)); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	s = openSpool(t, dir, 0)
+	if st := s.Stats(); st.Depth != 2 || st.Bytes != size {
+		t.Fatalf("stats = %+v, want depth 2 bytes %d", st, size)
+	}
+	if fi, err := os.Stat(seg); err != nil || fi.Size() != size {
+		t.Fatalf("segment size = %v %v, want %d", fi, err, size)
+	}
+	put(t, s, "c")
+	equal(t, take(t, s, 3), "a", "b", "c")
+	empty(t, s)
+}
+
+func TestSpoolAckAheadOfSegments(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 0)
+	put(t, s, "a", "b")
+	s.Close()
+
+	// ack 指向更后面的段, 现有的段都已经发完
+	ack := binary.BigEndian.AppendUint64(nil, 5)
+	ack = binary.BigEndian.AppendUint64(ack, 0)
+	if err := os.WriteFile(filepath.Join(dir, ackFile), ack, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s = openSpool(t, dir, 0)
+	if st := s.Stats(); st.Depth != 0 || st.Segments != 1 {
+		t.Fatalf("stats = %+v, want empty", st)
+	}
+	empty(t, s)
+	put(t, s, "c")
+	s.Close()
+
+	// 新写的段在 ack 之后, 重启后还在
+	s = openSpool(t, dir, 0)
+	equal(t, take(t, s, 1), "c")
+	empty(t, s)
+}
+
+func TestSpoolAckBeyondSegmentEnd(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 0)
+	put(t, s, "a")
+	seg := s.segs[0]
+	s.Close()
+
+	// 段末尾被截掉, ack 超出了有效长度
+	ack := binary.BigEndian.AppendUint64(nil, seg)
+	ack = binary.BigEndian.AppendUint64(ack, 1<<20)
+	if err := os.WriteFile(filepath.Join(dir, ackFile), ack, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	s = openSpool(t, dir, 0)
+	if st := s.Stats(); st.Depth != 0 || st.Bytes != 0 {
+		t.Fatalf("stats = %+v, want empty", st)
+	}
+	put(t, s, "b")
+	equal(t, take(t, s, 1), "b")
+}
+
+func TestSpoolTrimWhileReading(t *testing.T) {
+	// 段大小最小 64K, 每条 16K, 一段 3 条, 最多留 4 段
+	s := openSpool(t, t.TempDir(), 256<<10)
+	big := func(i int) string {
+		return strconv.Itoa(i) + string(bytes.Repeat([]byte{'x'}, 16<<10))
+	}
+	put(t, s, big(0), big(1), big(2))
+
+	// 正在发送第一条时第一段被丢弃
+	obj, pos, size, ok, err := s.peek()
+	if err != nil || !ok || string(obj.Data) != big(0) {
+		t.Fatalf("peek = %v %v", ok, err)
+	}
+	for i := 3; s.Stats().Dropped == 0; i++ {
+		put(t, s, big(i))
+	}
+	if st := s.Stats(); st.Dropped != 3 || st.Bytes > s.MaxBytes {
+		t.Fatalf("stats = %+v, want 3 dropped within %d bytes", st, s.MaxBytes)
+	}
+
+	// 之前读到的位置已经失效, ack 不能把新段的位置前移
+	if err := s.ack(pos, size); err != nil {
+		t.Fatal(err)
+	}
+	obj, _, _, ok, err = s.peek()
+	if err != nil || !ok || string(obj.Data) != big(3) {
+		t.Fatalf("peek after trim = %.1s %v %v, want 3", obj.Data, ok, err)
+	}
+}
+
+type collect struct {
+	mu   sync.Mutex
+	got  []string
+	fail int
+	done chan struct{}
+	n    int
+}
+
+func (c *collect) SendData(_ context.Context, obj common.SendObject) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.fail > 0 {
+		c.fail--
+		return os.ErrDeadlineExceeded
+	}
+	bs, _ := obj.MarshalBinary()
+	c.got = append(c.got, string(bs))
+	if len(c.got) == c.n {
+		close(c.done)
+	}
+	return nil
+}
+
+func TestSpoolRun(t *testing.T) {
+	dir := t.TempDir()
+	s := openSpool(t, dir, 0)
+	put(t, s, "a", "b")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &collect{n: 3, fail: 1, done: make(chan struct{})}
+	stopped := make(chan struct{})
+	go func() {
+		s.Run(ctx, c)
+		close(stopped)
+	}()
+	put(t, s, "c")
+	<-c.done
+	cancel()
+	<-stopped
+
+	// 失败的重发, 顺序不变
+	equal(t, c.got, "a", "b", "c")
+	if st := s.Stats(); st.Depth != 0 || st.Bytes != 0 {
+		t.Fatalf("stats = %+v, want empty", st)
+	}
+}
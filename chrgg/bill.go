@@ -0,0 +1,146 @@
+package chrgg
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/twiglab/h2o/chrgg/orm/ent"
+	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// 账单周期, 按本地时间的自然日, 自然月
+const (
+	BillDay   = "day"
+	BillMonth = "month"
+)
+
+var ErrBillPeriod = errors.New("invalid bill period")
+
+// Bill 一个周期内话单的汇总
+// 冻结读数的话单是周期的最后一张, 没有冻结读数的周期按 data_time 归入
+type Bill struct {
+	DeviceCode string
+	Start      time.Time
+	End        time.Time
+
+	Count int
+
+	StartValue int64 // 周期内第一张话单的上次表显
+	EndValue   int64 // 周期内最后一张话单的当前表显
+
+	Value  int64
+	FeeFen int64
+}
+
+// margin 冻结读数离零点不超过半天, 查询时前后多查这么多
+const margin = 12 * time.Hour
+
+func periodStart(t time.Time, period string) time.Time {
+	t = t.In(time.Local)
+	if period == BillMonth {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.Local)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func periodEnd(start time.Time, period string) time.Time {
+	if period == BillMonth {
+		return start.AddDate(0, 1, 0)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+// boundary 日账单以日冻结为界, 月账单以月冻结为界
+func boundary(period string) common.Flag {
+	if period == BillMonth {
+		return common.FlagMonthFreeze
+	}
+	return common.FlagFreeze
+}
+
+// closes 冻结读数结束的周期, 和采集端一样取离读数最近的零点
+// 23:59:59 和 00:00:05 的冻结读数都结束前一天
+func closes(t time.Time, period string) time.Time {
+	t = t.In(time.Local).Add(margin)
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+	return periodStart(midnight.Add(-time.Nanosecond), period)
+}
+
+// periods 每张话单所属周期的开始
+// 冻结之后零点之前的读数属于下一个周期, 零点之后冻结之前的读数属于上一个周期
+func periods(cdrs []*ent.CDR, period string) []time.Time {
+	flag := boundary(period)
+	ps := make([]time.Time, len(cdrs))
+
+	var (
+		prev time.Time
+		has  bool
+	)
+	for i, c := range cdrs {
+		if c.Flag.Has(flag) {
+			prev, has = closes(c.DataTime, period), true
+			ps[i] = prev
+			continue
+		}
+		ps[i] = periodStart(c.DataTime, period)
+		if has && !ps[i].After(prev) {
+			ps[i] = periodEnd(prev, period)
+		}
+	}
+
+	has = false
+	var next time.Time
+	for i := len(cdrs) - 1; i >= 0; i-- {
+		if cdrs[i].Flag.Has(flag) {
+			next, has = ps[i], true
+			continue
+		}
+		if has && ps[i].After(next) {
+			ps[i] = next
+		}
+	}
+	return ps
+}
+
+// Bills [start, end) 内的话单按周期汇总, start 和 end 按周期对齐, 没有话单的周期不返回
+func (d *DBx) Bills(ctx context.Context, code, period string, start, end time.Time) ([]Bill, error) {
+	if period != BillDay && period != BillMonth {
+		return nil, ErrBillPeriod
+	}
+	start = periodStart(start, period)
+	if ps := periodStart(end, period); !ps.Equal(end) {
+		end = periodEnd(ps, period)
+	}
+
+	cdrs, err := d.Cli.CDR.Query().
+		Where(cdr.DeviceCode(code), cdr.DataTimeGTE(start.Add(-margin)), cdr.DataTimeLT(end.Add(margin))).
+		Order(ent.Asc(cdr.FieldDataTime)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bills []Bill
+	for i, ps := range periods(cdrs, period) {
+		if ps.Before(start) || !ps.Before(end) {
+			continue
+		}
+		c := cdrs[i]
+		if len(bills) == 0 || !bills[len(bills)-1].Start.Equal(ps) {
+			bills = append(bills, Bill{
+				DeviceCode: code,
+				Start:      ps,
+				End:        periodEnd(ps, period),
+				StartValue: c.LastDataValue,
+			})
+		}
+		b := &bills[len(bills)-1]
+		b.Count++
+		b.EndValue = c.DataValue
+		b.Value += c.Value
+		b.FeeFen += c.FeeFen
+	}
+	return bills, nil
+}
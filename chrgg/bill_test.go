@@ -0,0 +1,165 @@
+package chrgg
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/twiglab/h2o/chrgg/orm"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+func TestBillsSplitOnFreeze(t *testing.T) {
+	ctx := context.Background()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	d := &DBx{Cli: cli}
+
+	at := func(day, h, m, s int) time.Time {
+		return time.Date(2024, 1, day, h, m, s, 0, time.Local)
+	}
+	month := common.FlagFreeze | common.FlagMonthFreeze
+	var total int64
+	for i, c := range []struct {
+		at    time.Time
+		flag  common.Flag
+		value int64
+	}{
+		{at(1, 10, 0, 0), 0, 10},
+		{at(1, 23, 59, 58), common.FlagFreeze, 5}, // 结束 1 日
+		{at(1, 23, 59, 59), 0, 1},                 // 冻结之后, 属于 2 日
+		{at(2, 12, 0, 0), 0, 20},
+		{at(3, 0, 0, 2), 0, 2},                 // 冻结之前, 属于 2 日
+		{at(3, 0, 0, 5), common.FlagFreeze, 3}, // 结束 2 日
+		{at(3, 8, 0, 0), 0, 4},
+		{at(32, 0, 0, 3), month, 6}, // 2 月 1 日的冻结结束 1 月
+		{at(32, 9, 0, 0), 0, 7},
+	} {
+		_, err := d.SaveCurrent(ctx, CDR{
+			DeviceCode:    "D1",
+			DeviceType:    common.ELECTRICITY,
+			DataCode:      strconv.Itoa(i),
+			DataTime:      c.at,
+			Flag:          c.flag,
+			LastDataValue: total,
+			DataValue:     total + c.value,
+			Value:         c.value,
+			FeeFen:        c.value * 10,
+			RuleID:        "r",
+			RuleType:      "t",
+			RuleCtg:       "c",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		total += c.value
+	}
+
+	type bill struct {
+		start        time.Time
+		count        int
+		value, first int64
+	}
+	check := func(period string, start, end time.Time, want ...bill) {
+		t.Helper()
+		bills, err := d.Bills(ctx, "D1", period, start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bills) != len(want) {
+			t.Fatalf("%s bills = %+v, want %d", period, bills, len(want))
+		}
+		for i, w := range want {
+			b := bills[i]
+			if !b.Start.Equal(w.start) || b.Count != w.count || b.Value != w.value || b.StartValue != w.first ||
+				b.EndValue != b.StartValue+b.Value || b.FeeFen != b.Value*10 {
+				t.Errorf("%s bill %d = %+v, want %+v", period, i, b, w)
+			}
+		}
+	}
+
+	check(BillDay, at(1, 0, 0, 0), at(4, 0, 0, 0),
+		bill{at(1, 0, 0, 0), 2, 15, 0},
+		bill{at(2, 0, 0, 0), 4, 26, 15},
+		bill{at(3, 0, 0, 0), 1, 4, 41},
+	)
+	// 只查 2 日, 零点前后的话单也要查到; 不对齐的时间按周期对齐
+	check(BillDay, at(2, 8, 0, 0), at(2, 20, 0, 0),
+		bill{at(2, 0, 0, 0), 4, 26, 15},
+	)
+	check(BillMonth, at(1, 0, 0, 0), at(60, 0, 0, 0),
+		bill{at(1, 0, 0, 0), 8, 51, 0},
+		bill{at(32, 0, 0, 0), 1, 7, 51},
+	)
+}
+
+func TestBillsFeeTotals(t *testing.T) {
+	ctx := context.Background()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	d := &DBx{Cli: cli}
+
+	at := func(day, h int) time.Time {
+		return time.Date(2024, 3, day, h, 0, 0, 0, time.Local)
+	}
+	for i, c := range []struct {
+		at         time.Time
+		value, fee int64
+	}{
+		{at(1, 8), 10, 125},
+		{at(1, 20), 5, 63},
+		{at(2, 9), 7, 88},
+	} {
+		_, err := d.SaveCurrent(ctx, CDR{
+			DeviceCode: "D1",
+			DeviceType: common.ELECTRICITY,
+			DataCode:   strconv.Itoa(i),
+			DataTime:   c.at,
+			Value:      c.value,
+			UnitFeeFen: 125,
+			FeeFen:     c.fee,
+			RuleID:     "r",
+			RuleType:   "t",
+			RuleCtg:    "c",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// 话单的费用要入库, 账单按周期累加
+	for _, tt := range []struct {
+		period string
+		fees   []int64
+	}{
+		{BillDay, []int64{188, 88}},
+		{BillMonth, []int64{276}},
+	} {
+		bills, err := d.Bills(ctx, "D1", tt.period, at(1, 0), at(3, 0))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(bills) != len(tt.fees) {
+			t.Fatalf("%s bills = %+v, want %d", tt.period, bills, len(tt.fees))
+		}
+		for i, fee := range tt.fees {
+			if bills[i].FeeFen != fee {
+				t.Fatalf("%s bill %d fee = %d, want %d", tt.period, i, bills[i].FeeFen, fee)
+			}
+		}
+	}
+}
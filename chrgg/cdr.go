@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/twiglab/h2o/chrgg/orm/ent"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 var nilCDR CDR
@@ -56,6 +57,8 @@ type CDR struct {
 	LastDataValue int64 // 上次表显
 	DataValue     int64 // 当前表显
 
+	Flag common.Flag // 当前读数的标志, 冻结读数是账单周期的分界
+
 	Value int64 // 计量值,两次表显的差值,用于计算费用的数值
 
 	RuleID     string
@@ -83,6 +86,8 @@ func CalcCDR(last LastCDR, cd ChargeData, cr ChargeRuler) CDR {
 		LastDataValue: last.DataValue,
 		DataValue:     cd.Data.DataValue,
 
+		Flag: cd.Flag,
+
 		LastDataCode: last.DataCode,
 		DataCode:     cd.DataCode,
 
@@ -3,8 +3,11 @@ package cmd
 import (
 	"cmp"
 	"context"
+	"fmt"
 	"log"
 	"log/slog"
+	"net/http"
+	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/spf13/viper"
@@ -14,7 +17,10 @@ import (
 	"github.com/twiglab/h2o/chrgg/orm/ent"
 	"github.com/twiglab/h2o/clog"
 	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/auth"
 	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/registry"
+	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func logLevel(s string) slog.Level {
@@ -73,6 +79,69 @@ func mqttcli() mqtt.Client {
 	return cli
 }
 
+func kafkacli() *kgo.Client {
+	brokers := viper.GetStringSlice("chrgg.kafka.brokers")
+	if len(brokers) == 0 {
+		log.Fatalf("no kafka brokers")
+	}
+	group := cmp.Or(viper.GetString("chrgg.kafka.group"), chrgg.CLIENT_ID)
+	cli, err := chrgg.NewKafkaClient(group, brokers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return cli
+}
+
+func natsConsumer() *chrgg.NatsConsumer {
+	url := viper.GetString("chrgg.nats.url")
+	if url == "" {
+		log.Fatalf("no nats url")
+	}
+	conf := chrgg.NatsConf{
+		URL:        url,
+		Durable:    cmp.Or(viper.GetString("chrgg.nats.durable"), chrgg.CLIENT_ID),
+		MaxDeliver: cmp.Or(viper.GetInt("chrgg.nats.max_deliver"), 5),
+		NakDelay:   cmp.Or(viper.GetDuration("chrgg.nats.nak_delay"), 10*time.Second),
+		AckWait:    cmp.Or(viper.GetDuration("chrgg.nats.ack_wait"), 30*time.Second),
+		DeadLetter: cmp.Or(viper.GetString("chrgg.nats.dead_letter"), common.NatsDeadLetter),
+	}
+	n, err := chrgg.NewNatsConsumer(context.Background(), conf)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return n
+}
+
+func consume(svr *chrgg.ChargeServer) {
+	use := viper.GetString("chrgg.bus.use")
+	switch use {
+	case "nats":
+		log.Println("using nats")
+		if _, err := natsConsumer().Consume(context.Background(), svr); err != nil {
+			log.Fatal(err)
+		}
+		return
+	case "kafka":
+		log.Println("using kafka")
+		cli := kafkacli()
+		go func() {
+			if err := chrgg.ConsumeKafka(context.Background(), cli, svr); err != nil {
+				log.Fatal(err)
+			}
+		}()
+		return
+	}
+
+	log.Println("using mqtt")
+	c := mqttcli()
+	t := c.SubscribeMultiple(topics(), chrgg.HandleChange(svr))
+	t.Wait()
+
+	if err := t.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
 func webaddr() string {
 	addr := viper.GetString("chrgg.web.addr")
 	return cmp.Or(addr, ":10007")
@@ -137,14 +206,50 @@ func ce() chrgg.ChargeEngine {
 	return chrgg.EngZ
 }
 
-func cs() *chrgg.ChargeServer {
+// archon 配置了 chrgg.registry.archon.url 才从 archon 同步设备档案
+func archon(logger *slog.Logger) chrgg.Registry {
+	url := viper.GetString("chrgg.registry.archon.url")
+	if url == "" {
+		return nil
+	}
+
+	m := registry.NewMirror(url)
+	m.Token = viper.GetString("chrgg.registry.archon.token")
+	m.Logger = logger
+	if err := m.Load(context.Background()); err != nil {
+		log.Fatal(fmt.Errorf("registry err: %w", err))
+	}
+	go m.Run(context.Background())
+	log.Println("registry:", url)
+	return m
+}
+
+func cs(d *chrgg.DBx) *chrgg.ChargeServer {
+	logger := serverLog()
 	return &chrgg.ChargeServer{
 		CdrWAL:      cdrWal(),
-		DBx:         dbx(),
+		DBx:         d,
 		ChargEngine: ce(),
 		CheckFunc:   chrgg.DefaultCheck,
 		SkipFunc:    chrgg.DefaultSkip,
+		Registry:    archon(logger),
 
-		Logger: serverLog(),
+		Logger: logger,
+	}
+}
+
+// authMW chrgg.auth.mode 为 none 时不认证, 为空时不能启动
+func authMW() func(http.Handler) http.Handler {
+	v, err := auth.NewVerifier(context.Background(), auth.Conf{
+		Mode:          viper.GetString("chrgg.auth.mode"),
+		Secret:        viper.GetString("chrgg.auth.secret"),
+		Issuer:        viper.GetString("chrgg.auth.issuer"),
+		ClientID:      viper.GetString("chrgg.auth.client_id"),
+		RolesClaim:    viper.GetString("chrgg.auth.roles_claim"),
+		ProjectsClaim: viper.GetString("chrgg.auth.projects_claim"),
+	})
+	if err != nil {
+		log.Fatal(err)
 	}
+	return auth.Middleware(v)
 }
@@ -1,12 +1,11 @@
 package cmd
 
 import (
-	"log"
 	"net/http"
 	_ "net/http/pprof"
 
 	"github.com/spf13/cobra"
-	"github.com/twiglab/h2o/chrgg"
+	"github.com/twiglab/h2o/chrgg/gql"
 )
 
 // runCmd represents the run command
@@ -32,15 +31,10 @@ func run() error {
 
 	_ = rootLog()
 
-	c := mqttcli()
+	d := dbx()
+	consume(cs(d))
 
-	svr := cs()
-	t := c.SubscribeMultiple(topics(), chrgg.HandleChange(svr))
-	t.Wait()
-
-	if err := t.Error(); err != nil {
-		log.Fatal(err)
-	}
+	http.Handle("/gql/", http.StripPrefix("/gql", gql.Handle(gql.NewConf(d), authMW())))
 
 	return http.ListenAndServe(webaddr(), nil)
 }
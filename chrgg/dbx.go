@@ -40,6 +40,7 @@ func (d *DBx) SaveCurrent(ctx context.Context, cdr CDR) (r *ent.CDR, err error)
 
 	cr.SetLastDataValue(cdr.LastDataValue)
 	cr.SetDataValue(cdr.DataValue)
+	cr.SetFlag(cdr.Flag)
 
 	cr.SetValue(cdr.Value)
 
@@ -47,6 +48,7 @@ func (d *DBx) SaveCurrent(ctx context.Context, cdr CDR) (r *ent.CDR, err error)
 	cr.SetRuleCtg(cdr.RuleCtg)
 	cr.SetRuleType(cdr.RuleType)
 	cr.SetUnitFeeFen(cdr.UnitFeeFen)
+	cr.SetFeeFen(cdr.FeeFen)
 
 	cr.SetPosCode(cdr.PosCode)
 	cr.SetProject(cdr.Project)
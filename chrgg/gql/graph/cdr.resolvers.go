@@ -0,0 +1,75 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/chrgg"
+	"github.com/twiglab/h2o/chrgg/gql/graph/model"
+	"github.com/twiglab/h2o/chrgg/orm/ent"
+	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
+)
+
+// Cdrs is the resolver for the cdrs field.
+func (r *deviceResolver) Cdrs(ctx context.Context, obj *model.Device, start *time.Time, end *time.Time, limit *int) ([]*ent.CDR, error) {
+	q := r.DBx.Cli.CDR.Query().
+		Where(cdr.DeviceCode(obj.DeviceCode)).
+		Order(ent.Desc(cdr.FieldDataTime)).
+		Limit(min(max(deref(limit, 100), 1), maxCDRs))
+	if start != nil {
+		q.Where(cdr.DataTimeGTE(*start))
+	}
+	if end != nil {
+		q.Where(cdr.DataTimeLT(*end))
+	}
+	return q.All(ctx)
+}
+
+// Bills is the resolver for the bills field.
+func (r *deviceResolver) Bills(ctx context.Context, obj *model.Device, period *model.BillPeriod, start time.Time, end time.Time) ([]*chrgg.Bill, error) {
+	p := chrgg.BillMonth
+	if deref(period, model.BillPeriodMonth) == model.BillPeriodDay {
+		p = chrgg.BillDay
+	}
+	bills, err := r.DBx.Bills(ctx, obj.DeviceCode, p, start, end)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]*chrgg.Bill, len(bills))
+	for i := range bills {
+		res[i] = &bills[i]
+	}
+	return res, nil
+}
+
+// CdrQuery is the resolver for the cdrQuery field.
+func (r *queryResolver) CdrQuery(ctx context.Context, input model.CDRQueryInput) ([]*ent.CDR, error) {
+	q := r.DBx.Cli.CDR.Query().
+		Where(
+			cdr.Project(input.Project),
+			cdr.DataTimeGTE(input.Start),
+			cdr.DataTimeLT(input.End),
+		).
+		Order(ent.Desc(cdr.FieldDataTime)).
+		Limit(min(max(deref(input.Limit, 100), 1), maxCDRs))
+	if input.PosCode != nil {
+		q.Where(cdr.PosCode(*input.PosCode))
+	}
+	return q.All(ctx)
+}
+
+// Device returns DeviceResolver implementation.
+func (r *Resolver) Device() DeviceResolver { return &deviceResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+type (
+	deviceResolver struct{ *Resolver }
+	queryResolver  struct{ *Resolver }
+)
@@ -0,0 +1,89 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package model
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/chrgg"
+	"github.com/twiglab/h2o/chrgg/orm/ent"
+)
+
+type CDRQueryInput struct {
+	Project string    `json:"project"`
+	PosCode *string   `json:"posCode,omitempty"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Limit   *int      `json:"limit,omitempty"`
+}
+
+type Device struct {
+	DeviceCode string        `json:"deviceCode"`
+	Cdrs       []*ent.CDR    `json:"cdrs"`
+	Bills      []*chrgg.Bill `json:"bills"`
+}
+
+func (Device) IsEntity() {}
+
+type DeviceByDeviceCodesInput struct {
+	DeviceCode string `json:"DeviceCode"`
+}
+
+type BillPeriod string
+
+const (
+	BillPeriodDay   BillPeriod = "DAY"
+	BillPeriodMonth BillPeriod = "MONTH"
+)
+
+var AllBillPeriod = []BillPeriod{
+	BillPeriodDay,
+	BillPeriodMonth,
+}
+
+func (e BillPeriod) IsValid() bool {
+	switch e {
+	case BillPeriodDay, BillPeriodMonth:
+		return true
+	}
+	return false
+}
+
+func (e BillPeriod) String() string {
+	return string(e)
+}
+
+func (e *BillPeriod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BillPeriod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BillPeriod", str)
+	}
+	return nil
+}
+
+func (e BillPeriod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BillPeriod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BillPeriod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,22 @@
+package graph
+
+import "github.com/twiglab/h2o/chrgg"
+
+// This file will not be regenerated automatically.
+//
+// It serves as dependency injection for your app, add any dependencies you require
+// here.
+
+type Resolver struct {
+	DBx *chrgg.DBx
+}
+
+// 一次最多返回的话单
+const maxCDRs = 1000
+
+func deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
@@ -0,0 +1,40 @@
+package chrgg
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/common/bus"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func KafkaTopics() []string {
+	return []string{
+		common.WaterKafkaTopic,
+		common.ElectricityKafkaTopic,
+		common.GasKafkaTopic,
+	}
+}
+
+// NewKafkaClient 关闭自动提交, offset 只在处理成功后提交
+func NewKafkaClient(group string, brokers ...string) (*kgo.Client, error) {
+	return kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.ClientID(CLIENT_ID),
+		kgo.ConsumerGroup(group),
+		kgo.ConsumeTopics(KafkaTopics()...),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DisableAutoCommit(),
+		kgo.BlockRebalanceOnPoll(),
+	)
+}
+
+func ConsumeKafka(ctx context.Context, cli *kgo.Client, s *ChargeServer) error {
+	return bus.ConsumeKafka(ctx, cli, bus.Handler{
+		Handle: func(ctx context.Context, topic string, payload []byte) error {
+			return s.HandlePayload(ctx, common.KafkaType(topic), payload)
+		},
+		Retryable: Retryable,
+		Logger:    s.Logger,
+	})
+}
@@ -18,19 +18,9 @@ func HandleChange(s *ChargeServer) mqtt.MessageHandler {
 
 		defer msg.Ack()
 
-		switch common.TopicType(msg.Topic()) {
-		case common.WaterTopic:
-
-		case common.ElectricityTopic:
-			var em ElectyMeterData
-			if err := em.UnmarshalBinary(msg.Payload()); err != nil {
-				s.Logger.Error("unmarshal error", slog.Any("error", err))
-				return
-			}
-			if _, err := s.Charge(context.Background(), em); err != nil {
-				s.Logger.Error("charge error", slog.Any("raw", em), slog.Any("error", err))
-			}
-		case common.GasTopic:
+		_, _, typ := common.TopicPart(msg.Topic())
+		if err := s.HandlePayload(context.Background(), typ, msg.Payload()); err != nil {
+			s.Logger.Error("handle payload error", slog.String("topic", msg.Topic()), slog.Any("error", err))
 		}
 	}
 }
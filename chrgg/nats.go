@@ -0,0 +1,119 @@
+package chrgg
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/common/bus"
+)
+
+const (
+	HeaderSubject = "H2o-Subject"
+	HeaderError   = "H2o-Error"
+)
+
+type NatsConf struct {
+	URL     string
+	Durable string
+
+	MaxDeliver int
+	NakDelay   time.Duration
+	AckWait    time.Duration
+
+	DeadLetter string
+}
+
+type NatsConsumer struct {
+	js   jetstream.JetStream
+	cons jetstream.Consumer
+	conf NatsConf
+}
+
+func NewNatsConsumer(ctx context.Context, conf NatsConf) (*NatsConsumer, error) {
+	nc, err := nats.Connect(conf.URL, nats.Name(CLIENT_ID))
+	if err != nil {
+		return nil, err
+	}
+	js, _ := jetstream.New(nc)
+
+	streams := []jetstream.StreamConfig{
+		{Name: common.NatsStream, Subjects: []string{common.NatsSubjects}},
+		{Name: common.NatsDLQStream, Subjects: []string{conf.DeadLetter + ".>"}},
+	}
+	for _, sc := range streams {
+		if err := bus.EnsureStream(ctx, js, sc); err != nil {
+			nc.Close()
+			return nil, err
+		}
+	}
+
+	cons, err := js.CreateOrUpdateConsumer(ctx, common.NatsStream, jetstream.ConsumerConfig{
+		Durable:        conf.Durable,
+		AckPolicy:      jetstream.AckExplicitPolicy,
+		AckWait:        conf.AckWait,
+		MaxDeliver:     conf.MaxDeliver,
+		FilterSubjects: []string{common.WaterSubject, common.ElectricitySubject, common.GasSubject},
+	})
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+
+	return &NatsConsumer{js: js, cons: cons, conf: conf}, nil
+}
+
+func (n *NatsConsumer) Consume(ctx context.Context, s *ChargeServer) (jetstream.ConsumeContext, error) {
+	return n.cons.Consume(func(msg jetstream.Msg) {
+		n.handle(ctx, s, msg)
+	})
+}
+
+func (n *NatsConsumer) Close() error {
+	return n.js.Conn().Drain()
+}
+
+// handle 处理成功ack, 失败延时nak重投, 解析失败或者超过最大投递次数转入死信
+// 检查不通过, 重复数据等不可重试的错误直接ack丢弃
+func (n *NatsConsumer) handle(ctx context.Context, s *ChargeServer, msg jetstream.Msg) {
+	err := s.HandlePayload(ctx, common.NatsType(msg.Subject()), msg.Data())
+	if err == nil {
+		_ = msg.Ack()
+		return
+	}
+
+	md, _ := msg.Metadata()
+	switch {
+	case errors.Is(err, ErrDecode):
+		// 解析失败直接转入死信
+	case !Retryable(err):
+		s.Logger.ErrorContext(ctx, "nats drop", slog.String("subject", msg.Subject()), slog.Any("err", err))
+		_ = msg.Ack()
+		return
+	case md == nil || md.NumDelivered < uint64(n.conf.MaxDeliver):
+		s.Logger.ErrorContext(ctx, "nats handle error", slog.String("subject", msg.Subject()), slog.Duration("delay", n.conf.NakDelay), slog.Any("err", err))
+		_ = msg.NakWithDelay(n.conf.NakDelay)
+		return
+	}
+
+	if dlErr := n.deadLetter(ctx, msg, err); dlErr != nil {
+		s.Logger.ErrorContext(ctx, "nats dead letter error", slog.String("subject", msg.Subject()), slog.Any("err", dlErr))
+		_ = msg.NakWithDelay(n.conf.NakDelay)
+		return
+	}
+	s.Logger.ErrorContext(ctx, "nats dead letter", slog.String("subject", msg.Subject()), slog.Any("err", err))
+	_ = msg.Term()
+}
+
+func (n *NatsConsumer) deadLetter(ctx context.Context, msg jetstream.Msg, cause error) error {
+	dl := nats.NewMsg(n.conf.DeadLetter + "." + msg.Subject())
+	dl.Data = msg.Data()
+	dl.Header.Set(HeaderSubject, msg.Subject())
+	dl.Header.Set(HeaderError, cause.Error())
+	_, err := n.js.PublishMsg(ctx, dl)
+	return err
+}
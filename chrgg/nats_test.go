@@ -0,0 +1,129 @@
+package chrgg
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twiglab/h2o/chrgg/orm"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+// fakeMsg 记录 ack/nak/term, 只实现 handle 用到的方法
+type fakeMsg struct {
+	jetstream.Msg
+	subject   string
+	data      []byte
+	delivered uint64
+
+	acked, termed bool
+	nakDelay      time.Duration
+}
+
+func (m *fakeMsg) Subject() string { return m.subject }
+func (m *fakeMsg) Data() []byte    { return m.data }
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{NumDelivered: m.delivered}, nil
+}
+func (m *fakeMsg) Ack() error  { m.acked = true; return nil }
+func (m *fakeMsg) Term() error { m.termed = true; return nil }
+func (m *fakeMsg) NakWithDelay(d time.Duration) error {
+	m.nakDelay = d
+	return nil
+}
+
+type fakeJetStream struct {
+	jetstream.JetStream
+	err  error
+	msgs []*nats.Msg
+}
+
+func (j *fakeJetStream) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	if j.err != nil {
+		return nil, j.err
+	}
+	j.msgs = append(j.msgs, msg)
+	return &jetstream.PubAck{}, nil
+}
+
+func TestNatsHandle(t *testing.T) {
+	ctx := context.Background()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	var em common.ElectricityMeter
+	em.Code, em.Type = "PT-1", common.ELECTRICITY
+	payload, err := em.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	errDown := errors.New("db down")
+
+	tests := []struct {
+		name      string
+		data      []byte
+		checkErr  error
+		pubErr    error
+		delivered uint64
+
+		acked, termed, dead bool
+		nak                 bool
+	}{
+		{"成功", payload, nil, nil, 1, true, false, false, false},
+		{"解析失败转死信", []byte("{"), nil, nil, 1, false, true, true, false},
+		{"检查不通过丢弃", payload, ErrDataCodeDup, nil, 1, true, false, false, false},
+		{"可重试延时重投", payload, errDown, nil, 2, false, false, false, true},
+		{"超过最大投递次数转死信", payload, errDown, nil, 3, false, true, true, false},
+		{"死信发送失败重投", payload, errDown, errDown, 3, false, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := &fakeJetStream{err: tt.pubErr}
+			n := &NatsConsumer{js: js, conf: NatsConf{MaxDeliver: 3, NakDelay: time.Second, DeadLetter: common.NatsDeadLetter}}
+			s := &ChargeServer{
+				DBx: &DBx{Cli: cli},
+				// 成功的用例直接跳过, 不用准备计费规则
+				SkipFunc: func(context.Context, LastCDR, ChargeData) SkipReturn {
+					if tt.checkErr == nil {
+						return SkipOK("test")
+					}
+					return NoSkip()
+				},
+				CheckFunc: func(context.Context, LastCDR, ChargeData) error {
+					return tt.checkErr
+				},
+				Logger: slog.New(slog.DiscardHandler),
+			}
+			msg := &fakeMsg{subject: "h2o.PT-1.E", data: tt.data, delivered: tt.delivered}
+
+			n.handle(ctx, s, msg)
+
+			if msg.acked != tt.acked || msg.termed != tt.termed || (msg.nakDelay > 0) != tt.nak {
+				t.Fatalf("ack %v term %v nak %v, want ack %v term %v nak %v",
+					msg.acked, msg.termed, msg.nakDelay, tt.acked, tt.termed, tt.nak)
+			}
+			if tt.nak && msg.nakDelay != time.Second {
+				t.Fatalf("nak delay = %v, want 1s", msg.nakDelay)
+			}
+			if (len(js.msgs) == 1) != tt.dead {
+				t.Fatalf("dead letters = %d, want %v", len(js.msgs), tt.dead)
+			}
+			if tt.dead {
+				dl := js.msgs[0]
+				if dl.Subject != "dlq.h2o.h2o.PT-1.E" || dl.Header.Get(HeaderSubject) != msg.subject || dl.Header.Get(HeaderError) == "" {
+					t.Fatalf("dead letter %s %v", dl.Subject, dl.Header)
+				}
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 // CDR is the model entity for the CDR schema.
@@ -37,6 +38,8 @@ type CDR struct {
 	LastDataTime time.Time `json:"last_data_time,omitempty"`
 	// 当前时间
 	DataTime time.Time `json:"data_time,omitempty"`
+	// 数据标志, 日冻结, 月冻结
+	Flag common.Flag `json:"flag,omitempty"`
 	// 计费规则ID
 	RuleID string `json:"rule_id,omitempty"`
 	// 规则类型
@@ -63,7 +66,7 @@ func (*CDR) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case cdr.FieldLastDataValue, cdr.FieldDataValue, cdr.FieldValue, cdr.FieldUnitFeeFen, cdr.FieldFeeFen:
+		case cdr.FieldLastDataValue, cdr.FieldDataValue, cdr.FieldFlag, cdr.FieldValue, cdr.FieldUnitFeeFen, cdr.FieldFeeFen:
 			values[i] = new(sql.NullInt64)
 		case cdr.FieldID, cdr.FieldDeviceCode, cdr.FieldDeviceType, cdr.FieldLastDataCode, cdr.FieldDataCode, cdr.FieldRuleID, cdr.FieldRuleType, cdr.FieldRuleCtg, cdr.FieldPosCode, cdr.FieldProject, cdr.FieldMemo:
 			values[i] = new(sql.NullString)
@@ -150,6 +153,12 @@ func (_m *CDR) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DataTime = value.Time
 			}
+		case cdr.FieldFlag:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flag", values[i])
+			} else if value.Valid {
+				_m.Flag = common.Flag(value.Int64)
+			}
 		case cdr.FieldRuleID:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
@@ -270,6 +279,9 @@ func (_m *CDR) String() string {
 	builder.WriteString("data_time=")
 	builder.WriteString(_m.DataTime.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("flag=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flag))
+	builder.WriteString(", ")
 	builder.WriteString("rule_id=")
 	builder.WriteString(_m.RuleID)
 	builder.WriteString(", ")
@@ -5,7 +5,9 @@ package cdr
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 const (
@@ -33,6 +35,8 @@ const (
 	FieldLastDataTime = "last_data_time"
 	// FieldDataTime holds the string denoting the data_time field in the database.
 	FieldDataTime = "data_time"
+	// FieldFlag holds the string denoting the flag field in the database.
+	FieldFlag = "flag"
 	// FieldRuleID holds the string denoting the rule_id field in the database.
 	FieldRuleID = "rule_id"
 	// FieldRuleType holds the string denoting the rule_type field in the database.
@@ -68,6 +72,7 @@ var Columns = []string{
 	FieldDataCode,
 	FieldLastDataTime,
 	FieldDataTime,
+	FieldFlag,
 	FieldRuleID,
 	FieldRuleType,
 	FieldRuleCtg,
@@ -89,7 +94,14 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/twiglab/h2o/chrgg/orm/ent/runtime"
 var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
 	// DefaultCreateTime holds the default value on creation for the "create_time" field.
 	DefaultCreateTime func() time.Time
 	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
@@ -106,6 +118,8 @@ var (
 	DefaultDataValue int64
 	// DataCodeValidator is a validator for the "data_code" field. It is called by the builders before save.
 	DataCodeValidator func(string) error
+	// DefaultFlag holds the default value on creation for the "flag" field.
+	DefaultFlag common.Flag
 	// RuleIDValidator is a validator for the "rule_id" field. It is called by the builders before save.
 	RuleIDValidator func(string) error
 	// RuleTypeValidator is a validator for the "rule_type" field. It is called by the builders before save.
@@ -182,6 +196,11 @@ func ByDataTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDataTime, opts...).ToFunc()
 }
 
+// ByFlag orders the results by the flag field.
+func ByFlag(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlag, opts...).ToFunc()
+}
+
 // ByRuleID orders the results by the rule_id field.
 func ByRuleID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRuleID, opts...).ToFunc()
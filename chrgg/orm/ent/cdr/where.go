@@ -7,6 +7,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"github.com/twiglab/h2o/chrgg/orm/ent/predicate"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 // ID filters vertices based on their ID field.
@@ -114,6 +115,12 @@ func DataTime(v time.Time) predicate.CDR {
 	return predicate.CDR(sql.FieldEQ(FieldDataTime, v))
 }
 
+// Flag applies equality check predicate on the "flag" field. It's identical to FlagEQ.
+func Flag(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldEQ(FieldFlag, vc))
+}
+
 // RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
 func RuleID(v string) predicate.CDR {
 	return predicate.CDR(sql.FieldEQ(FieldRuleID, v))
@@ -659,6 +666,60 @@ func DataTimeLTE(v time.Time) predicate.CDR {
 	return predicate.CDR(sql.FieldLTE(FieldDataTime, v))
 }
 
+// FlagEQ applies the EQ predicate on the "flag" field.
+func FlagEQ(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldEQ(FieldFlag, vc))
+}
+
+// FlagNEQ applies the NEQ predicate on the "flag" field.
+func FlagNEQ(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldNEQ(FieldFlag, vc))
+}
+
+// FlagIn applies the In predicate on the "flag" field.
+func FlagIn(vs ...common.Flag) predicate.CDR {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint32(vs[i])
+	}
+	return predicate.CDR(sql.FieldIn(FieldFlag, v...))
+}
+
+// FlagNotIn applies the NotIn predicate on the "flag" field.
+func FlagNotIn(vs ...common.Flag) predicate.CDR {
+	v := make([]any, len(vs))
+	for i := range v {
+		v[i] = uint32(vs[i])
+	}
+	return predicate.CDR(sql.FieldNotIn(FieldFlag, v...))
+}
+
+// FlagGT applies the GT predicate on the "flag" field.
+func FlagGT(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldGT(FieldFlag, vc))
+}
+
+// FlagGTE applies the GTE predicate on the "flag" field.
+func FlagGTE(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldGTE(FieldFlag, vc))
+}
+
+// FlagLT applies the LT predicate on the "flag" field.
+func FlagLT(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldLT(FieldFlag, vc))
+}
+
+// FlagLTE applies the LTE predicate on the "flag" field.
+func FlagLTE(v common.Flag) predicate.CDR {
+	vc := uint32(v)
+	return predicate.CDR(sql.FieldLTE(FieldFlag, vc))
+}
+
 // RuleIDEQ applies the EQ predicate on the "rule_id" field.
 func RuleIDEQ(v string) predicate.CDR {
 	return predicate.CDR(sql.FieldEQ(FieldRuleID, v))
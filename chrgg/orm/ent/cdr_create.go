@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 // CDRCreate is the builder for creating a CDR entity.
@@ -115,6 +116,20 @@ func (_c *CDRCreate) SetDataTime(v time.Time) *CDRCreate {
 	return _c
 }
 
+// SetFlag sets the "flag" field.
+func (_c *CDRCreate) SetFlag(v common.Flag) *CDRCreate {
+	_c.mutation.SetFlag(v)
+	return _c
+}
+
+// SetNillableFlag sets the "flag" field if the given value is not nil.
+func (_c *CDRCreate) SetNillableFlag(v *common.Flag) *CDRCreate {
+	if v != nil {
+		_c.SetFlag(*v)
+	}
+	return _c
+}
+
 // SetRuleID sets the "rule_id" field.
 func (_c *CDRCreate) SetRuleID(v string) *CDRCreate {
 	_c.mutation.SetRuleID(v)
@@ -222,7 +237,9 @@ func (_c *CDRCreate) Mutation() *CDRMutation {
 
 // Save creates the CDR in the database.
 func (_c *CDRCreate) Save(ctx context.Context) (*CDR, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -249,12 +266,18 @@ func (_c *CDRCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *CDRCreate) defaults() {
+func (_c *CDRCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if cdr.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized cdr.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := cdr.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if cdr.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized cdr.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := cdr.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
@@ -266,6 +289,10 @@ func (_c *CDRCreate) defaults() {
 		v := cdr.DefaultDataValue
 		_c.mutation.SetDataValue(v)
 	}
+	if _, ok := _c.mutation.Flag(); !ok {
+		v := cdr.DefaultFlag
+		_c.mutation.SetFlag(v)
+	}
 	if _, ok := _c.mutation.Value(); !ok {
 		v := cdr.DefaultValue
 		_c.mutation.SetValue(v)
@@ -279,9 +306,13 @@ func (_c *CDRCreate) defaults() {
 		_c.mutation.SetFeeFen(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if cdr.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized cdr.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := cdr.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -331,6 +362,9 @@ func (_c *CDRCreate) check() error {
 	if _, ok := _c.mutation.DataTime(); !ok {
 		return &ValidationError{Name: "data_time", err: errors.New(`ent: missing required field "CDR.data_time"`)}
 	}
+	if _, ok := _c.mutation.Flag(); !ok {
+		return &ValidationError{Name: "flag", err: errors.New(`ent: missing required field "CDR.flag"`)}
+	}
 	if _, ok := _c.mutation.RuleID(); !ok {
 		return &ValidationError{Name: "rule_id", err: errors.New(`ent: missing required field "CDR.rule_id"`)}
 	}
@@ -451,6 +485,10 @@ func (_c *CDRCreate) createSpec() (*CDR, *sqlgraph.CreateSpec) {
 		_spec.SetField(cdr.FieldDataTime, field.TypeTime, value)
 		_node.DataTime = value
 	}
+	if value, ok := _c.mutation.Flag(); ok {
+		_spec.SetField(cdr.FieldFlag, field.TypeUint32, value)
+		_node.Flag = value
+	}
 	if value, ok := _c.mutation.RuleID(); ok {
 		_spec.SetField(cdr.FieldRuleID, field.TypeString, value)
 		_node.RuleID = value
@@ -595,6 +633,9 @@ func (u *CDRUpsertOne) UpdateNewValues() *CDRUpsertOne {
 		if _, exists := u.create.mutation.DataTime(); exists {
 			s.SetIgnore(cdr.FieldDataTime)
 		}
+		if _, exists := u.create.mutation.Flag(); exists {
+			s.SetIgnore(cdr.FieldFlag)
+		}
 		if _, exists := u.create.mutation.RuleID(); exists {
 			s.SetIgnore(cdr.FieldRuleID)
 		}
@@ -877,6 +918,9 @@ func (u *CDRUpsertBulk) UpdateNewValues() *CDRUpsertBulk {
 			if _, exists := b.mutation.DataTime(); exists {
 				s.SetIgnore(cdr.FieldDataTime)
 			}
+			if _, exists := b.mutation.Flag(); exists {
+				s.SetIgnore(cdr.FieldFlag)
+			}
 			if _, exists := b.mutation.RuleID(); exists {
 				s.SetIgnore(cdr.FieldRuleID)
 			}
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
 
@@ -329,6 +330,12 @@ func (_q *CDRQuery) prepareQuery(ctx context.Context) error {
 		}
 		_q.sql = prev
 	}
+	if cdr.Policy == nil {
+		return errors.New("ent: uninitialized cdr.Policy (forgotten import ent/runtime?)")
+	}
+	if err := cdr.Policy.EvalQuery(ctx, _q); err != nil {
+		return err
+	}
 	return nil
 }
 
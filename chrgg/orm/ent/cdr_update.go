@@ -41,7 +41,9 @@ func (_u *CDRUpdate) Mutation() *CDRMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *CDRUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -68,11 +70,15 @@ func (_u *CDRUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *CDRUpdate) defaults() {
+func (_u *CDRUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if cdr.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized cdr.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := cdr.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 func (_u *CDRUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -136,7 +142,9 @@ func (_u *CDRUpdateOne) Select(field string, fields ...string) *CDRUpdateOne {
 
 // Save executes the query and returns the updated CDR entity.
 func (_u *CDRUpdateOne) Save(ctx context.Context) (*CDR, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -163,11 +171,15 @@ func (_u *CDRUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *CDRUpdateOne) defaults() {
+func (_u *CDRUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if cdr.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized cdr.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := cdr.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 func (_u *CDRUpdateOne) sqlSave(ctx context.Context) (_node *CDR, err error) {
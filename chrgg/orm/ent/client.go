@@ -308,7 +308,8 @@ func (c *CDRClient) GetX(ctx context.Context, id string) *CDR {
 
 // Hooks returns the client hooks.
 func (c *CDRClient) Hooks() []Hook {
-	return c.hooks.CDR
+	hooks := c.hooks.CDR
+	return append(hooks[:len(hooks):len(hooks)], cdr.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -22,6 +22,7 @@ var (
 		{Name: "data_code", Type: field.TypeString, Unique: true, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "last_data_time", Type: field.TypeTime},
 		{Name: "data_time", Type: field.TypeTime},
+		{Name: "flag", Type: field.TypeUint32, Default: 0},
 		{Name: "rule_id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "rule_type", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
 		{Name: "rule_ctg", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
@@ -61,12 +62,12 @@ var (
 			{
 				Name:    "cdr_pos_code",
 				Unique:  false,
-				Columns: []*schema.Column{TNhCdrColumns[17]},
+				Columns: []*schema.Column{TNhCdrColumns[18]},
 			},
 			{
 				Name:    "cdr_project",
 				Unique:  false,
-				Columns: []*schema.Column{TNhCdrColumns[18]},
+				Columns: []*schema.Column{TNhCdrColumns[19]},
 			},
 		},
 	}
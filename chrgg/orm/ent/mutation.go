@@ -13,6 +13,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
 	"github.com/twiglab/h2o/chrgg/orm/ent/predicate"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 const (
@@ -45,6 +46,8 @@ type CDRMutation struct {
 	data_code          *string
 	last_data_time     *time.Time
 	data_time          *time.Time
+	flag               *common.Flag
+	addflag            *common.Flag
 	rule_id            *string
 	rule_type          *string
 	rule_ctg           *string
@@ -567,6 +570,62 @@ func (m *CDRMutation) ResetDataTime() {
 	m.data_time = nil
 }
 
+// SetFlag sets the "flag" field.
+func (m *CDRMutation) SetFlag(c common.Flag) {
+	m.flag = &c
+	m.addflag = nil
+}
+
+// Flag returns the value of the "flag" field in the mutation.
+func (m *CDRMutation) Flag() (r common.Flag, exists bool) {
+	v := m.flag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlag returns the old "flag" field's value of the CDR entity.
+// If the CDR object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CDRMutation) OldFlag(ctx context.Context) (v common.Flag, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlag is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlag requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlag: %w", err)
+	}
+	return oldValue.Flag, nil
+}
+
+// AddFlag adds c to the "flag" field.
+func (m *CDRMutation) AddFlag(c common.Flag) {
+	if m.addflag != nil {
+		*m.addflag += c
+	} else {
+		m.addflag = &c
+	}
+}
+
+// AddedFlag returns the value that was added to the "flag" field in this mutation.
+func (m *CDRMutation) AddedFlag() (r common.Flag, exists bool) {
+	v := m.addflag
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFlag resets all changes to the "flag" field.
+func (m *CDRMutation) ResetFlag() {
+	m.flag = nil
+	m.addflag = nil
+}
+
 // SetRuleID sets the "rule_id" field.
 func (m *CDRMutation) SetRuleID(s string) {
 	m.rule_id = &s
@@ -998,7 +1057,7 @@ func (m *CDRMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *CDRMutation) Fields() []string {
-	fields := make([]string, 0, 19)
+	fields := make([]string, 0, 20)
 	if m.create_time != nil {
 		fields = append(fields, cdr.FieldCreateTime)
 	}
@@ -1029,6 +1088,9 @@ func (m *CDRMutation) Fields() []string {
 	if m.data_time != nil {
 		fields = append(fields, cdr.FieldDataTime)
 	}
+	if m.flag != nil {
+		fields = append(fields, cdr.FieldFlag)
+	}
 	if m.rule_id != nil {
 		fields = append(fields, cdr.FieldRuleID)
 	}
@@ -1084,6 +1146,8 @@ func (m *CDRMutation) Field(name string) (ent.Value, bool) {
 		return m.LastDataTime()
 	case cdr.FieldDataTime:
 		return m.DataTime()
+	case cdr.FieldFlag:
+		return m.Flag()
 	case cdr.FieldRuleID:
 		return m.RuleID()
 	case cdr.FieldRuleType:
@@ -1131,6 +1195,8 @@ func (m *CDRMutation) OldField(ctx context.Context, name string) (ent.Value, err
 		return m.OldLastDataTime(ctx)
 	case cdr.FieldDataTime:
 		return m.OldDataTime(ctx)
+	case cdr.FieldFlag:
+		return m.OldFlag(ctx)
 	case cdr.FieldRuleID:
 		return m.OldRuleID(ctx)
 	case cdr.FieldRuleType:
@@ -1228,6 +1294,13 @@ func (m *CDRMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetDataTime(v)
 		return nil
+	case cdr.FieldFlag:
+		v, ok := value.(common.Flag)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlag(v)
+		return nil
 	case cdr.FieldRuleID:
 		v, ok := value.(string)
 		if !ok {
@@ -1305,6 +1378,9 @@ func (m *CDRMutation) AddedFields() []string {
 	if m.adddata_value != nil {
 		fields = append(fields, cdr.FieldDataValue)
 	}
+	if m.addflag != nil {
+		fields = append(fields, cdr.FieldFlag)
+	}
 	if m.addvalue != nil {
 		fields = append(fields, cdr.FieldValue)
 	}
@@ -1326,6 +1402,8 @@ func (m *CDRMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedLastDataValue()
 	case cdr.FieldDataValue:
 		return m.AddedDataValue()
+	case cdr.FieldFlag:
+		return m.AddedFlag()
 	case cdr.FieldValue:
 		return m.AddedValue()
 	case cdr.FieldUnitFeeFen:
@@ -1355,6 +1433,13 @@ func (m *CDRMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddDataValue(v)
 		return nil
+	case cdr.FieldFlag:
+		v, ok := value.(common.Flag)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFlag(v)
+		return nil
 	case cdr.FieldValue:
 		v, ok := value.(int64)
 		if !ok {
@@ -1442,6 +1527,9 @@ func (m *CDRMutation) ResetField(name string) error {
 	case cdr.FieldDataTime:
 		m.ResetDataTime()
 		return nil
+	case cdr.FieldFlag:
+		m.ResetFlag()
+		return nil
 	case cdr.FieldRuleID:
 		m.ResetRuleID()
 		return nil
@@ -0,0 +1,43 @@
+// Package rule ent 的权限规则
+// context 中没有 auth.Claims 的是服务内部的调用(计费), 不做限制
+package rule
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/chrgg/orm/ent"
+	"github.com/twiglab/h2o/chrgg/orm/ent/cdr"
+	"github.com/twiglab/h2o/chrgg/orm/ent/privacy"
+	"github.com/twiglab/h2o/pkg/auth"
+)
+
+// CDRQuery 只能查到自己项目的话单
+func CDRQuery() privacy.QueryRule {
+	return privacy.CDRQueryRuleFunc(func(ctx context.Context, q *ent.CDRQuery) error {
+		c, ok := auth.FromContext(ctx)
+		if !ok {
+			return privacy.Allow
+		}
+		if !c.CanRead() {
+			return privacy.Denyf("%s: no role", c.Subject)
+		}
+		if ps, all := c.Scope(); !all {
+			q.Where(cdr.ProjectIn(ps...))
+		}
+		return privacy.Allow
+	})
+}
+
+// CDRMutation 话单由计费生成, 对外只有不限项目的 admin 可以修正
+func CDRMutation() privacy.MutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		c, ok := auth.FromContext(ctx)
+		if !ok {
+			return privacy.Allow
+		}
+		if _, all := c.Scope(); all && c.Has(auth.RoleAdmin) {
+			return privacy.Allow
+		}
+		return privacy.Denyf("%s: cdr is read only", c.Subject)
+	})
+}
@@ -11,6 +11,9 @@ import (
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
 	"github.com/google/uuid"
+	"github.com/twiglab/h2o/chrgg/orm/ent/privacy"
+	"github.com/twiglab/h2o/chrgg/orm/rule"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 func cdrid() string {
@@ -52,6 +55,7 @@ func (CDR) Fields() []ent.Field {
 
 		field.Time("last_data_time").Immutable().Comment("上次时间"),
 		field.Time("data_time").Immutable().Comment("当前时间"),
+		field.Uint32("flag").GoType(common.Flag(0)).Immutable().Default(0).Comment("数据标志, 日冻结, 月冻结"),
 
 		field.String("rule_id").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("计费规则ID"),
 		field.String("rule_type").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("规则类型"),
@@ -90,3 +94,10 @@ func (CDR) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "t_nh_cdr"},
 	}
 }
+
+func (CDR) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.CDRQuery()},
+		Mutation: privacy.MutationPolicy{rule.CDRMutation()},
+	}
+}
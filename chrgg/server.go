@@ -2,11 +2,24 @@ package chrgg
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 
+	"github.com/twiglab/h2o/chrgg/orm/ent"
 	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/registry"
 )
 
+// ErrDecode 数据无法解析
+var ErrDecode = errors.New("decode error")
+
+// Registry 设备档案, 由 registry.Mirror 实现
+type Registry interface {
+	Get(code string) (registry.Device, bool)
+}
+
 type ChargeServer struct {
 	DBx         *DBx
 	CdrWAL      *wal.WAL
@@ -14,11 +27,25 @@ type ChargeServer struct {
 	CheckFunc   CheckFunc
 	SkipFunc    SkipFunc
 
+	// Registry 不为空时, 话单的项目和位置以 archon 的设备档案为准
+	Registry Registry
+
 	Logger *slog.Logger
 }
 
-func (s *ChargeServer) pre(_ context.Context, md ElectyMeterData) (ChargeData, error) {
-	return ChargeData{ElectyMeterData: md}, nil
+func (s *ChargeServer) pre(ctx context.Context, md ElectyMeterData) (ChargeData, error) {
+	cd := ChargeData{ElectyMeterData: md}
+	if s.Registry == nil {
+		return cd, nil
+	}
+	d, ok := s.Registry.Get(md.Code)
+	if !ok {
+		s.Logger.WarnContext(ctx, "device not in registry", slog.String("code", md.Code))
+		return cd, nil
+	}
+	cd.Pos.Project = d.Project
+	cd.Pos.PosCode = d.PosCode
+	return cd, nil
 }
 
 func (s *ChargeServer) loadLast(ctx context.Context, cd ChargeData) (LastCDR, error) {
@@ -79,3 +106,27 @@ func (s *ChargeServer) Charge(ctx context.Context, md ElectyMeterData) (CDR, err
 func (s *ChargeServer) ChargeWater(ctx context.Context, wd WaterMeterData) (CDR, error) {
 	return nilCDR, nil
 }
+
+// HandlePayload 按设备类型解析并计费, 与具体的消息总线无关
+func (s *ChargeServer) HandlePayload(ctx context.Context, typ string, payload []byte) error {
+	switch typ {
+	case common.ELECTRICITY:
+		var em ElectyMeterData
+		if err := em.UnmarshalBinary(payload); err != nil {
+			return fmt.Errorf("%w: %w", ErrDecode, err)
+		}
+		_, err := s.Charge(ctx, em)
+		return err
+	}
+	return nil
+}
+
+// Retryable 解析错误, 检查不通过, 重复数据, 重试也不会成功
+func Retryable(err error) bool {
+	var ce *ChargeErr
+	switch {
+	case errors.Is(err, ErrDecode), errors.As(err, &ce), ent.IsConstraintError(err):
+		return false
+	}
+	return true
+}
@@ -2,6 +2,8 @@ package chrgg
 
 import (
 	"context"
+
+	"github.com/twiglab/h2o/pkg/common"
 )
 
 type SkipReturn struct {
@@ -27,7 +29,11 @@ type SkipFunc func(ctx context.Context, last LastCDR, cd ChargeData) SkipReturn
 
 const tm_22h45m = 1365 // 22:45分的分钟数
 
+// DefaultSkip 冻结读数总是出话单, 日和月的话单以它为界
 func DefaultSkip(_ context.Context, last LastCDR, cd ChargeData) SkipReturn {
+	if cd.Flag.Has(common.FlagFreeze) {
+		return NoSkip()
+	}
 	if MinPerDay(cd.DataTime) < tm_22h45m && !IsValueChangeLeeway(last, cd, 100) {
 		return SkipOK("小于一个读数")
 	}
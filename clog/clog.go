@@ -38,7 +38,8 @@ func isConsole(logFile string) bool {
 	return false
 }
 
-func NewLog(logFile string, level slog.Level) *slog.Logger {
+// NewLog level 传 *slog.LevelVar 时可以在运行中调整级别
+func NewLog(logFile string, level slog.Leveler) *slog.Logger {
 	var out io.Writer = os.Stdout
 	if !isConsole(logFile) {
 		out = NewLogWriter(logFile)
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"cmp"
+	"log"
+	"log/slog"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/twiglab/h2o/clog"
+	"github.com/twiglab/h2o/gateway"
+)
+
+func webaddr() string {
+	addr := viper.GetString("gateway.web.addr")
+	return cmp.Or(addr, ":10009")
+}
+
+// subgraphs 按顺序配置, 同一个字段多个子图提供时取前面的
+//
+//	[[gateway.subgraphs]]
+//	name = "archon"
+//	url = "http://127.0.0.1:10008/gql/query"
+func subgraphs() []gateway.Subgraph {
+	var sgs []gateway.Subgraph
+	if err := viper.UnmarshalKey("gateway.subgraphs", &sgs); err != nil {
+		log.Fatal(err)
+	}
+	if len(sgs) == 0 {
+		log.Fatal("gateway.subgraphs is empty")
+	}
+	return sgs
+}
+
+func reload() time.Duration {
+	return cmp.Or(viper.GetDuration("gateway.reload"), time.Minute)
+}
+
+func rootLog() *slog.Logger {
+	rlogF := viper.GetString("gateway.log.root.file")
+	rlogL := viper.GetString("gateway.log.root.level")
+	logL := viper.GetString("gateway.log.level")
+
+	level := clog.Level(cmp.Or(rlogL, logL))
+	log := clog.NewLog(rlogF, level)
+	slog.SetDefault(log)
+	return log
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/twiglab/h2o/gateway"
+)
+
+// runCmd represents the run command
+var runCmd = &cobra.Command{
+	Use:   "run",
+	Short: "启动 federation 网关, 组合 archon, vigil, chrgg 的 GraphQL",
+	Run: func(cmd *cobra.Command, args []string) {
+		run()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(runCmd)
+}
+
+func run() {
+	logger := rootLog()
+
+	g := gateway.New(subgraphs())
+	g.Logger = logger
+	g.Token = viper.GetString("gateway.token")
+	if err := g.Load(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	go g.Run(context.Background(), reload())
+
+	http.Handle("/gql", playground.ApolloSandboxHandler("gql", "/gql/query"))
+	http.Handle("/gql/query", gateway.Handler(g))
+	http.Handle("/gql/sdl", gateway.SDLHandler(g))
+
+	if err := http.ListenAndServe(webaddr(), nil); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,7 @@
+package main
+
+import "github.com/twiglab/h2o/gateway/cmd/gateway/cmd"
+
+func main() {
+	cmd.Execute()
+}
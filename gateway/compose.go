@@ -0,0 +1,193 @@
+package gateway
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// supergraph 合并后的 schema, 以及每个字段由哪些子图提供
+type supergraph struct {
+	schema *ast.Schema
+	sdl    string
+
+	subgraphs map[string]Subgraph
+
+	// owners 类型 -> 字段 -> 提供的子图, 按配置的顺序, 第一个优先
+	owners map[string]map[string][]string
+	// keys 实体类型 -> 子图 -> @key 的字段
+	keys map[string]map[string][]string
+}
+
+type source struct {
+	sg  Subgraph
+	sdl string
+}
+
+// skipType federation 自己的类型不出现在合并后的 schema 中
+func skipType(name string) bool {
+	return strings.HasPrefix(name, "_") || strings.Contains(name, "__") || name == "FieldSet"
+}
+
+// compose 按类型名合并子图的 schema
+// 同名的对象类型合并字段, 枚举合并取值, 其他类型(标量, 输入)以第一个为准
+// 只支持单个字段组成的 @key, 如 @key(fields: "deviceCode")
+func compose(srcs []source) (*supergraph, error) {
+	sg := &supergraph{
+		subgraphs: make(map[string]Subgraph, len(srcs)),
+		owners:    make(map[string]map[string][]string),
+		keys:      make(map[string]map[string][]string),
+	}
+
+	var (
+		names  []string
+		merged = make(map[string]*ast.Definition)
+	)
+
+	for _, src := range srcs {
+		sg.subgraphs[src.sg.Name] = src.sg
+
+		doc, err := parser.ParseSchema(&ast.Source{Name: src.sg.Name, Input: src.sdl})
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", src.sg.Name, err)
+		}
+
+		for _, def := range append(doc.Definitions, doc.Extensions...) {
+			if skipType(def.Name) {
+				continue
+			}
+
+			if err := sg.key(src.sg.Name, def); err != nil {
+				return nil, err
+			}
+
+			m, ok := merged[def.Name]
+			if !ok {
+				m = &ast.Definition{
+					Kind:        def.Kind,
+					Description: def.Description,
+					Name:        def.Name,
+					Interfaces:  def.Interfaces,
+					Directives:  keep(def.Directives),
+				}
+				merged[def.Name] = m
+				names = append(names, def.Name)
+			} else if m.Kind != def.Kind {
+				return nil, fmt.Errorf("%s: type %s is %s, but %s elsewhere", src.sg.Name, def.Name, def.Kind, m.Kind)
+			}
+			m.Description = cmp.Or(m.Description, def.Description)
+
+			for _, f := range def.Fields {
+				if root(def.Name) && strings.HasPrefix(f.Name, "_") {
+					continue
+				}
+				if def.Kind == ast.Object || def.Kind == ast.Interface {
+					sg.own(def.Name, f.Name, src.sg.Name)
+				}
+				if m.Fields.ForName(f.Name) == nil {
+					fc := *f
+					fc.Directives = keep(f.Directives)
+					m.Fields = append(m.Fields, &fc)
+				}
+			}
+			for _, v := range def.EnumValues {
+				if m.EnumValues.ForName(v.Name) == nil {
+					m.EnumValues = append(m.EnumValues, v)
+				}
+			}
+			for _, t := range def.Types {
+				if !slices.Contains(m.Types, t) {
+					m.Types = append(m.Types, t)
+				}
+			}
+		}
+	}
+
+	doc := &ast.SchemaDocument{}
+	for _, name := range names {
+		doc.Definitions = append(doc.Definitions, merged[name])
+	}
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatSchemaDocument(doc)
+	sg.sdl = buf.String()
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: "supergraph", Input: sg.sdl})
+	if err != nil {
+		return nil, fmt.Errorf("supergraph: %w", err)
+	}
+	sg.schema = schema
+	return sg, nil
+}
+
+func (sg *supergraph) own(typ, field, subgraph string) {
+	fs, ok := sg.owners[typ]
+	if !ok {
+		fs = make(map[string][]string)
+		sg.owners[typ] = fs
+	}
+	if !slices.Contains(fs[field], subgraph) {
+		fs[field] = append(fs[field], subgraph)
+	}
+}
+
+func (sg *supergraph) key(subgraph string, def *ast.Definition) error {
+	for _, d := range def.Directives.ForNames("key") {
+		if r := d.Arguments.ForName("resolvable"); r != nil && r.Value.Raw == "false" {
+			continue
+		}
+		a := d.Arguments.ForName("fields")
+		if a == nil {
+			return fmt.Errorf("%s: @key on %s has no fields", subgraph, def.Name)
+		}
+		fields := strings.Fields(a.Value.Raw)
+		if len(fields) == 0 || strings.ContainsAny(a.Value.Raw, "{}") {
+			return fmt.Errorf("%s: @key(fields: %q) on %s is not supported", subgraph, a.Value.Raw, def.Name)
+		}
+		ks, ok := sg.keys[def.Name]
+		if !ok {
+			ks = make(map[string][]string)
+			sg.keys[def.Name] = ks
+		}
+		if _, ok := ks[subgraph]; !ok {
+			ks[subgraph] = fields
+		}
+	}
+	return nil
+}
+
+// serves 子图 s 能否直接返回 typ.field
+func (sg *supergraph) serves(s, typ, field string) bool {
+	return field == "__typename" || slices.Contains(sg.owners[typ][field], s)
+}
+
+// owner 从哪个子图取 typ.field, 只有一个子图时不需要是实体
+func (sg *supergraph) owner(typ, field string) (string, bool) {
+	for _, s := range sg.owners[typ][field] {
+		if _, ok := sg.keys[typ][s]; ok {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+func root(name string) bool {
+	return name == "Query" || name == "Mutation" || name == "Subscription"
+}
+
+// keep 只保留标准的指令, federation 和 gqlgen 的指令只对子图有意义
+func keep(ds ast.DirectiveList) ast.DirectiveList {
+	var res ast.DirectiveList
+	for _, d := range ds {
+		if d.Name == "deprecated" || d.Name == "specifiedBy" {
+			res = append(res, d)
+		}
+	}
+	return res
+}
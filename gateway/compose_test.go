@@ -0,0 +1,72 @@
+package gateway
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestComposeSubgraphs(t *testing.T) {
+	sg, err := compose([]source{
+		{sg: Subgraph{Name: "archon"}, sdl: sdl(t, "archon")},
+		{sg: Subgraph{Name: "vigil"}, sdl: sdl(t, "vigil")},
+		{sg: Subgraph{Name: "chrgg"}, sdl: sdl(t, "chrgg")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	owners := map[string]string{
+		"deviceCode":    "archon",
+		"rate":          "archon",
+		"readings":      "vigil",
+		"latestReading": "vigil",
+		"cdrs":          "chrgg",
+		"bills":         "chrgg",
+	}
+	for f, want := range owners {
+		if got, ok := sg.owner("Device", f); !ok || got != want {
+			t.Errorf("Device.%s owner = %s %v, want %s", f, got, ok, want)
+		}
+	}
+	if fs := sg.owners["Device"]["deviceCode"]; !slices.Equal(fs, []string{"archon", "vigil", "chrgg"}) {
+		t.Errorf("Device.deviceCode owners = %v", fs)
+	}
+	for _, s := range []string{"archon", "vigil", "chrgg"} {
+		if k := sg.keys["Device"][s]; !slices.Equal(k, []string{"deviceCode"}) {
+			t.Errorf("Device key in %s = %v", s, k)
+		}
+	}
+
+	for _, f := range []string{"deviceQuery", "NhRecordBefore", "cdrQuery"} {
+		if sg.schema.Query.Fields.ForName(f) == nil {
+			t.Errorf("Query.%s missing", f)
+		}
+	}
+	if sg.schema.Mutation.Fields.ForName("deviceCreate") == nil {
+		t.Error("Mutation.deviceCreate missing")
+	}
+	// federation 的类型和指令不出现在合并后的 schema 中
+	for _, s := range []string{"_entities", "_Any", "@key", "@entityResolver"} {
+		if strings.Contains(sg.sdl, s) {
+			t.Errorf("supergraph sdl contains %s", s)
+		}
+	}
+}
+
+func TestComposeConflicts(t *testing.T) {
+	_, err := compose([]source{
+		{sg: Subgraph{Name: "a"}, sdl: "type Query { x: Foo } type Foo { id: ID }"},
+		{sg: Subgraph{Name: "b"}, sdl: "type Query { y: Foo } input Foo { id: ID }"},
+	})
+	if err == nil {
+		t.Fatal("kind conflict: want error")
+	}
+
+	_, err = compose([]source{
+		{sg: Subgraph{Name: "a"}, sdl: `type Query { x: Foo } type Foo @key(fields: "a { b }") { id: ID }`},
+	})
+	if err == nil {
+		t.Fatal("nested key: want error")
+	}
+}
@@ -0,0 +1,543 @@
+package gateway
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// field 合并后的字段, 同一个返回键的子选择合并在一起
+type field struct {
+	key  string
+	f    *ast.Field
+	sels ast.SelectionSet
+}
+
+// exec 一次请求的执行
+//
+// 先把根字段按子图分组, 每组发给提供它的子图, 只选择这个子图能返回的字段,
+// 需要其他子图的字段时附带实体的 key (别名为 _fed_ 开头);
+// 返回后再对这些对象用 _entities 向其他子图取剩下的字段, 逐层进行.
+// 最后按原始的选择输出, 顺序和请求一致, 附带的 key 不输出.
+type exec struct {
+	g  *Gateway
+	sg *supergraph
+	op *ast.OperationDefinition
+
+	vars map[string]any // 校验过的变量, 用于 @skip, @include 和内省
+	raw  map[string]any // 原始变量, 转发给子图
+
+	mu   sync.Mutex
+	errs []any
+}
+
+const (
+	keyPrefix = "_fed_"
+	repsVar   = "_fed_reps"
+)
+
+func (x *exec) fail(err error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	x.errs = append(x.errs, map[string]any{"message": err.Error()})
+}
+
+func (x *exec) errors(errs []map[string]any) {
+	if len(errs) == 0 {
+		return
+	}
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	for _, e := range errs {
+		x.errs = append(x.errs, e)
+	}
+}
+
+func (x *exec) skipped(ds ast.DirectiveList) bool {
+	if d := ds.ForName("skip"); d != nil {
+		if v, _ := d.ArgumentMap(x.vars)["if"].(bool); v {
+			return true
+		}
+	}
+	if d := ds.ForName("include"); d != nil {
+		if v, _ := d.ArgumentMap(x.vars)["if"].(bool); !v {
+			return true
+		}
+	}
+	return false
+}
+
+func (x *exec) applies(typ *ast.Definition, cond string) bool {
+	if cond == "" || cond == typ.Name {
+		return true
+	}
+	if c := x.sg.schema.Types[cond]; c != nil && c.IsAbstractType() {
+		for _, p := range x.sg.schema.GetPossibleTypes(c) {
+			if p.Name == typ.Name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// collect 展开片段, 按返回键合并字段
+func (x *exec) collect(typ *ast.Definition, sels ast.SelectionSet) []*field {
+	var fields []*field
+	idx := make(map[string]*field)
+
+	var walk func(ast.SelectionSet)
+	walk = func(sels ast.SelectionSet) {
+		for _, sel := range sels {
+			switch s := sel.(type) {
+			case *ast.Field:
+				if x.skipped(s.Directives) {
+					continue
+				}
+				key := cmp.Or(s.Alias, s.Name)
+				if f, ok := idx[key]; ok {
+					f.sels = append(f.sels, s.SelectionSet...)
+					continue
+				}
+				f := &field{key: key, f: s, sels: s.SelectionSet}
+				idx[key] = f
+				fields = append(fields, f)
+			case *ast.InlineFragment:
+				if !x.skipped(s.Directives) && x.applies(typ, s.TypeCondition) {
+					walk(s.SelectionSet)
+				}
+			case *ast.FragmentSpread:
+				if !x.skipped(s.Directives) && s.Definition != nil && x.applies(typ, s.Definition.TypeCondition) {
+					walk(s.Definition.SelectionSet)
+				}
+			}
+		}
+	}
+	walk(sels)
+	return fields
+}
+
+func (x *exec) typeOf(f *field) *ast.Definition {
+	return x.sg.schema.Types[f.f.Definition.Type.Name()]
+}
+
+// query 发给子图的查询, 记录用到的变量
+type query struct {
+	strings.Builder
+	vars map[string]bool
+}
+
+func (q *query) args(args ast.ArgumentList) {
+	if len(args) == 0 {
+		return
+	}
+	q.WriteByte('(')
+	for i, a := range args {
+		if i > 0 {
+			q.WriteString(", ")
+		}
+		q.WriteString(a.Name)
+		q.WriteString(": ")
+		q.WriteString(a.Value.String())
+		q.uses(a.Value)
+	}
+	q.WriteByte(')')
+}
+
+func (q *query) uses(v *ast.Value) {
+	if v == nil {
+		return
+	}
+	if v.Kind == ast.Variable {
+		if q.vars == nil {
+			q.vars = make(map[string]bool)
+		}
+		q.vars[v.Raw] = true
+	}
+	for _, c := range v.Children {
+		q.uses(c.Value)
+	}
+}
+
+// verbatim 抽象类型不拆分, 原样发给子图
+func (x *exec) verbatim(q *query, sels ast.SelectionSet) {
+	for _, sel := range sels {
+		switch s := sel.(type) {
+		case *ast.Field:
+			if x.skipped(s.Directives) {
+				continue
+			}
+			if s.Alias != "" && s.Alias != s.Name {
+				q.WriteString(s.Alias)
+				q.WriteString(": ")
+			}
+			q.WriteString(s.Name)
+			q.args(s.Arguments)
+			if len(s.SelectionSet) > 0 {
+				q.WriteString(" { ")
+				x.verbatim(q, s.SelectionSet)
+				q.WriteString("}")
+			}
+			q.WriteByte(' ')
+		case *ast.InlineFragment:
+			if x.skipped(s.Directives) {
+				continue
+			}
+			q.WriteString("... ")
+			if s.TypeCondition != "" {
+				q.WriteString("on " + s.TypeCondition + " ")
+			}
+			q.WriteString("{ ")
+			x.verbatim(q, s.SelectionSet)
+			q.WriteString("} ")
+		case *ast.FragmentSpread:
+			if x.skipped(s.Directives) || s.Definition == nil {
+				continue
+			}
+			q.WriteString("... on " + s.Definition.TypeCondition + " { ")
+			x.verbatim(q, s.Definition.SelectionSet)
+			q.WriteString("} ")
+		}
+	}
+}
+
+// selection 子图 s 在 typ 上能返回的字段, 以及其他子图需要的 key
+func (x *exec) selection(q *query, s string, typ *ast.Definition, fields []*field) error {
+	var (
+		others []string
+		keys   = make(map[string]bool)
+		empty  = true
+	)
+	for _, f := range fields {
+		if !x.sg.serves(s, typ.Name, f.f.Name) {
+			t, ok := x.sg.owner(typ.Name, f.f.Name)
+			if !ok {
+				return fmt.Errorf("%s.%s can not be resolved from %s", typ.Name, f.f.Name, s)
+			}
+			others = append(others, t)
+			continue
+		}
+
+		empty = false
+		if f.key != f.f.Name {
+			q.WriteString(f.key)
+			q.WriteString(": ")
+		}
+		q.WriteString(f.f.Name)
+		q.args(f.f.Arguments)
+		if len(f.sels) > 0 {
+			ct := x.typeOf(f)
+			q.WriteString(" { ")
+			if ct.IsAbstractType() {
+				x.verbatim(q, f.sels)
+			} else if err := x.selection(q, s, ct, x.collect(ct, f.sels)); err != nil {
+				return err
+			}
+			q.WriteString("}")
+		}
+		q.WriteByte(' ')
+	}
+
+	for _, t := range others {
+		for _, k := range x.sg.keys[typ.Name][t] {
+			if keys[k] {
+				continue
+			}
+			if !x.sg.serves(s, typ.Name, k) {
+				return fmt.Errorf("%s.%s: %s has no key field %s", typ.Name, t, s, k)
+			}
+			keys[k] = true
+			empty = false
+			q.WriteString(keyPrefix + k + ": " + k + " ")
+		}
+	}
+
+	if empty {
+		q.WriteString("__typename ")
+	}
+	return nil
+}
+
+// header 操作和变量定义, extra 为附加的变量
+func (x *exec) header(op string, used map[string]bool, extra string) (string, map[string]any) {
+	var defs []string
+	if extra != "" {
+		defs = append(defs, extra)
+	}
+	vars := make(map[string]any)
+	for _, v := range x.op.VariableDefinitions {
+		if !used[v.Variable] {
+			continue
+		}
+		d := "$" + v.Variable + ": " + v.Type.String()
+		if v.DefaultValue != nil {
+			d += " = " + v.DefaultValue.String()
+		}
+		defs = append(defs, d)
+		if val, ok := x.raw[v.Variable]; ok {
+			vars[v.Variable] = val
+		}
+	}
+	if len(defs) == 0 {
+		return op, vars
+	}
+	return op + "(" + strings.Join(defs, ", ") + ")", vars
+}
+
+// root 根字段中由子图 s 提供的部分
+func (x *exec) root(ctx context.Context, s string, typ *ast.Definition, fields []*field) map[string]any {
+	var q query
+	if err := x.selection(&q, s, typ, fields); err != nil {
+		x.fail(err)
+		return nil
+	}
+	head, vars := x.header(string(x.op.Operation), q.vars, "")
+
+	res, err := x.g.post(ctx, x.sg.subgraphs[s], head+" { "+q.String()+"}", vars)
+	if err != nil {
+		x.fail(err)
+		return nil
+	}
+	x.errors(res.Errors)
+	if res.Data != nil {
+		x.rest(ctx, s, typ, fields, []map[string]any{res.Data})
+	}
+	return res.Data
+}
+
+// rest 子图 s 已经返回了 objs, 向其他子图取剩下的字段
+func (x *exec) rest(ctx context.Context, s string, typ *ast.Definition, fields []*field, objs []map[string]any) {
+	var order []string
+	others := make(map[string][]*field)
+
+	for _, f := range fields {
+		if x.sg.serves(s, typ.Name, f.f.Name) {
+			if len(f.sels) == 0 {
+				continue
+			}
+			ct := x.typeOf(f)
+			if ct.IsAbstractType() {
+				continue
+			}
+			var children []map[string]any
+			for _, o := range objs {
+				flatten(o[f.key], &children)
+			}
+			if len(children) > 0 {
+				x.rest(ctx, s, ct, x.collect(ct, f.sels), children)
+			}
+			continue
+		}
+
+		t, _ := x.sg.owner(typ.Name, f.f.Name)
+		if _, ok := others[t]; !ok {
+			order = append(order, t)
+		}
+		others[t] = append(others[t], f)
+	}
+
+	for _, t := range order {
+		x.entities(ctx, t, typ, others[t], objs)
+	}
+}
+
+// entities 按 key 向子图 t 批量取实体的字段
+func (x *exec) entities(ctx context.Context, t string, typ *ast.Definition, fields []*field, objs []map[string]any) {
+	keys := x.sg.keys[typ.Name][t]
+
+	var (
+		reps    []any
+		targets []map[string]any
+	)
+	for _, o := range objs {
+		rep := map[string]any{"__typename": typ.Name}
+		ok := true
+		for _, k := range keys {
+			v := o[keyPrefix+k]
+			if v == nil {
+				ok = false
+				break
+			}
+			rep[k] = v
+		}
+		if ok {
+			reps = append(reps, rep)
+			targets = append(targets, o)
+		}
+	}
+	if len(reps) == 0 {
+		return
+	}
+
+	var q query
+	if err := x.selection(&q, t, typ, fields); err != nil {
+		x.fail(err)
+		return
+	}
+	head, vars := x.header("query", q.vars, "$"+repsVar+": [_Any!]!")
+	vars[repsVar] = reps
+
+	body := fmt.Sprintf("%s { _entities(representations: $%s) { ... on %s { %s} } }", head, repsVar, typ.Name, q.String())
+	res, err := x.g.post(ctx, x.sg.subgraphs[t], body, vars)
+	if err != nil {
+		x.fail(err)
+		return
+	}
+	x.errors(res.Errors)
+
+	list, _ := res.Data["_entities"].([]any)
+	var got []map[string]any
+	for i, o := range targets {
+		if i >= len(list) {
+			break
+		}
+		if m, ok := list[i].(map[string]any); ok {
+			maps.Copy(o, m)
+			got = append(got, o)
+		}
+	}
+	x.rest(ctx, t, typ, fields, got)
+}
+
+func flatten(v any, out *[]map[string]any) {
+	switch v := v.(type) {
+	case map[string]any:
+		*out = append(*out, v)
+	case []any:
+		for _, e := range v {
+			flatten(e, out)
+		}
+	}
+}
+
+// run 执行操作, query 的各个子图并行, mutation 按字段顺序串行
+func (x *exec) run(ctx context.Context) map[string]any {
+	typ := x.sg.schema.Query
+	if x.op.Operation == ast.Mutation {
+		typ = x.sg.schema.Mutation
+	}
+	fields := x.collect(typ, x.op.SelectionSet)
+
+	data := make(map[string]any)
+	type group struct {
+		s      string
+		fields []*field
+	}
+	var groups []*group
+	for _, f := range fields {
+		if v, ok := x.local(typ, f); ok {
+			data[f.key] = v
+			continue
+		}
+		s := x.sg.owners[typ.Name][f.f.Name][0]
+		if x.op.Operation == ast.Mutation {
+			if n := len(groups); n > 0 && groups[n-1].s == s {
+				groups[n-1].fields = append(groups[n-1].fields, f)
+			} else {
+				groups = append(groups, &group{s: s, fields: []*field{f}})
+			}
+			continue
+		}
+		if i := indexOf(groups, func(g *group) bool { return g.s == s }); i >= 0 {
+			groups[i].fields = append(groups[i].fields, f)
+		} else {
+			groups = append(groups, &group{s: s, fields: []*field{f}})
+		}
+	}
+
+	results := make([]map[string]any, len(groups))
+	if x.op.Operation == ast.Mutation {
+		for i, g := range groups {
+			results[i] = x.root(ctx, g.s, typ, g.fields)
+		}
+	} else {
+		var wg sync.WaitGroup
+		for i, g := range groups {
+			wg.Go(func() { results[i] = x.root(ctx, g.s, typ, g.fields) })
+		}
+		wg.Wait()
+	}
+	for _, r := range results {
+		maps.Copy(data, r)
+	}
+	return data
+}
+
+func indexOf[T any](s []T, f func(T) bool) int {
+	for i, v := range s {
+		if f(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// write 按请求的选择输出, 附带的 key 不输出
+func (x *exec) write(b *bytes.Buffer, typ *ast.Definition, fields []*field, v any) {
+	m, ok := v.(map[string]any)
+	if !ok {
+		b.WriteString("null")
+		return
+	}
+	b.WriteByte('{')
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		marshal(b, f.key)
+		b.WriteByte(':')
+
+		val, has := m[f.key]
+		if f.f.Name == "__typename" && !has {
+			val = typ.Name
+		}
+		if fn, ok := val.(func(map[string]any) any); ok {
+			val = fn(f.f.ArgumentMap(x.vars))
+		}
+		x.value(b, f, val)
+	}
+	b.WriteByte('}')
+}
+
+func (x *exec) value(b *bytes.Buffer, f *field, v any) {
+	if len(f.sels) == 0 {
+		marshal(b, v)
+		return
+	}
+	ct := x.typeOf(f)
+	switch v := v.(type) {
+	case []any:
+		b.WriteByte('[')
+		for i, e := range v {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			x.value(b, f, e)
+		}
+		b.WriteByte(']')
+	case map[string]any:
+		if ct.IsAbstractType() {
+			marshal(b, v)
+			return
+		}
+		x.write(b, ct, x.collect(ct, f.sels), v)
+	default:
+		marshal(b, v)
+	}
+}
+
+func marshal(b *bytes.Buffer, v any) {
+	bs, err := json.Marshal(v)
+	if err != nil {
+		b.WriteString("null")
+		return
+	}
+	b.Write(bs)
+}
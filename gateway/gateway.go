@@ -0,0 +1,171 @@
+// Package gateway 组合 archon, vigil, chrgg 的 federation 子图, 对外提供一个 GraphQL 入口
+//
+// 只实现 h2o 需要的部分: 单字段的 @key, 对象类型按字段拆分到子图, 抽象类型整体发给一个子图.
+// 子图的 schema 通过 _service { sdl } 获取, 定期刷新.
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"github.com/vektah/gqlparser/v2/validator"
+)
+
+var ErrNotLoaded = errors.New("supergraph not loaded")
+
+type Gateway struct {
+	// Subgraphs 同一个字段多个子图都提供时, 按这里的顺序取第一个
+	Subgraphs []Subgraph
+
+	Client *http.Client
+	Logger *slog.Logger
+
+	// Token 网关自己访问子图时使用, 如拉取 schema; 转发的请求使用调用方的认证
+	Token string
+
+	sg atomic.Pointer[supergraph]
+}
+
+func New(subgraphs []Subgraph) *Gateway {
+	return &Gateway{
+		Subgraphs: subgraphs,
+		Client:    &http.Client{Timeout: 30 * time.Second},
+		Logger:    slog.Default(),
+	}
+}
+
+// Load 拉取全部子图的 schema 并合并, 任何一个失败都保留原来的
+func (g *Gateway) Load(ctx context.Context) error {
+	srcs := make([]source, len(g.Subgraphs))
+	errs := make([]error, len(g.Subgraphs))
+
+	var wg sync.WaitGroup
+	for i, s := range g.Subgraphs {
+		wg.Go(func() {
+			sdl, err := g.sdl(ctx, s)
+			srcs[i] = source{sg: s, sdl: sdl}
+			errs[i] = err
+		})
+	}
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	sg, err := compose(srcs)
+	if err != nil {
+		return err
+	}
+	g.sg.Store(sg)
+	return nil
+}
+
+func (g *Gateway) sdl(ctx context.Context, s Subgraph) (string, error) {
+	res, err := g.post(ctx, s, "{ _service { sdl } }", nil)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Errors) > 0 {
+		return "", fmt.Errorf("%s: %v", s.Name, res.Errors[0]["message"])
+	}
+	svc, _ := res.Data["_service"].(map[string]any)
+	sdl, _ := svc["sdl"].(string)
+	if sdl == "" {
+		return "", fmt.Errorf("%s: empty sdl", s.Name)
+	}
+	return sdl, nil
+}
+
+// Run 定期刷新, 子图升级后不需要重启网关
+func (g *Gateway) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := g.Load(ctx); err != nil {
+				g.Logger.ErrorContext(ctx, "supergraph reload error", slog.Any("error", err))
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// SDL 合并后的 schema
+func (g *Gateway) SDL() string {
+	if sg := g.sg.Load(); sg != nil {
+		return sg.sdl
+	}
+	return ""
+}
+
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// Execute 返回完整的 GraphQL 响应
+func (g *Gateway) Execute(ctx context.Context, req Request) []byte {
+	sg := g.sg.Load()
+	if sg == nil {
+		return failure(ErrNotLoaded)
+	}
+
+	doc, gerrs := gqlparser.LoadQuery(sg.schema, req.Query)
+	if len(gerrs) > 0 {
+		return failure(gerrs)
+	}
+	op := doc.Operations.ForName(req.OperationName)
+	if op == nil {
+		return failure(fmt.Errorf("operation %q not found", req.OperationName))
+	}
+	if op.Operation == ast.Subscription {
+		return failure(errors.New("subscription is not supported"))
+	}
+	vars, gerr := validator.VariableValues(sg.schema, op, req.Variables)
+	if gerr != nil {
+		return failure(gerr)
+	}
+
+	x := &exec{g: g, sg: sg, op: op, vars: vars, raw: req.Variables}
+	data := x.run(ctx)
+
+	typ := sg.schema.Query
+	if op.Operation == ast.Mutation {
+		typ = sg.schema.Mutation
+	}
+
+	var b bytes.Buffer
+	b.WriteString(`{"data":`)
+	x.write(&b, typ, x.collect(typ, op.SelectionSet), data)
+	if len(x.errs) > 0 {
+		b.WriteString(`,"errors":`)
+		marshal(&b, x.errs)
+	}
+	b.WriteByte('}')
+	return b.Bytes()
+}
+
+func failure(err error) []byte {
+	var list gqlerror.List
+	if !errors.As(err, &list) {
+		list = gqlerror.List{gqlerror.WrapIfUnwrapped(err)}
+	}
+	var b bytes.Buffer
+	b.WriteString(`{"errors":`)
+	marshal(&b, list)
+	b.WriteByte('}')
+	return b.Bytes()
+}
@@ -0,0 +1,348 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// sdl 子图实际的 schema 文件, 和 _service { sdl } 返回的一致
+func sdl(t *testing.T, service string) string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("..", service, "gql", "graph", "schema", "*.graphqls"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("%s schema not found: %v", service, err)
+	}
+	var b strings.Builder
+	for _, f := range files {
+		bs, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Write(bs)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// call 子图收到的一次请求
+type call struct {
+	subgraph string
+	query    string
+	vars     map[string]any
+}
+
+// fake 模拟的子图, resolve 按收到的查询返回 data
+type fake struct {
+	name    string
+	sdl     string
+	resolve func(q string, vars map[string]any) any
+}
+
+// calls 按到达顺序记录全部子图的请求, 同时检查是否有并发
+type calls struct {
+	mu       sync.Mutex
+	list     []call
+	inflight int
+	overlap  bool
+}
+
+func (c *calls) enter(cl call) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.list = append(c.list, cl)
+	c.inflight++
+	if c.inflight > 1 {
+		c.overlap = true
+	}
+}
+
+func (c *calls) leave() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.inflight--
+}
+
+func (c *calls) of(subgraph string) []call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var res []call
+	for _, cl := range c.list {
+		if cl.subgraph == subgraph {
+			res = append(res, cl)
+		}
+	}
+	return res
+}
+
+func testGateway(t *testing.T, fakes ...fake) (*Gateway, *calls) {
+	t.Helper()
+	cs := &calls{}
+	var subgraphs []Subgraph
+	for _, f := range fakes {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var req request
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			var data any
+			if strings.Contains(req.Query, "_service") {
+				data = map[string]any{"_service": map[string]any{"sdl": f.sdl}}
+			} else {
+				cs.enter(call{subgraph: f.name, query: req.Query, vars: req.Variables})
+				defer cs.leave()
+				data = f.resolve(req.Query, req.Variables)
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+		}))
+		t.Cleanup(srv.Close)
+		subgraphs = append(subgraphs, Subgraph{Name: f.name, URL: srv.URL})
+	}
+
+	g := New(subgraphs)
+	if err := g.Load(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	return g, cs
+}
+
+// entities 按 representations 的顺序返回每个实体的字段
+func entities(vars map[string]any, fn func(code string) map[string]any) any {
+	reps, _ := vars[repsVar].([]any)
+	list := make([]any, len(reps))
+	for i, r := range reps {
+		code, _ := r.(map[string]any)["deviceCode"].(string)
+		list[i] = fn(code)
+	}
+	return map[string]any{"_entities": list}
+}
+
+func execute(t *testing.T, g *Gateway, query string, vars map[string]any) string {
+	t.Helper()
+	return string(g.Execute(context.Background(), Request{Query: query, Variables: vars}))
+}
+
+func TestExecuteEntitiesFanOut(t *testing.T) {
+	g, cs := testGateway(t,
+		fake{name: "archon", sdl: sdl(t, "archon"), resolve: func(q string, _ map[string]any) any {
+			return map[string]any{"deviceQuery": []any{
+				map[string]any{"deviceCode": "D1", "rate": 80, keyPrefix + "deviceCode": "D1"},
+				map[string]any{"deviceCode": "D2", "rate": 1, keyPrefix + "deviceCode": "D2"},
+			}}
+		}},
+		fake{name: "vigil", sdl: sdl(t, "vigil"), resolve: func(_ string, vars map[string]any) any {
+			return entities(vars, func(code string) map[string]any {
+				return map[string]any{"latestReading": map[string]any{"dataValue": len(code) * 100}}
+			})
+		}},
+		fake{name: "chrgg", sdl: sdl(t, "chrgg"), resolve: func(_ string, vars map[string]any) any {
+			return entities(vars, func(code string) map[string]any {
+				return map[string]any{"bills": []any{map[string]any{"feeFen": 12}}}
+			})
+		}},
+	)
+
+	got := execute(t, g, `{
+		deviceQuery(input: {project: "P1"}) {
+			deviceCode
+			latestReading { dataValue }
+			rate
+			bills(start: "2024-01-01T00:00:00Z", end: "2024-02-01T00:00:00Z") { feeFen }
+		}
+	}`, nil)
+	want := `{"data":{"deviceQuery":[` +
+		`{"deviceCode":"D1","latestReading":{"dataValue":200},"rate":80,"bills":[{"feeFen":12}]},` +
+		`{"deviceCode":"D2","latestReading":{"dataValue":200},"rate":1,"bills":[{"feeFen":12}]}]}}`
+	if got != want {
+		t.Fatalf("got %s\nwant %s", got, want)
+	}
+
+	a := cs.of("archon")
+	if len(a) != 1 || !strings.Contains(a[0].query, keyPrefix+"deviceCode: deviceCode") ||
+		strings.Contains(a[0].query, "latestReading") || strings.Contains(a[0].query, "bills") {
+		t.Fatalf("archon query = %v", a)
+	}
+	for _, s := range []string{"vigil", "chrgg"} {
+		cl := cs.of(s)
+		if len(cl) != 1 || !strings.Contains(cl[0].query, "_entities(representations: $"+repsVar+")") {
+			t.Fatalf("%s calls = %v, want one _entities", s, cl)
+		}
+		reps, _ := cl[0].vars[repsVar].([]any)
+		if len(reps) != 2 || reps[0].(map[string]any)["deviceCode"] != "D1" ||
+			reps[1].(map[string]any)["__typename"] != "Device" {
+			t.Fatalf("%s representations = %v", s, reps)
+		}
+	}
+	if c := cs.of("chrgg"); strings.Contains(c[0].query, "latestReading") {
+		t.Fatalf("chrgg query asks for vigil fields: %s", c[0].query)
+	}
+}
+
+func TestExecuteAliasesFragmentsSkip(t *testing.T) {
+	archon := func(q string, _ map[string]any) any {
+		return map[string]any{"list": []any{
+			map[string]any{"code": "D1", "deviceName": "meter", "rate": 2, keyPrefix + "deviceCode": "D1"},
+		}}
+	}
+	vigil := func(_ string, vars map[string]any) any {
+		return entities(vars, func(string) map[string]any {
+			return map[string]any{"latest": map[string]any{"dataValue": 7}}
+		})
+	}
+	query := `query($skip: Boolean!) {
+		list: deviceQuery(input: {project: "P1"}) {
+			code: deviceCode
+			...Names
+			latest: latestReading @skip(if: $skip) { dataValue }
+			... on Device { rate }
+		}
+	}
+	fragment Names on Device { deviceName }`
+
+	t.Run("skip", func(t *testing.T) {
+		g, cs := testGateway(t,
+			fake{name: "archon", sdl: sdl(t, "archon"), resolve: archon},
+			fake{name: "vigil", sdl: sdl(t, "vigil"), resolve: vigil},
+		)
+		got := execute(t, g, query, map[string]any{"skip": true})
+		want := `{"data":{"list":[{"code":"D1","deviceName":"meter","rate":2}]}}`
+		if got != want {
+			t.Fatalf("got %s\nwant %s", got, want)
+		}
+		a := cs.of("archon")
+		if len(a) != 1 || !strings.Contains(a[0].query, "list: deviceQuery") || !strings.Contains(a[0].query, "code: deviceCode") {
+			t.Fatalf("archon calls = %v", a)
+		}
+		// 跳过的字段不需要 key, 也不访问 vigil
+		if strings.Contains(a[0].query, keyPrefix) {
+			t.Fatalf("archon query asks for keys: %s", a[0].query)
+		}
+		if v := cs.of("vigil"); len(v) != 0 {
+			t.Fatalf("vigil calls = %v, want none", v)
+		}
+	})
+
+	t.Run("include", func(t *testing.T) {
+		g, cs := testGateway(t,
+			fake{name: "archon", sdl: sdl(t, "archon"), resolve: archon},
+			fake{name: "vigil", sdl: sdl(t, "vigil"), resolve: vigil},
+		)
+		got := execute(t, g, query, map[string]any{"skip": false})
+		want := `{"data":{"list":[{"code":"D1","deviceName":"meter","latest":{"dataValue":7},"rate":2}]}}`
+		if got != want {
+			t.Fatalf("got %s\nwant %s", got, want)
+		}
+		v := cs.of("vigil")
+		if len(v) != 1 || !strings.Contains(v[0].query, "latest: latestReading") {
+			t.Fatalf("vigil calls = %v", v)
+		}
+	})
+}
+
+func TestExecuteMutationSerial(t *testing.T) {
+	// vigil 没有 mutation, 测试时补一个, 验证跨子图的 mutation 按顺序执行
+	vigilSDL := sdl(t, "vigil") + "\nextend type Mutation { recordFix(deviceCode: String!): Int }\n"
+
+	g, cs := testGateway(t,
+		fake{name: "archon", sdl: sdl(t, "archon"), resolve: func(q string, _ map[string]any) any {
+			data := map[string]any{}
+			if strings.Contains(q, "deviceRemove") {
+				data["a"] = map[string]any{"deviceCode": "D1"}
+			}
+			if strings.Contains(q, "deviceRestore") {
+				data["b"] = map[string]any{"deviceCode": "D1"}
+			}
+			return data
+		}},
+		fake{name: "vigil", sdl: vigilSDL, resolve: func(string, map[string]any) any {
+			return map[string]any{"fix": 1}
+		}},
+	)
+
+	got := execute(t, g, `mutation {
+		a: deviceRemove(input: {id: "1"}) { deviceCode }
+		fix: recordFix(deviceCode: "D1")
+		b: deviceRestore(input: {id: "1"}) { deviceCode }
+	}`, nil)
+	want := `{"data":{"a":{"deviceCode":"D1"},"fix":1,"b":{"deviceCode":"D1"}}}`
+	if got != want {
+		t.Fatalf("got %s\nwant %s", got, want)
+	}
+
+	if cs.overlap {
+		t.Fatal("mutations ran concurrently")
+	}
+	if len(cs.list) != 3 {
+		t.Fatalf("calls = %v, want 3", cs.list)
+	}
+	for i, want := range []string{"deviceRemove", "recordFix", "deviceRestore"} {
+		if cl := cs.list[i]; !strings.HasPrefix(cl.query, "mutation") || !strings.Contains(cl.query, want) {
+			t.Fatalf("call %d = %s %s, want mutation %s", i, cl.subgraph, cl.query, want)
+		}
+	}
+}
+
+func TestExecuteMutationGroupsAdjacentFields(t *testing.T) {
+	g, cs := testGateway(t,
+		fake{name: "archon", sdl: sdl(t, "archon"), resolve: func(string, map[string]any) any {
+			return map[string]any{"a": map[string]any{"deviceCode": "D1"}, "b": map[string]any{"deviceCode": "D1"}}
+		}},
+	)
+	execute(t, g, `mutation {
+		a: deviceRemove(input: {id: "1"}) { deviceCode }
+		b: deviceRestore(input: {id: "1"}) { deviceCode }
+	}`, nil)
+	a := cs.of("archon")
+	if len(a) != 1 {
+		t.Fatalf("archon calls = %d, want 1", len(a))
+	}
+	// 同一个请求中子图按字段顺序串行执行
+	if i, j := strings.Index(a[0].query, "deviceRemove"), strings.Index(a[0].query, "deviceRestore"); i < 0 || j < i {
+		t.Fatalf("archon query = %s", a[0].query)
+	}
+}
+
+func TestExecuteIntrospection(t *testing.T) {
+	g, cs := testGateway(t,
+		fake{name: "archon", sdl: sdl(t, "archon")},
+		fake{name: "vigil", sdl: sdl(t, "vigil")},
+		fake{name: "chrgg", sdl: sdl(t, "chrgg")},
+	)
+	got := execute(t, g, `{ __typename t: __type(name: "Device") { name fields { name } } }`, nil)
+
+	var res struct {
+		Data struct {
+			Typename string `json:"__typename"`
+			T        struct {
+				Name   string
+				Fields []struct{ Name string }
+			}
+		}
+		Errors []any
+	}
+	if err := json.Unmarshal([]byte(got), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Errors) > 0 || res.Data.Typename != "Query" || res.Data.T.Name != "Device" {
+		t.Fatalf("got %s", got)
+	}
+	fields := map[string]bool{}
+	for _, f := range res.Data.T.Fields {
+		fields[f.Name] = true
+	}
+	for _, f := range []string{"deviceCode", "rate", "readings", "latestReading", "cdrs", "bills"} {
+		if !fields[f] {
+			t.Errorf("Device has no field %s", f)
+		}
+	}
+	if len(cs.list) != 0 {
+		t.Fatalf("introspection reached subgraphs: %v", cs.list)
+	}
+}
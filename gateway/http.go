@@ -0,0 +1,70 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Handler POST application/json 或者 GET ?query=, 请求头中的认证信息转发给子图
+// GET 只能查询, 不能修改, 避免通过链接或者跨站请求触发 mutation
+func Handler(g *Gateway) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req Request
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			req.Query = q.Get("query")
+			req.OperationName = q.Get("operationName")
+			if v := q.Get("variables"); v != "" {
+				if err := json.Unmarshal([]byte(v), &req.Variables); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+			if mutation(req) {
+				w.Header().Set("Allow", "POST")
+				http.Error(w, "mutation is not allowed over GET", http.StatusMethodNotAllowed)
+				return
+			}
+		case http.MethodPost:
+			dec := json.NewDecoder(r.Body)
+			dec.UseNumber()
+			if err := dec.Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+		case http.MethodOptions:
+			w.Header().Set("Allow", "GET, POST, OPTIONS")
+			w.WriteHeader(http.StatusNoContent)
+			return
+		default:
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		res := g.Execute(WithHeader(r.Context(), r.Header), req)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(res)
+	})
+}
+
+// mutation 语法错误的交给 Execute 报告
+func mutation(req Request) bool {
+	doc, err := parser.ParseQuery(&ast.Source{Input: req.Query})
+	if err != nil {
+		return false
+	}
+	op := doc.Operations.ForName(req.OperationName)
+	return op != nil && op.Operation == ast.Mutation
+}
+
+// SDLHandler 合并后的 schema, 用于生成前端的类型
+func SDLHandler(g *Gateway) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = w.Write([]byte(g.SDL()))
+	})
+}
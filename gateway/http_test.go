@@ -0,0 +1,75 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestHandlerRejectsMutationOverGet(t *testing.T) {
+	g, cs := testGateway(t,
+		fake{name: "archon", sdl: sdl(t, "archon"), resolve: func(string, map[string]any) any {
+			return map[string]any{"deviceRemove": map[string]any{"deviceCode": "D1"}}
+		}},
+	)
+	h := Handler(g)
+	mutation := `mutation { deviceRemove(input: {id: "1"}) { deviceCode } }`
+
+	get := func(q, op string) *httptest.ResponseRecorder {
+		v := url.Values{"query": {q}}
+		if op != "" {
+			v.Set("operationName", op)
+		}
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/?"+v.Encode(), nil))
+		return w
+	}
+
+	if w := get(mutation, ""); w.Code != http.StatusMethodNotAllowed || w.Header().Get("Allow") != "POST" {
+		t.Fatalf("GET mutation = %d %q, want 405", w.Code, w.Header().Get("Allow"))
+	}
+	// 多个操作时按 operationName 判断
+	doc := `query Q { __typename } ` + strings.Replace(mutation, "mutation", "mutation M", 1)
+	if w := get(doc, "M"); w.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("GET named mutation = %d, want 405", w.Code)
+	}
+	if w := get(doc, "Q"); w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"__typename":"Query"`) {
+		t.Fatalf("GET query = %d %s", w.Code, w.Body.String())
+	}
+	if len(cs.list) != 0 {
+		t.Fatalf("GET reached subgraphs: %v", cs.list)
+	}
+
+	w := httptest.NewRecorder()
+	body, _ := json.Marshal(Request{Query: mutation})
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body)))
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), `"deviceCode":"D1"`) {
+		t.Fatalf("POST mutation = %d %s", w.Code, w.Body.String())
+	}
+}
+
+func TestPostForwardsAuthorizationOnly(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"data":{}}`))
+	}))
+	defer srv.Close()
+
+	h := http.Header{}
+	h.Set("Authorization", "Bearer t1")
+	h.Set("X-H2o-Actor", "mallory")
+	h.Set("Cookie", "session=1")
+	g := New(nil)
+	if _, err := g.post(WithHeader(context.Background(), h), Subgraph{Name: "archon", URL: srv.URL}, "{ __typename }", nil); err != nil {
+		t.Fatal(err)
+	}
+	if got.Get("Authorization") != "Bearer t1" || got.Get("X-H2o-Actor") != "" || got.Get("Cookie") != "" {
+		t.Fatalf("forwarded %v, want Authorization only", got)
+	}
+}
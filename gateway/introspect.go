@@ -0,0 +1,239 @@
+package gateway
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// local 网关自己处理的根字段: __typename, __schema, __type
+func (x *exec) local(typ *ast.Definition, f *field) (any, bool) {
+	switch f.f.Name {
+	case "__typename":
+		return typ.Name, true
+	case "__schema":
+		return x.schemaValue(), true
+	case "__type":
+		name, _ := f.f.ArgumentMap(x.vars)["name"].(string)
+		if def := x.sg.schema.Types[name]; def != nil {
+			return x.typeValue(def), true
+		}
+		return nil, true
+	}
+	return nil, false
+}
+
+// 内省的结果用 map 表示, 有参数或者可能循环引用的字段用函数延迟计算
+type lazy = func(map[string]any) any
+
+func (x *exec) schemaValue() map[string]any {
+	s := x.sg.schema
+	ref := func(def *ast.Definition) any {
+		if def == nil {
+			return nil
+		}
+		return x.typeValue(def)
+	}
+	return map[string]any{
+		"__typename":       "__Schema",
+		"description":      nil,
+		"queryType":        ref(s.Query),
+		"mutationType":     ref(s.Mutation),
+		"subscriptionType": ref(s.Subscription),
+		"types": lazy(func(map[string]any) any {
+			var ts []any
+			for _, name := range sortedKeys(s.Types) {
+				ts = append(ts, x.typeValue(s.Types[name]))
+			}
+			return ts
+		}),
+		"directives": lazy(func(map[string]any) any {
+			var ds []any
+			for _, name := range sortedKeys(s.Directives) {
+				ds = append(ds, x.directiveValue(s.Directives[name]))
+			}
+			return ds
+		}),
+	}
+}
+
+func (x *exec) typeValue(def *ast.Definition) map[string]any {
+	v := map[string]any{
+		"__typename":     "__Type",
+		"kind":           string(def.Kind),
+		"name":           def.Name,
+		"description":    str(def.Description),
+		"specifiedByURL": nil,
+		"ofType":         nil,
+		"isOneOf":        false,
+		"fields":         nil,
+		"interfaces":     nil,
+		"possibleTypes":  nil,
+		"enumValues":     nil,
+		"inputFields":    nil,
+	}
+
+	switch def.Kind {
+	case ast.Object, ast.Interface:
+		v["fields"] = lazy(func(args map[string]any) any {
+			all, _ := args["includeDeprecated"].(bool)
+			fs := []any{}
+			for _, f := range def.Fields {
+				if strings.HasPrefix(f.Name, "__") {
+					continue
+				}
+				if dep := f.Directives.ForName("deprecated"); dep != nil && !all {
+					continue
+				}
+				fs = append(fs, x.fieldValue(f))
+			}
+			return fs
+		})
+		v["interfaces"] = lazy(func(map[string]any) any {
+			is := []any{}
+			for _, name := range def.Interfaces {
+				is = append(is, x.typeValue(x.sg.schema.Types[name]))
+			}
+			return is
+		})
+	case ast.Enum:
+		v["enumValues"] = lazy(func(args map[string]any) any {
+			all, _ := args["includeDeprecated"].(bool)
+			es := []any{}
+			for _, e := range def.EnumValues {
+				dep := e.Directives.ForName("deprecated")
+				if dep != nil && !all {
+					continue
+				}
+				es = append(es, map[string]any{
+					"__typename":        "__EnumValue",
+					"name":              e.Name,
+					"description":       str(e.Description),
+					"isDeprecated":      dep != nil,
+					"deprecationReason": reason(dep),
+				})
+			}
+			return es
+		})
+	case ast.InputObject:
+		v["inputFields"] = lazy(func(map[string]any) any {
+			fs := []any{}
+			for _, f := range def.Fields {
+				fs = append(fs, x.inputValue(f.Name, f.Description, f.Type, f.DefaultValue))
+			}
+			return fs
+		})
+	}
+
+	if def.IsAbstractType() {
+		v["possibleTypes"] = lazy(func(map[string]any) any {
+			ps := []any{}
+			for _, p := range x.sg.schema.GetPossibleTypes(def) {
+				ps = append(ps, x.typeValue(p))
+			}
+			return ps
+		})
+	}
+	return v
+}
+
+func (x *exec) typeRef(t *ast.Type) map[string]any {
+	switch {
+	case t.NonNull:
+		inner := *t
+		inner.NonNull = false
+		return wrap("NON_NULL", x.typeRef(&inner))
+	case t.Elem != nil:
+		return wrap("LIST", x.typeRef(t.Elem))
+	}
+	return x.typeValue(x.sg.schema.Types[t.NamedType])
+}
+
+func wrap(kind string, of map[string]any) map[string]any {
+	return map[string]any{
+		"__typename":  "__Type",
+		"kind":        kind,
+		"name":        nil,
+		"description": nil,
+		"ofType":      of,
+	}
+}
+
+func (x *exec) fieldValue(f *ast.FieldDefinition) map[string]any {
+	dep := f.Directives.ForName("deprecated")
+	return map[string]any{
+		"__typename":  "__Field",
+		"name":        f.Name,
+		"description": str(f.Description),
+		"args": lazy(func(map[string]any) any {
+			as := []any{}
+			for _, a := range f.Arguments {
+				as = append(as, x.inputValue(a.Name, a.Description, a.Type, a.DefaultValue))
+			}
+			return as
+		}),
+		"type":              lazy(func(map[string]any) any { return x.typeRef(f.Type) }),
+		"isDeprecated":      dep != nil,
+		"deprecationReason": reason(dep),
+	}
+}
+
+func (x *exec) inputValue(name, desc string, t *ast.Type, def *ast.Value) map[string]any {
+	var dv any
+	if def != nil {
+		dv = def.String()
+	}
+	return map[string]any{
+		"__typename":        "__InputValue",
+		"name":              name,
+		"description":       str(desc),
+		"type":              lazy(func(map[string]any) any { return x.typeRef(t) }),
+		"defaultValue":      dv,
+		"isDeprecated":      false,
+		"deprecationReason": nil,
+	}
+}
+
+func (x *exec) directiveValue(d *ast.DirectiveDefinition) map[string]any {
+	locs := make([]any, len(d.Locations))
+	for i, l := range d.Locations {
+		locs[i] = string(l)
+	}
+	return map[string]any{
+		"__typename":   "__Directive",
+		"name":         d.Name,
+		"description":  str(d.Description),
+		"locations":    locs,
+		"isRepeatable": d.IsRepeatable,
+		"args": lazy(func(map[string]any) any {
+			as := []any{}
+			for _, a := range d.Arguments {
+				as = append(as, x.inputValue(a.Name, a.Description, a.Type, a.DefaultValue))
+			}
+			return as
+		}),
+	}
+}
+
+func str(s string) any {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+func reason(dep *ast.Directive) any {
+	if dep == nil {
+		return nil
+	}
+	if a := dep.Arguments.ForName("reason"); a != nil {
+		return a.Value.Raw
+	}
+	return "No longer supported"
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
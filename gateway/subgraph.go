@@ -0,0 +1,71 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Subgraph 一个提供 federation 的 GraphQL 服务
+type Subgraph struct {
+	Name string `mapstructure:"name"`
+	URL  string `mapstructure:"url"`
+}
+
+type request struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables,omitempty"`
+}
+
+type response struct {
+	Data   map[string]any   `json:"data"`
+	Errors []map[string]any `json:"errors,omitempty"`
+}
+
+// 转发给子图的请求头, 操作人由子图从 Authorization 得出, 不转发 X-H2o-Actor
+var forwardHeaders = []string{"Authorization"}
+
+func (g *Gateway) post(ctx context.Context, sg Subgraph, q string, vars map[string]any) (*response, error) {
+	body, err := json.Marshal(request{Query: q, Variables: vars})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sg.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if h, ok := ctx.Value(headerKey{}).(http.Header); ok {
+		for _, k := range forwardHeaders {
+			if v := h.Get(k); v != "" {
+				req.Header.Set(k, v)
+			}
+		}
+	} else if g.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+g.Token)
+	}
+
+	resp, err := g.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", sg.Name, err)
+	}
+	defer resp.Body.Close()
+
+	// UseNumber 保留数字原样, Int64 的读数和金额不会因为 float64 丢失精度
+	var res response
+	dec := json.NewDecoder(resp.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("%s: %s: %w", sg.Name, resp.Status, err)
+	}
+	return &res, nil
+}
+
+type headerKey struct{}
+
+// WithHeader 请求头中需要转发给子图的部分, 如认证
+func WithHeader(ctx context.Context, h http.Header) context.Context {
+	return context.WithValue(ctx, headerKey{}, h)
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"log"
 	"log/slog"
+	"path/filepath"
 
 	"github.com/spf13/viper"
 	"github.com/twiglab/h2o/abm"
@@ -12,6 +13,8 @@ import (
 	"github.com/twiglab/h2o/clog"
 	"github.com/twiglab/h2o/clog/wal"
 	"github.com/twiglab/h2o/hank"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/registry"
 )
 
 func rootLog() *slog.Logger {
@@ -65,7 +68,68 @@ func nats() *hank.NatsAction {
 	return n
 }
 
+func kafka() *hank.KafkaAction {
+	brokers := viper.GetStringSlice("hank.sender.kafka.brokers")
+	if len(brokers) == 0 {
+		log.Fatalln("hank.sender.kafka.brokers is empty")
+	}
+	cli, err := hank.NewKafkaClient(brokers...)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return hank.NewKafkaAction(cli)
+}
+
+func fanout() *hank.FanOut {
+	uses := viper.GetStringSlice("hank.sender.fanout.use")
+	if len(uses) == 0 {
+		log.Fatalln("hank.sender.fanout.use is empty")
+	}
+	dir := cmp.Or(viper.GetString("hank.sender.fanout.dir"), "outbox")
+	max := cmp.Or(viper.GetInt("hank.sender.fanout.max"), 100000)
+
+	var relays []*hank.Relay
+	for _, use := range uses {
+		var s hank.Sender
+		switch use {
+		case "mqtt":
+			s = mqtt()
+		case "nats":
+			s = nats()
+		case "kafka":
+			s = kafka()
+		default:
+			log.Fatalln("unknown fanout sender:", use)
+		}
+
+		ob, err := hank.OpenOutbox(filepath.Join(dir, use), max)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Println("fanout", use, "outbox:", ob.Dir, "depth:", ob.Len())
+		relays = append(relays, hank.NewRelay(use, s, ob))
+	}
+
+	f := hank.NewFanOut(relays...)
+	f.Run(context.Background())
+	return f
+}
+
 func sender() hank.Sender {
+	s := useSender()
+	enc := viper.GetString("hank.sender.encoding")
+	if enc == "" {
+		return s
+	}
+	c, err := common.CodecOf(enc)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Println("sender encoding:", c.ContentType())
+	return hank.CodecSender{Codec: c, Sender: s}
+}
+
+func useSender() hank.Sender {
 	use := viper.GetString("hank.sender.use")
 	switch use {
 	case "mqtt":
@@ -74,6 +138,12 @@ func sender() hank.Sender {
 	case "nats":
 		log.Println("using nats")
 		return nats()
+	case "kafka":
+		log.Println("using kafka")
+		return kafka()
+	case "fanout":
+		log.Println("using fanout")
+		return fanout()
 	}
 	log.Println("using logAction")
 	return hank.LogAction{}
@@ -110,12 +180,28 @@ func simple() hank.SimpleMD {
 	return hank.SimpleMD{Project: proj}
 }
 
+func archon() hank.RegistryMD {
+	url := viper.GetString("hank.meta.archon.url")
+	if url == "" {
+		log.Fatal("hank.meta.archon.url is empty")
+	}
+	m := registry.NewMirror(url)
+	m.Token = viper.GetString("hank.meta.archon.token")
+	if err := m.Load(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+	go m.Run(context.Background())
+	return hank.RegistryMD{Mirror: m}
+}
+
 func backend() cache.Cache[string, hank.MetaData] {
 	var backend cache.Cache[string, hank.MetaData]
 	b := viper.GetString("hank.meta.backend")
 	switch b {
 	case "ddb":
 		backend, _ = ddb()
+	case "archon":
+		backend = archon()
 	default:
 		backend = simple()
 	}
@@ -135,3 +221,11 @@ func playback() *hank.PlayBack {
 	log.Println("playback file:", logF)
 	return hank.NewPlayBack(logF)
 }
+
+func fanoutOf(s hank.Sender) (*hank.FanOut, bool) {
+	if c, ok := s.(hank.CodecSender); ok {
+		s = c.Sender
+	}
+	f, ok := s.(*hank.FanOut)
+	return f, ok
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	_ "net/http/pprof"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/twiglab/h2o/hank"
 
 	"github.com/spf13/cobra"
@@ -44,6 +45,13 @@ func run() error {
 		PlayBack: playback(),
 	}
 
+	if f, ok := fanoutOf(s.Hub.Sender); ok {
+		http.Handle("/sender/stats", f)
+	}
+	http.Handle("/metrics", promhttp.Handler())
+	http.Handle("/healthz", hank.HealthHandler(s))
+	http.Handle("/admin/gateways", hank.GatewaysHandler(s))
+
 	go http.ListenAndServe(viper.GetString("hank.web.addr"), nil)
 
 	return s.Run()
@@ -19,7 +19,7 @@ func (e *Enh) ToWater(dd DeviceData) (WaterMeter, error) {
 		return WaterMeter{}, err
 	}
 
-	meta, _, _ := e.Cache.Get(context.Background(), dd.No)
+	meta := e.meta(dd.No)
 	t, ts := parseTime(dd.DataTime)
 
 	return WaterMeter{
@@ -53,7 +53,7 @@ func (e *Enh) ToElecty(dd DeviceData) (ElectricityMeter, error) {
 		return ElectricityMeter{}, err
 	}
 
-	meta, _, _ := e.Cache.Get(context.Background(), dd.No)
+	meta := e.meta(dd.No)
 	t, ts := parseTime(dd.DataTime)
 
 	return ElectricityMeter{
@@ -81,6 +81,12 @@ func (e *Enh) ToElecty(dd DeviceData) (ElectricityMeter, error) {
 	}, nil
 }
 
+func (e *Enh) meta(code string) MetaData {
+	meta, ok, err := e.Cache.Get(context.Background(), code)
+	metaLookups.WithLabelValues(metaResult(ok, err)).Inc()
+	return meta
+}
+
 func (e *Enh) ToGas(dd DeviceData) (gm GasMeter, err error) {
 	return
 }
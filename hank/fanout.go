@@ -0,0 +1,209 @@
+package hank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+const (
+	minRetry = time.Second
+	maxRetry = time.Minute
+)
+
+// RawObject 已经序列化好的数据, 从 Outbox 中取出后原样发送
+type RawObject = common.RawObject
+
+type RelayStats struct {
+	Name string `json:"name"`
+
+	Enqueued uint64 `json:"enqueued"`
+	Sent     uint64 `json:"sent"`
+	Failed   uint64 `json:"failed"`
+	Dropped  uint64 `json:"dropped"`
+	Depth    int    `json:"depth"`
+
+	LastSent      time.Time `json:"last_sent,omitzero"`
+	LastLatencyMs int64     `json:"last_latency_ms"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// Relay 一个发送后端, 数据先进入自己的 Outbox, 再由后台投递
+type Relay struct {
+	Name   string
+	Sender Sender
+	Outbox *Outbox
+
+	Logger *slog.Logger
+
+	enqueued atomic.Uint64
+	sent     atomic.Uint64
+	failed   atomic.Uint64
+
+	mu          sync.Mutex
+	lastSent    time.Time
+	lastLatency time.Duration
+	lastErr     error
+}
+
+func NewRelay(name string, sender Sender, outbox *Outbox) *Relay {
+	return &Relay{
+		Name:   name,
+		Sender: sender,
+		Outbox: outbox,
+		Logger: slog.Default(),
+	}
+}
+
+func (r *Relay) enqueue(topic string, payload []byte) error {
+	if err := r.Outbox.Put(topic, payload); err != nil {
+		return err
+	}
+	r.enqueued.Add(1)
+	relayDepth.WithLabelValues(r.Name).Set(float64(r.Outbox.Len()))
+	return nil
+}
+
+func (r *Relay) deliver(ctx context.Context) error {
+	seq, topic, payload, err := r.Outbox.Peek()
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	err = r.Sender.SendData(ctx, RawObject{T: topic, Data: payload})
+	latency := time.Since(start)
+
+	r.mu.Lock()
+	r.lastLatency = latency
+	r.lastErr = err
+	if err == nil {
+		r.lastSent = time.Now()
+	}
+	r.mu.Unlock()
+
+	if err != nil {
+		r.failed.Add(1)
+		relayFailures.WithLabelValues(r.Name).Inc()
+		return err
+	}
+
+	r.sent.Add(1)
+	relayDelivered.WithLabelValues(r.Name).Inc()
+	err = r.Outbox.Remove(seq)
+	relayDepth.WithLabelValues(r.Name).Set(float64(r.Outbox.Len()))
+	return err
+}
+
+func (r *Relay) Run(ctx context.Context) {
+	retry := minRetry
+	for {
+		err := r.deliver(ctx)
+		switch {
+		case err == nil:
+			retry = minRetry
+			continue
+		case errors.Is(err, ErrOutboxEmpty):
+			select {
+			case <-r.Outbox.Notify():
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		r.Logger.ErrorContext(ctx, "relay deliver error",
+			slog.String("relay", r.Name),
+			slog.Int("depth", r.Outbox.Len()),
+			slog.Duration("retry", retry),
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-time.After(retry):
+			retry = min(retry*2, maxRetry)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Relay) Stats() RelayStats {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	s := RelayStats{
+		Name:          r.Name,
+		Enqueued:      r.enqueued.Load(),
+		Sent:          r.sent.Load(),
+		Failed:        r.failed.Load(),
+		Dropped:       r.Outbox.Dropped(),
+		Depth:         r.Outbox.Len(),
+		LastSent:      r.lastSent,
+		LastLatencyMs: r.lastLatency.Milliseconds(),
+	}
+	if r.lastErr != nil {
+		s.LastError = r.lastErr.Error()
+	}
+	return s
+}
+
+// FanOut 把数据同时发送到多个后端, 每个后端独立缓存和重试
+type FanOut struct {
+	Relays []*Relay
+}
+
+func NewFanOut(relays ...*Relay) *FanOut {
+	return &FanOut{Relays: relays}
+}
+
+func (f *FanOut) Run(ctx context.Context) {
+	for _, r := range f.Relays {
+		go r.Run(ctx)
+	}
+}
+
+// SendData 只负责写入各后端的 Outbox, 全部写入失败才算失败
+func (f *FanOut) SendData(ctx context.Context, obj SendObject) error {
+	bs, err := obj.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	topic := obj.Topic()
+
+	var errs []error
+	for _, r := range f.Relays {
+		if err := r.enqueue(topic, bs); err != nil {
+			errs = append(errs, fmt.Errorf("relay %s: %w", r.Name, err))
+		}
+	}
+
+	if len(errs) == len(f.Relays) {
+		return errors.Join(errs...)
+	}
+	for _, err := range errs {
+		slog.ErrorContext(ctx, "fanout enqueue error", slog.Any("error", err))
+	}
+	return nil
+}
+
+func (f *FanOut) Stats() []RelayStats {
+	ss := make([]RelayStats, 0, len(f.Relays))
+	for _, r := range f.Relays {
+		ss = append(ss, r.Stats())
+	}
+	return ss
+}
+
+func (f *FanOut) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = writeReturn(w, f.Stats())
+}
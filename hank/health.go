@@ -0,0 +1,102 @@
+package hank
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Checker 可选接口, Sender 和元数据后端实现后参与健康检查
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+func check(ctx context.Context, v any) error {
+	if c, ok := v.(Checker); ok {
+		return c.Check(ctx)
+	}
+	return nil
+}
+
+func (c *MQTTAction) Check(_ context.Context) error {
+	if !c.client.IsConnectionOpen() {
+		return errors.New("mqtt not connected")
+	}
+	return nil
+}
+
+func (c *NatsAction) Check(_ context.Context) error {
+	if s := c.js.Conn().Status(); s != nats.CONNECTED {
+		return fmt.Errorf("nats %s", s)
+	}
+	return nil
+}
+
+func (c *KafkaAction) Check(ctx context.Context) error {
+	return c.cli.Ping(ctx)
+}
+
+// Check fanout 只要有一个后端可用就能继续接收数据, 这里仍然报告所有不可用的后端
+func (f *FanOut) Check(ctx context.Context) error {
+	var errs []error
+	for _, r := range f.Relays {
+		if err := check(ctx, r.Sender); err != nil {
+			errs = append(errs, fmt.Errorf("relay %s: %w", r.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (e *Enh) Check(ctx context.Context) error {
+	return check(ctx, e.Cache)
+}
+
+type HealthStatus struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks"`
+}
+
+func (s *Server) Health(ctx context.Context) (HealthStatus, bool) {
+	checks := map[string]error{
+		"sender": check(ctx, s.Hub.Sender),
+		"meta":   s.Enh.Check(ctx),
+	}
+
+	hs := HealthStatus{Status: "ok", Checks: map[string]string{}}
+	healthy := true
+	for name, err := range checks {
+		if err != nil {
+			healthy = false
+			hs.Status = "fail"
+			hs.Checks[name] = err.Error()
+			continue
+		}
+		hs.Checks[name] = "ok"
+	}
+	return hs, healthy
+}
+
+func HealthHandler(s *Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), 3*time.Second)
+		defer cancel()
+
+		hs, ok := s.Health(ctx)
+		w.Header().Set("Content-Type", "application/json")
+		if !ok {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = writeReturn(w, hs)
+	})
+}
+
+func GatewaysHandler(s *Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = writeReturn(w, s.Gateways())
+	})
+}
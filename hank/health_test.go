@@ -0,0 +1,152 @@
+package hank
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloudwego/netpoll"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/twiglab/h2o/cache"
+	"github.com/twiglab/h2o/pkg/common"
+)
+
+type checkSender struct {
+	err error
+}
+
+func (c checkSender) SendData(context.Context, common.SendObject) error { return nil }
+func (c checkSender) Check(context.Context) error                       { return c.err }
+
+type checkCache struct {
+	cache.Cache[string, MetaData]
+	err error
+}
+
+func (c checkCache) Check(context.Context) error { return c.err }
+
+func TestHealthHandler(t *testing.T) {
+	down := errors.New("down")
+	tests := []struct {
+		name   string
+		sender Sender
+		meta   error
+		code   int
+		checks map[string]string
+	}{
+		{"正常", checkSender{}, nil, http.StatusOK, map[string]string{"sender": "ok", "meta": "ok"}},
+		{"发送失败", checkSender{err: down}, nil, http.StatusServiceUnavailable, map[string]string{"sender": "down", "meta": "ok"}},
+		{"元数据失败", checkSender{}, down, http.StatusServiceUnavailable, map[string]string{"sender": "ok", "meta": "down"}},
+		// fanout 报告每个不可用的后端
+		{"fanout", &FanOut{Relays: []*Relay{{Name: "nats", Sender: checkSender{err: down}}, {Name: "kafka", Sender: checkSender{}}}},
+			nil, http.StatusServiceUnavailable, map[string]string{"sender": "relay nats: down", "meta": "ok"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{Hub: &Hub{Sender: tt.sender}, Enh: &Enh{Cache: checkCache{err: tt.meta}}}
+			rec := httptest.NewRecorder()
+			HealthHandler(s).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+			if rec.Code != tt.code {
+				t.Fatalf("code = %d, want %d", rec.Code, tt.code)
+			}
+			var hs HealthStatus
+			if err := json.Unmarshal(rec.Body.Bytes(), &hs); err != nil {
+				t.Fatal(err)
+			}
+			if want := map[bool]string{true: "ok", false: "fail"}[tt.code == http.StatusOK]; hs.Status != want {
+				t.Fatalf("status = %s, want %s", hs.Status, want)
+			}
+			for k, v := range tt.checks {
+				if hs.Checks[k] != v {
+					t.Fatalf("checks = %v, want %v", hs.Checks, tt.checks)
+				}
+			}
+		})
+	}
+}
+
+func gateways(t *testing.T, s *Server, n int) []Gateway {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		rec := httptest.NewRecorder()
+		GatewaysHandler(s).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/gateways", nil))
+		var gs []Gateway
+		if err := json.Unmarshal(rec.Body.Bytes(), &gs); err != nil {
+			t.Fatal(err)
+		}
+		if len(gs) == n {
+			return gs
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("gateways = %v, want %d", gs, n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestGatewaysAndMetrics(t *testing.T) {
+	ln, err := netpoll.CreateListener("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	s := &Server{Hub: &Hub{}, Enh: &Enh{}, Logger: slog.New(slog.DiscardHandler), PlayBack: &PlayBack{out: io.Discard}}
+	go s.RunAt(ln)
+
+	conn, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	gs := gateways(t, s, 1)
+	if gs[0].RemoteAddr != conn.LocalAddr().String() || gs[0].Connected.IsZero() {
+		t.Fatalf("gateway = %+v, want %s", gs[0], conn.LocalAddr())
+	}
+
+	// 一条未知类型的报文, 一条无法解析的报文
+	if _, err := io.WriteString(conn, `{"type":"unknown","data":null}`+"\n"+"garbage\n"); err != nil {
+		t.Fatal(err)
+	}
+	if line, err := bufio.NewReader(conn).ReadString('\n'); err != nil || !strings.Contains(line, "success") {
+		t.Fatalf("reply %q %v", line, err)
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		rec := httptest.NewRecorder()
+		promhttp.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		body := rec.Body.String()
+		missing := ""
+		for _, m := range []string{
+			"hank_gateway_connections 1",
+			`hank_frames_total{type="other"}`,
+			`hank_decode_errors_total{reason="sync_data"}`,
+		} {
+			if !strings.Contains(body, m) {
+				missing = m
+				break
+			}
+		}
+		if missing == "" {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("metric %s missing", missing)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	conn.Close()
+	gateways(t, s, 0)
+}
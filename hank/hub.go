@@ -2,19 +2,15 @@ package hank
 
 import (
 	"context"
-	"encoding"
+	"time"
 
 	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/common"
 )
 
-type SendObject interface {
-	encoding.BinaryMarshaler
-	Topic() string
-}
+type SendObject = common.SendObject
 
-type Sender interface {
-	SendData(ctx context.Context, obj SendObject) error
-}
+type Sender = common.Sender
 
 type Hub struct {
 	WAL    *wal.WAL
@@ -31,7 +27,7 @@ func (h *Hub) HandleElectricity(ctx context.Context, data ElectricityMeter) erro
 		wal.Any("data", data),
 		wal.String("topic", data.Topic()))
 
-	return h.Sender.SendData(ctx, data)
+	return h.send(ctx, data.Type, data)
 }
 
 func (h *Hub) HandleWater(ctx context.Context, data WaterMeter) error {
@@ -40,5 +36,15 @@ func (h *Hub) HandleWater(ctx context.Context, data WaterMeter) error {
 		wal.Any("data", data),
 		wal.String("topic", data.Topic()))
 
-	return h.Sender.SendData(ctx, data)
+	return h.send(ctx, data.Type, data)
+}
+
+func (h *Hub) send(ctx context.Context, typ string, obj SendObject) error {
+	start := time.Now()
+	err := h.Sender.SendData(ctx, obj)
+	sendLatency.WithLabelValues(typ).Observe(time.Since(start).Seconds())
+	if err != nil {
+		sendFailures.WithLabelValues(typ).Inc()
+	}
+	return err
 }
@@ -0,0 +1,57 @@
+package hank
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+type KafkaAction struct {
+	cli *kgo.Client
+}
+
+func NewKafkaAction(cli *kgo.Client) *KafkaAction {
+	return &KafkaAction{cli: cli}
+}
+
+// SendData 以设备code为key, 同一设备的数据落在同一分区, 保证顺序
+func (c *KafkaAction) SendData(ctx context.Context, obj SendObject) error {
+	bs, err := obj.MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	topic, code := common.KafkaTopic(obj.Topic())
+	r := &kgo.Record{
+		Topic: topic,
+		Key:   []byte(code),
+		Value: bs,
+		Headers: []kgo.RecordHeader{
+			{Key: common.HeaderContentType, Value: []byte(common.ContentTypeOf(bs))},
+		},
+	}
+	return c.cli.ProduceSync(ctx, r).FirstErr()
+}
+
+func (c *KafkaAction) Close() error {
+	c.cli.Close()
+	return nil
+}
+
+func NewKafkaClient(brokers ...string) (*kgo.Client, error) {
+	cli, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.ClientID(CLIENT_ID),
+		kgo.RecordPartitioner(kgo.StickyKeyPartitioner(nil)),
+		kgo.RequiredAcks(kgo.AllISRAcks()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := cli.Ping(context.Background()); err != nil {
+		cli.Close()
+		return nil, err
+	}
+	return cli, nil
+}
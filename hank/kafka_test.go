@@ -0,0 +1,82 @@
+package hank
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestKafkaActionKeyedByCode(t *testing.T) {
+	c, err := kfake.NewCluster(
+		kfake.NumBrokers(1),
+		kfake.SeedTopics(4, common.ElectricityKafkaTopic),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	cli, err := NewKafkaClient(c.ListenAddrs()...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ka := NewKafkaAction(cli)
+	defer ka.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	codes := []string{"PT-1-IN", "PT-2-IN", "PT-1-IN", "PT-3-IN", "PT-1-IN"}
+	for i, code := range codes {
+		var em ElectricityMeter
+		em.Code = code
+		em.Type = common.ELECTRICITY
+		em.Data.DataValue = int64(i)
+		if err := ka.SendData(ctx, em); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cons, err := kgo.NewClient(
+		kgo.SeedBrokers(c.ListenAddrs()...),
+		kgo.ConsumeTopics(common.ElectricityKafkaTopic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cons.Close()
+
+	parts := map[string]int32{}
+	last := map[string]int64{}
+	for n := 0; n < len(codes); {
+		fs := cons.PollFetches(ctx)
+		if err := ctx.Err(); err != nil {
+			t.Fatal(err)
+		}
+		fs.EachRecord(func(r *kgo.Record) {
+			n++
+			code := string(r.Key)
+			if p, ok := parts[code]; ok && p != r.Partition {
+				t.Errorf("code %s in partitions %d and %d", code, p, r.Partition)
+			}
+			parts[code] = r.Partition
+
+			var em ElectricityMeter
+			if err := unmarshal(r.Value, &em); err != nil {
+				t.Fatal(err)
+			}
+			if em.Code != code {
+				t.Errorf("key %s, code %s", code, em.Code)
+			}
+			if v, ok := last[code]; ok && v >= em.Data.DataValue {
+				t.Errorf("code %s out of order: %d after %d", code, em.Data.DataValue, v)
+			}
+			last[code] = em.Data.DataValue
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package hank
 
-import "context"
+import (
+	"context"
+
+	"github.com/twiglab/h2o/pkg/registry"
+)
 
 type MetaData struct {
 	SN   string `json:"sn,omitempty"`   // 仪表的序列号,仪表上有个条形码,如果没有就是空,或者自定义
@@ -24,3 +28,28 @@ func (s SimpleMD) Get(ctx context.Context, code string) (MetaData, bool, error)
 }
 
 func (e SimpleMD) Set(_ context.Context, _ string, _ MetaData) (err error) { return }
+
+// RegistryMD 从 archon 同步的设备档案
+type RegistryMD struct {
+	Mirror *registry.Mirror
+}
+
+func (r RegistryMD) Get(ctx context.Context, code string) (MetaData, bool, error) {
+	d, ok := r.Mirror.Get(code)
+	if !ok {
+		return MetaData{}, false, nil
+	}
+	return MetaData{
+		SN:      d.SN,
+		Code:    d.Code,
+		Name:    d.Name,
+		Project: d.Project,
+		PosCode: d.PosCode,
+	}, true, nil
+}
+
+func (r RegistryMD) Set(_ context.Context, _ string, _ MetaData) (err error) { return }
+
+func (r RegistryMD) Check(ctx context.Context) error {
+	return r.Mirror.Check(ctx)
+}
@@ -1,33 +1,14 @@
 package hank
 
-import "github.com/twiglab/h2o/pkg/common"
+import (
+	"github.com/twiglab/h2o/pkg/common"
+)
 
-type Meter struct {
-	common.Device
-	Pos common.Pos `json:"pos,omitzero"`
-}
-
-func (m Meter) Topic() string {
-	return common.Topic(m.Device)
-}
+type Meter = common.Meter
 
-type ElectricityMeter struct {
-	Meter
-	Data common.Electricity `json:"data"`
-}
+type ElectricityMeter = common.ElectricityMeter
 
-func (m ElectricityMeter) MarshalBinary() ([]byte, error) {
-	return marshal(m)
-}
-
-type WaterMeter struct {
-	Meter
-	Data common.Water `json:"data"`
-}
-
-func (m WaterMeter) MarshalBinary() (data []byte, err error) {
-	return marshal(m)
-}
+type WaterMeter = common.WaterMeter
 
 type GasMeter struct {
 	Meter
@@ -37,3 +18,5 @@ type GasMeter struct {
 func (m GasMeter) MarshalBinary() (data []byte, err error) {
 	return marshal(m)
 }
+
+type CodecSender = common.CodecSender
@@ -0,0 +1,85 @@
+package hank
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "hank"
+
+// 解析失败的原因
+const (
+	reasonSyncData   = "sync_data"
+	reasonDeviceData = "device_data"
+	reasonStatusData = "device_status"
+	reasonElecty     = "electricity"
+	reasonWater      = "water"
+	reasonDeviceType = "device_type"
+)
+
+var (
+	gatewayConns = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "gateway_connections",
+		Help:      "当前连接的网关数",
+	})
+
+	frames = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "frames_total",
+		Help:      "按类型统计收到的网关报文",
+	}, []string{"type"})
+
+	decodeErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "decode_errors_total",
+		Help:      "按原因统计的解析错误",
+	}, []string{"reason"})
+
+	sendLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "send_duration_seconds",
+		Help:      "Sender 发送耗时",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 4, 8),
+	}, []string{"type"})
+
+	sendFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "send_failures_total",
+		Help:      "Sender 发送失败次数",
+	}, []string{"type"})
+
+	metaLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "meta_lookups_total",
+		Help:      "元数据查询, result 为 hit, miss 或 error",
+	}, []string{"result"})
+
+	relayDelivered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "relay_delivered_total",
+		Help:      "fanout 各后端投递成功次数",
+	}, []string{"relay"})
+
+	relayFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "relay_failures_total",
+		Help:      "fanout 各后端投递失败次数",
+	}, []string{"relay"})
+
+	relayDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "relay_outbox_depth",
+		Help:      "fanout 各后端待投递的消息数",
+	}, []string{"relay"})
+)
+
+func metaResult(ok bool, err error) string {
+	switch {
+	case err != nil:
+		return "error"
+	case ok:
+		return "hit"
+	}
+	return "miss"
+}
@@ -21,7 +21,11 @@ func (c *MQTTAction) SendData(ctx context.Context, obj SendObject) error {
 	}
 
 	pubToken := c.client.Publish(obj.Topic(), 0x01, false, bb)
-	pubToken.Wait()
+	select {
+	case <-pubToken.Done():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 
 	return pubToken.Error()
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/nats-io/nats.go"
 	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/common/bus"
 )
 
 type NatsAction struct {
@@ -16,7 +18,10 @@ func (c *NatsAction) SendData(ctx context.Context, obj SendObject) error {
 	if err != nil {
 		return err
 	}
-	_, err = c.js.Publish(ctx, obj.Topic(), bs)
+	msg := nats.NewMsg(common.NatsSubject(obj.Topic()))
+	msg.Data = bs
+	msg.Header.Set(common.HeaderContentType, common.ContentTypeOf(bs))
+	_, err = c.js.PublishMsg(ctx, msg)
 	return err
 }
 
@@ -30,6 +35,16 @@ func NewNatsAction(url string) (*NatsAction, error) {
 		return nil, err
 	}
 	js, _ := jetstream.New(nc)
+
+	err = bus.EnsureStream(context.Background(), js, jetstream.StreamConfig{
+		Name:     common.NatsStream,
+		Subjects: []string{common.NatsSubjects},
+	})
+	if err != nil {
+		nc.Close()
+		return nil, err
+	}
+
 	return &NatsAction{
 		js: js,
 	}, nil
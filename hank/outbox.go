@@ -0,0 +1,202 @@
+package hank
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const outboxExt = ".msg"
+
+var ErrOutboxEmpty = errors.New("outbox empty")
+
+// Outbox 磁盘队列, 每条消息一个文件, 文件名为单调递增的序号
+// 超过 Max 条时丢弃最旧的消息
+type Outbox struct {
+	Dir string
+	Max int
+
+	mu   sync.Mutex
+	seqs []uint64
+	next uint64
+
+	dropped uint64
+
+	notify chan struct{}
+}
+
+func OpenOutbox(dir string, max int) (*Outbox, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &Outbox{
+		Dir:    dir,
+		Max:    max,
+		notify: make(chan struct{}, 1),
+	}
+
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, outboxExt) {
+			continue
+		}
+		seq, err := strconv.ParseUint(strings.TrimSuffix(name, outboxExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		o.seqs = append(o.seqs, seq)
+	}
+	slices.Sort(o.seqs)
+
+	if n := len(o.seqs); n > 0 {
+		o.next = o.seqs[n-1] + 1
+	}
+	return o, nil
+}
+
+func (o *Outbox) file(seq uint64) string {
+	return filepath.Join(o.Dir, fmt.Sprintf("%020d%s", seq, outboxExt))
+}
+
+// Put 写入一条消息, 先写临时文件再 rename, 保证不会读到半条消息
+func (o *Outbox) Put(topic string, payload []byte) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	seq := o.next
+	name := o.file(seq)
+	tmp := name + ".tmp"
+
+	if err := writeSync(tmp, encodeRecord(topic, payload)); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+	if err := os.Rename(tmp, name); err != nil {
+		return err
+	}
+	// rename 落盘后掉电才不会丢文件
+	if err := syncDir(o.Dir); err != nil {
+		return err
+	}
+
+	o.next++
+	o.seqs = append(o.seqs, seq)
+
+	for o.Max > 0 && len(o.seqs) > o.Max {
+		_ = os.Remove(o.file(o.seqs[0]))
+		o.seqs = o.seqs[1:]
+		o.dropped++
+	}
+
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// writeSync 写文件并 fsync, 掉电后不会留下空文件或半个文件
+func writeSync(name string, data []byte) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+// Peek 返回最旧的一条消息, 不删除
+func (o *Outbox) Peek() (seq uint64, topic string, payload []byte, err error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for len(o.seqs) > 0 {
+		seq = o.seqs[0]
+		var bs []byte
+		bs, err = os.ReadFile(o.file(seq))
+		if err == nil {
+			if topic, payload, err = decodeRecord(bs); err == nil {
+				return
+			}
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return
+		}
+		// 损坏或已被删除的消息直接丢弃, 否则队头一直卡住
+		_ = os.Remove(o.file(seq))
+		o.seqs = o.seqs[1:]
+		o.dropped++
+	}
+	err = ErrOutboxEmpty
+	return
+}
+
+// Remove 删除已经投递成功的消息
+func (o *Outbox) Remove(seq uint64) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if len(o.seqs) == 0 || o.seqs[0] != seq {
+		return nil
+	}
+	o.seqs = o.seqs[1:]
+	return os.Remove(o.file(seq))
+}
+
+func (o *Outbox) Len() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.seqs)
+}
+
+func (o *Outbox) Dropped() uint64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.dropped
+}
+
+func (o *Outbox) Notify() <-chan struct{} {
+	return o.notify
+}
+
+// record: topic长度(uvarint) + topic + payload
+func encodeRecord(topic string, payload []byte) []byte {
+	bs := make([]byte, 0, binary.MaxVarintLen64+len(topic)+len(payload))
+	bs = binary.AppendUvarint(bs, uint64(len(topic)))
+	bs = append(bs, topic...)
+	bs = append(bs, payload...)
+	return bs
+}
+
+func decodeRecord(bs []byte) (string, []byte, error) {
+	l, n := binary.Uvarint(bs)
+	if n <= 0 || uint64(len(bs)-n) < l {
+		return "", nil, errors.New("bad outbox record")
+	}
+	return string(bs[n : n+int(l)]), bs[n+int(l):], nil
+}
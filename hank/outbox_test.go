@@ -0,0 +1,152 @@
+package hank
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func peek(t *testing.T, o *Outbox) (uint64, string, string) {
+	t.Helper()
+	seq, topic, payload, err := o.Peek()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return seq, topic, string(payload)
+}
+
+func TestOutboxPutPeekRemove(t *testing.T) {
+	o, err := OpenOutbox(t.TempDir(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, _, err := o.Peek(); !errors.Is(err, ErrOutboxEmpty) {
+		t.Fatalf("err = %v, want ErrOutboxEmpty", err)
+	}
+
+	for _, p := range []string{"a", "b", "c"} {
+		if err := o.Put("topic/"+p, []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if o.Len() != 3 {
+		t.Fatalf("len = %d, want 3", o.Len())
+	}
+
+	for _, want := range []string{"a", "b", "c"} {
+		seq, topic, payload := peek(t, o)
+		if topic != "topic/"+want || payload != want {
+			t.Fatalf("peek = %s %s, want %s", topic, payload, want)
+		}
+		// 重复 Peek 返回同一条
+		if seq2, _, _ := peek(t, o); seq2 != seq {
+			t.Fatalf("peek seq %d, then %d", seq, seq2)
+		}
+		if err := o.Remove(seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, _, _, err := o.Peek(); !errors.Is(err, ErrOutboxEmpty) {
+		t.Fatalf("err = %v, want ErrOutboxEmpty", err)
+	}
+}
+
+func TestOutboxMaxDropsOldest(t *testing.T) {
+	o, err := OpenOutbox(t.TempDir(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"a", "b", "c"} {
+		if err := o.Put("t", []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if o.Len() != 2 || o.Dropped() != 1 {
+		t.Fatalf("len = %d dropped = %d, want 2 1", o.Len(), o.Dropped())
+	}
+	if _, _, payload := peek(t, o); payload != "b" {
+		t.Fatalf("head = %s, want b", payload)
+	}
+}
+
+func TestOutboxRecoverAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	o, err := OpenOutbox(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"a", "b", "c"} {
+		if err := o.Put("t", []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	seq, _, _ := peek(t, o)
+	if err := o.Remove(seq); err != nil {
+		t.Fatal(err)
+	}
+	// 写到一半的临时文件不是消息
+	if err := os.WriteFile(filepath.Join(dir, "00000000000000000099.msg.tmp"), []byte("x"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	o, err = OpenOutbox(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Len() != 2 {
+		t.Fatalf("len = %d, want 2", o.Len())
+	}
+	if _, _, payload := peek(t, o); payload != "b" {
+		t.Fatalf("head = %s, want b", payload)
+	}
+
+	// 新消息的序号接着已有的往后排
+	if err := o.Put("t", []byte("d")); err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for {
+		seq, _, payload, err := o.Peek()
+		if errors.Is(err, ErrOutboxEmpty) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, string(payload))
+		if err := o.Remove(seq); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(got) != 3 || got[0] != "b" || got[1] != "c" || got[2] != "d" {
+		t.Fatalf("drained %v, want [b c d]", got)
+	}
+}
+
+func TestOutboxSkipsMissingAndCorruptHead(t *testing.T) {
+	dir := t.TempDir()
+	o, err := OpenOutbox(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []string{"a", "b", "c"} {
+		if err := o.Put("t", []byte(p)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 队头被手工删除, 第二条内容损坏
+	if err := os.Remove(o.file(0)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(o.file(1), []byte{0xFF}, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, payload := peek(t, o); payload != "c" {
+		t.Fatalf("head = %s, want c", payload)
+	}
+	if o.Len() != 1 || o.Dropped() != 2 {
+		t.Fatalf("len = %d dropped = %d, want 1 2", o.Len(), o.Dropped())
+	}
+}
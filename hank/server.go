@@ -6,6 +6,10 @@ import (
 	"log/slog"
 	"math/rand/v2"
 	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cloudwego/netpoll"
 )
@@ -19,6 +23,22 @@ var ck = connKey{"__conn_id"}
 type cid struct {
 	s  *Server
 	id int64
+
+	remoteAddr string
+	connected  time.Time
+	lastSeen   atomic.Int64
+}
+
+func (c *cid) touch() {
+	c.lastSeen.Store(time.Now().UnixNano())
+}
+
+// Gateway 当前连接的网关
+type Gateway struct {
+	CID        int64     `json:"cid"`
+	RemoteAddr string    `json:"remote_addr"`
+	Connected  time.Time `json:"connected"`
+	LastSeen   time.Time `json:"last_seen"`
 }
 
 type Server struct {
@@ -31,6 +51,26 @@ type Server struct {
 	Logger *slog.Logger
 
 	PlayBack *PlayBack
+
+	conns sync.Map
+}
+
+func (s *Server) Gateways() []Gateway {
+	var gs []Gateway
+	s.conns.Range(func(_, v any) bool {
+		c := v.(*cid)
+		gs = append(gs, Gateway{
+			CID:        c.id,
+			RemoteAddr: c.remoteAddr,
+			Connected:  c.connected,
+			LastSeen:   time.Unix(0, c.lastSeen.Load()),
+		})
+		return true
+	})
+	slices.SortFunc(gs, func(a, b Gateway) int {
+		return a.Connected.Compare(b.Connected)
+	})
+	return gs
 }
 
 func (s *Server) RunAt(l net.Listener) error {
@@ -38,10 +78,25 @@ func (s *Server) RunAt(l net.Listener) error {
 		at(serve),
 
 		netpoll.WithOnConnect(func(ctx context.Context, conn netpoll.Connection) context.Context {
-			sk := &cid{s: s, id: rand.Int64()}
+			sk := &cid{
+				s:          s,
+				id:         rand.Int64(),
+				remoteAddr: conn.RemoteAddr().String(),
+				connected:  time.Now(),
+			}
+			sk.touch()
+			s.conns.Store(sk.id, sk)
+			gatewayConns.Inc()
 			return context.WithValue(ctx, ck, sk)
 		}),
 
+		netpoll.WithOnDisconnect(func(ctx context.Context, conn netpoll.Connection) {
+			if sk, ok := ctx.Value(ck).(*cid); ok {
+				s.conns.Delete(sk.id)
+				gatewayConns.Dec()
+			}
+		}),
+
 		netpoll.WithOnPrepare(func(conn netpoll.Connection) context.Context {
 			if s.BaseCtx != nil {
 				return s.BaseCtx(conn)
@@ -84,10 +139,12 @@ func serve(ctx context.Context, conn net.Conn, s *Server) error {
 	)
 
 	for sc.Scan() {
+		sk.touch()
 		s.PlayBack.Record(ctx, sc.Text())
 
 		var sd SyncData
 		if err := unmarshal(sc.Bytes(), &sd); err != nil {
+			decodeErrors.WithLabelValues(reasonSyncData).Inc()
 			s.Logger.ErrorContext(ctx, "unmarshal SyncData error",
 				slog.String("remoteAddr", conn.RemoteAddr().String()),
 				slog.Int64("cid", sk.id),
@@ -95,6 +152,7 @@ func serve(ctx context.Context, conn net.Conn, s *Server) error {
 			)
 			continue
 		}
+		frames.WithLabelValues(frameType(sd.Type)).Inc()
 
 		if err := writeReturn(conn, OK); err != nil {
 			// 和对方确认，网关发送完毕数据2s后断开，但是经过实际测试，网关并没有2s的延时，应该是发送完毕就直接断开了
@@ -128,6 +186,7 @@ func serve(ctx context.Context, conn net.Conn, s *Server) error {
 func doDeviceData(ctx context.Context, sd SyncData, s *Server) {
 	var ddl DeviceDataList
 	if err := unmarshal(sd.Data, &ddl); err != nil {
+		decodeErrors.WithLabelValues(reasonDeviceData).Inc()
 		s.Logger.ErrorContext(ctx, "unmarshal deviceDataList error", slog.Any("error", err))
 		return
 	}
@@ -137,6 +196,7 @@ func doDeviceData(ctx context.Context, sd SyncData, s *Server) {
 		case ELECTRICITY:
 			em, err := s.Enh.ToElecty(dd)
 			if err != nil {
+				decodeErrors.WithLabelValues(reasonElecty).Inc()
 				s.Logger.ErrorContext(ctx, "Enh.ToElecty error", slog.Any("raw", dd), slog.Any("error", err))
 				return
 			}
@@ -147,6 +207,7 @@ func doDeviceData(ctx context.Context, sd SyncData, s *Server) {
 		case WATER:
 			wm, err := s.Enh.ToWater(dd)
 			if err != nil {
+				decodeErrors.WithLabelValues(reasonWater).Inc()
 				s.Logger.ErrorContext(ctx, "Enh.ToWater error", slog.Any("raw", dd), slog.Any("error", err))
 				return
 			}
@@ -155,6 +216,7 @@ func doDeviceData(ctx context.Context, sd SyncData, s *Server) {
 				return
 			}
 		default:
+			decodeErrors.WithLabelValues(reasonDeviceType).Inc()
 			s.Logger.ErrorContext(ctx, "unknow device type", slog.String("type", dd.Type))
 		}
 	}
@@ -163,6 +225,7 @@ func doDeviceData(ctx context.Context, sd SyncData, s *Server) {
 func doDeviceStatus(ctx context.Context, sd SyncData, s *Server) {
 	var dsl DeviceStatusList
 	if err := unmarshal(sd.Data, &dsl); err != nil {
+		decodeErrors.WithLabelValues(reasonStatusData).Inc()
 		s.Logger.ErrorContext(ctx, "unmarshal deviceStatusList error", slog.Any("error", err))
 		return
 	}
@@ -172,3 +235,12 @@ func doDeviceStatus(ctx context.Context, sd SyncData, s *Server) {
 		}
 	}
 }
+
+// frameType 网关上送的类型不可控, 未知类型统一计数, 避免标签无限增长
+func frameType(t string) string {
+	switch t {
+	case TypeDeviceList, TypeGatewayInfo, TypeRate, TypeDeviceData, TypeDeviceStatus, TypeTime:
+		return t
+	}
+	return "other"
+}
@@ -182,3 +182,14 @@ func ReloadPlugins() {
 		ReloadPlugin(name)
 	}
 }
+
+// HasPlugin 判断插件是否已注册
+// 参数:
+//   - pluginName: 插件名称
+//
+// 返回值:
+//   - bool: 已注册返回 true
+func HasPlugin(pluginName string) bool {
+	_, ok := plugins.plugins[pluginName]
+	return ok
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/twiglab/h2o/nab/box/exports/gateway"
 	"github.com/twiglab/h2o/nab/box/exports/linkedge"
 	"github.com/twiglab/h2o/nab/box/exports/mirror"
+	"github.com/twiglab/h2o/nab/box/exports/provision"
 )
 
 // EnableAll 加载driver-box框架内置的所有Export插件
@@ -16,4 +17,5 @@ func EnableAll() {
 	mirror.EnableExport()
 	discover.EnableExport()
 	gateway.EnableExport()
+	provision.EnableExport()
 }
@@ -0,0 +1,225 @@
+package provision
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/pkg/provision"
+)
+
+// apply 先校验全部插件, 没有错误时才写入核心缓存, 写入后持久化并重启涉及的插件
+// 写入时出错不会回滚已经写入的插件, 错误中说明写入到了哪里
+func apply(cfg provision.Config) provision.Report {
+	rep := provision.Report{
+		Box:     cfg.Box,
+		Version: cfg.Version,
+		Time:    time.Now(),
+		DryRun:  cfg.DryRun,
+	}
+
+	plans := make([]plan, 0, len(cfg.Plugins))
+	seen := make(map[string]string)
+	for _, p := range cfg.Plugins {
+		pl, errs := check(p, cfg.Prune, seen)
+		rep.Errors = append(rep.Errors, errs...)
+		plans = append(plans, pl)
+		rep.Plugins = append(rep.Plugins, pl.report)
+	}
+	if !rep.OK() || cfg.DryRun {
+		return rep
+	}
+
+	// 遇到第一个失败就停止, 报告哪些插件已经写入, 后面的不再写入
+	// 失败的插件可能已经写入了一部分, 同样持久化并重启, 运行的配置和缓存保持一致
+	for i, pl := range plans {
+		err := pl.apply()
+		driverbox.CoreCache().Flush(pl.plugin.Name)
+		driverbox.ReloadPlugin(pl.plugin.Name)
+		if err != nil {
+			rep.Errors = append(rep.Errors,
+				fmt.Sprintf("%s: %v", pl.plugin.Name, err),
+				fmt.Sprintf("applied: %s; not applied: %s", names(plans[:i]), names(plans[i+1:])))
+			break
+		}
+	}
+	return rep
+}
+
+func names(plans []plan) string {
+	if len(plans) == 0 {
+		return "none"
+	}
+	ns := make([]string, len(plans))
+	for i, pl := range plans {
+		ns[i] = pl.plugin.Name
+	}
+	return strings.Join(ns, ", ")
+}
+
+type plan struct {
+	plugin provision.Plugin
+	report provision.PluginReport
+	// moved 更换了模型的设备, 先删除再添加
+	moved []string
+}
+
+// check 校验一个插件的配置, 并和核心缓存比较得出新增, 更新和删除的设备
+// seen 记录已经出现过的插件, 连接, 模型和设备, 它们在整个配置中不能重复
+func check(p provision.Plugin, prune bool, seen map[string]string) (plan, []string) {
+	pl := plan{plugin: p, report: provision.PluginReport{Plugin: p.Name}}
+	var errs []string
+	errorf := func(format string, a ...any) {
+		errs = append(errs, p.Name+": "+fmt.Sprintf(format, a...))
+	}
+	once := func(kind, key string) bool {
+		k := kind + "/" + key
+		if owner, ok := seen[k]; ok {
+			errorf("%s %s duplicated in %s", kind, key, owner)
+			return false
+		}
+		seen[k] = p.Name
+		return true
+	}
+
+	if p.Name == "" {
+		return pl, []string{"plugin name is empty"}
+	}
+	if !once("plugin", p.Name) {
+		return pl, errs
+	}
+	if !driverbox.HasPlugin(p.Name) {
+		errorf("plugin not enabled")
+		return pl, errs
+	}
+
+	for key := range p.Connections {
+		if key == "" {
+			errorf("connection key is empty")
+			continue
+		}
+		once("connection", key)
+		if owner, _ := driverbox.CoreCache().GetConnection(key); owner != "" && owner != p.Name {
+			errorf("connection %s belongs to %s", key, owner)
+		}
+	}
+
+	models := make(map[string]bool, len(p.DeviceModels))
+	devices := make(map[string]bool)
+	for _, m := range p.DeviceModels {
+		if m.Name == "" {
+			errorf("model name is empty")
+			continue
+		}
+		once("model", m.Name)
+		models[m.Name] = true
+		if _, ok := driverbox.CoreCache().GetModel(m.Name); ok && !owns(p.Name, m.Name) {
+			errorf("model %s belongs to another plugin", m.Name)
+		}
+		for i, pt := range m.DevicePoints {
+			if name, _ := pt["name"].(string); name == "" {
+				errorf("model %s point %d: name is required", m.Name, i)
+			}
+			if _, ok := pt["description"].(string); !ok {
+				pt["description"] = pt["name"]
+			}
+		}
+
+		for _, d := range m.Devices {
+			if d.ID == "" {
+				errorf("model %s: device id is empty", m.Name)
+				continue
+			}
+			once("device", d.ID)
+			devices[d.ID] = true
+			if _, ok := p.Connections[d.ConnectionKey]; !ok {
+				if owner, _ := driverbox.CoreCache().GetConnection(d.ConnectionKey); owner != p.Name {
+					errorf("device %s: connection %q not found", d.ID, d.ConnectionKey)
+				}
+			}
+
+			old, ok := driverbox.CoreCache().GetDevice(d.ID)
+			switch {
+			case !ok:
+				pl.report.Added = append(pl.report.Added, d.ID)
+			case old.PluginName != p.Name:
+				errorf("device %s belongs to %s", d.ID, old.PluginName)
+			default:
+				if old.ModelName != m.Name {
+					pl.moved = append(pl.moved, d.ID)
+				}
+				pl.report.Updated = append(pl.report.Updated, d.ID)
+			}
+		}
+	}
+
+	if prune {
+		for _, d := range driverbox.CoreCache().Devices() {
+			if d.PluginName == p.Name && !devices[d.ID] {
+				pl.report.Removed = append(pl.report.Removed, d.ID)
+			}
+		}
+		slices.Sort(pl.report.Removed)
+	}
+	return pl, errs
+}
+
+// owns 模型是否属于插件, 核心缓存只能通过设备判断, 没有设备的模型允许覆盖
+func owns(pluginName, modelName string) bool {
+	for _, d := range driverbox.CoreCache().Devices() {
+		if d.ModelName == modelName {
+			return d.PluginName == pluginName
+		}
+	}
+	return true
+}
+
+func (pl plan) apply() error {
+	cc := driverbox.CoreCache()
+	p := pl.plugin
+
+	for key, conn := range p.Connections {
+		if err := cc.AddOrUpdateConnection(p.Name, key, conn); err != nil {
+			return err
+		}
+	}
+
+	if err := cc.BatchRemoveDevice(append(pl.moved, pl.report.Removed...)); err != nil {
+		return err
+	}
+
+	for _, m := range p.DeviceModels {
+		points := make([]config.Point, len(m.DevicePoints))
+		for i, pt := range m.DevicePoints {
+			points[i] = config.Point(pt)
+		}
+		model := config.Model{
+			Name:         m.Name,
+			ModelID:      m.ModelID,
+			Description:  m.Description,
+			Attributes:   m.Attributes,
+			DevicePoints: points,
+		}
+		if err := cc.AddModel(p.Name, model); err != nil {
+			return err
+		}
+
+		for _, d := range m.Devices {
+			dev := config.Device{
+				ID:            d.ID,
+				ModelName:     m.Name,
+				Description:   d.Description,
+				ConnectionKey: d.ConnectionKey,
+				Properties:    d.Properties,
+				DriverKey:     d.DriverKey,
+			}
+			if err := cc.AddOrUpdateDevice(dev); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,224 @@
+package provision
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/internal/cache"
+	"github.com/twiglab/h2o/nab/box/internal/logger"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/pkg/provision"
+	"go.uber.org/zap"
+)
+
+type fakePlugin struct{}
+
+func (fakePlugin) Initialize(config.DeviceConfig) {}
+
+func (fakePlugin) Connector(string) (plugin.Connector, error) {
+	return nil, errors.New("no connector")
+}
+
+func (fakePlugin) Destroy() error { return nil }
+
+// setup 核心缓存中 modbus 有连接 rtu1, 模型 em 和设备 E1 E2, dlt645 有连接 com2, 模型 wm 和设备 W1
+func setup(t *testing.T) {
+	t.Helper()
+	logger.Logger = zap.NewNop()
+	config.ResourcePath = t.TempDir()
+
+	plugins := map[string]plugin.Plugin{"modbus": fakePlugin{}, "dlt645": fakePlugin{}}
+	for name, p := range plugins {
+		driverbox.EnablePlugin(name, p)
+	}
+	cache.Get()
+	cache.Reset()
+	if _, err := cache.InitCoreCache(plugins); err != nil {
+		t.Fatal(err)
+	}
+
+	cc := driverbox.CoreCache()
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	must(cc.AddOrUpdateConnection("modbus", "rtu1", map[string]any{"mode": "rtu"}))
+	must(cc.AddOrUpdateConnection("dlt645", "com2", map[string]any{}))
+	must(cc.AddModel("modbus", config.Model{Name: "em"}))
+	must(cc.AddModel("dlt645", config.Model{Name: "wm"}))
+	for _, d := range []config.Device{
+		{ID: "E1", ModelName: "em", ConnectionKey: "rtu1"},
+		{ID: "E2", ModelName: "em", ConnectionKey: "rtu1"},
+		{ID: "W1", ModelName: "wm", ConnectionKey: "com2"},
+	} {
+		must(cc.AddOrUpdateDevice(d))
+	}
+}
+
+func model(name string, ids ...string) provision.Model {
+	m := provision.Model{Name: name, DevicePoints: []map[string]any{{"name": "energy"}}}
+	for _, id := range ids {
+		m.Devices = append(m.Devices, provision.Device{ID: id, ConnectionKey: "rtu1"})
+	}
+	return m
+}
+
+func modbus(ms ...provision.Model) provision.Plugin {
+	return provision.Plugin{Name: "modbus", Connections: map[string]any{"rtu1": map[string]any{"mode": "rtu"}}, DeviceModels: ms}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		plugins []provision.Plugin
+		prune   bool
+		errs    []string
+		report  provision.PluginReport
+		moved   []string
+	}{
+		{"新增和更新", []provision.Plugin{modbus(model("em", "E1", "E3"))}, false, nil,
+			provision.PluginReport{Plugin: "modbus", Added: []string{"E3"}, Updated: []string{"E1"}}, nil},
+		{"删除配置中没有的设备", []provision.Plugin{modbus(model("em", "E1"))}, true, nil,
+			provision.PluginReport{Plugin: "modbus", Updated: []string{"E1"}, Removed: []string{"E2"}}, nil},
+		{"更换模型", []provision.Plugin{modbus(model("em2", "E1"), model("em", "E2"))}, true, nil,
+			provision.PluginReport{Plugin: "modbus", Updated: []string{"E1", "E2"}}, []string{"E1"}},
+		{"设备属于其他插件", []provision.Plugin{modbus(model("em", "W1"))}, false,
+			[]string{"modbus: device W1 belongs to dlt645"},
+			provision.PluginReport{Plugin: "modbus"}, nil},
+		{"模型属于其他插件", []provision.Plugin{modbus(model("wm", "E1"))}, false,
+			[]string{"modbus: model wm belongs to another plugin"},
+			provision.PluginReport{Plugin: "modbus", Updated: []string{"E1"}}, []string{"E1"}},
+		{"连接属于其他插件", []provision.Plugin{{Name: "modbus", Connections: map[string]any{"com2": nil}}}, false,
+			[]string{"modbus: connection com2 belongs to dlt645"},
+			provision.PluginReport{Plugin: "modbus"}, nil},
+		{"连接不存在", []provision.Plugin{{Name: "modbus", DeviceModels: []provision.Model{
+			{Name: "em", Devices: []provision.Device{{ID: "E1", ConnectionKey: "com2"}}}}}}, false,
+			[]string{`modbus: device E1: connection "com2" not found`},
+			provision.PluginReport{Plugin: "modbus", Updated: []string{"E1"}}, nil},
+		{"插件未启用", []provision.Plugin{{Name: "bacnet"}}, true,
+			[]string{"bacnet: plugin not enabled"}, provision.PluginReport{Plugin: "bacnet"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup(t)
+			seen := make(map[string]string)
+			var errs []string
+			var pl plan
+			for _, p := range tt.plugins {
+				var es []string
+				pl, es = check(p, tt.prune, seen)
+				errs = append(errs, es...)
+			}
+			if !slices.Equal(errs, tt.errs) {
+				t.Fatalf("errs = %q, want %q", errs, tt.errs)
+			}
+			r := pl.report
+			if r.Plugin != tt.report.Plugin || !slices.Equal(r.Added, tt.report.Added) ||
+				!slices.Equal(r.Updated, tt.report.Updated) || !slices.Equal(r.Removed, tt.report.Removed) {
+				t.Fatalf("report = %+v, want %+v", r, tt.report)
+			}
+			if !slices.Equal(pl.moved, tt.moved) {
+				t.Fatalf("moved = %v, want %v", pl.moved, tt.moved)
+			}
+		})
+	}
+}
+
+func TestCheckDuplicated(t *testing.T) {
+	setup(t)
+	dlt := provision.Plugin{Name: "dlt645",
+		Connections: map[string]any{"rtu1": nil, "com2": nil},
+		DeviceModels: []provision.Model{{Name: "wm", Devices: []provision.Device{
+			{ID: "W1", ConnectionKey: "com2"}, {ID: "E1", ConnectionKey: "com2"}}}},
+	}
+	rep := apply(provision.Config{Box: "B1", Plugins: []provision.Plugin{modbus(model("em", "E1", "E2")), dlt, modbus()}})
+	want := []string{
+		"dlt645: connection rtu1 duplicated in modbus",
+		"dlt645: connection rtu1 belongs to modbus",
+		"dlt645: device E1 duplicated in modbus",
+		"dlt645: device E1 belongs to modbus",
+		"modbus: plugin modbus duplicated in modbus",
+	}
+	if !slices.Equal(rep.Errors, want) {
+		t.Fatalf("errors = %q, want %q", rep.Errors, want)
+	}
+	// 校验失败不写入
+	if d, _ := driverbox.CoreCache().GetDevice("E1"); d.PluginName != "modbus" {
+		t.Fatalf("E1 = %+v after failed apply", d)
+	}
+}
+
+func TestApplyDryRun(t *testing.T) {
+	setup(t)
+	cfg := provision.Config{Box: "B1", Prune: true, DryRun: true,
+		Plugins: []provision.Plugin{modbus(model("em", "E1", "E3"))}}
+	file := filepath.Join(config.ResourcePath, "driver", "modbus", "config.json")
+
+	rep := apply(cfg)
+	if !rep.OK() || !rep.DryRun || len(rep.Plugins) != 1 || !slices.Equal(rep.Plugins[0].Removed, []string{"E2"}) {
+		t.Fatalf("dry run report = %+v", rep)
+	}
+	if _, ok := driverbox.CoreCache().GetDevice("E3"); ok {
+		t.Fatal("dry run added E3")
+	}
+	if _, ok := driverbox.CoreCache().GetDevice("E2"); !ok {
+		t.Fatal("dry run removed E2")
+	}
+	if _, err := os.Stat(file); !os.IsNotExist(err) {
+		t.Fatalf("dry run wrote %s: %v", file, err)
+	}
+
+	cfg.DryRun = false
+	if rep := apply(cfg); !rep.OK() {
+		t.Fatalf("apply errors = %q", rep.Errors)
+	}
+	if _, ok := driverbox.CoreCache().GetDevice("E3"); !ok {
+		t.Fatal("E3 not added")
+	}
+	if _, ok := driverbox.CoreCache().GetDevice("E2"); ok {
+		t.Fatal("E2 not removed")
+	}
+	if _, err := os.Stat(file); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestAuth(t *testing.T) {
+	tests := []struct {
+		name   string
+		token  string
+		header string
+		ok     bool
+	}{
+		{"正确", "secret", "Bearer secret", true},
+		{"没有请求头", "secret", "", false},
+		{"不是 Bearer", "secret", "Basic secret", false},
+		{"token 错误", "secret", "Bearer secre", false},
+		{"大小写不同", "secret", "bearer secret", false},
+		// 没有配置 token 时拒绝所有请求
+		{"未配置 token", "", "Bearer ", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := http.NewRequest(http.MethodGet, provision.StatusPath, nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+			e := &export{token: tt.token}
+			if err := e.auth(r); (err == nil) != tt.ok {
+				t.Fatalf("auth = %v, want ok %v", err, tt.ok)
+			}
+			if _, err := e.status(r); (err == nil) != tt.ok {
+				t.Fatalf("status = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package provision
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/event"
+	"github.com/twiglab/h2o/pkg/provision"
+	"go.uber.org/zap"
+)
+
+var (
+	errUnauthorized = errors.New("unauthorized")
+	errBox          = errors.New("box is required")
+)
+
+var driverInstance *export
+var once = &sync.Once{}
+
+// 接收 archon 下发的设备配置
+type export struct {
+	ready bool
+	token string
+
+	mu   sync.Mutex
+	last provision.Report
+}
+
+func EnableExport() {
+	driverbox.EnableExport(newExport())
+}
+
+func newExport() *export {
+	once.Do(func() {
+		driverInstance = &export{}
+	})
+	return driverInstance
+}
+
+func (export *export) Init() error {
+	if os.Getenv(config.ENV_EXPORT_PROVISION_ENABLED) == "false" {
+		driverbox.Log().Warn("provision export is disabled")
+		return nil
+	}
+	// 下发的配置会替换边缘盒子的设备, 没有 token 时不开放接口
+	export.token = os.Getenv(config.ENV_EXPORT_PROVISION_TOKEN)
+	if export.token == "" {
+		driverbox.Log().Error("provision export is disabled, token is not set", zap.String("env", config.ENV_EXPORT_PROVISION_TOKEN))
+		return nil
+	}
+	driverbox.BaseExport().HandleFunc(http.MethodPost, provision.ApplyPath, export.apply)
+	driverbox.BaseExport().HandleFunc(http.MethodGet, provision.StatusPath, export.status)
+	export.ready = true
+	return nil
+}
+
+func (export *export) Destroy() error {
+	export.ready = false
+	return nil
+}
+
+func (export *export) ExportTo(deviceData plugin.DeviceData) {
+}
+
+func (export *export) OnEvent(eventCode event.EventCode, key string, eventValue interface{}) error {
+	return nil
+}
+
+func (export *export) IsReady() bool {
+	return export.ready
+}
+
+func (export *export) auth(r *http.Request) error {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || export.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(export.token)) != 1 {
+		return errUnauthorized
+	}
+	return nil
+}
+
+// apply 校验失败时返回带错误的 Report, 不作为接口错误
+func (export *export) apply(r *http.Request) (any, error) {
+	if err := export.auth(r); err != nil {
+		return nil, err
+	}
+	var cfg provision.Config
+	if err := json.NewDecoder(r.Body).Decode(&cfg); err != nil {
+		return nil, err
+	}
+	if cfg.Box == "" {
+		return nil, errBox
+	}
+
+	export.mu.Lock()
+	defer export.mu.Unlock()
+
+	rep := apply(cfg)
+	if !rep.DryRun {
+		export.last = rep
+	}
+	driverbox.Log().Info("provision", zap.String("box", rep.Box), zap.String("version", rep.Version),
+		zap.Bool("dryRun", rep.DryRun), zap.Strings("errors", rep.Errors), zap.Any("plugins", rep.Plugins))
+	return rep, nil
+}
+
+func (export *export) status(r *http.Request) (any, error) {
+	if err := export.auth(r); err != nil {
+		return nil, err
+	}
+	export.mu.Lock()
+	defer export.mu.Unlock()
+	return export.last, nil
+}
@@ -92,6 +92,12 @@ type CoreCache interface {
 	// 返回: error - 添加过程中可能发生的错误
 	AddConnection(plugin string, key string, conn any) error
 
+	// AddOrUpdateConnection 新增或更新指定插件的连接
+	// 注意: 连接已属于其他插件时返回错误, 更新后需要重启插件才能生效
+	// 参数: plugin - 插件名称, key - 连接标识键, conn - 连接实例
+	// 返回: error - 操作过程中可能发生的错误
+	AddOrUpdateConnection(plugin string, key string, conn any) error
+
 	// GetConnection 根据连接键获取连接实例
 	// 参数: key - 连接标识键
 	// 返回: string - 关联插件名称
@@ -648,6 +654,25 @@ func (c *cache) AddConnection(plugin string, key string, conn any) error {
 	return nil
 }
 
+// AddOrUpdateConnection 新增或更新连接
+func (c *cache) AddOrUpdateConnection(plugin string, key string, conn any) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	_, ok := c.plugins[plugin]
+	if !ok {
+		return errors.New("plugin " + plugin + " not exists")
+	}
+	if old, ok := c.connections[key]; ok && old.pluginName != plugin {
+		return errors.New("connection " + key + " already exists in " + old.pluginName)
+	}
+	c.connections[key] = cacheConnection{
+		connection: conn,
+		pluginName: plugin,
+	}
+	c.flushable(plugin)
+	return nil
+}
+
 // GetConnection 获取连接信息
 func (c *cache) GetConnection(key string) (string, any) {
 	c.mutex.RLock()
@@ -734,7 +759,7 @@ func (c *cache) Flush(pluginName string) {
 	cfg := convertConfig(pluginName)
 
 	//闲置插件
-	if len(cfg.Connections) == 0 && len(cfg.Connections) == 0 {
+	if len(cfg.Connections) == 0 && len(cfg.DeviceModels) == 0 {
 		//无接入配置，需删除
 		if len(p.FilePath) > 0 {
 			err := os.Remove(p.FilePath)
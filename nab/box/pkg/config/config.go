@@ -33,6 +33,11 @@ const (
 	//MCP功能是否可用
 	ENV_EXPORT_MCP_ENABLED = "EXPORT_MCP_ENABLED"
 
+	//配置下发功能是否可用
+	ENV_EXPORT_PROVISION_ENABLED = "EXPORT_PROVISION_ENABLED"
+	//配置下发的 Bearer token, 为空时不校验
+	ENV_EXPORT_PROVISION_TOKEN = "EXPORT_PROVISION_TOKEN"
+
 	//设备历史数据存放路径
 	EXPORT_HISTORY_DATA_PATH = "EXPORT_HISTORY_DATA_PATH"
 	//设备历史数据保存时长，单位（天），默认值：14
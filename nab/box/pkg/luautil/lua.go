@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sync"
 
 	"github.com/cjoudrey/gluahttp"
@@ -56,6 +57,19 @@ func InitLuaVM(filePath string) (*lua.LState, error) {
 	return ls, nil
 }
 
+// InitMockVM 加载协议虚拟模式的脚本, 优先 driver/<protocol>/converter.lua, 其次 driver/virtual/converter.lua
+// 两个脚本都不存在时返回 nil
+func InitMockVM(protocol string) (*lua.LState, error) {
+	path := filepath.Join(config.ResourcePath, "driver", protocol, "converter.lua")
+	if !fileutil.FileExists(path) {
+		path = filepath.Join(config.ResourcePath, "driver", "virtual", "converter.lua")
+	}
+	if !fileutil.FileExists(path) {
+		return nil, nil
+	}
+	return InitLuaVM(path)
+}
+
 func CallLuaConverter(L *lua.LState, method string, raw interface{}) ([]plugin.DeviceData, error) {
 	data, ok := raw.(string)
 	if !ok {
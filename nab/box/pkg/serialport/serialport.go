@@ -0,0 +1,169 @@
+package serialport
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	"github.com/goburrow/serial"
+	"github.com/twiglab/h2o/nab/box/internal/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	ModeRTU = "rtu" // 串口
+	ModeTCP = "tcp" // 串口服务器透传
+)
+
+var (
+	// ErrIncomplete 协议解析时收到的字节还不够一帧, 需要继续读取
+	ErrIncomplete = errors.New("frame incomplete")
+	// ErrTimeout 超时前没有收到完整的应答
+	ErrTimeout = errors.New("request timeout")
+)
+
+// Config 串口或串口服务器透传的连接参数
+type Config struct {
+	Address     string `json:"address"`     // 地址：串口 /dev/ttyS0，透传 192.168.1.10:4001
+	Mode        string `json:"mode"`        // 连接模式：rtu、tcp
+	BaudRate    int    `json:"baudRate"`    // 波特率（仅串口模式），默认 2400
+	DataBits    int    `json:"dataBits"`    // 数据位（仅串口模式），默认 8
+	StopBits    int    `json:"stopBits"`    // 停止位（仅串口模式），默认 1
+	Parity      string `json:"parity"`      // 奇偶性校验（仅串口模式）：N、E、O，默认 E
+	MinInterval uint16 `json:"minInterval"` // 两次请求的最小间隔，毫秒
+	Timeout     uint16 `json:"timeout"`     // 请求超时，毫秒
+}
+
+// Init 补齐未设置的参数并检查连接模式, 请求间隔和超时的默认值由协议决定
+func (c *Config) Init(minInterval, timeout uint16) error {
+	if c.Mode == "" {
+		c.Mode = ModeRTU
+	}
+	if c.MinInterval == 0 {
+		c.MinInterval = minInterval
+	}
+	if c.Timeout == 0 {
+		c.Timeout = timeout
+	}
+	if c.BaudRate == 0 {
+		c.BaudRate = 2400
+	}
+	if c.DataBits == 0 {
+		c.DataBits = 8
+	}
+	if c.StopBits == 0 {
+		c.StopBits = 1
+	}
+	if c.Parity == "" {
+		c.Parity = "E"
+	}
+	if c.Mode != ModeRTU && c.Mode != ModeTCP {
+		return fmt.Errorf("unsupported mode: %s", c.Mode)
+	}
+	return nil
+}
+
+// Transport 串口或串口服务器透传连接
+// 不能并发使用, 同一总线上的请求由调用方加锁依次执行
+type Transport struct {
+	name   string
+	config *Config
+	port   io.ReadWriteCloser
+	buf    []byte
+	// 最近一次执行IO的时间
+	latestIoTime time.Time
+}
+
+// New 创建连接, name 为协议名, 用于日志和错误信息; 第一次请求时才打开
+func New(name string, config *Config) *Transport {
+	return &Transport{name: name, config: config}
+}
+
+func (t *Transport) timeout() time.Duration {
+	return time.Duration(t.config.Timeout) * time.Millisecond
+}
+
+func (t *Transport) open() (err error) {
+	if t.port != nil {
+		return nil
+	}
+	switch t.config.Mode {
+	case ModeRTU:
+		t.port, err = serial.Open(&serial.Config{
+			Address:  t.config.Address,
+			BaudRate: t.config.BaudRate,
+			DataBits: t.config.DataBits,
+			StopBits: t.config.StopBits,
+			Parity:   t.config.Parity,
+			// 串口按小段超时读取, 整帧的超时由 Request 控制
+			Timeout: 50 * time.Millisecond,
+		})
+	case ModeTCP:
+		t.port, err = net.DialTimeout("tcp", t.config.Address, t.timeout())
+	default:
+		err = fmt.Errorf("unsupported %s mode: %s", t.name, t.config.Mode)
+	}
+	if err != nil {
+		logger.Logger.Error("open "+t.name+" connection error", zap.Any(t.name, t.config), zap.Error(err))
+	}
+	return err
+}
+
+// Close 关闭连接, 下次请求重新打开
+func (t *Transport) Close() {
+	if t.port != nil {
+		_ = t.port.Close()
+		t.port = nil
+	}
+}
+
+// ensureInterval 确保与前一次IO至少间隔minInterval毫秒
+func (t *Transport) ensureInterval() {
+	np := t.latestIoTime.Add(time.Duration(t.config.MinInterval) * time.Millisecond)
+	if time.Now().Before(np) {
+		time.Sleep(time.Until(np))
+	}
+	t.latestIoTime = time.Now()
+}
+
+// Request 发送 req 并等待应答
+// 每收到一段数据用 decode 解析已收到的全部字节, decode 返回 ErrIncomplete 时继续读取,
+// 其他结果直接返回. 超时返回 ErrTimeout. 返回的 buf 是收到的字节, 下次请求前有效.
+// 出错时不关闭连接, 由调用方按协议决定是否 Close
+func (t *Transport) Request(req []byte, decode func(buf []byte) error) (buf []byte, err error) {
+	t.buf = t.buf[:0]
+	if err = t.open(); err != nil {
+		return nil, err
+	}
+	t.ensureInterval()
+
+	if _, err = t.port.Write(req); err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(t.timeout())
+	if conn, ok := t.port.(net.Conn); ok {
+		_ = conn.SetReadDeadline(deadline)
+	}
+
+	chunk := make([]byte, 256)
+	for time.Now().Before(deadline) {
+		n, e := t.port.Read(chunk)
+		t.buf = append(t.buf, chunk[:n]...)
+		if n > 0 {
+			if err = decode(t.buf); !errors.Is(err, ErrIncomplete) {
+				return t.buf, err
+			}
+		}
+		if e == nil || errors.Is(e, serial.ErrTimeout) {
+			continue
+		}
+		var ne net.Error
+		if errors.As(e, &ne) && ne.Timeout() {
+			break
+		}
+		return t.buf, e
+	}
+	return t.buf, fmt.Errorf("%s %w", t.name, ErrTimeout)
+}
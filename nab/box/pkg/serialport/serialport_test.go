@@ -0,0 +1,100 @@
+package serialport
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+// serve 透传端口, 每收到一个请求按 reply 分段应答
+func serve(t *testing.T, reply ...[]byte) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 64)
+		for {
+			if _, err := conn.Read(buf); err != nil {
+				return
+			}
+			for _, r := range reply {
+				conn.Write(r)
+				time.Sleep(10 * time.Millisecond)
+			}
+		}
+	}()
+	return ln.Addr().String()
+}
+
+// fixed 收够 n 个字节为一帧
+func fixed(n int) func([]byte) error {
+	return func(buf []byte) error {
+		if len(buf) < n {
+			return ErrIncomplete
+		}
+		return nil
+	}
+}
+
+func transport(t *testing.T, addr string) *Transport {
+	t.Helper()
+	c := &Config{Address: addr, Mode: ModeTCP}
+	if err := c.Init(0, 200); err != nil {
+		t.Fatal(err)
+	}
+	tr := New("test", c)
+	t.Cleanup(tr.Close)
+	return tr
+}
+
+func TestRequestChunks(t *testing.T) {
+	tr := transport(t, serve(t, []byte{1, 2}, []byte{3, 4}))
+	for range 2 {
+		buf, err := tr.Request([]byte{0}, fixed(4))
+		if err != nil || string(buf) != "\x01\x02\x03\x04" {
+			t.Fatalf("got % X %v, want 01 02 03 04", buf, err)
+		}
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	tr := transport(t, serve(t, []byte{1, 2}))
+	buf, err := tr.Request([]byte{0}, fixed(4))
+	if !errors.Is(err, ErrTimeout) || len(buf) != 2 {
+		t.Fatalf("got % X %v, want partial frame and ErrTimeout", buf, err)
+	}
+	if err.Error() != "test request timeout" {
+		t.Fatalf("got %q", err)
+	}
+}
+
+func TestRequestDecodeError(t *testing.T) {
+	tr := transport(t, serve(t, []byte{0xFF}))
+	bad := errors.New("bad frame")
+	_, err := tr.Request([]byte{0}, func([]byte) error { return bad })
+	if !errors.Is(err, bad) {
+		t.Fatalf("got %v, want %v", err, bad)
+	}
+}
+
+func TestConfigInit(t *testing.T) {
+	c := &Config{}
+	if err := c.Init(100, 1000); err != nil {
+		t.Fatal(err)
+	}
+	if c.Mode != ModeRTU || c.MinInterval != 100 || c.Timeout != 1000 || c.BaudRate != 2400 || c.Parity != "E" {
+		t.Fatalf("got %+v", c)
+	}
+	if err := (&Config{Mode: "udp"}).Init(0, 0); err == nil {
+		t.Fatal("got nil error for mode udp")
+	}
+}
@@ -0,0 +1,18 @@
+// Package testutil 插件测试共用的工具
+package testutil
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// Unhex 抓包的十六进制报文, 字节之间用空格分隔
+func Unhex(t testing.TB, s string) []byte {
+	t.Helper()
+	bs, err := hex.DecodeString(strings.ReplaceAll(s, " ", ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
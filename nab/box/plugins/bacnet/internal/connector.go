@@ -0,0 +1,638 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/convutil"
+	"github.com/twiglab/h2o/nab/box/pkg/crontab"
+	"github.com/twiglab/h2o/nab/box/pkg/event"
+	"go.uber.org/zap"
+)
+
+// 未绑定地址的设备按实例号发送 Who-Is 的间隔, 订阅失败后重试的间隔
+const retryInterval = 30 * time.Second
+
+func newConnector(p *Plugin, cf *ConnectionConfig) (*connector, error) {
+	if cf.LocalAddress == "" {
+		cf.LocalAddress = "0.0.0.0:47808"
+	}
+	if cf.BroadcastAddress == "" {
+		cf.BroadcastAddress = "255.255.255.255:47808"
+	}
+	if cf.Retry == 0 {
+		cf.Retry = 3
+	}
+	if cf.Timeout <= 0 {
+		cf.Timeout = 3000
+	}
+	if cf.MaxReadProperties <= 0 {
+		cf.MaxReadProperties = 20
+	}
+	if cf.CovLifetime == 0 {
+		cf.CovLifetime = 300
+	}
+	if cf.DiscoverDuration == "" {
+		cf.DiscoverDuration = "10m"
+	}
+
+	conn := &connector{
+		config:        cf,
+		plugin:        p,
+		transport:     &transport{config: cf},
+		virtual:       cf.Virtual || config.IsVirtual(),
+		devices:       make(map[string]*device),
+		instances:     make(map[uint32]*device),
+		subscriptions: make(map[uint32]*subscription),
+		discovered:    make(map[uint32]bool),
+	}
+	conn.transport.handler = conn.handle
+	return conn, nil
+}
+
+func (c *connector) initCollectTask(conf *ConnectionConfig) (*crontab.Future, error) {
+	discoverDuration, err := time.ParseDuration(conf.DiscoverDuration)
+	if err != nil {
+		driverbox.Log().Error("error bacnet discover duration config", zap.String("key", conf.ConnectionKey), zap.Error(err))
+		discoverDuration = 10 * time.Minute
+	}
+
+	//注册定时采集任务
+	return driverbox.AddFunc("1s", func() {
+		if conf.Discover && !c.virtual && c.latestDiscoverTime.Add(discoverDuration).Before(time.Now()) {
+			c.latestDiscoverTime = time.Now()
+			if err := c.transport.broadcastRequest(encodeWhoIs(-1, -1)); err != nil {
+				driverbox.Log().Error("bacnet who-is error", zap.String("key", conf.ConnectionKey), zap.Error(err))
+			}
+		}
+
+		for _, sub := range c.subscriptions {
+			if c.close {
+				return
+			}
+			c.renew(sub)
+		}
+
+		for _, d := range c.devices {
+			if c.close {
+				driverbox.Log().Warn("bacnet connection is closed, ignore collect task!", zap.String("key", conf.ConnectionKey))
+				return
+			}
+			if !c.bound(d) {
+				continue
+			}
+			points := d.pollingPoints()
+			if len(points) == 0 {
+				continue
+			}
+			duration := d.duration
+			//设备不应答，按倍数延长采集间隔，最长一分钟
+			if d.timeoutCount > 0 {
+				duration = min(duration*time.Duration(1<<min(d.timeoutCount, 16)), max(d.duration, time.Minute))
+			}
+			if d.latestTime.Add(duration).After(time.Now()) {
+				continue
+			}
+
+			err := c.readPoints(d, points)
+			d.latestTime = time.Now()
+			if err != nil {
+				driverbox.Log().Error("read error", zap.String("deviceId", d.deviceId), zap.Error(err))
+				if errors.Is(err, ErrRequestTimeout) {
+					d.timeoutCount += 1
+				}
+				_ = driverbox.Shadow().MayBeOffline(d.deviceId)
+			} else {
+				d.timeoutCount = 0
+			}
+		}
+	})
+}
+
+// bound 设备地址未配置且还没有收到 I-Am 时, 按实例号发送 Who-Is
+func (c *connector) bound(d *device) bool {
+	if c.virtual || d.addr.Load() != nil {
+		return true
+	}
+	if d.latestWhoIsTime.Add(retryInterval).Before(time.Now()) {
+		d.latestWhoIsTime = time.Now()
+		if err := c.transport.broadcastRequest(encodeWhoIs(int(d.instance), int(d.instance))); err != nil {
+			driverbox.Log().Error("bacnet who-is error", zap.String("deviceId", d.deviceId), zap.Error(err))
+		}
+	}
+	return false
+}
+
+// pollingPoints 需要轮询的点位, COV 订阅成功的点位不轮询
+func (d *device) pollingPoints() []*Point {
+	points := make([]*Point, 0, len(d.points))
+	for _, p := range d.points {
+		if p.ReadWrite() != config.ReadWrite_R && p.ReadWrite() != config.ReadWrite_RW {
+			continue
+		}
+		if p.sub != nil && !p.sub.failed {
+			continue
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// createDevice 每个设备对应一台 BACnet 设备
+// 设备属性 deviceInstance 为设备实例号; address 为 IP 或 IP:端口, 不配置时通过 Who-Is 绑定;
+// 路由器后的设备还需要 network 网络号和十六进制的 mac 地址
+func (c *connector) createDevice(model config.DeviceModel, dev config.Device) error {
+	instance, err := strconv.ParseUint(dev.Properties["deviceInstance"], 10, 22)
+	if err != nil {
+		return fmt.Errorf("invalid device instance: %s", dev.Properties["deviceInstance"])
+	}
+	d := &device{deviceId: dev.ID, instance: uint32(instance)}
+	if ip := dev.Properties["address"]; ip != "" {
+		addr, err := parseAddress(ip, dev.Properties["network"], dev.Properties["mac"])
+		if err != nil {
+			return err
+		}
+		d.addr.Store(&addr)
+	}
+
+	for _, point := range model.DevicePoints {
+		ext, err := convToPointExtend(point)
+		if err != nil {
+			driverbox.Log().Error("error bacnet point config", zap.String("deviceId", dev.ID), zap.Any("point", point), zap.Error(err))
+			continue
+		}
+		ext.DeviceId = dev.ID
+		d.points = append(d.points, ext)
+		if point.ReadWrite() != config.ReadWrite_R && point.ReadWrite() != config.ReadWrite_RW {
+			continue
+		}
+		if d.duration == 0 || ext.duration < d.duration {
+			d.duration = ext.duration
+		}
+		if ext.Cov && !c.virtual {
+			c.subscribe(d, ext)
+		}
+	}
+	c.devices[dev.ID] = d
+	c.instances[d.instance] = d
+	c.discovered[d.instance] = true
+	return nil
+}
+
+// subscribe 同一对象的 COV 点位共用一个订阅, 只有 presentValue 和 statusFlags 会随 COV 通知上报
+func (c *connector) subscribe(d *device, p *Point) {
+	if p.ref.Property != PropertyPresentValue && p.ref.Property != PropertyStatusFlags {
+		driverbox.Log().Warn("bacnet cov only supports presentValue and statusFlags, use polling", zap.String("deviceId", d.deviceId), zap.String("point", p.Name()))
+		return
+	}
+	for _, sub := range c.subscriptions {
+		if sub.device == d && sub.object == p.ref.Object {
+			sub.points = append(sub.points, p)
+			p.sub = sub
+			return
+		}
+	}
+	sub := &subscription{
+		processId: uint32(len(c.subscriptions) + 1),
+		device:    d,
+		object:    p.ref.Object,
+		points:    []*Point{p},
+	}
+	c.subscriptions[sub.processId] = sub
+	p.sub = sub
+}
+
+// renew 订阅或在有效期过去四分之三时续订
+// 设备不支持 COV 时改为轮询; 超时则稍后重试, 期间点位不轮询
+func (c *connector) renew(sub *subscription) {
+	if sub.failed || !c.bound(sub.device) {
+		return
+	}
+	lifetime := time.Duration(c.config.CovLifetime) * time.Second
+	if !sub.subscribedAt.IsZero() && time.Since(sub.subscribedAt) < lifetime*3/4 {
+		return
+	}
+	if sub.retryAt.After(time.Now()) {
+		return
+	}
+	_, err := c.transport.request(*sub.device.addr.Load(), serviceSubscribeCOV, encodeSubscribeCOV(sub.processId, sub.object, c.config.CovLifetime))
+	if err == nil {
+		sub.subscribedAt = time.Now()
+		return
+	}
+	sub.subscribedAt = time.Time{}
+	sub.retryAt = time.Now().Add(retryInterval)
+	if errors.Is(err, ErrRequestTimeout) {
+		driverbox.Log().Warn("bacnet subscribe cov timeout", zap.String("deviceId", sub.device.deviceId), zap.String("object", sub.object.String()))
+		_ = driverbox.Shadow().MayBeOffline(sub.device.deviceId)
+		return
+	}
+	driverbox.Log().Warn("bacnet subscribe cov error, use polling", zap.String("deviceId", sub.device.deviceId), zap.String("object", sub.object.String()), zap.Error(err))
+	sub.failed = true
+}
+
+func convToPointExtend(extends config.Point) (*Point, error) {
+	extend := new(Point)
+	extend.Point = extends
+	if err := convutil.Struct(extends, extend); err != nil {
+		return nil, err
+	}
+	if extend.ObjectType == "" {
+		return nil, errors.New("objectType missed")
+	}
+	objectType, err := parseObjectType(extend.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+	if extend.ObjectInstance > maxObjectInstance {
+		return nil, fmt.Errorf("invalid object instance: %d", extend.ObjectInstance)
+	}
+	if extend.Property == "" {
+		extend.Property = "presentValue"
+	}
+	property, err := parseProperty(extend.Property)
+	if err != nil {
+		return nil, err
+	}
+	extend.ref = propertyRef{
+		Object:   objectId{Type: objectType, Instance: extend.ObjectInstance},
+		Property: property,
+		Index:    arrayIndexUnset,
+	}
+	if extend.Priority == 0 {
+		extend.Priority = 16
+	}
+	if extend.Priority > 16 {
+		return nil, fmt.Errorf("invalid priority: %d", extend.Priority)
+	}
+	//未设置，则默认每分钟轮询一次
+	if extend.Duration == "" {
+		extend.Duration = "1m"
+	}
+	duration, err := time.ParseDuration(extend.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("convert duration error: %s", err.Error())
+	}
+	extend.duration = duration
+	return extend, nil
+}
+
+func (d *device) point(name string) (*Point, bool) {
+	for _, p := range d.points {
+		if p.Name() == name {
+			return p, true
+		}
+	}
+	return nil, false
+}
+
+// Encode 编码数据
+// 读操作读取指定的点位, 未指定时读取全部可读点位; 写操作一次写一个点位, 值为 null 时释放该优先级
+func (c *connector) Encode(deviceId string, mode plugin.EncodeMode, values ...plugin.PointData) (res interface{}, err error) {
+	d, ok := c.devices[deviceId]
+	if !ok {
+		return nil, fmt.Errorf("device [%s] not found", deviceId)
+	}
+
+	switch mode {
+	case plugin.ReadMode:
+		points := make([]*Point, 0, len(values))
+		for _, v := range values {
+			p, ok := d.point(v.PointName)
+			if !ok {
+				return nil, fmt.Errorf("point [%s] not found", v.PointName)
+			}
+			points = append(points, p)
+		}
+		if len(points) == 0 {
+			for _, p := range d.points {
+				if p.ReadWrite() == config.ReadWrite_R || p.ReadWrite() == config.ReadWrite_RW {
+					points = append(points, p)
+				}
+			}
+		}
+		return command{Mode: plugin.ReadMode, Value: readValue{device: d, points: points}}, nil
+	case plugin.WriteMode:
+		if len(values) != 1 {
+			return nil, errors.New("bacnet only supports writing one point at a time")
+		}
+		p, ok := d.point(values[0].PointName)
+		if !ok {
+			return nil, fmt.Errorf("point [%s] not found", values[0].PointName)
+		}
+		if p.ReadWrite() != config.ReadWrite_W && p.ReadWrite() != config.ReadWrite_RW {
+			return nil, fmt.Errorf("point [%s] is not writable", values[0].PointName)
+		}
+		return command{
+			Mode:  plugin.WriteMode,
+			Value: writeValue{device: d, point: p, value: values[0].Value, priority: p.Priority},
+		}, nil
+	}
+	return nil, plugin.NotSupportEncode
+}
+
+// Send 发送数据
+func (c *connector) Send(data interface{}) (err error) {
+	cmd, ok := data.(command)
+	if !ok {
+		return errors.New("unsupported data type")
+	}
+	switch cmd.Mode {
+	case plugin.ReadMode:
+		rv := cmd.Value.(readValue)
+		return c.readPoints(rv.device, rv.points)
+	case plugin.WriteMode:
+		return c.writePoint(cmd.Value.(writeValue))
+	}
+	return errors.New("not support mode error")
+}
+
+// Release 释放资源
+// 不释放连接资源, UDP 端口由连接器一直持有
+func (c *connector) Release() (err error) {
+	return
+}
+
+// Close 关闭连接, 不取消 COV 订阅, 订阅到期后设备自行删除
+func (c *connector) Close() {
+	c.close = true
+	if c.collectTask != nil {
+		c.collectTask.Disable()
+	}
+	c.transport.close()
+}
+
+// readPoints 优先用 ReadPropertyMultiple 读取, 设备不支持时逐个 ReadProperty
+func (c *connector) readPoints(d *device, points []*Point) error {
+	if c.virtual {
+		return c.mockRead(d, points)
+	}
+	addr := d.addr.Load()
+	if addr == nil {
+		return fmt.Errorf("device instance %d address not bound", d.instance)
+	}
+
+	// 同一属性只读一次
+	refs := make([]propertyRef, 0, len(points))
+	byRef := make(map[propertyRef][]*Point)
+	for _, p := range points {
+		if _, ok := byRef[p.ref]; !ok {
+			refs = append(refs, p.ref)
+		}
+		byRef[p.ref] = append(byRef[p.ref], p)
+	}
+
+	var results []propertyResult
+	var err error
+	if !d.rpmUnsupported.Load() {
+		results, err = c.readMultiple(*addr, refs)
+		if unsupported(err) {
+			driverbox.Log().Warn("bacnet read property multiple unsupported, use read property", zap.String("deviceId", d.deviceId), zap.Error(err))
+			d.rpmUnsupported.Store(true)
+		}
+	}
+	if d.rpmUnsupported.Load() {
+		results, err = c.readEach(*addr, refs)
+	}
+
+	pointData := make([]plugin.PointData, 0, len(points))
+	for _, r := range results {
+		for _, p := range byRef[r.propertyRef] {
+			if r.Err != nil {
+				driverbox.Log().Warn("bacnet read property error", zap.String("deviceId", d.deviceId), zap.String("point", p.Name()), zap.Error(r.Err))
+				continue
+			}
+			pointData = append(pointData, plugin.PointData{PointName: p.Name(), Value: r.Value})
+		}
+	}
+	if len(pointData) > 0 {
+		driverbox.Export([]plugin.DeviceData{{ID: d.deviceId, Values: pointData}})
+	}
+	return err
+}
+
+// unsupported 设备拒绝服务, 或应答需要分段
+func unsupported(err error) bool {
+	var re RejectError
+	var ae AbortError
+	return errors.As(err, &re) && re.Reason == rejectUnrecognizedService || errors.As(err, &ae) || errors.Is(err, ErrSegmentation)
+}
+
+// readMultiple 按 MaxReadProperties 分批读取
+func (c *connector) readMultiple(addr address, refs []propertyRef) (results []propertyResult, err error) {
+	for i := 0; i < len(refs); i += c.config.MaxReadProperties {
+		batch := refs[i:min(i+c.config.MaxReadProperties, len(refs))]
+		data, err := c.transport.request(addr, serviceReadPropertyMultiple, encodeReadPropertyMultiple(batch))
+		if err != nil {
+			return results, err
+		}
+		rs, err := decodeReadPropertyMultipleAck(data)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, rs...)
+	}
+	return results, nil
+}
+
+// readEach 逐个读取, 属性读取出错时记录在结果中, 超时则停止
+func (c *connector) readEach(addr address, refs []propertyRef) (results []propertyResult, err error) {
+	for _, ref := range refs {
+		r := propertyResult{propertyRef: ref}
+		data, err := c.transport.request(addr, serviceReadProperty, encodeReadProperty(ref))
+		if err == nil {
+			r.Value, err = decodeReadPropertyAck(data)
+		}
+		var be Error
+		var re RejectError
+		if err != nil && !errors.As(err, &be) && !errors.As(err, &re) {
+			return results, err
+		}
+		r.Err = err
+		results = append(results, r)
+	}
+	return results, nil
+}
+
+// writePoint 写入属性, 写入成功后立即读回上报
+func (c *connector) writePoint(wv writeValue) error {
+	if c.virtual {
+		return c.mockWrite(wv)
+	}
+	addr := wv.device.addr.Load()
+	if addr == nil {
+		return fmt.Errorf("device instance %d address not bound", wv.device.instance)
+	}
+	value, err := encodeWriteValue(wv.point.ref, wv.value)
+	if err != nil {
+		return err
+	}
+	_, err = c.transport.request(*addr, serviceWriteProperty, encodeWriteProperty(wv.point.ref, value, wv.priority))
+	if err != nil {
+		return fmt.Errorf("write [%s] %s error: %w", wv.device.deviceId, wv.point.Name(), err)
+	}
+	driverbox.Log().Info("bacnet write property", zap.String("deviceId", wv.device.deviceId), zap.String("point", wv.point.Name()),
+		zap.Any("value", wv.value), zap.Uint8("priority", wv.priority))
+	if wv.point.ReadWrite() == config.ReadWrite_RW {
+		return c.readPoints(wv.device, []*Point{wv.point})
+	}
+	return nil
+}
+
+// encodeWriteValue 按对象类型编码 presentValue: 模拟量为实数, 开关量为枚举 0、1, 多态为无符号数;
+// 其他属性按值的类型编码; nil 或 "null" 编码为 Null, 用于释放优先级
+func encodeWriteValue(ref propertyRef, v interface{}) ([]byte, error) {
+	if v == nil || v == "null" {
+		return appendAppNull(nil), nil
+	}
+	if ref.Property == PropertyPresentValue {
+		switch ref.Object.Type {
+		case ObjectAnalogInput, ObjectAnalogOutput, ObjectAnalogValue:
+			f, err := convutil.Float64(v)
+			if err != nil {
+				return nil, err
+			}
+			return appendAppReal(nil, float32(f)), nil
+		case ObjectBinaryInput, ObjectBinaryOutput, ObjectBinaryValue:
+			i, err := convutil.Int64(v)
+			if err != nil {
+				return nil, err
+			}
+			if i != 0 && i != 1 {
+				return nil, fmt.Errorf("invalid binary value: %v", v)
+			}
+			return appendAppEnumerated(nil, uint32(i)), nil
+		case ObjectMultiStateInput, ObjectMultiStateOutput, ObjectMultiStateValue:
+			i, err := convutil.Int64(v)
+			if err != nil {
+				return nil, err
+			}
+			if i < 1 || i > math.MaxUint32 {
+				return nil, fmt.Errorf("invalid multi-state value: %v", v)
+			}
+			return appendAppUnsigned(nil, uint32(i)), nil
+		}
+	}
+	switch val := v.(type) {
+	case bool:
+		return appendAppBoolean(nil, val), nil
+	case string:
+		if _, err := strconv.ParseFloat(val, 64); err != nil {
+			return appendAppCharacterString(nil, val), nil
+		}
+	}
+	f, err := convutil.Float64(v)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case f != math.Trunc(f):
+		return appendAppReal(nil, float32(f)), nil
+	case f >= 0 && f <= math.MaxUint32:
+		return appendAppUnsigned(nil, uint32(f)), nil
+	case f < 0 && f >= math.MinInt32:
+		return appendAppSigned(nil, int32(f)), nil
+	}
+	return nil, fmt.Errorf("unsupported write value: %v", v)
+}
+
+// handle 处理 I-Am 和 COV 通知, 在接收协程中执行
+func (c *connector) handle(src address, service byte, data []byte) {
+	switch service {
+	case serviceIAm:
+		r, err := decodeIAm(data)
+		if err != nil || r.Device.Type != ObjectDevice {
+			return
+		}
+		c.iAm(src, r)
+	case serviceUnconfirmedCOVNotification, serviceConfirmedCOVNotification:
+		n, err := decodeCOVNotification(data)
+		if err != nil {
+			driverbox.Log().Warn("bacnet cov notification error", zap.String("from", src.String()), zap.Error(err))
+			return
+		}
+		c.notify(n)
+	}
+}
+
+// iAm 已配置的设备绑定地址, 未知的设备触发设备自动发现
+func (c *connector) iAm(src address, r iAm) {
+	if d, ok := c.instances[r.Device.Instance]; ok {
+		if old := d.addr.Load(); old == nil || old.String() != src.String() {
+			d.addr.Store(&src)
+			driverbox.Log().Info("bacnet device address bound", zap.String("deviceId", d.deviceId), zap.String("address", src.String()))
+		}
+		return
+	}
+	if !c.config.Discover || c.discovered[r.Device.Instance] {
+		return
+	}
+	c.discovered[r.Device.Instance] = true
+	// 读取设备名称需要等待应答, 不能阻塞接收协程
+	go c.discover(src, r)
+}
+
+func (c *connector) discover(src address, r iAm) {
+	instance := strconv.FormatUint(uint64(r.Device.Instance), 10)
+	description := "bacnet device " + instance
+	if data, err := c.transport.request(src, serviceReadProperty, encodeReadProperty(propertyRef{Object: r.Device, Property: PropertyObjectName, Index: arrayIndexUnset})); err == nil {
+		if v, err := decodeReadPropertyAck(data); err == nil {
+			if name, ok := v.(string); ok && name != "" {
+				description = name
+			}
+		}
+	}
+
+	deviceId := ProtocolName + "_" + instance
+	properties := map[string]string{
+		"deviceInstance": instance,
+		"address":        src.IP.String(),
+	}
+	if src.Net != 0 {
+		properties["network"] = strconv.Itoa(int(src.Net))
+		properties["mac"] = fmt.Sprintf("%x", src.Mac)
+	}
+	driverbox.Log().Info("bacnet device discovered", zap.String("key", c.config.ConnectionKey), zap.String("address", src.String()),
+		zap.String("instance", instance), zap.Uint32("vendor", r.Vendor))
+	deviceData := []plugin.DeviceData{{
+		ID: deviceId,
+		Events: []event.Data{{
+			Code: event.DeviceDiscover,
+			Value: map[string]interface{}{
+				"modelKey": c.config.ModelKey,
+				"device": map[string]interface{}{
+					"id":          deviceId,
+					"description": description,
+					"properties":  properties,
+				},
+			},
+		}},
+	}}
+	plugin.WrapperDiscoverEvent(deviceData, c.config.ConnectionKey, ProtocolName)
+	driverbox.Export(deviceData)
+}
+
+// notify COV 通知按属性上报订阅的点位
+func (c *connector) notify(n covNotification) {
+	sub, ok := c.subscriptions[n.ProcessId]
+	if !ok || sub.device.instance != n.Device.Instance || sub.object != n.Object {
+		return
+	}
+	pointData := make([]plugin.PointData, 0, len(sub.points))
+	for _, v := range n.Values {
+		for _, p := range sub.points {
+			if p.ref.Property == v.Property {
+				pointData = append(pointData, plugin.PointData{PointName: p.Name(), Value: v.Value})
+			}
+		}
+	}
+	if len(pointData) > 0 {
+		driverbox.Export([]plugin.DeviceData{{ID: sub.device.deviceId, Values: pointData}})
+	}
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/fileutil"
+	"github.com/twiglab/h2o/nab/box/pkg/luautil"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var ls *lua.LState
+
+// InitMockLua 虚拟模式下由脚本中的 mockBacnetRead、mockBacnetWrite 模拟设备
+func InitMockLua() {
+	if ls == nil {
+		path := filepath.Join(config.ResourcePath, "driver", ProtocolName, "converter.lua")
+		if !fileutil.FileExists(path) {
+			path = filepath.Join(config.ResourcePath, "driver", "virtual", "converter.lua")
+		}
+		if fileutil.FileExists(path) {
+			l, err := luautil.InitLuaVM(path)
+			if err != nil {
+				driverbox.Log().Error("init lua vm error", zap.Error(err))
+				return
+			}
+			ls = l
+		}
+	}
+}
+
+// mockRead 脚本按设备实例号、对象如 analogInput:1、属性编号返回属性值, 数字按数值上报
+func (c *connector) mockRead(d *device, points []*Point) error {
+	if ls == nil {
+		return errors.New("lua vm is nil")
+	}
+	pointData := make([]plugin.PointData, 0, len(points))
+	for _, p := range points {
+		mockData, err := luautil.CallLuaMethod(ls, "mockBacnetRead", lua.LNumber(d.instance), lua.LString(p.ref.Object.String()), lua.LNumber(p.ref.Property))
+		if err != nil {
+			return err
+		}
+		var value interface{} = mockData
+		if f, err := strconv.ParseFloat(mockData, 64); err == nil {
+			value = f
+		}
+		pointData = append(pointData, plugin.PointData{PointName: p.Name(), Value: value})
+	}
+	driverbox.Export([]plugin.DeviceData{{ID: d.deviceId, Values: pointData}})
+	return nil
+}
+
+func (c *connector) mockWrite(wv writeValue) error {
+	if ls == nil {
+		return errors.New("lua vm is nil")
+	}
+	result, err := luautil.CallLuaMethod(ls, "mockBacnetWrite", lua.LNumber(wv.device.instance), lua.LString(wv.point.ref.Object.String()),
+		lua.LNumber(wv.point.ref.Property), lua.LString(fmt.Sprint(wv.value)), lua.LNumber(wv.priority))
+	if err == nil {
+		driverbox.Log().Info("mockWrite result", zap.Any("result", result))
+	}
+	return err
+}
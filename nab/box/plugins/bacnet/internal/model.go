@@ -0,0 +1,105 @@
+package internal
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+)
+
+// ConnectionConfig 连接器配置
+type ConnectionConfig struct {
+	plugin.BaseConnection
+	// 本地绑定地址，默认 0.0.0.0:47808，设备的 I-Am 广播发往 47808 端口
+	LocalAddress string `json:"localAddress"`
+	// 广播地址，默认 255.255.255.255:47808，建议配置为所在网段的广播地址
+	BroadcastAddress string `json:"broadcastAddress"`
+	Timeout          uint16 `json:"timeout"` // 请求超时，毫秒
+	Retry            int    `json:"retry"`   // 重试次数
+	// 一次 ReadPropertyMultiple 最多读取的属性数，避免应答超过 APDU 长度需要分段，默认 20
+	MaxReadProperties int `json:"maxReadProperties"`
+	// COV 订阅的有效期，秒，到期前续订，默认 300
+	CovLifetime uint32 `json:"covLifetime"`
+	// 自动发现时使用的模型 Key
+	ModelKey string `json:"modelKey"`
+	// Who-Is 广播的间隔，默认十分钟
+	DiscoverDuration string `json:"discoverDuration"`
+}
+
+// Point bacnet 点位
+type Point struct {
+	config.Point
+	DeviceId string
+
+	// 对象类型，如 analogInput、binaryValue、multiStateValue，或类型编号
+	ObjectType string `json:"objectType"`
+	// 对象实例号
+	ObjectInstance uint32 `json:"objectInstance"`
+	// 属性，默认 presentValue
+	Property string `json:"property"`
+	// 写入优先级 1-16，默认 16
+	Priority uint8 `json:"priority"`
+	// 是否订阅 COV，订阅成功后不再轮询
+	Cov bool `json:"cov"`
+	// 点位轮询周期，同一设备取最短的周期，默认一分钟
+	Duration string `json:"duration"`
+
+	ref      propertyRef
+	duration time.Duration
+	// 所属的 COV 订阅
+	sub *subscription
+}
+
+// device 一台 BACnet 设备
+type device struct {
+	deviceId string
+	instance uint32
+	// 设备地址，未配置时由 Who-Is/I-Am 绑定
+	addr atomic.Pointer[address]
+	// 不支持 ReadPropertyMultiple 时逐个读取
+	rpmUnsupported atomic.Bool
+	// 最近一次按实例号发送 Who-Is 的时间
+	latestWhoIsTime time.Time
+
+	points []*Point
+	// 轮询周期
+	duration   time.Duration
+	latestTime time.Time
+	// 最近连续超时次数
+	timeoutCount int
+}
+
+// subscription 一个对象的 COV 订阅
+type subscription struct {
+	processId uint32
+	device    *device
+	object    objectId
+	points    []*Point
+	// 订阅成功的时间，零值表示未订阅
+	subscribedAt time.Time
+	// 超时后下次重试的时间
+	retryAt time.Time
+	// 设备不支持 COV 时这些点位改为轮询
+	failed bool
+}
+
+// Connector#Send接入入参
+type command struct {
+	Mode  plugin.EncodeMode // 模式
+	Value interface{}
+}
+
+// 读操作时 command 的 value 类型
+type readValue struct {
+	device *device
+	points []*Point
+}
+
+// 写操作时 command 的 value 类型
+type writeValue struct {
+	device   *device
+	point    *Point
+	value    interface{}
+	priority uint8
+}
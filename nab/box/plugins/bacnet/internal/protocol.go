@@ -0,0 +1,591 @@
+package internal
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// BACnet/IP 报文 (ASHRAE 135 附录 J):
+// BVLC: 81 功能码 长度(2)
+// NPDU: 版本 01, 控制字, [DNET DLEN DADR], [SNET SLEN SADR], [跳数]
+// APDU: 类型和标志, 服务参数
+const (
+	bvlcType              byte = 0x81
+	bvlcResult            byte = 0x00
+	bvlcForwardedNPDU     byte = 0x04
+	bvlcOriginalUnicast   byte = 0x0A
+	bvlcOriginalBroadcast byte = 0x0B
+
+	npduVersion        byte = 0x01
+	npduNetworkMessage byte = 0x80
+	npduDestination    byte = 0x20
+	npduSource         byte = 0x08
+	npduExpectingReply byte = 0x04
+
+	// APDU 类型
+	pduConfirmedRequest   byte = 0x00
+	pduUnconfirmedRequest byte = 0x10
+	pduSimpleAck          byte = 0x20
+	pduComplexAck         byte = 0x30
+	pduSegmentAck         byte = 0x40
+	pduError              byte = 0x50
+	pduReject             byte = 0x60
+	pduAbort              byte = 0x70
+
+	// ComplexACK 分段标志
+	pduSegmented byte = 0x08
+
+	// 不接受分段应答, 最大 APDU 1476 字节
+	maxApduAccepted byte = 0x05
+
+	// 确认服务
+	serviceConfirmedCOVNotification byte = 1
+	serviceSubscribeCOV             byte = 5
+	serviceReadProperty             byte = 12
+	serviceReadPropertyMultiple     byte = 14
+	serviceWriteProperty            byte = 15
+
+	// 非确认服务
+	serviceIAm                        byte = 0
+	serviceUnconfirmedCOVNotification byte = 2
+	serviceWhoIs                      byte = 8
+
+	// Reject 原因: 不认识的服务
+	rejectUnrecognizedService byte = 9
+)
+
+// 对象类型
+const (
+	ObjectAnalogInput      uint16 = 0
+	ObjectAnalogOutput     uint16 = 1
+	ObjectAnalogValue      uint16 = 2
+	ObjectBinaryInput      uint16 = 3
+	ObjectBinaryOutput     uint16 = 4
+	ObjectBinaryValue      uint16 = 5
+	ObjectDevice           uint16 = 8
+	ObjectMultiStateInput  uint16 = 13
+	ObjectMultiStateOutput uint16 = 14
+	ObjectMultiStateValue  uint16 = 19
+
+	maxObjectInstance uint32 = 0x3FFFFF
+	// 不指定数组下标
+	arrayIndexUnset = -1
+)
+
+var objectTypes = map[string]uint16{
+	"analogInput":      ObjectAnalogInput,
+	"analogOutput":     ObjectAnalogOutput,
+	"analogValue":      ObjectAnalogValue,
+	"binaryInput":      ObjectBinaryInput,
+	"binaryOutput":     ObjectBinaryOutput,
+	"binaryValue":      ObjectBinaryValue,
+	"device":           ObjectDevice,
+	"multiStateInput":  ObjectMultiStateInput,
+	"multiStateOutput": ObjectMultiStateOutput,
+	"multiStateValue":  ObjectMultiStateValue,
+}
+
+// 属性标识
+const (
+	PropertyDescription       uint32 = 28
+	PropertyEventState        uint32 = 36
+	PropertyModelName         uint32 = 70
+	PropertyNumberOfStates    uint32 = 74
+	PropertyObjectList        uint32 = 76
+	PropertyObjectName        uint32 = 77
+	PropertyOutOfService      uint32 = 81
+	PropertyPresentValue      uint32 = 85
+	PropertyPriorityArray     uint32 = 87
+	PropertyReliability       uint32 = 103
+	PropertyRelinquishDefault uint32 = 104
+	PropertyStatusFlags       uint32 = 111
+	PropertyUnits             uint32 = 117
+	PropertyVendorName        uint32 = 121
+)
+
+var properties = map[string]uint32{
+	"description":       PropertyDescription,
+	"eventState":        PropertyEventState,
+	"modelName":         PropertyModelName,
+	"numberOfStates":    PropertyNumberOfStates,
+	"objectList":        PropertyObjectList,
+	"objectName":        PropertyObjectName,
+	"outOfService":      PropertyOutOfService,
+	"presentValue":      PropertyPresentValue,
+	"priorityArray":     PropertyPriorityArray,
+	"reliability":       PropertyReliability,
+	"relinquishDefault": PropertyRelinquishDefault,
+	"statusFlags":       PropertyStatusFlags,
+	"units":             PropertyUnits,
+	"vendorName":        PropertyVendorName,
+}
+
+// parseObjectType 对象类型名称, 如 analogInput, 或类型编号
+func parseObjectType(s string) (uint16, error) {
+	if t, ok := objectTypes[s]; ok {
+		return t, nil
+	}
+	t, err := strconv.ParseUint(s, 10, 10)
+	if err != nil {
+		return 0, fmt.Errorf("invalid object type: %s", s)
+	}
+	return uint16(t), nil
+}
+
+// parseProperty 属性名称, 如 presentValue, 或属性编号
+func parseProperty(s string) (uint32, error) {
+	if p, ok := properties[s]; ok {
+		return p, nil
+	}
+	p, err := strconv.ParseUint(s, 10, 22)
+	if err != nil {
+		return 0, fmt.Errorf("invalid property: %s", s)
+	}
+	return uint32(p), nil
+}
+
+var (
+	ErrPacketInvalid   = errors.New("bacnet packet invalid")
+	ErrRequestTimeout  = errors.New("bacnet request timeout")
+	ErrSegmentation    = errors.New("bacnet segmented response not supported")
+	errNetworkMessage  = errors.New("bacnet network layer message")
+	errNotForThisLayer = errors.New("bacnet bvlc message")
+)
+
+// Error 设备返回的 Error PDU
+type Error struct {
+	Class uint32
+	Code  uint32
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("bacnet error class %d code %d", e.Class, e.Code)
+}
+
+// RejectError 设备拒绝请求
+type RejectError struct {
+	Reason byte
+}
+
+func (e RejectError) Error() string {
+	return fmt.Sprintf("bacnet reject reason %d", e.Reason)
+}
+
+// AbortError 设备中止事务
+type AbortError struct {
+	Reason byte
+}
+
+func (e AbortError) Error() string {
+	return fmt.Sprintf("bacnet abort reason %d", e.Reason)
+}
+
+// objectId 对象标识: 类型 10 位, 实例号 22 位
+type objectId struct {
+	Type     uint16
+	Instance uint32
+}
+
+func (o objectId) encode() uint32 {
+	return uint32(o.Type)<<22 | o.Instance&maxObjectInstance
+}
+
+func decodeObjectId(v uint32) objectId {
+	return objectId{Type: uint16(v >> 22), Instance: v & maxObjectInstance}
+}
+
+func (o objectId) String() string {
+	for name, t := range objectTypes {
+		if t == o.Type {
+			return name + ":" + strconv.FormatUint(uint64(o.Instance), 10)
+		}
+	}
+	return strconv.Itoa(int(o.Type)) + ":" + strconv.FormatUint(uint64(o.Instance), 10)
+}
+
+// address 设备地址, 本网段的设备只有 IP 地址, 路由器后的设备 (如 MS/TP) 还有网络号和 MAC 地址
+type address struct {
+	IP  *net.UDPAddr
+	Net uint16
+	Mac []byte
+}
+
+func (a address) String() string {
+	if a.Net == 0 {
+		return a.IP.String()
+	}
+	return fmt.Sprintf("%s/%d/%s", a.IP, a.Net, hex.EncodeToString(a.Mac))
+}
+
+// encodePacket 编码 BVLC、NPDU 和 APDU, 广播时 dst 只用网络号
+func encodePacket(dst address, broadcast, expectingReply bool, apdu []byte) []byte {
+	buf := make([]byte, 4, 4+8+len(dst.Mac)+len(apdu))
+	buf[0] = bvlcType
+	buf[1] = bvlcOriginalUnicast
+	if broadcast {
+		buf[1] = bvlcOriginalBroadcast
+	}
+	control := byte(0)
+	if expectingReply {
+		control |= npduExpectingReply
+	}
+	if dst.Net != 0 {
+		control |= npduDestination
+	}
+	buf = append(buf, npduVersion, control)
+	if dst.Net != 0 {
+		buf = binary.BigEndian.AppendUint16(buf, dst.Net)
+		buf = append(buf, byte(len(dst.Mac)))
+		buf = append(buf, dst.Mac...)
+		// 跳数
+		buf = append(buf, 0xFF)
+	}
+	buf = append(buf, apdu...)
+	binary.BigEndian.PutUint16(buf[2:], uint16(len(buf)))
+	return buf
+}
+
+// decodePacket 解析 BVLC 和 NPDU, 返回源地址和 APDU
+// from 为 UDP 源地址, 转发的报文使用 BVLC 中的原始地址
+func decodePacket(buf []byte, from *net.UDPAddr) (src address, apdu []byte, err error) {
+	if len(buf) < 4 || buf[0] != bvlcType {
+		return src, nil, fmt.Errorf("%w: bvlc header", ErrPacketInvalid)
+	}
+	if int(binary.BigEndian.Uint16(buf[2:])) != len(buf) {
+		return src, nil, fmt.Errorf("%w: bvlc length", ErrPacketInvalid)
+	}
+	src.IP = from
+	npdu := buf[4:]
+	switch buf[1] {
+	case bvlcOriginalUnicast, bvlcOriginalBroadcast:
+	case bvlcForwardedNPDU:
+		if len(npdu) < 6 {
+			return src, nil, fmt.Errorf("%w: forwarded npdu", ErrPacketInvalid)
+		}
+		src.IP = &net.UDPAddr{IP: net.IP(append([]byte(nil), npdu[:4]...)), Port: int(binary.BigEndian.Uint16(npdu[4:6]))}
+		npdu = npdu[6:]
+	default:
+		return src, nil, errNotForThisLayer
+	}
+
+	if len(npdu) < 2 || npdu[0] != npduVersion {
+		return src, nil, fmt.Errorf("%w: npdu header", ErrPacketInvalid)
+	}
+	control := npdu[1]
+	i := 2
+	if control&npduDestination != 0 {
+		if len(npdu) < i+3 {
+			return src, nil, fmt.Errorf("%w: npdu destination", ErrPacketInvalid)
+		}
+		i += 3 + int(npdu[i+2])
+	}
+	if control&npduSource != 0 {
+		if len(npdu) < i+3 || len(npdu) < i+3+int(npdu[i+2]) {
+			return src, nil, fmt.Errorf("%w: npdu source", ErrPacketInvalid)
+		}
+		src.Net = binary.BigEndian.Uint16(npdu[i:])
+		l := int(npdu[i+2])
+		src.Mac = append([]byte(nil), npdu[i+3:i+3+l]...)
+		i += 3 + l
+	}
+	if control&npduDestination != 0 {
+		i++
+	}
+	if control&npduNetworkMessage != 0 {
+		return src, nil, errNetworkMessage
+	}
+	if len(npdu) <= i {
+		return src, nil, fmt.Errorf("%w: apdu missed", ErrPacketInvalid)
+	}
+	return src, npdu[i:], nil
+}
+
+// parseAddress 设备属性中的地址: IP 或 IP:端口, 路由器后的设备还有网络号和十六进制的 MAC 地址
+func parseAddress(ip, network, mac string) (a address, err error) {
+	if !strings.Contains(ip, ":") {
+		ip += ":47808"
+	}
+	if a.IP, err = net.ResolveUDPAddr("udp4", ip); err != nil {
+		return a, fmt.Errorf("invalid bacnet address: %s", ip)
+	}
+	if network == "" {
+		return a, nil
+	}
+	n, err := strconv.ParseUint(network, 10, 16)
+	if err != nil || n == 0 || n == 0xFFFF {
+		return a, fmt.Errorf("invalid bacnet network: %s", network)
+	}
+	a.Net = uint16(n)
+	if a.Mac, err = hex.DecodeString(mac); err != nil || len(a.Mac) == 0 {
+		return a, fmt.Errorf("invalid bacnet mac: %s", mac)
+	}
+	return a, nil
+}
+
+// confirmedRequest 确认请求的 APDU 头, 不分段
+func confirmedRequest(invokeId, service byte, params []byte) []byte {
+	buf := make([]byte, 0, 4+len(params))
+	buf = append(buf, pduConfirmedRequest, maxApduAccepted, invokeId, service)
+	return append(buf, params...)
+}
+
+func unconfirmedRequest(service byte, params []byte) []byte {
+	return append([]byte{pduUnconfirmedRequest, service}, params...)
+}
+
+// encodeWhoIs 实例号范围, low 为负数时不限范围
+func encodeWhoIs(low, high int) []byte {
+	if low < 0 {
+		return unconfirmedRequest(serviceWhoIs, nil)
+	}
+	var buf []byte
+	buf = appendContextUnsigned(buf, 0, uint32(low))
+	buf = appendContextUnsigned(buf, 1, uint32(high))
+	return unconfirmedRequest(serviceWhoIs, buf)
+}
+
+// iAm I-Am 服务参数
+type iAm struct {
+	Device       objectId
+	MaxApdu      uint32
+	Segmentation uint32
+	Vendor       uint32
+}
+
+func decodeIAm(buf []byte) (r iAm, err error) {
+	var vs [4]tag
+	for i := range vs {
+		t, n, err := decodeTag(buf)
+		if err != nil {
+			return r, err
+		}
+		if t.Context {
+			return r, fmt.Errorf("%w: i-am", ErrPacketInvalid)
+		}
+		vs[i] = t
+		buf = buf[n:]
+	}
+	if vs[0].Number != tagObjectId || len(vs[0].Data) != 4 {
+		return r, fmt.Errorf("%w: i-am device", ErrPacketInvalid)
+	}
+	r.Device = decodeObjectId(binary.BigEndian.Uint32(vs[0].Data))
+	r.MaxApdu = decodeUnsigned(vs[1].Data)
+	r.Segmentation = decodeUnsigned(vs[2].Data)
+	r.Vendor = decodeUnsigned(vs[3].Data)
+	return r, nil
+}
+
+// propertyRef 属性引用
+type propertyRef struct {
+	Object   objectId
+	Property uint32
+	// 数组下标, -1 表示整个属性
+	Index int
+}
+
+func appendPropertyRef(buf []byte, ref propertyRef, first byte) []byte {
+	buf = appendContextUnsigned(buf, first, ref.Property)
+	if ref.Index >= 0 {
+		buf = appendContextUnsigned(buf, first+1, uint32(ref.Index))
+	}
+	return buf
+}
+
+func encodeReadProperty(ref propertyRef) []byte {
+	buf := appendContextObjectId(nil, 0, ref.Object)
+	return appendPropertyRef(buf, ref, 1)
+}
+
+// decodeReadPropertyAck ReadProperty 的 ComplexACK: 对象 属性 [下标] [3 值 3]
+func decodeReadPropertyAck(buf []byte) (interface{}, error) {
+	for len(buf) > 0 {
+		t, n, err := decodeTag(buf)
+		if err != nil {
+			return nil, err
+		}
+		buf = buf[n:]
+		if t.isOpening(3) {
+			v, _, err := decodeConstructed(buf, 3)
+			return v, err
+		}
+	}
+	return nil, fmt.Errorf("%w: property value missed", ErrPacketInvalid)
+}
+
+// encodeReadPropertyMultiple 同一对象的属性合并到一个读访问规范
+func encodeReadPropertyMultiple(refs []propertyRef) []byte {
+	var buf []byte
+	for i, ref := range refs {
+		if i == 0 || refs[i-1].Object != ref.Object {
+			if i > 0 {
+				buf = appendClosing(buf, 1)
+			}
+			buf = appendContextObjectId(buf, 0, ref.Object)
+			buf = appendOpening(buf, 1)
+		}
+		buf = appendPropertyRef(buf, ref, 0)
+	}
+	if len(refs) > 0 {
+		buf = appendClosing(buf, 1)
+	}
+	return buf
+}
+
+// propertyResult ReadPropertyMultiple 的单个结果, 读取失败时 Err 不为空
+type propertyResult struct {
+	propertyRef
+	Value interface{}
+	Err   error
+}
+
+// decodeReadPropertyMultipleAck 解析读访问结果:
+// 0 对象 1 { 2 属性 [3 下标] (4 值 4 | 5 错误类 错误码 5) } 1
+func decodeReadPropertyMultipleAck(buf []byte) (results []propertyResult, err error) {
+	var obj objectId
+	var cur *propertyResult
+	for len(buf) > 0 {
+		t, n, err := decodeTag(buf)
+		if err != nil {
+			return results, err
+		}
+		buf = buf[n:]
+		switch {
+		case t.is(true, 0):
+			obj = decodeObjectId(decodeUnsigned(t.Data))
+		case t.isOpening(1), t.isClosing(1):
+		case t.is(true, 2):
+			results = append(results, propertyResult{propertyRef: propertyRef{Object: obj, Property: decodeUnsigned(t.Data), Index: arrayIndexUnset}})
+			cur = &results[len(results)-1]
+		case t.is(true, 3) && cur != nil:
+			cur.Index = int(decodeUnsigned(t.Data))
+		case t.isOpening(4) && cur != nil:
+			v, l, err := decodeConstructed(buf, 4)
+			if err != nil {
+				return results, err
+			}
+			cur.Value = v
+			buf = buf[l:]
+		case t.isOpening(5) && cur != nil:
+			v, l, err := decodeConstructed(buf, 5)
+			if err != nil {
+				return results, err
+			}
+			cur.Err = decodeErrorValues(v)
+			buf = buf[l:]
+		default:
+			return results, fmt.Errorf("%w: unexpected tag %d", ErrPacketInvalid, t.Number)
+		}
+	}
+	return results, nil
+}
+
+func decodeErrorValues(v interface{}) error {
+	if vs, ok := v.([]interface{}); ok && len(vs) == 2 {
+		class, _ := vs[0].(uint32)
+		code, _ := vs[1].(uint32)
+		return Error{Class: class, Code: code}
+	}
+	return Error{}
+}
+
+// encodeWriteProperty value 为已编码的应用标签, priority 为 0 时不带优先级
+func encodeWriteProperty(ref propertyRef, value []byte, priority uint8) []byte {
+	buf := appendContextObjectId(nil, 0, ref.Object)
+	buf = appendPropertyRef(buf, ref, 1)
+	buf = appendOpening(buf, 3)
+	buf = append(buf, value...)
+	buf = appendClosing(buf, 3)
+	if priority > 0 {
+		buf = appendContextUnsigned(buf, 4, uint32(priority))
+	}
+	return buf
+}
+
+// encodeSubscribeCOV lifetime 为 0 时取消订阅
+func encodeSubscribeCOV(processId uint32, obj objectId, lifetime uint32) []byte {
+	buf := appendContextUnsigned(nil, 0, processId)
+	buf = appendContextObjectId(buf, 1, obj)
+	if lifetime > 0 {
+		// 使用非确认的通知
+		buf = appendContextBoolean(buf, 2, false)
+		buf = appendContextUnsigned(buf, 3, lifetime)
+	}
+	return buf
+}
+
+// covNotification COV 通知
+type covNotification struct {
+	ProcessId uint32
+	Device    objectId
+	Object    objectId
+	Values    []propertyResult
+}
+
+// decodeCOVNotification 0 订阅进程 1 设备 2 对象 3 剩余时间 4 { 0 属性 [1 下标] 2 值 2 [3 优先级] } 4
+func decodeCOVNotification(buf []byte) (r covNotification, err error) {
+	inList := false
+	var cur *propertyResult
+	for len(buf) > 0 {
+		t, n, err := decodeTag(buf)
+		if err != nil {
+			return r, err
+		}
+		buf = buf[n:]
+		switch {
+		case t.isOpening(4):
+			inList = true
+		case t.isClosing(4):
+			inList = false
+		case !inList && t.is(true, 0):
+			r.ProcessId = decodeUnsigned(t.Data)
+		case !inList && t.is(true, 1):
+			r.Device = decodeObjectId(decodeUnsigned(t.Data))
+		case !inList && t.is(true, 2):
+			r.Object = decodeObjectId(decodeUnsigned(t.Data))
+		case !inList && t.is(true, 3):
+		case inList && t.is(true, 0):
+			r.Values = append(r.Values, propertyResult{propertyRef: propertyRef{Object: r.Object, Property: decodeUnsigned(t.Data), Index: arrayIndexUnset}})
+			cur = &r.Values[len(r.Values)-1]
+		case inList && t.is(true, 1) && cur != nil:
+			cur.Index = int(decodeUnsigned(t.Data))
+		case inList && t.isOpening(2) && cur != nil:
+			v, l, err := decodeConstructed(buf, 2)
+			if err != nil {
+				return r, err
+			}
+			cur.Value = v
+			buf = buf[l:]
+		case inList && t.is(true, 3):
+		default:
+			return r, fmt.Errorf("%w: unexpected tag %d", ErrPacketInvalid, t.Number)
+		}
+	}
+	for i := range r.Values {
+		r.Values[i].Object = r.Object
+	}
+	return r, nil
+}
+
+// decodeErrorPDU Error PDU 的参数: 错误类 错误码
+func decodeErrorPDU(buf []byte) error {
+	var vs []uint32
+	for len(buf) > 0 && len(vs) < 2 {
+		t, n, err := decodeTag(buf)
+		if err != nil {
+			break
+		}
+		buf = buf[n:]
+		if !t.Opening && !t.Closing {
+			vs = append(vs, decodeUnsigned(t.Data))
+		}
+	}
+	if len(vs) < 2 {
+		return Error{}
+	}
+	return Error{Class: vs[0], Code: vs[1]}
+}
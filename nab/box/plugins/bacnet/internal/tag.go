@@ -0,0 +1,349 @@
+package internal
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 应用标签
+const (
+	tagNull byte = iota
+	tagBoolean
+	tagUnsigned
+	tagSigned
+	tagReal
+	tagDouble
+	tagOctetString
+	tagCharacterString
+	tagBitString
+	tagEnumerated
+	tagDate
+	tagTime
+	tagObjectId
+)
+
+// tag 标签头: 标签号 4 位, 类型 1 位 (应用或上下文), 长度 3 位, 长度为 5 时扩展
+// 上下文标签长度为 6、7 时是构造数据的开始、结束
+type tag struct {
+	Number  byte
+	Context bool
+	Opening bool
+	Closing bool
+	// 应用标签的布尔值保存在长度中
+	Length uint32
+	Data   []byte
+}
+
+func (t tag) is(context bool, number byte) bool {
+	return t.Context == context && t.Number == number && !t.Opening && !t.Closing
+}
+
+func (t tag) isOpening(number byte) bool {
+	return t.Opening && t.Number == number
+}
+
+func (t tag) isClosing(number byte) bool {
+	return t.Closing && t.Number == number
+}
+
+// decodeTag 解析一个标签, 返回标签和占用的字节数
+func decodeTag(buf []byte) (t tag, n int, err error) {
+	if len(buf) == 0 {
+		return t, 0, fmt.Errorf("%w: tag truncated", ErrPacketInvalid)
+	}
+	b := buf[0]
+	n = 1
+	t.Number = b >> 4
+	t.Context = b&0x08 != 0
+	if t.Number == 0x0F {
+		if len(buf) < 2 {
+			return t, 0, fmt.Errorf("%w: tag truncated", ErrPacketInvalid)
+		}
+		t.Number = buf[1]
+		n++
+	}
+	lvt := b & 0x07
+	if t.Context && lvt == 6 {
+		t.Opening = true
+		return t, n, nil
+	}
+	if t.Context && lvt == 7 {
+		t.Closing = true
+		return t, n, nil
+	}
+	t.Length = uint32(lvt)
+	if !t.Context && t.Number == tagBoolean {
+		return t, n, nil
+	}
+	if lvt == 5 {
+		if len(buf) < n+1 {
+			return t, 0, fmt.Errorf("%w: tag truncated", ErrPacketInvalid)
+		}
+		t.Length = uint32(buf[n])
+		n++
+		switch t.Length {
+		case 254:
+			if len(buf) < n+2 {
+				return t, 0, fmt.Errorf("%w: tag truncated", ErrPacketInvalid)
+			}
+			t.Length = uint32(binary.BigEndian.Uint16(buf[n:]))
+			n += 2
+		case 255:
+			if len(buf) < n+4 {
+				return t, 0, fmt.Errorf("%w: tag truncated", ErrPacketInvalid)
+			}
+			t.Length = binary.BigEndian.Uint32(buf[n:])
+			n += 4
+		}
+	}
+	if uint32(len(buf)-n) < t.Length {
+		return t, 0, fmt.Errorf("%w: tag data truncated", ErrPacketInvalid)
+	}
+	t.Data = buf[n : n+int(t.Length)]
+	return t, n + int(t.Length), nil
+}
+
+func appendTag(buf []byte, number byte, context bool, length int) []byte {
+	b := byte(0)
+	if context {
+		b |= 0x08
+	}
+	if number < 15 {
+		b |= number << 4
+	} else {
+		b |= 0xF0
+	}
+	if length < 5 {
+		b |= byte(length)
+	} else {
+		b |= 5
+	}
+	buf = append(buf, b)
+	if number >= 15 {
+		buf = append(buf, number)
+	}
+	switch {
+	case length < 5:
+	case length < 254:
+		buf = append(buf, byte(length))
+	case length < 65536:
+		buf = append(buf, 254, byte(length>>8), byte(length))
+	default:
+		buf = append(buf, 255, byte(length>>24), byte(length>>16), byte(length>>8), byte(length))
+	}
+	return buf
+}
+
+func appendOpening(buf []byte, number byte) []byte {
+	return append(buf, number<<4|0x0E)
+}
+
+func appendClosing(buf []byte, number byte) []byte {
+	return append(buf, number<<4|0x0F)
+}
+
+// unsignedBytes 无符号数的最短大端编码
+func unsignedBytes(v uint32) []byte {
+	switch {
+	case v < 1<<8:
+		return []byte{byte(v)}
+	case v < 1<<16:
+		return []byte{byte(v >> 8), byte(v)}
+	case v < 1<<24:
+		return []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+	}
+	return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+// signedBytes 有符号数的最短大端补码编码
+func signedBytes(v int32) []byte {
+	switch {
+	case v >= -128 && v < 128:
+		return []byte{byte(v)}
+	case v >= -32768 && v < 32768:
+		return []byte{byte(v >> 8), byte(v)}
+	case v >= -8388608 && v < 8388608:
+		return []byte{byte(v >> 16), byte(v >> 8), byte(v)}
+	}
+	return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+}
+
+func appendContextUnsigned(buf []byte, number byte, v uint32) []byte {
+	bs := unsignedBytes(v)
+	return append(appendTag(buf, number, true, len(bs)), bs...)
+}
+
+func appendContextBoolean(buf []byte, number byte, v bool) []byte {
+	buf = appendTag(buf, number, true, 1)
+	if v {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+func appendContextObjectId(buf []byte, number byte, oid objectId) []byte {
+	buf = appendTag(buf, number, true, 4)
+	return binary.BigEndian.AppendUint32(buf, oid.encode())
+}
+
+func appendAppNull(buf []byte) []byte {
+	return appendTag(buf, tagNull, false, 0)
+}
+
+func appendAppBoolean(buf []byte, v bool) []byte {
+	if v {
+		return appendTag(buf, tagBoolean, false, 1)
+	}
+	return appendTag(buf, tagBoolean, false, 0)
+}
+
+func appendAppUnsigned(buf []byte, v uint32) []byte {
+	bs := unsignedBytes(v)
+	return append(appendTag(buf, tagUnsigned, false, len(bs)), bs...)
+}
+
+func appendAppSigned(buf []byte, v int32) []byte {
+	bs := signedBytes(v)
+	return append(appendTag(buf, tagSigned, false, len(bs)), bs...)
+}
+
+func appendAppReal(buf []byte, v float32) []byte {
+	buf = appendTag(buf, tagReal, false, 4)
+	return binary.BigEndian.AppendUint32(buf, math.Float32bits(v))
+}
+
+func appendAppEnumerated(buf []byte, v uint32) []byte {
+	bs := unsignedBytes(v)
+	return append(appendTag(buf, tagEnumerated, false, len(bs)), bs...)
+}
+
+// appendAppCharacterString 字符集固定为 UTF-8
+func appendAppCharacterString(buf []byte, s string) []byte {
+	buf = appendTag(buf, tagCharacterString, false, 1+len(s))
+	buf = append(buf, 0)
+	return append(buf, s...)
+}
+
+func decodeUnsigned(bs []byte) uint32 {
+	var v uint32
+	for _, b := range bs {
+		v = v<<8 | uint32(b)
+	}
+	return v
+}
+
+func decodeSigned(bs []byte) int32 {
+	if len(bs) == 0 {
+		return 0
+	}
+	v := int32(int8(bs[0]))
+	for _, b := range bs[1:] {
+		v = v<<8 | int32(b)
+	}
+	return v
+}
+
+// decodeAppValue 应用标签的值
+// 实数按单精度的有效数字转为 float64, 位串转为 0、1 组成的字符串
+func decodeAppValue(t tag) (interface{}, error) {
+	switch t.Number {
+	case tagNull:
+		return nil, nil
+	case tagBoolean:
+		return t.Length != 0, nil
+	case tagUnsigned:
+		return decodeUnsigned(t.Data), nil
+	case tagSigned:
+		return decodeSigned(t.Data), nil
+	case tagEnumerated:
+		return decodeUnsigned(t.Data), nil
+	case tagReal:
+		if len(t.Data) != 4 {
+			return nil, fmt.Errorf("%w: real length %d", ErrPacketInvalid, len(t.Data))
+		}
+		f := math.Float32frombits(binary.BigEndian.Uint32(t.Data))
+		v, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'g', -1, 32), 64)
+		return v, nil
+	case tagDouble:
+		if len(t.Data) != 8 {
+			return nil, fmt.Errorf("%w: double length %d", ErrPacketInvalid, len(t.Data))
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(t.Data)), nil
+	case tagOctetString:
+		return hex.EncodeToString(t.Data), nil
+	case tagCharacterString:
+		if len(t.Data) == 0 {
+			return "", nil
+		}
+		// 只处理 UTF-8, 其他字符集原样返回
+		return string(t.Data[1:]), nil
+	case tagBitString:
+		if len(t.Data) == 0 {
+			return "", nil
+		}
+		var sb strings.Builder
+		bits := 8*(len(t.Data)-1) - int(t.Data[0])
+		for i := range bits {
+			if t.Data[1+i/8]&(0x80>>(i%8)) != 0 {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+		}
+		return sb.String(), nil
+	case tagDate:
+		if len(t.Data) != 4 {
+			return nil, fmt.Errorf("%w: date length %d", ErrPacketInvalid, len(t.Data))
+		}
+		return fmt.Sprintf("%04d-%02d-%02d", 1900+int(t.Data[0]), t.Data[1], t.Data[2]), nil
+	case tagTime:
+		if len(t.Data) != 4 {
+			return nil, fmt.Errorf("%w: time length %d", ErrPacketInvalid, len(t.Data))
+		}
+		return fmt.Sprintf("%02d:%02d:%02d.%02d", t.Data[0], t.Data[1], t.Data[2], t.Data[3]), nil
+	case tagObjectId:
+		if len(t.Data) != 4 {
+			return nil, fmt.Errorf("%w: object id length %d", ErrPacketInvalid, len(t.Data))
+		}
+		return decodeObjectId(binary.BigEndian.Uint32(t.Data)).String(), nil
+	}
+	return nil, fmt.Errorf("%w: unknown application tag %d", ErrPacketInvalid, t.Number)
+}
+
+// decodeConstructed 解析开始标签之后到对应结束标签的属性值, 返回值和占用的字节数(含结束标签)
+// 只有一个应用标签时返回该值, 多个时返回数组, 嵌套的构造数据忽略
+func decodeConstructed(buf []byte, closing byte) (value interface{}, n int, err error) {
+	var values []interface{}
+	depth := 0
+	for {
+		t, l, err := decodeTag(buf[n:])
+		if err != nil {
+			return nil, n, err
+		}
+		n += l
+		switch {
+		case t.Opening:
+			depth++
+		case t.Closing && depth > 0:
+			depth--
+		case t.Closing:
+			if t.Number != closing {
+				return nil, n, fmt.Errorf("%w: unexpected closing tag %d", ErrPacketInvalid, t.Number)
+			}
+			if len(values) == 1 {
+				return values[0], n, nil
+			}
+			return values, n, nil
+		case depth == 0 && !t.Context:
+			v, err := decodeAppValue(t)
+			if err != nil {
+				return nil, n, err
+			}
+			values = append(values, v)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/twiglab/h2o/nab/box/pkg/testutil"
+)
+
+// ReadProperty analogInput:0 presentValue 的 ComplexACK 服务参数, 值为 72.5
+const readPropertyAck = "0C 00 00 00 00 19 55 3E 44 42 91 00 00 3F"
+
+func TestDecodeTag(t *testing.T) {
+	long := append(testutil.Unhex(t, "65 FE 01 00"), bytes.Repeat([]byte{0x20}, 256)...)
+	tests := []struct {
+		name string
+		in   []byte
+		want tag
+		n    int
+	}{
+		{"上下文 对象标识", testutil.Unhex(t, readPropertyAck), tag{Number: 0, Context: true, Length: 4, Data: []byte{0, 0, 0, 0}}, 5},
+		{"上下文 属性号", testutil.Unhex(t, "19 55"), tag{Number: 1, Context: true, Length: 1, Data: []byte{0x55}}, 2},
+		{"开始标签", testutil.Unhex(t, "3E 44"), tag{Number: 3, Context: true, Opening: true}, 1},
+		{"结束标签", testutil.Unhex(t, "3F"), tag{Number: 3, Context: true, Closing: true}, 1},
+		{"实数", testutil.Unhex(t, "44 42 91 00 00 3F"), tag{Number: tagReal, Length: 4, Data: []byte{0x42, 0x91, 0, 0}}, 5},
+		{"布尔真", testutil.Unhex(t, "11"), tag{Number: tagBoolean, Length: 1}, 1},
+		{"布尔假", testutil.Unhex(t, "10"), tag{Number: tagBoolean}, 1},
+		{"上下文布尔", testutil.Unhex(t, "29 01"), tag{Number: 2, Context: true, Length: 1, Data: []byte{1}}, 2},
+		{"扩展长度 字符串", testutil.Unhex(t, "75 0B 00 5A 6F 6E 65 20 54 65 6D 70 31"),
+			tag{Number: tagCharacterString, Length: 11, Data: testutil.Unhex(t, "00 5A 6F 6E 65 20 54 65 6D 70 31")}, 13},
+		{"两字节长度", long, tag{Number: tagOctetString, Length: 256, Data: long[4:]}, 260},
+		{"扩展标签号", testutil.Unhex(t, "F9 20 07"), tag{Number: 32, Context: true, Length: 1, Data: []byte{7}}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n, err := decodeTag(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != tt.n || got.Number != tt.want.Number || got.Context != tt.want.Context ||
+				got.Opening != tt.want.Opening || got.Closing != tt.want.Closing ||
+				got.Length != tt.want.Length || !bytes.Equal(got.Data, tt.want.Data) {
+				t.Fatalf("got %+v %d, want %+v %d", got, n, tt.want, tt.n)
+			}
+		})
+	}
+
+	for _, in := range []string{"", "44 42 91", "75", "65 FE 01", "F9"} {
+		if _, _, err := decodeTag(testutil.Unhex(t, in)); !errors.Is(err, ErrPacketInvalid) {
+			t.Fatalf("decodeTag(%s) = %v, want ErrPacketInvalid", in, err)
+		}
+	}
+}
+
+func TestAppendTag(t *testing.T) {
+	tests := []struct {
+		number  byte
+		context bool
+		length  int
+		want    string
+	}{
+		{0, true, 4, "0C"},
+		{1, true, 1, "19"},
+		{tagReal, false, 4, "44"},
+		{tagCharacterString, false, 11, "75 0B"},
+		{tagOctetString, false, 253, "65 FD"},
+		{tagOctetString, false, 256, "65 FE 01 00"},
+		{tagOctetString, false, 70000, "65 FF 00 01 11 70"},
+		{32, true, 1, "F9 20"},
+	}
+	for _, tt := range tests {
+		got := appendTag(nil, tt.number, tt.context, tt.length)
+		if !bytes.Equal(got, testutil.Unhex(t, tt.want)) {
+			t.Fatalf("appendTag(%d, %v, %d) = % X, want %s", tt.number, tt.context, tt.length, got, tt.want)
+		}
+		// 编码后能原样解析
+		buf := append(got, make([]byte, tt.length)...)
+		tg, n, err := decodeTag(buf)
+		if err != nil || tg.Number != tt.number || tg.Context != tt.context || int(tg.Length) != tt.length || n != len(buf) {
+			t.Fatalf("decodeTag(appendTag(%d, %v, %d)) = %+v %d %v", tt.number, tt.context, tt.length, tg, n, err)
+		}
+	}
+
+	// 写属性的参数: 对象、属性、值、优先级
+	var buf []byte
+	buf = appendContextObjectId(buf, 0, objectId{Type: 2, Instance: 1})
+	buf = appendContextUnsigned(buf, 1, 85)
+	buf = appendOpening(buf, 3)
+	buf = appendAppReal(buf, 21.5)
+	buf = appendClosing(buf, 3)
+	buf = appendContextUnsigned(buf, 4, 8)
+	if want := "0C 00 80 00 01 19 55 3E 44 41 AC 00 00 3F 49 08"; !bytes.Equal(buf, testutil.Unhex(t, want)) {
+		t.Fatalf("write property = % X, want %s", buf, want)
+	}
+}
+
+func TestDecodeConstructed(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want interface{}
+		n    int
+	}{
+		{"单个实数", "44 42 91 00 00 3F", 72.5, 6},
+		{"对象名", "75 0B 00 5A 6F 6E 65 20 54 65 6D 70 31 3F", "Zone Temp1", 14},
+		{"状态标志", "82 04 00 3F", "0000", 4},
+		{"数组", "91 00 91 01 21 05 3F", []interface{}{uint32(0), uint32(1), uint32(5)}, 7},
+		{"优先级数组", "00 00 44 41 AC 00 00 3F", []interface{}{nil, nil, 21.5}, 8},
+		{"嵌套的构造数据忽略", "0E 1C 00 00 00 01 0F 21 05 3F", uint32(5), 10},
+		{"空", "3F", []interface{}(nil), 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n, err := decodeConstructed(testutil.Unhex(t, tt.in), 3)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != tt.n || !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %#v %d, want %#v %d", got, n, tt.want, tt.n)
+			}
+		})
+	}
+
+	// 从 ComplexACK 中定位开始标签
+	buf := testutil.Unhex(t, readPropertyAck)
+	if v, n, err := decodeConstructed(buf[8:], 3); err != nil || v != 72.5 || 8+n != len(buf) {
+		t.Fatalf("got %v %d %v, want 72.5", v, n, err)
+	}
+
+	for _, in := range []string{"44 42 91 00 00 2F", "44 42 91 00 00", "44 42 91 3F"} {
+		if _, _, err := decodeConstructed(testutil.Unhex(t, in), 3); !errors.Is(err, ErrPacketInvalid) {
+			t.Fatalf("decodeConstructed(%s) = %v, want ErrPacketInvalid", in, err)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package internal
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"go.uber.org/zap"
+)
+
+// response 确认请求的应答
+type response struct {
+	data []byte
+	err  error
+}
+
+// pendingKey 调用号只在同一对端内有效, 应答按来源地址和调用号匹配
+type pendingKey struct {
+	peer     string
+	invokeId byte
+}
+
+// transport BACnet/IP 的 UDP 连接
+// 接收协程把应答按来源地址和调用号交给等待的请求, 其他报文交给 handler
+type transport struct {
+	config    *ConnectionConfig
+	conn      *net.UDPConn
+	broadcast *net.UDPAddr
+	// 收到非确认请求或确认的 COV 通知
+	handler func(src address, service byte, data []byte)
+
+	mutex    sync.Mutex
+	invokeId byte
+	pending  map[pendingKey]chan response
+}
+
+func (t *transport) timeout() time.Duration {
+	return time.Duration(t.config.Timeout) * time.Millisecond
+}
+
+func (t *transport) open() (err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.conn != nil {
+		return nil
+	}
+	if t.broadcast, err = net.ResolveUDPAddr("udp4", t.config.BroadcastAddress); err != nil {
+		return err
+	}
+	local, err := net.ResolveUDPAddr("udp4", t.config.LocalAddress)
+	if err != nil {
+		return err
+	}
+	if t.conn, err = net.ListenUDP("udp4", local); err != nil {
+		driverbox.Log().Error("open bacnet connection error", zap.Any("bacnet", t.config), zap.Error(err))
+		return err
+	}
+	t.pending = make(map[pendingKey]chan response)
+	go t.receive(t.conn)
+	return nil
+}
+
+func (t *transport) close() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.conn != nil {
+		_ = t.conn.Close()
+		t.conn = nil
+	}
+}
+
+func (t *transport) receive(conn *net.UDPConn) {
+	buf := make([]byte, 1500)
+	for {
+		n, from, err := conn.ReadFromUDP(buf)
+		if err != nil {
+			if !errors.Is(err, net.ErrClosed) {
+				driverbox.Log().Error("bacnet receive error", zap.String("key", t.config.ConnectionKey), zap.Error(err))
+				t.close()
+			}
+			return
+		}
+		packet := append([]byte(nil), buf[:n]...)
+		t.dispatch(conn, packet, from)
+	}
+}
+
+func (t *transport) dispatch(conn *net.UDPConn, packet []byte, from *net.UDPAddr) {
+	src, apdu, err := decodePacket(packet, from)
+	if err != nil {
+		if errors.Is(err, ErrPacketInvalid) {
+			driverbox.Log().Debug("bacnet packet invalid", zap.String("from", from.String()), zap.Error(err))
+		}
+		return
+	}
+	if len(apdu) < 2 {
+		return
+	}
+	pdu := apdu[0] & 0xF0
+	switch pdu {
+	case pduUnconfirmedRequest:
+		if t.handler != nil {
+			t.handler(src, apdu[1], apdu[2:])
+		}
+	case pduConfirmedRequest:
+		// 只处理 COV 通知, 其他服务拒绝
+		if len(apdu) < 4 || apdu[0]&pduSegmented != 0 {
+			return
+		}
+		invokeId, service := apdu[2], apdu[3]
+		if service == serviceConfirmedCOVNotification {
+			if t.handler != nil {
+				t.handler(src, service, apdu[4:])
+			}
+			t.reply(conn, src, []byte{pduSimpleAck, invokeId, service})
+		} else {
+			t.reply(conn, src, []byte{pduReject, invokeId, rejectUnrecognizedService})
+		}
+	case pduSimpleAck, pduComplexAck, pduError, pduReject, pduAbort:
+		var r response
+		invokeId := apdu[1]
+		switch pdu {
+		case pduComplexAck:
+			if apdu[0]&pduSegmented != 0 {
+				r.err = ErrSegmentation
+			} else if len(apdu) >= 3 {
+				r.data = apdu[3:]
+			}
+		case pduError:
+			if len(apdu) >= 3 {
+				r.err = decodeErrorPDU(apdu[3:])
+			}
+		case pduReject:
+			if len(apdu) >= 3 {
+				r.err = RejectError{Reason: apdu[2]}
+			}
+		case pduAbort:
+			if len(apdu) >= 3 {
+				r.err = AbortError{Reason: apdu[2]}
+			}
+		}
+		t.mutex.Lock()
+		ch, ok := t.pending[pendingKey{peer: src.String(), invokeId: invokeId}]
+		t.mutex.Unlock()
+		if !ok {
+			// 其他设备用了同一个调用号, 不能当作本次请求的应答
+			driverbox.Log().Debug("bacnet unexpected reply", zap.String("from", src.String()), zap.Uint8("invokeId", invokeId))
+			return
+		}
+		select {
+		case ch <- r:
+		default:
+		}
+	}
+}
+
+func (t *transport) reply(conn *net.UDPConn, dst address, apdu []byte) {
+	if _, err := conn.WriteToUDP(encodePacket(dst, false, false, apdu), dst.IP); err != nil {
+		driverbox.Log().Error("bacnet reply error", zap.String("to", dst.String()), zap.Error(err))
+	}
+}
+
+func (t *transport) connection() *net.UDPConn {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	return t.conn
+}
+
+// allocate 给发往 dst 的请求分配一个空闲的调用号
+func (t *transport) allocate(dst address) (pendingKey, chan response, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.conn == nil {
+		return pendingKey{}, nil, net.ErrClosed
+	}
+	key := pendingKey{peer: dst.String()}
+	for range 256 {
+		t.invokeId++
+		key.invokeId = t.invokeId
+		if _, ok := t.pending[key]; !ok {
+			ch := make(chan response, 1)
+			t.pending[key] = ch
+			return key, ch, nil
+		}
+	}
+	return pendingKey{}, nil, errors.New("bacnet no free invoke id")
+}
+
+func (t *transport) release(key pendingKey) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	delete(t.pending, key)
+}
+
+// request 发送确认请求并等待应答, 超时重试, 返回 ComplexACK 的服务参数
+func (t *transport) request(dst address, service byte, params []byte) ([]byte, error) {
+	if err := t.open(); err != nil {
+		return nil, err
+	}
+	key, ch, err := t.allocate(dst)
+	if err != nil {
+		return nil, err
+	}
+	defer t.release(key)
+
+	packet := encodePacket(dst, false, true, confirmedRequest(key.invokeId, service, params))
+	for i := 0; i < t.config.Retry; i++ {
+		conn := t.connection()
+		if conn == nil {
+			return nil, net.ErrClosed
+		}
+		if _, err := conn.WriteToUDP(packet, dst.IP); err != nil {
+			return nil, err
+		}
+		timer := time.NewTimer(t.timeout())
+		select {
+		case r := <-ch:
+			timer.Stop()
+			return r.data, r.err
+		case <-timer.C:
+		}
+	}
+	return nil, ErrRequestTimeout
+}
+
+// broadcastRequest 广播非确认请求, 网络号 0xFFFF 全网广播, 路由器后的设备也能收到
+func (t *transport) broadcastRequest(apdu []byte) error {
+	if err := t.open(); err != nil {
+		return err
+	}
+	dst := address{Net: 0xFFFF}
+	conn := t.connection()
+	if conn == nil {
+		return net.ErrClosed
+	}
+	_, err := conn.WriteToUDP(encodePacket(dst, true, false, apdu), t.broadcast)
+	return err
+}
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"net"
+	"testing"
+
+	"github.com/twiglab/h2o/nab/box/internal/logger"
+	"go.uber.org/zap"
+)
+
+func udpAddr(t *testing.T, s string) *net.UDPAddr {
+	t.Helper()
+	a, err := net.ResolveUDPAddr("udp4", s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestDispatchMatchesSource(t *testing.T) {
+	logger.Logger = zap.NewNop()
+	conn, err := net.ListenUDP("udp4", udpAddr(t, "127.0.0.1:0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	tr := &transport{config: &ConnectionConfig{}, conn: conn, pending: make(map[pendingKey]chan response)}
+
+	device := address{IP: udpAddr(t, "127.0.0.1:47001")}
+	routed := address{IP: udpAddr(t, "127.0.0.1:47002"), Net: 5, Mac: []byte{0x0A}}
+	key, ch, err := tr.allocate(device)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rkey, rch, err := tr.allocate(routed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 调用号全局递增, 不同设备也不会重复
+	if key.invokeId == rkey.invokeId {
+		t.Fatalf("invoke id %d allocated twice", key.invokeId)
+	}
+
+	ack := func(invokeId byte) []byte {
+		return encodePacket(address{}, false, false, []byte{pduSimpleAck, invokeId, serviceConfirmedCOVNotification})
+	}
+	// 其他设备用同一个调用号的应答丢弃
+	tr.dispatch(conn, ack(key.invokeId), udpAddr(t, "127.0.0.1:47003"))
+	// 来自路由器但没有源网络号, 不是路由器后设备的应答
+	tr.dispatch(conn, ack(rkey.invokeId), routed.IP)
+	select {
+	case r := <-ch:
+		t.Fatalf("got reply %+v from other source", r)
+	case r := <-rch:
+		t.Fatalf("got reply %+v from router itself", r)
+	default:
+	}
+
+	tr.dispatch(conn, ack(key.invokeId), device.IP)
+	select {
+	case r := <-ch:
+		if r.err != nil {
+			t.Fatalf("got %v", r.err)
+		}
+	default:
+		t.Fatal("reply from device dropped")
+	}
+
+	tr.release(key)
+	tr.release(rkey)
+	if len(tr.pending) != 0 {
+		t.Fatalf("pending = %v, want empty", tr.pending)
+	}
+}
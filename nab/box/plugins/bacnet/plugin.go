@@ -0,0 +1,10 @@
+package bacnet
+
+import (
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/plugins/bacnet/internal"
+)
+
+func EnablePlugin() {
+	driverbox.EnablePlugin(internal.ProtocolName, new(internal.Plugin))
+}
@@ -0,0 +1,325 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/convutil"
+	"github.com/twiglab/h2o/nab/box/pkg/crontab"
+	"github.com/twiglab/h2o/nab/box/pkg/event"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+	"go.uber.org/zap"
+)
+
+func newConnector(p *Plugin, cf *ConnectionConfig) (*connector, error) {
+	if err := cf.Config.Init(200, 2000); err != nil {
+		return nil, fmt.Errorf("cjt188 %w", err)
+	}
+	if cf.Retry == 0 {
+		cf.Retry = 3
+	}
+	if cf.DiscoverDuration == "" {
+		cf.DiscoverDuration = "1m"
+	}
+
+	conn := &connector{
+		config:     cf,
+		plugin:     p,
+		transport:  serialport.New(ProtocolName, &cf.Config),
+		virtual:    cf.Virtual || config.IsVirtual(),
+		meters:     make(map[string]*meter),
+		discovered: make(map[[7]byte]bool),
+	}
+	return conn, nil
+}
+
+func (c *connector) initCollectTask(conf *ConnectionConfig) (*crontab.Future, error) {
+	discoverDuration, err := time.ParseDuration(conf.DiscoverDuration)
+	if err != nil {
+		driverbox.Log().Error("error cjt188 discover duration config", zap.String("key", conf.ConnectionKey), zap.Error(err))
+		discoverDuration = time.Minute
+	}
+
+	//注册定时采集任务
+	return driverbox.AddFunc("1s", func() {
+		if conf.Discover && c.latestDiscoverTime.Add(discoverDuration).Before(time.Now()) {
+			c.latestDiscoverTime = time.Now()
+			c.discover()
+		}
+
+		for _, m := range c.meters {
+			if c.close {
+				driverbox.Log().Warn("cjt188 connection is closed, ignore collect task!", zap.String("key", conf.ConnectionKey))
+				return
+			}
+			if len(m.points) == 0 {
+				continue
+			}
+			duration := m.duration
+			//水表不应答，按倍数延长采集间隔，最长一分钟
+			if m.timeoutCount > 0 {
+				duration = min(duration*time.Duration(1<<min(m.timeoutCount, 16)), max(m.duration, time.Minute))
+			}
+			if m.latestTime.Add(duration).After(time.Now()) {
+				continue
+			}
+
+			err := c.Send(command{Mode: plugin.ReadMode, Value: m})
+			m.latestTime = time.Now()
+			if err != nil {
+				driverbox.Log().Error("read error", zap.String("deviceId", m.deviceId), zap.Error(err))
+				if errors.Is(err, serialport.ErrTimeout) {
+					m.timeoutCount += 1
+				}
+				_ = driverbox.Shadow().MayBeOffline(m.deviceId)
+			} else {
+				m.timeoutCount = 0
+			}
+		}
+	})
+}
+
+// createMeter 每个设备对应一块表, 设备属性 address 为 14 位表号, meterType 为仪表类型
+func (c *connector) createMeter(model config.DeviceModel, dev config.Device) error {
+	addr, err := parseAddress(dev.Properties["address"])
+	if err != nil {
+		return err
+	}
+	m := &meter{
+		deviceId:  dev.ID,
+		meterType: TypeColdWater,
+		address:   addr,
+	}
+	if s := dev.Properties["meterType"]; s != "" {
+		t, err := strconv.ParseUint(s, 0, 8)
+		if err != nil {
+			return fmt.Errorf("invalid meter type: %s", s)
+		}
+		m.meterType = byte(t)
+	}
+
+	for _, point := range model.DevicePoints {
+		if point.ReadWrite() != config.ReadWrite_R && point.ReadWrite() != config.ReadWrite_RW {
+			continue
+		}
+		ext, err := convToPointExtend(point)
+		if err != nil {
+			driverbox.Log().Error("error cjt188 point config", zap.String("deviceId", dev.ID), zap.Any("point", point), zap.Error(err))
+			continue
+		}
+		ext.DeviceId = dev.ID
+		m.points = append(m.points, ext)
+		if m.duration == 0 || ext.duration < m.duration {
+			m.duration = ext.duration
+		}
+	}
+	c.meters[dev.ID] = m
+	c.discovered[addr] = true
+	return nil
+}
+
+func convToPointExtend(extends config.Point) (*Point, error) {
+	extend := new(Point)
+	extend.Point = extends
+	if err := convutil.Struct(extends, extend); err != nil {
+		return nil, err
+	}
+	if extend.Field == "" {
+		return nil, errors.New("field missed")
+	}
+	//未设置，则默认每十五分钟采集一次
+	if extend.Duration == "" {
+		extend.Duration = "15m"
+	}
+	duration, err := time.ParseDuration(extend.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("convert duration error: %s", err.Error())
+	}
+	extend.duration = duration
+	return extend, nil
+}
+
+// Encode 编码数据
+// 读操作读取整块表的计量数据, 写操作只支持 valve 点位, 0 开阀 1 关阀
+func (c *connector) Encode(deviceId string, mode plugin.EncodeMode, values ...plugin.PointData) (res interface{}, err error) {
+	m, ok := c.meters[deviceId]
+	if !ok {
+		return nil, fmt.Errorf("device [%s] not found", deviceId)
+	}
+
+	switch mode {
+	case plugin.ReadMode:
+		return command{Mode: plugin.ReadMode, Value: m}, nil
+	case plugin.WriteMode:
+		if len(values) != 1 {
+			return nil, errors.New("cjt188 only supports writing one valve point")
+		}
+		p, ok := driverbox.CoreCache().GetPointByDevice(deviceId, values[0].PointName)
+		if !ok {
+			return nil, fmt.Errorf("point [%s] not found", values[0].PointName)
+		}
+		if field, _ := p.FieldValue("field"); field != FieldValve {
+			return nil, fmt.Errorf("point [%s] is not writable", values[0].PointName)
+		}
+		v, err := convutil.Int64(values[0].Value)
+		if err != nil {
+			return nil, err
+		}
+		if v != 0 && v != 1 {
+			return nil, fmt.Errorf("invalid valve value: %v", values[0].Value)
+		}
+		return command{
+			Mode:  plugin.WriteMode,
+			Value: &valveValue{meter: m, open: v == 0},
+		}, nil
+	}
+	return nil, plugin.NotSupportEncode
+}
+
+// Send 发送数据
+func (c *connector) Send(data interface{}) (err error) {
+	cmd, ok := data.(command)
+	if !ok {
+		return errors.New("unsupported data type")
+	}
+	switch cmd.Mode {
+	case plugin.ReadMode:
+		return c.sendReadCommand(cmd.Value.(*meter))
+	case plugin.WriteMode:
+		wv := cmd.Value.(*valveValue)
+		return c.sendValveCommand(wv.meter, wv.open)
+	}
+	return errors.New("not support mode error")
+}
+
+// Release 释放资源
+// 不释放连接资源, 串口由连接器一直持有
+func (c *connector) Release() (err error) {
+	return
+}
+
+func (c *connector) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.close = true
+	if c.collectTask != nil {
+		c.collectTask.Disable()
+	}
+	c.transport.Close()
+}
+
+// request 请求失败时重试, 异常应答不重试
+func (c *connector) request(req frame) (resp frame, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.close {
+		return resp, errors.New("cjt188 connection is closed")
+	}
+	for i := 0; i < c.config.Retry; i++ {
+		c.ser++
+		req.Ser = c.ser
+		resp, err = c.exchange(req)
+		if err == nil || isAbnormal(err) {
+			return
+		}
+	}
+	return
+}
+
+// sendReadCommand 读取计量数据, 按点位的字段上报
+func (c *connector) sendReadCommand(m *meter) error {
+	var values map[string]interface{}
+	var err error
+	if c.virtual {
+		values, err = c.mockRead(m)
+	} else {
+		values, err = c.readMeterData(m)
+	}
+	if err != nil {
+		return err
+	}
+
+	pointData := make([]plugin.PointData, 0, len(m.points))
+	for _, p := range m.points {
+		v, ok := values[p.Field]
+		if !ok {
+			driverbox.Log().Warn("cjt188 field not found", zap.String("deviceId", m.deviceId), zap.String("point", p.Name()), zap.String("field", p.Field))
+			continue
+		}
+		pointData = append(pointData, plugin.PointData{PointName: p.Name(), Value: v})
+	}
+	if len(pointData) > 0 {
+		driverbox.Export([]plugin.DeviceData{{ID: m.deviceId, Values: pointData}})
+	}
+	return nil
+}
+
+func (c *connector) readMeterData(m *meter) (map[string]interface{}, error) {
+	resp, err := c.request(frame{Type: m.meterType, Address: m.address, Control: ctrlRead, DI: diMeterData})
+	if err != nil {
+		return nil, err
+	}
+	return decodeMeterData(resp.Type, resp.Data)
+}
+
+func (c *connector) sendValveCommand(m *meter, open bool) error {
+	op := valveClose
+	if open {
+		op = valveOpen
+	}
+	var err error
+	if c.virtual {
+		err = c.mockValve(m, open)
+	} else {
+		_, err = c.request(frame{Type: m.meterType, Address: m.address, Control: ctrlWrite, DI: diValve, Data: []byte{op}})
+	}
+	if err != nil {
+		return fmt.Errorf("valve [%s] 0x%02X error: %w", m.deviceId, op, err)
+	}
+	driverbox.Log().Info("cjt188 valve control", zap.String("deviceId", m.deviceId), zap.Bool("open", open))
+	return nil
+}
+
+// discover 用广播地址读地址, 新的表号触发设备自动发现
+func (c *connector) discover() {
+	if c.virtual {
+		return
+	}
+	resp, err := c.request(frame{Type: typeBroadcast, Address: broadcastAddress, Control: ctrlReadAddress, DI: diReadAddress})
+	if err != nil {
+		driverbox.Log().Debug("cjt188 discover error", zap.String("key", c.config.ConnectionKey), zap.Error(err))
+		return
+	}
+	if c.discovered[resp.Address] {
+		return
+	}
+	c.discovered[resp.Address] = true
+
+	address := formatAddress(resp.Address)
+	deviceId := ProtocolName + "_" + address
+	driverbox.Log().Info("cjt188 meter discovered", zap.String("key", c.config.ConnectionKey), zap.String("address", address), zap.Uint8("type", resp.Type))
+	deviceData := []plugin.DeviceData{{
+		ID: deviceId,
+		Events: []event.Data{{
+			Code: event.DeviceDiscover,
+			Value: map[string]interface{}{
+				"modelKey": c.config.ModelKey,
+				"device": map[string]interface{}{
+					"id":          deviceId,
+					"description": "cjt188 " + address,
+					"properties": map[string]string{
+						"address":   address,
+						"meterType": fmt.Sprintf("0x%02X", resp.Type),
+					},
+				},
+			},
+		}},
+	}}
+	plugin.WrapperDiscoverEvent(deviceData, c.config.ConnectionKey, ProtocolName)
+	driverbox.Export(deviceData)
+}
@@ -0,0 +1,50 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/pkg/luautil"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var ls *lua.LState
+
+// InitMockLua 虚拟模式下由脚本中的 mockCjt188Read、mockCjt188Valve 模拟仪表
+func InitMockLua() {
+	if ls != nil {
+		return
+	}
+	l, err := luautil.InitMockVM(ProtocolName)
+	if err != nil {
+		driverbox.Log().Error("init lua vm error", zap.Error(err))
+		return
+	}
+	ls = l
+}
+
+// mockRead 脚本返回字段和值的 json 对象, 如 {"flow": 12.5, "valve": 0}
+func (c *connector) mockRead(m *meter) (values map[string]interface{}, err error) {
+	if ls == nil {
+		return nil, errors.New("lua vm is nil")
+	}
+	mockData, err := luautil.CallLuaMethod(ls, "mockCjt188Read", lua.LString(formatAddress(m.address)), lua.LNumber(m.meterType))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal([]byte(mockData), &values)
+	return
+}
+
+func (c *connector) mockValve(m *meter, open bool) error {
+	if ls == nil {
+		return errors.New("lua vm is nil")
+	}
+	result, err := luautil.CallLuaMethod(ls, "mockCjt188Valve", lua.LString(formatAddress(m.address)), lua.LBool(open))
+	if err == nil {
+		driverbox.Log().Info("mockValve result", zap.Any("result", result))
+	}
+	return err
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// ConnectionConfig 连接器配置
+type ConnectionConfig struct {
+	plugin.BaseConnection
+	serialport.Config
+	Retry int `json:"retry"` // 重试次数
+	// 自动发现时使用的模型 Key，总线上只能有一块表应答广播地址
+	ModelKey string `json:"modelKey"`
+	// 自动发现的间隔
+	DiscoverDuration string `json:"discoverDuration"`
+}
+
+// Point cjt188 点位
+type Point struct {
+	config.Point
+	DeviceId string
+
+	// 点位采集周期，同一块表取最短的周期
+	Duration string `json:"duration"`
+	// 计量数据中的字段，如 flow 累积流量、valve 阀门状态
+	Field string `json:"field"`
+
+	duration time.Duration
+}
+
+// meter 一块水表或热量表
+type meter struct {
+	deviceId string
+	// 仪表类型，默认 0x10 冷水水表
+	meterType byte
+	address   [7]byte
+
+	points []*Point
+	// 采集周期
+	duration   time.Duration
+	latestTime time.Time
+	// 最近连续超时次数
+	timeoutCount int
+}
+
+// Connector#Send接入入参
+type command struct {
+	Mode  plugin.EncodeMode // 模式
+	Value interface{}
+}
+
+// 写操作时 command 的 value 类型
+type valveValue struct {
+	meter *meter
+	open  bool
+}
@@ -0,0 +1,344 @@
+package internal
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// CJ/T 188-2004 帧格式:
+// 68 T A0..A6 C L DATA CS 16
+// T 为仪表类型, 地址为 BCD 码低字节在前, DATA 为 DI0 DI1 SER 数据, CS 为 68 到 CS 之前所有字节的模 256 和
+const (
+	frameStart byte = 0x68
+	frameEnd   byte = 0x16
+	preamble   byte = 0xFE
+
+	// 主站控制码
+	ctrlRead        byte = 0x01 // 读数据
+	ctrlWrite       byte = 0x04 // 写数据
+	ctrlReadAddress byte = 0x03 // 读地址
+
+	// 从站应答标志
+	flagResponse byte = 0x80
+	flagAbnormal byte = 0x40
+
+	// 帧头 68 + T + 地址 7 + C + L
+	headerLen  = 11
+	maxDataLen = 100
+)
+
+// 数据标识
+const (
+	diMeterData   uint16 = 0x901F // 计量数据
+	diValve       uint16 = 0xA017 // 阀门控制
+	diReadAddress uint16 = 0x810A // 读地址
+)
+
+// 阀门控制
+const (
+	valveOpen  byte = 0x55
+	valveClose byte = 0x99
+)
+
+// 仪表类型
+const (
+	TypeColdWater byte = 0x10 // 冷水水表
+	TypeHotWater  byte = 0x11 // 生活热水水表
+	TypeHeat      byte = 0x20 // 热量表(计热量)
+	TypeCold      byte = 0x21 // 热量表(计冷量)
+	typeBroadcast byte = 0xAA
+)
+
+// broadcastAddress 读地址时使用的广播地址
+var broadcastAddress = [7]byte{0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA}
+
+var (
+	// 串口收到的字节不够一帧时继续读取
+	ErrFrameIncomplete = fmt.Errorf("cjt188 %w", serialport.ErrIncomplete)
+	ErrFrameInvalid    = errors.New("cjt188 frame invalid")
+	ErrInvalidBCD      = errors.New("cjt188 invalid bcd")
+)
+
+// AbnormalError 从站异常应答, Code 为状态字
+type AbnormalError struct {
+	Code uint16
+}
+
+func (e AbnormalError) Error() string {
+	return fmt.Sprintf("cjt188 abnormal response 0x%04X", e.Code)
+}
+
+// frame 一帧报文
+type frame struct {
+	Type    byte
+	Address [7]byte
+	Control byte
+	DI      uint16
+	Ser     byte
+	Data    []byte
+}
+
+// encode 编码报文, 前面加 2 个 FE 唤醒字节
+func (f frame) encode() []byte {
+	buf := make([]byte, 0, 2+headerLen+3+len(f.Data)+2)
+	buf = append(buf, preamble, preamble)
+	start := len(buf)
+	buf = append(buf, frameStart, f.Type)
+	buf = append(buf, f.Address[:]...)
+	buf = append(buf, f.Control, byte(3+len(f.Data)), byte(f.DI>>8), byte(f.DI), f.Ser)
+	buf = append(buf, f.Data...)
+	buf = append(buf, checksum(buf[start:]), frameEnd)
+	return buf
+}
+
+func checksum(bs []byte) byte {
+	var cs byte
+	for _, b := range bs {
+		cs += b
+	}
+	return cs
+}
+
+// decodeFrame 从 buf 中解析一帧, 跳过帧前的唤醒字节和杂波
+// 帧头没有第二个 68, 从每个 68 开始尝试, 结束符和校验和都对才是一帧
+func decodeFrame(buf []byte) (f frame, err error) {
+	for i := indexFrameStart(buf); i >= 0; i = indexFrameStart(buf) {
+		buf = buf[i:]
+		f, err = parseFrame(buf)
+		if err == nil || !errors.Is(err, errSkip) {
+			return f, err
+		}
+		buf = buf[1:]
+	}
+	return f, ErrFrameIncomplete
+}
+
+// errSkip 不是一帧, 或者帧还不完整, 从下一个 68 继续找
+var errSkip = errors.New("skip")
+
+func parseFrame(buf []byte) (f frame, err error) {
+	if len(buf) < headerLen {
+		return f, errSkip
+	}
+	n := int(buf[10])
+	if n > maxDataLen || len(buf) < headerLen+n+2 {
+		return f, errSkip
+	}
+	if buf[headerLen+n+1] != frameEnd || checksum(buf[:headerLen+n]) != buf[headerLen+n] {
+		return f, errSkip
+	}
+	f.Type = buf[1]
+	copy(f.Address[:], buf[2:9])
+	f.Control = buf[9]
+	data := buf[headerLen : headerLen+n]
+	// 异常应答只有 SER 和状态字
+	if f.Control&flagAbnormal != 0 {
+		f.Data = append([]byte(nil), data...)
+		return f, nil
+	}
+	if n < 3 {
+		return f, fmt.Errorf("%w: data length %d", ErrFrameInvalid, n)
+	}
+	f.DI = uint16(data[0])<<8 | uint16(data[1])
+	f.Ser = data[2]
+	f.Data = append([]byte(nil), data[3:]...)
+	return f, nil
+}
+
+func indexFrameStart(buf []byte) int {
+	for i, b := range buf {
+		if b == frameStart {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkResponse 校验应答的控制码、数据标识和序号, 异常应答转为 AbnormalError
+func checkResponse(req, resp frame) error {
+	if resp.Control&^(flagResponse|flagAbnormal) != req.Control || resp.Control&flagResponse == 0 {
+		return fmt.Errorf("%w: unexpected control code 0x%02X", ErrFrameInvalid, resp.Control)
+	}
+	if resp.Control&flagAbnormal != 0 {
+		// SER ST0 ST1
+		if len(resp.Data) < 3 {
+			return AbnormalError{}
+		}
+		return AbnormalError{Code: uint16(resp.Data[1]) | uint16(resp.Data[2])<<8}
+	}
+	if resp.DI != req.DI || resp.Ser != req.Ser {
+		return fmt.Errorf("%w: unexpected di 0x%04X ser %d", ErrFrameInvalid, resp.DI, resp.Ser)
+	}
+	if req.Address != broadcastAddress && req.Address != resp.Address {
+		return fmt.Errorf("%w: address mismatch %s", ErrFrameInvalid, formatAddress(resp.Address))
+	}
+	return nil
+}
+
+// parseAddress 表地址 14 位数字, 不足 14 位左补 0
+func parseAddress(s string) (addr [7]byte, err error) {
+	s = strings.TrimSpace(s)
+	if len(s) > 14 {
+		return addr, fmt.Errorf("invalid meter address: %s", s)
+	}
+	s = strings.Repeat("0", 14-len(s)) + s
+	bs, err := hex.DecodeString(s)
+	if err != nil {
+		return addr, fmt.Errorf("invalid meter address: %s", s)
+	}
+	// 低字节在前
+	for i := range addr {
+		addr[i] = bs[6-i]
+	}
+	return addr, nil
+}
+
+func formatAddress(addr [7]byte) string {
+	bs := make([]byte, 7)
+	for i := range addr {
+		bs[i] = addr[6-i]
+	}
+	return hex.EncodeToString(bs)
+}
+
+// decodeBCD 低字节在前的 BCD 码
+func decodeBCD(bs []byte, decimals int) (float64, error) {
+	var v float64
+	for i := len(bs) - 1; i >= 0; i-- {
+		hi, lo := bs[i]>>4, bs[i]&0x0F
+		if hi > 9 || lo > 9 {
+			return 0, fmt.Errorf("%w: % X", ErrInvalidBCD, bs)
+		}
+		v = v*100 + float64(hi*10+lo)
+	}
+	for range decimals {
+		v /= 10
+	}
+	return v, nil
+}
+
+// decodeTime 实时时间 ss mm hh DD MM YYYY, 低字节在前
+func decodeTime(bs []byte) (time.Time, error) {
+	var vs [7]int
+	for i, b := range bs[:7] {
+		hi, lo := b>>4, b&0x0F
+		if hi > 9 || lo > 9 {
+			return time.Time{}, fmt.Errorf("%w: % X", ErrInvalidBCD, bs[:7])
+		}
+		vs[i] = int(hi*10 + lo)
+	}
+	year := vs[6]*100 + vs[5]
+	return time.Date(year, time.Month(vs[4]), vs[3], vs[2], vs[1], vs[0], 0, time.Local), nil
+}
+
+// 单位代号, 换算到 m³、kWh、kW、m³/h
+var units = map[byte]float64{
+	0x02: 0.001,         // Wh
+	0x05: 1,             // kWh
+	0x08: 1000,          // MWh
+	0x0A: 100000,        // MWh×100
+	0x01: 1 / 3600000.0, // J
+	0x0B: 1 / 3600.0,    // kJ
+	0x0E: 1 / 3.6,       // MJ
+	0x11: 1000 / 3.6,    // GJ
+	0x13: 100000 / 3.6,  // GJ×100
+	0x14: 0.001,         // W
+	0x17: 1,             // kW
+	0x1A: 1000,          // MW
+	0x29: 0.001,         // L
+	0x2C: 1,             // m³
+	0x32: 0.001,         // L/h
+	0x35: 1,             // m³/h
+}
+
+// decodeValue 4 字节 BCD 加 1 字节单位
+func decodeValue(bs []byte, decimals int) (float64, error) {
+	v, err := decodeBCD(bs[:4], decimals)
+	if err != nil {
+		return 0, err
+	}
+	scale, ok := units[bs[4]]
+	if !ok {
+		return 0, fmt.Errorf("%w: unknown unit 0x%02X", ErrFrameInvalid, bs[4])
+	}
+	return v * scale, nil
+}
+
+// 点位字段
+const (
+	FieldFlow       = "flow"       // 当前累积流量 m³
+	FieldSettleFlow = "settleFlow" // 结算日累积流量 m³
+	FieldSettleHeat = "settleHeat" // 结算日热量 kWh
+	FieldHeat       = "heat"       // 当前热量 kWh
+	FieldPower      = "power"      // 热功率 kW
+	FieldFlowRate   = "flowRate"   // 流量 m³/h
+	FieldSupplyTemp = "supplyTemp" // 供水温度 ℃
+	FieldReturnTemp = "returnTemp" // 回水温度 ℃
+	FieldWorkHours  = "workHours"  // 累积工作时间 h
+	FieldTime       = "time"       // 表内时间
+	FieldStatus     = "status"     // 状态字 ST
+	FieldValve      = "valve"      // 阀门 0 开 1 关 3 异常
+	FieldBattery    = "battery"    // 电池 0 正常 1 欠压
+)
+
+// decodeMeterData 解析 901F 计量数据
+func decodeMeterData(typ byte, data []byte) (map[string]interface{}, error) {
+	values := make(map[string]interface{})
+	var err error
+	read := func(field string, bs []byte, decimals int) {
+		if err != nil {
+			return
+		}
+		values[field], err = decodeValue(bs, decimals)
+	}
+
+	var rest []byte
+	switch {
+	case typ >= 0x10 && typ <= 0x19: // 水表
+		if len(data) < 19 {
+			return nil, fmt.Errorf("%w: water meter data length %d", ErrFrameInvalid, len(data))
+		}
+		read(FieldFlow, data[0:5], 2)
+		read(FieldSettleFlow, data[5:10], 2)
+		rest = data[10:]
+	case typ == TypeHeat || typ == TypeCold: // 热量表
+		if len(data) < 43 {
+			return nil, fmt.Errorf("%w: heat meter data length %d", ErrFrameInvalid, len(data))
+		}
+		read(FieldSettleHeat, data[0:5], 2)
+		read(FieldHeat, data[5:10], 2)
+		read(FieldPower, data[10:15], 2)
+		read(FieldFlowRate, data[15:20], 4)
+		read(FieldFlow, data[20:25], 2)
+		if err == nil {
+			values[FieldSupplyTemp], err = decodeBCD(data[25:28], 2)
+		}
+		if err == nil {
+			values[FieldReturnTemp], err = decodeBCD(data[28:31], 2)
+		}
+		if err == nil {
+			values[FieldWorkHours], err = decodeBCD(data[31:34], 0)
+		}
+		rest = data[34:]
+	default:
+		return nil, fmt.Errorf("unsupported meter type 0x%02X", typ)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 实时时间 7 字节, 状态字 2 字节
+	if t, e := decodeTime(rest[:7]); e == nil {
+		values[FieldTime] = t.Format(time.DateTime)
+	}
+	st := uint16(rest[7]) | uint16(rest[8])<<8
+	values[FieldStatus] = st
+	values[FieldValve] = st & 0x03
+	values[FieldBattery] = (st >> 2) & 0x01
+	return values, nil
+}
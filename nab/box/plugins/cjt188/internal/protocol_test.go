@@ -0,0 +1,204 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/twiglab/h2o/nab/box/pkg/testutil"
+)
+
+// 表号 00000012345678
+var meterAddress = [7]byte{0x78, 0x56, 0x34, 0x12, 0x00, 0x00, 0x00}
+
+const (
+	// 冷水水表 901F 应答: 累积流量 123.45m³, 结算日 100.00m³, 2024-05-06 07:08:09, 阀开
+	waterResp = "FE FE 68 10 78 56 34 12 00 00 00 81 16 90 1F 01 45 23 01 00 2C 00 00 01 00 2C 09 08 07 06 05 24 20 00 00 FC 16"
+	// 热量表 901F 应答: 结算日热量 1234.56kWh, 当前 12.34MWh, 5.67kW, 1.2345m³/h, 567.89m³,
+	// 供水 45.67℃, 回水 38.90℃, 12345h, 阀关, 电池欠压
+	heatResp = "68 20 78 56 34 12 00 00 00 81 2E 90 1F 02 56 34 12 00 05 34 12 00 00 08 67 05 00 00 17 45 23 01 00 35 89 67 05 00 2C 67 45 00 90 38 00 45 23 01 09 08 07 06 05 24 20 05 00 76 16"
+	// 写阀门异常应答: SER 03, 状态字 0001
+	abnormalResp = "68 10 78 56 34 12 00 00 00 C4 03 03 01 00 57 16"
+)
+
+func TestEncodeFrame(t *testing.T) {
+	f := frame{Type: TypeColdWater, Address: meterAddress, Control: ctrlRead, DI: diMeterData, Ser: 1}
+	want := "FE FE 68 10 78 56 34 12 00 00 00 01 03 90 1F 01 40 16"
+	if got := f.encode(); !bytes.Equal(got, testutil.Unhex(t, want)) {
+		t.Fatalf("got % X, want %s", got, want)
+	}
+}
+
+func TestDecodeFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		typ     byte
+		control byte
+		dataLen int
+		err     error
+	}{
+		{"水表计量数据", waterResp, TypeColdWater, 0x81, 19, nil},
+		{"热量表计量数据", heatResp, TypeHeat, 0x81, 43, nil},
+		{"异常应答", abnormalResp, TypeColdWater, 0xC4, 3, nil},
+		// 数据里的 68 不是帧头
+		{"帧前有杂波", "68 00 FE " + abnormalResp, TypeColdWater, 0xC4, 3, nil},
+		{"不完整", waterResp[:60], 0, 0, 0, ErrFrameIncomplete},
+		{"校验和错误", strings.Replace(abnormalResp, "57 16", "58 16", 1), 0, 0, 0, ErrFrameIncomplete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := decodeFrame(testutil.Unhex(t, tt.in))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if f.Type != tt.typ || f.Address != meterAddress || f.Control != tt.control || len(f.Data) != tt.dataLen {
+				t.Fatalf("got %02X %s %02X %d bytes, want %02X %02X %d bytes",
+					f.Type, formatAddress(f.Address), f.Control, len(f.Data), tt.typ, tt.control, tt.dataLen)
+			}
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	resp, err := decodeFrame(testutil.Unhex(t, abnormalResp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := frame{Type: TypeColdWater, Address: meterAddress, Control: ctrlWrite, DI: diValve, Ser: 3}
+	var ae AbnormalError
+	if err := checkResponse(req, resp); !errors.As(err, &ae) || ae.Code != 0x0001 {
+		t.Fatalf("got %v, want abnormal 0x0001", err)
+	}
+
+	resp, err = decodeFrame(testutil.Unhex(t, waterResp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = frame{Type: TypeColdWater, Address: meterAddress, Control: ctrlRead, DI: diMeterData, Ser: 2}
+	if err := checkResponse(req, resp); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("got %v, want ser mismatch", err)
+	}
+}
+
+func TestDecodeMeterData(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want map[string]interface{}
+	}{
+		{"水表", waterResp, map[string]interface{}{
+			FieldFlow:       123.45,
+			FieldSettleFlow: 100.0,
+			FieldTime:       "2024-05-06 07:08:09",
+			FieldStatus:     uint16(0),
+			FieldValve:      uint16(0),
+			FieldBattery:    uint16(0),
+		}},
+		{"热量表", heatResp, map[string]interface{}{
+			FieldSettleHeat: 1234.56,
+			FieldHeat:       12340.0,
+			FieldPower:      5.67,
+			FieldFlowRate:   1.2345,
+			FieldFlow:       567.89,
+			FieldSupplyTemp: 45.67,
+			FieldReturnTemp: 38.9,
+			FieldWorkHours:  12345.0,
+			FieldTime:       "2024-05-06 07:08:09",
+			FieldStatus:     uint16(5),
+			FieldValve:      uint16(1),
+			FieldBattery:    uint16(1),
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := decodeFrame(testutil.Unhex(t, tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := decodeMeterData(f.Type, f.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for k, want := range tt.want {
+				if w, ok := want.(float64); ok {
+					if g, ok := got[k].(float64); !ok || math.Abs(g-w) > 1e-9 {
+						t.Fatalf("%s = %v, want %v", k, got[k], w)
+					}
+					continue
+				}
+				if got[k] != want {
+					t.Fatalf("%s = %v, want %v", k, got[k], want)
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeMeterDataInvalid(t *testing.T) {
+	f, err := decodeFrame(testutil.Unhex(t, waterResp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := decodeMeterData(TypeColdWater, f.Data[:18]); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("short data: got %v, want ErrFrameInvalid", err)
+	}
+	// 按热量表解析水表数据长度不够
+	if _, err := decodeMeterData(TypeHeat, f.Data); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("water data as heat: got %v, want ErrFrameInvalid", err)
+	}
+	if _, err := decodeMeterData(0x30, f.Data); err == nil {
+		t.Fatal("got nil error for gas meter type")
+	}
+	bad := append([]byte(nil), f.Data...)
+	bad[4] = 0x99
+	if _, err := decodeMeterData(TypeColdWater, bad); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("unknown unit: got %v, want ErrFrameInvalid", err)
+	}
+	bad[4], bad[0] = 0x2C, 0x4A
+	if _, err := decodeMeterData(TypeColdWater, bad); !errors.Is(err, ErrInvalidBCD) {
+		t.Fatalf("bad bcd: got %v, want ErrInvalidBCD", err)
+	}
+}
+
+func TestUnits(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		want float64
+	}{
+		{"Wh", []byte{0x00, 0x50, 0x00, 0x00, 0x02}, 0.05},
+		{"kWh", []byte{0x00, 0x50, 0x00, 0x00, 0x05}, 50},
+		{"MWh", []byte{0x00, 0x50, 0x00, 0x00, 0x08}, 50000},
+		{"MWh×100", []byte{0x01, 0x00, 0x00, 0x00, 0x0A}, 1000},
+		{"kJ", []byte{0x00, 0x00, 0x36, 0x00, 0x0B}, 1},
+		{"MJ", []byte{0x00, 0x00, 0x36, 0x00, 0x0E}, 1000},
+		{"GJ", []byte{0x00, 0x01, 0x00, 0x00, 0x11}, 1000 / 3.6},
+		{"GJ×100", []byte{0x01, 0x00, 0x00, 0x00, 0x13}, 100000 / 3.6 / 100},
+		{"W", []byte{0x00, 0x50, 0x00, 0x00, 0x14}, 0.05},
+		{"MW", []byte{0x00, 0x01, 0x00, 0x00, 0x1A}, 1000},
+		{"L", []byte{0x00, 0x50, 0x00, 0x00, 0x29}, 0.05},
+		{"m³", []byte{0x00, 0x50, 0x00, 0x00, 0x2C}, 50},
+		{"L/h", []byte{0x00, 0x50, 0x00, 0x00, 0x32}, 0.05},
+		{"m³/h", []byte{0x00, 0x50, 0x00, 0x00, 0x35}, 50},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeValue(tt.in, 2)
+			if err != nil || math.Abs(got-tt.want) > 1e-9 {
+				t.Fatalf("got %v %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+	if _, err := decodeValue([]byte{0, 0, 0, 0, 0x99}, 2); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("got %v, want unknown unit", err)
+	}
+}
@@ -0,0 +1,10 @@
+package cjt188
+
+import (
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/plugins/cjt188/internal"
+)
+
+func EnablePlugin() {
+	driverbox.EnablePlugin(internal.ProtocolName, new(internal.Plugin))
+}
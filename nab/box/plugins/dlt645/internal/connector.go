@@ -0,0 +1,399 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/convutil"
+	"github.com/twiglab/h2o/nab/box/pkg/crontab"
+	"github.com/twiglab/h2o/nab/box/pkg/event"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+	"go.uber.org/zap"
+)
+
+// relayDeadline 拉合闸命令的有效截止时间
+const relayDeadline = 10 * time.Minute
+
+func newConnector(p *Plugin, cf *ConnectionConfig) (*connector, error) {
+	if err := cf.Config.Init(200, 2000); err != nil {
+		return nil, fmt.Errorf("dlt645 %w", err)
+	}
+	if cf.Retry == 0 {
+		cf.Retry = 3
+	}
+	if cf.DiscoverDuration == "" {
+		cf.DiscoverDuration = "1m"
+	}
+
+	conn := &connector{
+		config:     cf,
+		plugin:     p,
+		transport:  serialport.New(ProtocolName, &cf.Config),
+		virtual:    cf.Virtual || config.IsVirtual(),
+		meters:     make(map[string]*meter),
+		discovered: make(map[[6]byte]bool),
+	}
+	return conn, nil
+}
+
+func (c *connector) initCollectTask(conf *ConnectionConfig) (*crontab.Future, error) {
+	discoverDuration, err := time.ParseDuration(conf.DiscoverDuration)
+	if err != nil {
+		driverbox.Log().Error("error dlt645 discover duration config", zap.String("key", conf.ConnectionKey), zap.Error(err))
+		discoverDuration = time.Minute
+	}
+
+	//注册定时采集任务
+	return driverbox.AddFunc("1s", func() {
+		if conf.Discover && c.latestDiscoverTime.Add(discoverDuration).Before(time.Now()) {
+			c.latestDiscoverTime = time.Now()
+			c.discover()
+		}
+
+		for _, m := range c.meters {
+			if c.close {
+				driverbox.Log().Warn("dlt645 connection is closed, ignore collect task!", zap.String("key", conf.ConnectionKey))
+				return
+			}
+			points := m.duePoints()
+			if len(points) == 0 {
+				continue
+			}
+
+			err := c.Send(command{
+				Mode:  plugin.ReadMode,
+				Value: &readValue{meter: m, points: points},
+			})
+			now := time.Now()
+			for _, p := range points {
+				p.latestTime = now
+			}
+			if err != nil {
+				driverbox.Log().Error("read error", zap.String("deviceId", m.deviceId), zap.Error(err))
+				//电表不应答，跳过数个采集周期
+				if errors.Is(err, serialport.ErrTimeout) {
+					m.timeoutCount += 1
+				}
+				_ = driverbox.Shadow().MayBeOffline(m.deviceId)
+			} else {
+				m.timeoutCount = 0
+			}
+		}
+	})
+}
+
+// duePoints 采集时间已到的点位, 连续超时后按倍数延长采集间隔, 最长一分钟
+func (m *meter) duePoints() []*Point {
+	var points []*Point
+	for _, p := range m.points {
+		duration := p.duration
+		if m.timeoutCount > 0 {
+			duration = duration * time.Duration(1<<min(m.timeoutCount, 16))
+			if duration > time.Minute {
+				duration = time.Minute
+			}
+		}
+		if p.latestTime.Add(duration).After(time.Now()) {
+			continue
+		}
+		points = append(points, p)
+	}
+	return points
+}
+
+// createMeter 每个设备对应一块表, 设备属性 address 为 12 位表号
+func (c *connector) createMeter(model config.DeviceModel, dev config.Device) error {
+	addr, err := parseAddress(dev.Properties["address"])
+	if err != nil {
+		return err
+	}
+	m := &meter{
+		deviceId: dev.ID,
+		address:  addr,
+		password: 0x02000000,
+	}
+	if s := dev.Properties["password"]; s != "" {
+		if m.password, err = parseHex4(s); err != nil {
+			return fmt.Errorf("invalid password: %w", err)
+		}
+	}
+	if s := dev.Properties["operator"]; s != "" {
+		if m.operator, err = parseHex4(s); err != nil {
+			return fmt.Errorf("invalid operator: %w", err)
+		}
+	}
+
+	for _, point := range model.DevicePoints {
+		if point.ReadWrite() != config.ReadWrite_R && point.ReadWrite() != config.ReadWrite_RW {
+			continue
+		}
+		ext, err := convToPointExtend(point)
+		if err != nil {
+			driverbox.Log().Error("error dlt645 point config", zap.String("deviceId", dev.ID), zap.Any("point", point), zap.Error(err))
+			continue
+		}
+		if ext.DataId == DataIdRelay {
+			continue
+		}
+		ext.DeviceId = dev.ID
+		m.points = append(m.points, ext)
+	}
+	c.meters[dev.ID] = m
+	c.discovered[addr] = true
+	return nil
+}
+
+func convToPointExtend(extends config.Point) (*Point, error) {
+	extend := new(Point)
+	extend.Point = extends
+	if err := convutil.Struct(extends, extend); err != nil {
+		return nil, err
+	}
+	//未设置，则默认每分钟采集一次
+	if extend.Duration == "" {
+		extend.Duration = "1m"
+	}
+	duration, err := time.ParseDuration(extend.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("convert duration error: %s", err.Error())
+	}
+	extend.duration = duration
+
+	if extend.DataId == DataIdRelay {
+		return extend, nil
+	}
+	extend.di, err = parseHex4(extend.DataId)
+	if err != nil {
+		return nil, fmt.Errorf("convert data id error: %s", err.Error())
+	}
+	format, ok := lookupFormat(extend.di)
+	if extend.DataLen > 0 {
+		format = dataFormat{Len: extend.DataLen, Decimals: extend.Decimals, Signed: extend.Signed}
+	} else if !ok {
+		return nil, fmt.Errorf("unknown data id %s, dataLen is required", extend.DataId)
+	}
+	extend.format = format
+	return extend, nil
+}
+
+// Encode 编码数据
+func (c *connector) Encode(deviceId string, mode plugin.EncodeMode, values ...plugin.PointData) (res interface{}, err error) {
+	m, ok := c.meters[deviceId]
+	if !ok {
+		return nil, fmt.Errorf("device [%s] not found", deviceId)
+	}
+
+	switch mode {
+	case plugin.ReadMode:
+		var points []*Point
+		for _, v := range values {
+			for _, p := range m.points {
+				if p.Name() == v.PointName {
+					points = append(points, p)
+					break
+				}
+			}
+		}
+		return command{
+			Mode:  plugin.ReadMode,
+			Value: &readValue{meter: m, points: points},
+		}, nil
+	case plugin.WriteMode:
+		if len(values) != 1 {
+			return nil, errors.New("dlt645 only supports writing one relay point")
+		}
+		p, ok := driverbox.CoreCache().GetPointByDevice(deviceId, values[0].PointName)
+		if !ok {
+			return nil, fmt.Errorf("point [%s] not found", values[0].PointName)
+		}
+		if dataId, _ := p.FieldValue("dataId"); dataId != DataIdRelay {
+			return nil, fmt.Errorf("point [%s] is not writable", values[0].PointName)
+		}
+		code, err := relayCode(values[0].Value)
+		if err != nil {
+			return nil, err
+		}
+		return command{
+			Mode:  plugin.WriteMode,
+			Value: &relayValue{meter: m, code: code},
+		}, nil
+	}
+	return nil, plugin.NotSupportEncode
+}
+
+// relayCode 0 跳闸, 1 直接合闸, 其他值为控制命令类型, 如 0x1B 合闸允许、0x3A 保电
+func relayCode(value interface{}) (byte, error) {
+	v, err := convutil.Int64(value)
+	if err != nil {
+		return 0, err
+	}
+	switch v {
+	case 0:
+		return RelayTrip, nil
+	case 1:
+		return RelayClose, nil
+	}
+	if v < 0 || v > 0xFF || !validRelayCode(byte(v)) {
+		return 0, fmt.Errorf("invalid relay control code: %v", value)
+	}
+	return byte(v), nil
+}
+
+// Send 发送数据
+func (c *connector) Send(data interface{}) (err error) {
+	cmd, ok := data.(command)
+	if !ok {
+		return errors.New("unsupported data type")
+	}
+	switch cmd.Mode {
+	case plugin.ReadMode:
+		rv := cmd.Value.(*readValue)
+		return c.sendReadCommand(rv.meter, rv.points)
+	case plugin.WriteMode:
+		wv := cmd.Value.(*relayValue)
+		return c.sendRelayCommand(wv.meter, wv.code)
+	}
+	return errors.New("not support mode error")
+}
+
+// Release 释放资源
+// 不释放连接资源, 串口由连接器一直持有
+func (c *connector) Release() (err error) {
+	return
+}
+
+func (c *connector) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.close = true
+	if c.collectTask != nil {
+		c.collectTask.Disable()
+	}
+	c.transport.Close()
+}
+
+// request 请求失败时重试, 异常应答不重试
+func (c *connector) request(req frame) (resp frame, err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.close {
+		return resp, errors.New("dlt645 connection is closed")
+	}
+	for i := 0; i < c.config.Retry; i++ {
+		resp, err = c.exchange(req)
+		if err == nil || isAbnormal(err) {
+			return
+		}
+	}
+	return
+}
+
+// sendReadCommand 逐个读数据标识, 读到的点位一起上报
+// 电表不应答时放弃剩余点位, 其他错误只跳过当前点位
+func (c *connector) sendReadCommand(m *meter, points []*Point) error {
+	values := make([]plugin.PointData, 0, len(points))
+	var errs []error
+	for _, p := range points {
+		v, err := c.readPoint(m, p)
+		if err != nil {
+			if errors.Is(err, serialport.ErrTimeout) {
+				return err
+			}
+			errs = append(errs, fmt.Errorf("%s: %w", p.Name(), err))
+			continue
+		}
+		values = append(values, plugin.PointData{PointName: p.Name(), Value: v})
+	}
+	if len(values) > 0 {
+		driverbox.Export([]plugin.DeviceData{{ID: m.deviceId, Values: values}})
+	}
+	return errors.Join(errs...)
+}
+
+func (c *connector) readPoint(m *meter, p *Point) (float64, error) {
+	if c.virtual {
+		return c.mockRead(m, p)
+	}
+	resp, err := c.request(frame{Address: m.address, Control: ctrlRead, Data: putUint32(p.di)})
+	if err != nil {
+		return 0, err
+	}
+	if len(resp.Data) < 4+p.format.Len {
+		return 0, fmt.Errorf("%w: data length %d", ErrFrameInvalid, len(resp.Data))
+	}
+	if di := putUint32(p.di); string(resp.Data[:4]) != string(di) {
+		return 0, fmt.Errorf("%w: data id % X", ErrFrameInvalid, resp.Data[:4])
+	}
+	return decodeBCD(resp.Data[4:4+p.format.Len], p.format.Decimals, p.format.Signed)
+}
+
+func (c *connector) sendRelayCommand(m *meter, code byte) error {
+	if c.virtual {
+		return c.mockRelay(m, code)
+	}
+	pw := putUint32(m.password)
+	data := []byte{pw[3], pw[0], pw[1], pw[2]}
+	data = append(data, putUint32(m.operator)...)
+	data = append(data, code, 0x00)
+	data = append(data, relayDeadlineBCD(time.Now().Add(relayDeadline))...)
+
+	_, err := c.request(frame{Address: m.address, Control: ctrlRelay, Data: data})
+	if err != nil {
+		return fmt.Errorf("relay [%s] 0x%02X error: %w", m.deviceId, code, err)
+	}
+	driverbox.Log().Info("dlt645 relay control", zap.String("deviceId", m.deviceId), zap.Uint8("code", code))
+	return nil
+}
+
+// relayDeadlineBCD 有效截止时间 ss mm hh DD MM YY
+func relayDeadlineBCD(t time.Time) []byte {
+	bs := make([]byte, 0, 6)
+	for _, v := range []int{t.Second(), t.Minute(), t.Hour(), t.Day(), int(t.Month()), t.Year() % 100} {
+		bs = append(bs, encodeBCD(uint64(v), 1)...)
+	}
+	return bs
+}
+
+// discover 用广播地址读通信地址, 新的表号触发设备自动发现
+func (c *connector) discover() {
+	if c.virtual {
+		return
+	}
+	resp, err := c.request(frame{Address: broadcastAddress, Control: ctrlReadAddress})
+	if err != nil {
+		driverbox.Log().Debug("dlt645 discover error", zap.String("key", c.config.ConnectionKey), zap.Error(err))
+		return
+	}
+	addr := resp.Address
+	if len(resp.Data) >= 6 {
+		copy(addr[:], resp.Data[:6])
+	}
+	if c.discovered[addr] {
+		return
+	}
+	c.discovered[addr] = true
+
+	address := formatAddress(addr)
+	deviceId := ProtocolName + "_" + address
+	driverbox.Log().Info("dlt645 meter discovered", zap.String("key", c.config.ConnectionKey), zap.String("address", address))
+	deviceData := []plugin.DeviceData{{
+		ID: deviceId,
+		Events: []event.Data{{
+			Code: event.DeviceDiscover,
+			Value: map[string]interface{}{
+				"modelKey": c.config.ModelKey,
+				"device": map[string]interface{}{
+					"id":          deviceId,
+					"description": "dlt645 " + address,
+					"properties":  map[string]string{"address": address},
+				},
+			},
+		}},
+	}}
+	plugin.WrapperDiscoverEvent(deviceData, c.config.ConnectionKey, ProtocolName)
+	driverbox.Export(deviceData)
+}
@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/pkg/luautil"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var ls *lua.LState
+
+// InitMockLua 虚拟模式下由脚本中的 mockDlt645Read、mockDlt645Relay 模拟电表
+func InitMockLua() {
+	if ls != nil {
+		return
+	}
+	l, err := luautil.InitMockVM(ProtocolName)
+	if err != nil {
+		driverbox.Log().Error("init lua vm error", zap.Error(err))
+		return
+	}
+	ls = l
+}
+
+func (c *connector) mockRead(m *meter, p *Point) (float64, error) {
+	if ls == nil {
+		return 0, errors.New("lua vm is nil")
+	}
+	mockData, err := luautil.CallLuaMethod(ls, "mockDlt645Read", lua.LString(formatAddress(m.address)), lua.LString(p.DataId))
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(mockData, 64)
+}
+
+func (c *connector) mockRelay(m *meter, code byte) error {
+	if ls == nil {
+		return errors.New("lua vm is nil")
+	}
+	result, err := luautil.CallLuaMethod(ls, "mockDlt645Relay", lua.LString(formatAddress(m.address)), lua.LNumber(code))
+	if err == nil {
+		driverbox.Log().Info("mockRelay result", zap.Any("result", result))
+	}
+	return err
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// DataIdRelay 拉合闸点位的数据标识
+const DataIdRelay = "relay"
+
+// ConnectionConfig 连接器配置
+type ConnectionConfig struct {
+	plugin.BaseConnection
+	serialport.Config
+	Retry int `json:"retry"` // 重试次数
+	// 自动发现时使用的模型 Key，总线上只能有一块表应答广播地址
+	ModelKey string `json:"modelKey"`
+	// 自动发现的间隔
+	DiscoverDuration string `json:"discoverDuration"`
+}
+
+// Point dlt645 点位
+type Point struct {
+	config.Point
+	DeviceId string
+
+	// 点位采集周期
+	Duration string `json:"duration"`
+	// 数据标识，如 00010000 正向有功总电能、02010100 A相电压，relay 为拉合闸
+	DataId string `json:"dataId"`
+	// 数据字节数、小数位数、符号位，常用数据标识可以不设置
+	DataLen  int  `json:"dataLen"`
+	Decimals int  `json:"decimals"`
+	Signed   bool `json:"signed"`
+
+	di         uint32
+	format     dataFormat
+	duration   time.Duration
+	latestTime time.Time
+}
+
+// meter 一块电表
+type meter struct {
+	deviceId string
+	address  [6]byte
+	// 密码，密码权限 PA 在前，如 02000000
+	password uint32
+	// 操作者代码
+	operator uint32
+
+	points []*Point
+	// 最近连续超时次数
+	timeoutCount int
+}
+
+// Connector#Send接入入参
+type command struct {
+	Mode  plugin.EncodeMode // 模式
+	Value interface{}
+}
+
+// 读操作时 command 的 value 类型
+type readValue struct {
+	meter  *meter
+	points []*Point
+}
+
+// 写操作时 command 的 value 类型
+type relayValue struct {
+	meter *meter
+	code  byte
+}
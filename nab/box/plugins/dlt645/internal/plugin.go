@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/convutil"
+	"github.com/twiglab/h2o/nab/box/pkg/crontab"
+	"github.com/twiglab/h2o/nab/box/pkg/luautil"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+	"go.uber.org/zap"
+)
+
+const ProtocolName = "dlt645"
+
+// Plugin 驱动插件
+type Plugin struct {
+	connPool map[string]*connector // 连接器
+	config   config.DeviceConfig
+}
+
+// connector 连接器, 一条 RS-485 总线或一个串口服务器端口
+type connector struct {
+	config    *ConnectionConfig
+	plugin    *Plugin
+	transport *serialport.Transport
+	// 同一总线上的请求依次执行
+	mutex sync.Mutex
+
+	// 设备 ID 对应的电表
+	meters map[string]*meter
+	// 已知的表号，自动发现时跳过
+	discovered         map[[6]byte]bool
+	latestDiscoverTime time.Time
+
+	//当前连接的定时扫描任务
+	collectTask *crontab.Future
+	//当前连接是否已关闭
+	close bool
+	//是否虚拟链接
+	virtual bool
+}
+
+// Initialize 插件初始化
+func (p *Plugin) Initialize(c config.DeviceConfig) {
+	p.config = c
+	//初始化连接池
+	p.initNetworks(c)
+}
+
+// 初始化 DL/T 645 连接池
+func (p *Plugin) initNetworks(config config.DeviceConfig) {
+	p.connPool = make(map[string]*connector)
+	//某个连接配置有问题，不影响其他连接的建立
+	for key, connConfig := range config.Connections {
+		connectionConfig := new(ConnectionConfig)
+		if err := convutil.Struct(connConfig, connectionConfig); err != nil {
+			driverbox.Log().Error("convert connector config error", zap.Any("connection", connConfig), zap.Error(err))
+			continue
+		}
+		connectionConfig.ConnectionKey = key
+		conn, err := newConnector(p, connectionConfig)
+		if err != nil {
+			driverbox.Log().Error("init connector error", zap.Any("connection", connConfig), zap.Error(err))
+			continue
+		}
+		if conn.virtual {
+			InitMockLua()
+		}
+
+		for _, model := range config.DeviceModels {
+			//如果模型不存在关联设备,清理该模型
+			if len(model.Devices) == 0 {
+				e := driverbox.CoreCache().DeleteModel(model.Name)
+				if e != nil {
+					driverbox.Log().Error("delete model error", zap.Any("model", model), zap.Error(e))
+				} else {
+					driverbox.Log().Warn("delete idle model", zap.Any("model", model))
+				}
+				continue
+			}
+			for _, dev := range model.Devices {
+				if dev.ConnectionKey != key {
+					continue
+				}
+				if err := conn.createMeter(model, dev); err != nil {
+					driverbox.Log().Error("error dlt645 device config", zap.String("deviceId", dev.ID), zap.Any("properties", dev.Properties), zap.Error(err))
+				}
+			}
+		}
+
+		//开启自动发现的连接可以没有设备
+		if len(conn.meters) == 0 && !connectionConfig.Discover {
+			err = driverbox.CoreCache().DeleteConnection(key)
+			if err != nil {
+				driverbox.Log().Error("delete connection error", zap.Any("connection", connConfig), zap.Error(err))
+			} else {
+				driverbox.Log().Warn("delete idle connection", zap.Any("connection", connConfig))
+			}
+			continue
+		}
+		if !connectionConfig.Enable {
+			driverbox.Log().Warn("dlt645 connection is disabled, ignore collect task", zap.String("key", key))
+			continue
+		}
+
+		//启动采集任务
+		conn.collectTask, err = conn.initCollectTask(connectionConfig)
+		p.connPool[key] = conn
+		if err != nil {
+			driverbox.Log().Error("init connector collect task error", zap.Any("connection", connConfig), zap.Error(err))
+		}
+	}
+}
+
+// Connector 连接器
+func (p *Plugin) Connector(deviceId string) (conn plugin.Connector, err error) {
+	// 获取连接key
+	device, ok := driverbox.CoreCache().GetDevice(deviceId)
+	if !ok {
+		return nil, errors.New("not found device connection key")
+	}
+	c, ok := p.connPool[device.ConnectionKey]
+	if !ok {
+		driverbox.Log().Error("not found connection key", zap.String("key", device.ConnectionKey))
+		return nil, errors.New("not found connection key, key is " + device.ConnectionKey)
+	}
+	return c, nil
+}
+
+// Destroy 销毁驱动插件
+func (p *Plugin) Destroy() error {
+	for _, conn := range p.connPool {
+		conn.Close()
+	}
+	if ls != nil {
+		luautil.Close(ls)
+		ls = nil
+	}
+	//延迟关闭lua虚拟机，防止lua虚拟机正在使用
+	time.Sleep(time.Second * 1)
+	return nil
+}
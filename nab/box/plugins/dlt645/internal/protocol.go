@@ -0,0 +1,305 @@
+package internal
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// DL/T 645-2007 帧格式:
+// 68 A0..A5 68 C L DATA CS 16
+// 地址为 BCD 码低字节在前, 数据域每字节加 0x33 传输, CS 为第一个 68 到 CS 之前所有字节的模 256 和
+const (
+	frameStart byte = 0x68
+	frameEnd   byte = 0x16
+	preamble   byte = 0xFE
+	dataOffset byte = 0x33
+
+	// 主站控制码
+	ctrlRead        byte = 0x11 // 读数据
+	ctrlReadAddress byte = 0x13 // 读通信地址
+	ctrlRelay       byte = 0x1C // 跳合闸、报警、保电
+
+	// 从站应答标志
+	flagResponse byte = 0x80
+	flagAbnormal byte = 0x40
+
+	// 帧头 68 + 地址 6 + 68 + C + L
+	headerLen = 10
+	// 数据域最大长度
+	maxDataLen = 200
+)
+
+// broadcastAddress 读通信地址时使用的广播地址
+var broadcastAddress = [6]byte{0xAA, 0xAA, 0xAA, 0xAA, 0xAA, 0xAA}
+
+var (
+	// 串口收到的字节不够一帧时继续读取
+	ErrFrameIncomplete = fmt.Errorf("dlt645 %w", serialport.ErrIncomplete)
+	ErrFrameChecksum   = errors.New("dlt645 frame checksum error")
+	ErrFrameInvalid    = errors.New("dlt645 frame invalid")
+	ErrInvalidBCD      = errors.New("dlt645 invalid bcd")
+)
+
+// 异常应答错误信息字 SERR 的各位含义
+var abnormalBits = []string{
+	"other error",         // bit0 其他错误
+	"no request data",     // bit1 无请求数据
+	"unauthorized",        // bit2 密码错/未授权
+	"baud rate unchanged", // bit3 通信速率不能更改
+	"year zone exceeded",  // bit4 年时区数超
+	"day period exceeded", // bit5 日时段数超
+	"tariff exceeded",     // bit6 费率数超
+}
+
+// AbnormalError 从站异常应答
+type AbnormalError struct {
+	Code byte
+}
+
+func (e AbnormalError) Error() string {
+	var reasons []string
+	for i, s := range abnormalBits {
+		if e.Code&(1<<i) != 0 {
+			reasons = append(reasons, s)
+		}
+	}
+	return fmt.Sprintf("dlt645 abnormal response 0x%02X: %s", e.Code, strings.Join(reasons, ","))
+}
+
+// frame 一帧报文, Data 为减去 0x33 后的数据域
+type frame struct {
+	Address [6]byte
+	Control byte
+	Data    []byte
+}
+
+// encode 编码报文, 前面加 4 个 FE 唤醒字节
+func (f frame) encode() []byte {
+	buf := make([]byte, 0, 4+headerLen+len(f.Data)+2)
+	buf = append(buf, preamble, preamble, preamble, preamble)
+	start := len(buf)
+	buf = append(buf, frameStart)
+	buf = append(buf, f.Address[:]...)
+	buf = append(buf, frameStart, f.Control, byte(len(f.Data)))
+	for _, b := range f.Data {
+		buf = append(buf, b+dataOffset)
+	}
+	buf = append(buf, checksum(buf[start:]), frameEnd)
+	return buf
+}
+
+func checksum(bs []byte) byte {
+	var cs byte
+	for _, b := range bs {
+		cs += b
+	}
+	return cs
+}
+
+// decodeFrame 从 buf 中解析一帧, 跳过帧前的唤醒字节和杂波
+// 返回 ErrFrameIncomplete 时需要继续读取
+func decodeFrame(buf []byte) (f frame, err error) {
+	for {
+		i := indexFrameStart(buf)
+		if i < 0 {
+			return f, ErrFrameIncomplete
+		}
+		buf = buf[i:]
+		if len(buf) < headerLen {
+			return f, ErrFrameIncomplete
+		}
+		n := int(buf[9])
+		if buf[7] != frameStart || n > maxDataLen {
+			// 不是帧头, 从下一个字节继续找
+			buf = buf[1:]
+			continue
+		}
+		if len(buf) < headerLen+n+2 {
+			return f, ErrFrameIncomplete
+		}
+		if buf[headerLen+n+1] != frameEnd {
+			buf = buf[1:]
+			continue
+		}
+		if checksum(buf[:headerLen+n]) != buf[headerLen+n] {
+			return f, ErrFrameChecksum
+		}
+		copy(f.Address[:], buf[1:7])
+		f.Control = buf[8]
+		f.Data = make([]byte, n)
+		for j, b := range buf[headerLen : headerLen+n] {
+			f.Data[j] = b - dataOffset
+		}
+		return f, nil
+	}
+}
+
+func indexFrameStart(buf []byte) int {
+	for i, b := range buf {
+		if b == frameStart {
+			return i
+		}
+	}
+	return -1
+}
+
+// checkResponse 校验应答的控制码, 异常应答转为 AbnormalError
+func checkResponse(req, resp frame) error {
+	if resp.Control&^(flagResponse|flagAbnormal|0x20) != req.Control {
+		return fmt.Errorf("%w: unexpected control code 0x%02X", ErrFrameInvalid, resp.Control)
+	}
+	if resp.Control&flagResponse == 0 {
+		return fmt.Errorf("%w: not a response 0x%02X", ErrFrameInvalid, resp.Control)
+	}
+	if resp.Control&flagAbnormal != 0 {
+		if len(resp.Data) == 0 {
+			return AbnormalError{}
+		}
+		return AbnormalError{Code: resp.Data[0]}
+	}
+	if req.Address != broadcastAddress && req.Address != resp.Address {
+		return fmt.Errorf("%w: address mismatch %s", ErrFrameInvalid, formatAddress(resp.Address))
+	}
+	return nil
+}
+
+// parseAddress 表地址 12 位数字, 不足 12 位左补 0
+func parseAddress(s string) (addr [6]byte, err error) {
+	s = strings.TrimSpace(s)
+	if len(s) > 12 {
+		return addr, fmt.Errorf("invalid meter address: %s", s)
+	}
+	s = strings.Repeat("0", 12-len(s)) + s
+	bs, err := hex.DecodeString(s)
+	if err != nil {
+		return addr, fmt.Errorf("invalid meter address: %s", s)
+	}
+	// 低字节在前
+	for i := range addr {
+		addr[i] = bs[5-i]
+	}
+	return addr, nil
+}
+
+func formatAddress(addr [6]byte) string {
+	bs := make([]byte, 6)
+	for i := range addr {
+		bs[i] = addr[5-i]
+	}
+	return hex.EncodeToString(bs)
+}
+
+// parseHex4 解析 8 位十六进制的数据标识、密码、操作者代码, 高字节在前
+func parseHex4(s string) (uint32, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "0x")
+	bs, err := hex.DecodeString(s)
+	if err != nil || len(bs) != 4 {
+		return 0, fmt.Errorf("invalid 4 bytes hex: %s", s)
+	}
+	return uint32(bs[0])<<24 | uint32(bs[1])<<16 | uint32(bs[2])<<8 | uint32(bs[3]), nil
+}
+
+// putUint32 低字节在前
+func putUint32(v uint32) []byte {
+	return []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)}
+}
+
+// decodeBCD 低字节在前的 BCD 码, signed 时最高位为符号位
+func decodeBCD(bs []byte, decimals int, signed bool) (float64, error) {
+	var v float64
+	negative := false
+	for i := len(bs) - 1; i >= 0; i-- {
+		b := bs[i]
+		if signed && i == len(bs)-1 {
+			negative = b&0x80 != 0
+			b &= 0x7F
+		}
+		hi, lo := b>>4, b&0x0F
+		if hi > 9 || lo > 9 {
+			return 0, fmt.Errorf("%w: % X", ErrInvalidBCD, bs)
+		}
+		v = v*100 + float64(hi*10+lo)
+	}
+	for range decimals {
+		v /= 10
+	}
+	if negative {
+		v = -v
+	}
+	return v, nil
+}
+
+// encodeBCD 编码 n 字节 BCD, 低字节在前
+func encodeBCD(v uint64, n int) []byte {
+	bs := make([]byte, n)
+	for i := range bs {
+		lo := v % 10
+		v /= 10
+		hi := v % 10
+		v /= 10
+		bs[i] = byte(hi<<4 | lo)
+	}
+	return bs
+}
+
+// dataFormat 数据标识对应的数据格式
+type dataFormat struct {
+	Len      int  // 字节数
+	Decimals int  // 小数位数
+	Signed   bool // 最高位是否为符号位
+}
+
+// knownFormats 常用数据标识的格式, 按 DI3 DI2 匹配
+var knownFormats = map[uint16]dataFormat{
+	0x0000: {4, 2, true},  // 组合有功电能 kWh
+	0x0001: {4, 2, false}, // 正向有功电能 kWh
+	0x0002: {4, 2, false}, // 反向有功电能 kWh
+	0x0003: {4, 2, true},  // 组合无功1电能 kvarh
+	0x0004: {4, 2, true},  // 组合无功2电能 kvarh
+	0x0201: {2, 1, false}, // 电压 V
+	0x0202: {3, 3, true},  // 电流 A
+	0x0203: {3, 4, true},  // 有功功率 kW
+	0x0204: {3, 4, true},  // 无功功率 kvar
+	0x0205: {3, 4, true},  // 视在功率 kVA
+	0x0206: {2, 3, true},  // 功率因数
+}
+
+// knownItems 不能按 DI3 DI2 匹配的数据标识
+var knownItems = map[uint32]dataFormat{
+	0x02800001: {3, 3, true},  // 零线电流 A
+	0x02800002: {2, 2, false}, // 电网频率 Hz
+	0x02800003: {3, 4, true},  // 一分钟有功总平均功率 kW
+	0x02800004: {3, 4, true},  // 当前有功需量 kW
+	0x02800007: {2, 1, true},  // 表内温度 ℃
+}
+
+func lookupFormat(di uint32) (dataFormat, bool) {
+	if f, ok := knownItems[di]; ok {
+		return f, true
+	}
+	f, ok := knownFormats[uint16(di>>16)]
+	return f, ok
+}
+
+// 拉合闸控制命令类型
+const (
+	RelayTrip         byte = 0x1A // 跳闸
+	RelayCloseAllow   byte = 0x1B // 合闸允许
+	RelayClose        byte = 0x1C // 直接合闸
+	RelayAlarm        byte = 0x2A // 报警
+	RelayAlarmRelease byte = 0x2B // 报警解除
+	RelayKeep         byte = 0x3A // 保电
+	RelayKeepRelease  byte = 0x3B // 保电解除
+)
+
+func validRelayCode(b byte) bool {
+	switch b {
+	case RelayTrip, RelayCloseAllow, RelayClose, RelayAlarm, RelayAlarmRelease, RelayKeep, RelayKeepRelease:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/pkg/testutil"
+)
+
+// 表号 000012345678
+var meterAddress = [6]byte{0x78, 0x56, 0x34, 0x12, 0x00, 0x00}
+
+func TestEncodeFrame(t *testing.T) {
+	tests := []struct {
+		name string
+		f    frame
+		want string
+	}{
+		{"读正向有功总电能", frame{Address: meterAddress, Control: ctrlRead, Data: []byte{0x00, 0x00, 0x01, 0x00}},
+			"FE FE FE FE 68 78 56 34 12 00 00 68 11 04 33 33 34 33 C6 16"},
+		{"广播读通信地址", frame{Address: broadcastAddress, Control: ctrlReadAddress},
+			"FE FE FE FE 68 AA AA AA AA AA AA 68 13 00 DF 16"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.f.encode(); !bytes.Equal(got, testutil.Unhex(t, tt.want)) {
+				t.Fatalf("got % X, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeFrame(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		control byte
+		data    []byte
+		err     error
+	}{
+		{"正向有功总电能 12345.67kWh", "FE FE FE FE 68 78 56 34 12 00 00 68 91 08 33 33 34 33 9A 78 56 34 E6 16",
+			0x91, []byte{0x00, 0x00, 0x01, 0x00, 0x67, 0x45, 0x23, 0x01}, nil},
+		{"异常应答 无请求数据", "FE FE 68 78 56 34 12 00 00 68 D1 01 35 EB 16",
+			0xD1, []byte{0x02}, nil},
+		{"读通信地址应答", "68 78 56 34 12 00 00 68 93 06 AB 89 67 45 33 33 C3 16",
+			0x93, meterAddress[:], nil},
+		{"帧前有杂波", "00 68 12 FE 68 78 56 34 12 00 00 68 D1 01 35 EB 16",
+			0xD1, []byte{0x02}, nil},
+		{"只收到唤醒字节", "FE FE FE FE", 0, nil, ErrFrameIncomplete},
+		{"只收到帧头", "FE FE 68 78 56 34 12 00 00 68 91", 0, nil, ErrFrameIncomplete},
+		{"缺少结束符", "68 78 56 34 12 00 00 68 91 08 33 33 34 33 9A 78 56 34 E6", 0, nil, ErrFrameIncomplete},
+		{"校验和错误", "68 78 56 34 12 00 00 68 91 08 33 33 34 33 9A 78 56 34 E7 16", 0, nil, ErrFrameChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := decodeFrame(testutil.Unhex(t, tt.in))
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if f.Address != meterAddress || f.Control != tt.control || !bytes.Equal(f.Data, tt.data) {
+				t.Fatalf("got %s %02X % X, want %02X % X", formatAddress(f.Address), f.Control, f.Data, tt.control, tt.data)
+			}
+		})
+	}
+}
+
+func TestCheckResponse(t *testing.T) {
+	req := frame{Address: meterAddress, Control: ctrlRead}
+	resp, err := decodeFrame(testutil.Unhex(t, "68 78 56 34 12 00 00 68 D1 01 35 EB 16"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ae AbnormalError
+	if err := checkResponse(req, resp); !errors.As(err, &ae) || ae.Code != 0x02 {
+		t.Fatalf("got %v, want abnormal 0x02", err)
+	}
+	other := frame{Address: [6]byte{0x01}, Control: ctrlRead}
+	resp.Control = 0x91
+	if err := checkResponse(other, resp); !errors.Is(err, ErrFrameInvalid) {
+		t.Fatalf("got %v, want address mismatch", err)
+	}
+}
+
+func TestDecodeBCD(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       []byte
+		decimals int
+		signed   bool
+		want     float64
+		err      error
+	}{
+		{"电能 XXXXXX.XX", []byte{0x67, 0x45, 0x23, 0x01}, 2, false, 12345.67, nil},
+		{"电压 XXX.X", []byte{0x01, 0x22}, 1, false, 220.1, nil},
+		{"反向电流 XXX.XXX", []byte{0x34, 0x12, 0x80}, 3, true, -1.234, nil},
+		{"功率因数 X.XXX", []byte{0x85, 0x09}, 3, true, 0.985, nil},
+		{"无符号时最高位是数字", []byte{0x00, 0x80}, 0, false, 8000, nil},
+		{"非法 BCD", []byte{0x1A, 0x00}, 0, false, 0, ErrInvalidBCD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := decodeBCD(tt.in, tt.decimals, tt.signed)
+			if !errors.Is(err, tt.err) || got != tt.want {
+				t.Fatalf("got %v %v, want %v %v", got, err, tt.want, tt.err)
+			}
+		})
+	}
+}
+
+func TestEncodeBCD(t *testing.T) {
+	tests := []struct {
+		v    uint64
+		n    int
+		want []byte
+	}{
+		{123456, 3, []byte{0x56, 0x34, 0x12}},
+		{7, 2, []byte{0x07, 0x00}},
+		{0, 1, []byte{0x00}},
+		// 超出的高位丢弃
+		{1234, 1, []byte{0x34}},
+	}
+	for _, tt := range tests {
+		if got := encodeBCD(tt.v, tt.n); !bytes.Equal(got, tt.want) {
+			t.Fatalf("encodeBCD(%d, %d) = % X, want % X", tt.v, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestRelayDeadlineBCD(t *testing.T) {
+	tests := []struct {
+		t    time.Time
+		want []byte
+	}{
+		{time.Date(2024, 5, 6, 7, 8, 9, 0, time.Local), []byte{0x09, 0x08, 0x07, 0x06, 0x05, 0x24}},
+		{time.Date(2031, 12, 31, 23, 59, 58, 0, time.Local), []byte{0x58, 0x59, 0x23, 0x31, 0x12, 0x31}},
+	}
+	for _, tt := range tests {
+		if got := relayDeadlineBCD(tt.t); !bytes.Equal(got, tt.want) {
+			t.Fatalf("relayDeadlineBCD(%s) = % X, want % X", tt.t, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package internal
+
+import (
+	"errors"
+)
+
+// exchange 发送一帧并等待应答, 超时或连接出错时关闭连接, 下次请求重新打开
+// 异常应答说明链路正常, 不关闭连接
+func (c *connector) exchange(req frame) (resp frame, err error) {
+	_, err = c.transport.Request(req.encode(), func(buf []byte) (e error) {
+		resp, e = decodeFrame(buf)
+		return e
+	})
+	if err == nil {
+		err = checkResponse(req, resp)
+	}
+	if err != nil && !isAbnormal(err) {
+		c.transport.Close()
+	}
+	return resp, err
+}
+
+func isAbnormal(err error) bool {
+	var ae AbnormalError
+	return errors.As(err, &ae)
+}
@@ -0,0 +1,10 @@
+package dlt645
+
+import (
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/plugins/dlt645/internal"
+)
+
+func EnablePlugin() {
+	driverbox.EnablePlugin(internal.ProtocolName, new(internal.Plugin))
+}
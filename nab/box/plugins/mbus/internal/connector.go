@@ -0,0 +1,459 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/convutil"
+	"github.com/twiglab/h2o/nab/box/pkg/crontab"
+	"github.com/twiglab/h2o/nab/box/pkg/event"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+	"go.uber.org/zap"
+)
+
+// 一次读数最多的报文数, 防止从站一直应答还有后续数据
+const maxTelegrams = 10
+
+func newConnector(p *Plugin, cf *ConnectionConfig) (*connector, error) {
+	if err := cf.Config.Init(100, 1000); err != nil {
+		return nil, fmt.Errorf("mbus %w", err)
+	}
+	if cf.Retry == 0 {
+		cf.Retry = 3
+	}
+	if cf.DiscoverDuration == "" {
+		cf.DiscoverDuration = "1h"
+	}
+
+	conn := &connector{
+		config:     cf,
+		plugin:     p,
+		transport:  serialport.New(ProtocolName, &cf.Config),
+		virtual:    cf.Virtual || config.IsVirtual(),
+		meters:     make(map[string]*meter),
+		discovered: make(map[secondaryAddress]bool),
+	}
+	return conn, nil
+}
+
+func (c *connector) initCollectTask(conf *ConnectionConfig) (*crontab.Future, error) {
+	discoverDuration, err := time.ParseDuration(conf.DiscoverDuration)
+	if err != nil {
+		driverbox.Log().Error("error mbus discover duration config", zap.String("key", conf.ConnectionKey), zap.Error(err))
+		discoverDuration = time.Hour
+	}
+
+	//注册定时采集任务
+	return driverbox.AddFunc("1s", func() {
+		//二次地址搜索耗时较长, 在后台进行, 搜索期间采集照常穿插执行
+		if conf.Discover && c.latestDiscoverTime.Add(discoverDuration).Before(time.Now()) && c.scanning.CompareAndSwap(false, true) {
+			c.latestDiscoverTime = time.Now()
+			go func() {
+				defer c.scanning.Store(false)
+				c.scan()
+			}()
+		}
+
+		for _, m := range c.meters {
+			if c.close {
+				driverbox.Log().Warn("mbus connection is closed, ignore collect task!", zap.String("key", conf.ConnectionKey))
+				return
+			}
+			if len(m.points) == 0 {
+				continue
+			}
+			duration := m.duration
+			//仪表不应答，按倍数延长采集间隔，最长一分钟
+			if m.timeoutCount > 0 {
+				duration = min(duration*time.Duration(1<<min(m.timeoutCount, 16)), max(m.duration, time.Minute))
+			}
+			if m.latestTime.Add(duration).After(time.Now()) {
+				continue
+			}
+
+			err := c.Send(command{Mode: plugin.ReadMode, Value: m})
+			m.latestTime = time.Now()
+			if err != nil {
+				driverbox.Log().Error("read error", zap.String("deviceId", m.deviceId), zap.Error(err))
+				if errors.Is(err, serialport.ErrTimeout) {
+					m.timeoutCount += 1
+				}
+				_ = driverbox.Shadow().MayBeOffline(m.deviceId)
+			} else {
+				m.timeoutCount = 0
+			}
+		}
+	})
+}
+
+// createMeter 每个设备对应一块表
+// 设备属性 secondaryAddress 为 16 位二次地址, 或 primaryAddress 为 1-250 的一次地址, 两者都有时使用二次地址
+func (c *connector) createMeter(model config.DeviceModel, dev config.Device) error {
+	m := &meter{deviceId: dev.ID}
+	if s := dev.Properties["secondaryAddress"]; s != "" {
+		sa, err := parseSecondaryAddress(s)
+		if err != nil {
+			return err
+		}
+		m.secondary = sa
+		c.discovered[sa] = true
+	} else {
+		s := dev.Properties["primaryAddress"]
+		a, err := strconv.ParseUint(s, 10, 8)
+		if err != nil || a > 250 {
+			return fmt.Errorf("invalid primary address: %s", s)
+		}
+		m.primary = byte(a)
+	}
+
+	for _, point := range model.DevicePoints {
+		if point.ReadWrite() != config.ReadWrite_R && point.ReadWrite() != config.ReadWrite_RW {
+			continue
+		}
+		ext, err := convToPointExtend(point)
+		if err != nil {
+			driverbox.Log().Error("error mbus point config", zap.String("deviceId", dev.ID), zap.Any("point", point), zap.Error(err))
+			continue
+		}
+		ext.DeviceId = dev.ID
+		m.points = append(m.points, ext)
+		if m.duration == 0 || ext.duration < m.duration {
+			m.duration = ext.duration
+		}
+	}
+	c.meters[dev.ID] = m
+	return nil
+}
+
+func convToPointExtend(extends config.Point) (*Point, error) {
+	extend := new(Point)
+	extend.Point = extends
+	if err := convutil.Struct(extends, extend); err != nil {
+		return nil, err
+	}
+	if extend.Record == "" {
+		extend.Record = extends.Name()
+	}
+	//未设置，则默认每十五分钟采集一次
+	if extend.Duration == "" {
+		extend.Duration = "15m"
+	}
+	duration, err := time.ParseDuration(extend.Duration)
+	if err != nil {
+		return nil, fmt.Errorf("convert duration error: %s", err.Error())
+	}
+	extend.duration = duration
+	return extend, nil
+}
+
+// Encode 编码数据
+// 读操作读取整块表的全部数据记录, 不支持写操作
+func (c *connector) Encode(deviceId string, mode plugin.EncodeMode, values ...plugin.PointData) (res interface{}, err error) {
+	m, ok := c.meters[deviceId]
+	if !ok {
+		return nil, fmt.Errorf("device [%s] not found", deviceId)
+	}
+	if mode == plugin.ReadMode {
+		return command{Mode: plugin.ReadMode, Value: m}, nil
+	}
+	return nil, plugin.NotSupportEncode
+}
+
+// Send 发送数据
+func (c *connector) Send(data interface{}) (err error) {
+	cmd, ok := data.(command)
+	if !ok {
+		return errors.New("unsupported data type")
+	}
+	if cmd.Mode == plugin.ReadMode {
+		return c.sendReadCommand(cmd.Value.(*meter))
+	}
+	return errors.New("not support mode error")
+}
+
+// Release 释放资源
+// 不释放连接资源, 串口由连接器一直持有
+func (c *connector) Release() (err error) {
+	return
+}
+
+func (c *connector) Close() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.close = true
+	if c.collectTask != nil {
+		c.collectTask.Disable()
+	}
+	c.transport.Close()
+}
+
+// withBus 独占总线执行一组请求
+func (c *connector) withBus(fn func() error) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.close {
+		return errors.New("mbus connection is closed")
+	}
+	return fn()
+}
+
+// request 请求失败时重试, 重试时报文不变, 从站据此识别重发
+func (c *connector) request(req []byte) (resp frame, err error) {
+	for i := 0; i < c.config.Retry; i++ {
+		resp, err = c.exchange(req)
+		if err == nil {
+			return
+		}
+	}
+	return
+}
+
+// confirm 发送需要 E5 确认的报文
+func (c *connector) confirm(req []byte) error {
+	resp, err := c.request(req)
+	if err != nil {
+		return err
+	}
+	if resp.Start != frameAck {
+		return fmt.Errorf("%w: expect ack, got 0x%02X", ErrFrameInvalid, resp.Start)
+	}
+	return nil
+}
+
+// sendReadCommand 读取全部数据记录, 按点位的记录名称上报
+func (c *connector) sendReadCommand(m *meter) error {
+	var values map[string]interface{}
+	var err error
+	if c.virtual {
+		values, err = c.mockRead(m)
+	} else {
+		values, err = c.readMeter(m)
+	}
+	if err != nil {
+		return err
+	}
+
+	pointData := make([]plugin.PointData, 0, len(m.points))
+	for _, p := range m.points {
+		v, ok := values[p.Record]
+		if !ok {
+			driverbox.Log().Warn("mbus record not found", zap.String("deviceId", m.deviceId), zap.String("point", p.Name()), zap.String("record", p.Record))
+			continue
+		}
+		pointData = append(pointData, plugin.PointData{PointName: p.Name(), Value: v})
+	}
+	if len(pointData) > 0 {
+		driverbox.Export([]plugin.DeviceData{{ID: m.deviceId, Values: pointData}})
+	}
+	return nil
+}
+
+// readMeter 一次地址先用 SND_NKE 复位链路, 二次地址先选择从站, 读完后用 SND_NKE 取消选择
+func (c *connector) readMeter(m *meter) (values map[string]interface{}, err error) {
+	err = c.withBus(func() error {
+		address := m.primary
+		if m.secondary != "" {
+			address = addressSecondary
+			if err := c.confirm(encodeLong(ctrlSndUd, addressSecondary, ciSelect, m.secondary.selectData())); err != nil {
+				return fmt.Errorf("select %s error: %w", m.secondary, err)
+			}
+			defer func() {
+				_, _ = c.exchange(encodeShort(ctrlSndNke, addressSecondary))
+			}()
+		} else if err := c.confirm(encodeShort(ctrlSndNke, address)); err != nil {
+			return fmt.Errorf("reset %d error: %w", address, err)
+		}
+
+		var h *header
+		h, values, err = c.readData(address)
+		if err != nil {
+			return err
+		}
+		if h != nil {
+			for k, v := range map[string]interface{}{
+				"id":           h.ID,
+				"manufacturer": manufacturerCode(h.Manufacturer),
+				"version":      h.Version,
+				"medium":       mediumName(h.Medium),
+				"accessNo":     h.Access,
+				"status":       h.Status,
+			} {
+				if _, ok := values[k]; !ok {
+					values[k] = v
+				}
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// readData 用 REQ_UD2 读取数据记录, 从站表示还有后续报文时翻转 FCB 继续读
+// 各报文中重名的记录保留第一个
+func (c *connector) readData(address byte) (h *header, values map[string]interface{}, err error) {
+	values = make(map[string]interface{})
+	fcb := true
+	for range maxTelegrams {
+		ctrl := ctrlReqUd2
+		if fcb {
+			ctrl |= ctrlFCB
+		}
+		resp, err := c.request(encodeShort(ctrl, address))
+		if err != nil {
+			return h, values, err
+		}
+		if resp.Start != frameLong || resp.Control&0x0F != ctrlRspUd {
+			return h, values, fmt.Errorf("%w: unexpected response 0x%02X 0x%02X", ErrFrameInvalid, resp.Start, resp.Control)
+		}
+		if address != addressSecondary && resp.Address != address {
+			return h, values, fmt.Errorf("%w: address mismatch %d", ErrFrameInvalid, resp.Address)
+		}
+		th, records, more, err := parseResponse(resp.CI, resp.Data)
+		if err != nil {
+			return h, values, err
+		}
+		if h == nil || h.ID == "" {
+			h = th
+		}
+		for _, r := range records {
+			if _, ok := values[r.Name]; !ok {
+				values[r.Name] = r.Value
+			}
+		}
+		if !more {
+			return h, values, nil
+		}
+		fcb = !fcb
+	}
+	driverbox.Log().Warn("mbus too many telegrams", zap.String("key", c.config.ConnectionKey), zap.Uint8("address", address))
+	return h, values, nil
+}
+
+// 二次地址选择的结果
+const (
+	probeNone      = iota // 没有从站应答
+	probeSingle           // 一个从站应答
+	probeCollision        // 多个从站同时应答
+)
+
+// scan 二次地址通配搜索, 表号从高位起逐位展开, 冲突时继续展开下一位, 新的二次地址触发设备自动发现
+func (c *connector) scan() {
+	if c.virtual {
+		return
+	}
+	driverbox.Log().Info("mbus secondary address scan start", zap.String("key", c.config.ConnectionKey))
+	mask := []byte("FFFFFFFF")
+	found := 0
+	c.search(mask, 0, &found)
+	driverbox.Log().Info("mbus secondary address scan done", zap.String("key", c.config.ConnectionKey), zap.Int("found", found))
+}
+
+func (c *connector) search(mask []byte, pos int, found *int) {
+	for d := byte('0'); d <= '9'; d++ {
+		if c.close {
+			return
+		}
+		mask[pos] = d
+		sa := secondaryAddress(string(mask) + "FFFFFFFF")
+		result, h, err := c.probe(sa)
+		if err != nil {
+			driverbox.Log().Warn("mbus probe error", zap.String("key", c.config.ConnectionKey), zap.String("mask", string(sa)), zap.Error(err))
+			continue
+		}
+		switch result {
+		case probeSingle:
+			*found += 1
+			c.discover(h)
+		case probeCollision:
+			if pos < len(mask)-1 {
+				c.search(mask, pos+1, found)
+			} else {
+				// 表号相同, 厂商、版本或介质不同
+				driverbox.Log().Warn("mbus duplicate meter id", zap.String("key", c.config.ConnectionKey), zap.String("id", string(mask)))
+			}
+		}
+	}
+	mask[pos] = 'F'
+}
+
+// probe 用通配地址选择从站, 只有一个从站应答时读取它的报文头
+func (c *connector) probe(sa secondaryAddress) (result int, h *header, err error) {
+	err = c.withBus(func() error {
+		resp, err := c.exchange(encodeLong(ctrlSndUd, addressSecondary, ciSelect, sa.selectData()))
+		switch {
+		case errors.Is(err, serialport.ErrTimeout):
+			result = probeNone
+			return nil
+		case errors.Is(err, ErrFrameInvalid):
+			result = probeCollision
+			return nil
+		case err != nil:
+			return err
+		case resp.Start != frameAck:
+			result = probeCollision
+			return nil
+		}
+		defer func() {
+			_, _ = c.exchange(encodeShort(ctrlSndNke, addressSecondary))
+		}()
+
+		resp, err = c.exchange(encodeShort(ctrlReqUd2|ctrlFCB, addressSecondary))
+		if errors.Is(err, ErrFrameInvalid) {
+			result = probeCollision
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if resp.Start != frameLong {
+			return fmt.Errorf("%w: unexpected response 0x%02X", ErrFrameInvalid, resp.Start)
+		}
+		h, _, _, err = parseResponse(resp.CI, resp.Data)
+		if err != nil {
+			return err
+		}
+		if h == nil || h.ID == "" {
+			return fmt.Errorf("meter %s has no long header", sa)
+		}
+		result = probeSingle
+		return nil
+	})
+	return
+}
+
+// discover 新的二次地址触发设备自动发现
+func (c *connector) discover(h *header) {
+	sa := h.secondaryAddress()
+	if c.discovered[sa] {
+		return
+	}
+	c.discovered[sa] = true
+
+	deviceId := ProtocolName + "_" + string(sa)
+	manufacturer := manufacturerCode(h.Manufacturer)
+	driverbox.Log().Info("mbus meter discovered", zap.String("key", c.config.ConnectionKey), zap.String("secondaryAddress", string(sa)),
+		zap.String("manufacturer", manufacturer), zap.String("medium", mediumName(h.Medium)))
+	deviceData := []plugin.DeviceData{{
+		ID: deviceId,
+		Events: []event.Data{{
+			Code: event.DeviceDiscover,
+			Value: map[string]interface{}{
+				"modelKey": c.config.ModelKey,
+				"device": map[string]interface{}{
+					"id":          deviceId,
+					"description": fmt.Sprintf("mbus %s %s %s", manufacturer, mediumName(h.Medium), h.ID),
+					"properties": map[string]string{
+						"secondaryAddress": string(sa),
+					},
+				},
+			},
+		}},
+	}}
+	plugin.WrapperDiscoverEvent(deviceData, c.config.ConnectionKey, ProtocolName)
+	driverbox.Export(deviceData)
+}
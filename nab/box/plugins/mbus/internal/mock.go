@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/pkg/luautil"
+	lua "github.com/yuin/gopher-lua"
+	"go.uber.org/zap"
+)
+
+var ls *lua.LState
+
+// InitMockLua 虚拟模式下由脚本中的 mockMbusRead 模拟仪表
+func InitMockLua() {
+	if ls != nil {
+		return
+	}
+	l, err := luautil.InitMockVM(ProtocolName)
+	if err != nil {
+		driverbox.Log().Error("init lua vm error", zap.Error(err))
+		return
+	}
+	ls = l
+}
+
+// mockRead 脚本按地址返回数据记录的 json 对象, 如 {"energy": 1234.5, "volume": 56.7}
+func (c *connector) mockRead(m *meter) (values map[string]interface{}, err error) {
+	if ls == nil {
+		return nil, errors.New("lua vm is nil")
+	}
+	mockData, err := luautil.CallLuaMethod(ls, "mockMbusRead", lua.LString(m.address()))
+	if err != nil {
+		return
+	}
+	err = json.Unmarshal([]byte(mockData), &values)
+	return
+}
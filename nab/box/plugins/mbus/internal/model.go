@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/twiglab/h2o/nab/box/driverbox/plugin"
+	"github.com/twiglab/h2o/nab/box/pkg/config"
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// ConnectionConfig 连接器配置
+type ConnectionConfig struct {
+	plugin.BaseConnection
+	serialport.Config
+	Retry int `json:"retry"` // 重试次数
+	// 自动发现时使用的模型 Key
+	ModelKey string `json:"modelKey"`
+	// 二次地址搜索的间隔，默认一小时
+	DiscoverDuration string `json:"discoverDuration"`
+}
+
+// Point mbus 点位
+type Point struct {
+	config.Point
+	DeviceId string
+
+	// 点位采集周期，同一块表取最短的周期
+	Duration string `json:"duration"`
+	// 数据记录名称，如 energy、volume、flowTemperature、volume_s1，默认为点位名称
+	Record string `json:"record"`
+
+	duration time.Duration
+}
+
+// meter 一块 M-Bus 仪表，使用一次地址或二次地址
+type meter struct {
+	deviceId string
+	// 一次地址 1-250
+	primary byte
+	// 二次地址，非空时按二次地址选择
+	secondary secondaryAddress
+
+	points []*Point
+	// 采集周期
+	duration   time.Duration
+	latestTime time.Time
+	// 最近连续超时次数
+	timeoutCount int
+}
+
+// Connector#Send接入入参
+type command struct {
+	Mode  plugin.EncodeMode // 模式
+	Value interface{}
+}
+
+// address 二次地址或一次地址, 用于日志和虚拟模式
+func (m *meter) address() string {
+	if m.secondary != "" {
+		return string(m.secondary)
+	}
+	return strconv.Itoa(int(m.primary))
+}
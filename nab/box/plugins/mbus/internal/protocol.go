@@ -0,0 +1,235 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// M-Bus 链路层 (EN 13757-2, FT1.2):
+// 单字符 E5
+// 短帧 10 C A CS 16
+// 长帧 68 L L 68 C A CI DATA CS 16, L 为 C 到 DATA 的长度, CS 为 C 到 DATA 的模 256 和
+const (
+	frameAck   byte = 0xE5
+	frameShort byte = 0x10
+	frameLong  byte = 0x68
+	frameEnd   byte = 0x16
+
+	// 控制域
+	ctrlSndNke byte = 0x40 // 链路复位
+	ctrlSndUd  byte = 0x53 // 发送用户数据
+	ctrlReqUd2 byte = 0x5B // 请求 2 类数据
+	ctrlFCB    byte = 0x20 // 帧计数位
+	ctrlRspUd  byte = 0x08 // 应答用户数据, 高位为 ACD DFC
+
+	// 地址
+	addressSecondary byte = 0xFD // 二次地址选中的从站
+	addressBroadcast byte = 0xFF // 广播, 从站不应答
+
+	// CI
+	ciSelect     byte = 0x52 // 二次地址选择
+	ciLongHeader byte = 0x72 // 可变数据结构, 12 字节长报文头
+	ciShortHead  byte = 0x7A // 可变数据结构, 4 字节短报文头
+	ciNoHeader   byte = 0x78 // 可变数据结构, 无报文头
+)
+
+var (
+	// 串口收到的字节不够一帧时继续读取
+	ErrFrameIncomplete = fmt.Errorf("mbus %w", serialport.ErrIncomplete)
+	ErrFrameInvalid    = errors.New("mbus frame invalid")
+	ErrInvalidBCD      = errors.New("mbus invalid bcd")
+)
+
+// frame 链路层报文
+type frame struct {
+	Start   byte // E5, 10, 68
+	Control byte
+	Address byte
+	CI      byte
+	Data    []byte
+}
+
+func checksum(bs []byte) byte {
+	var cs byte
+	for _, b := range bs {
+		cs += b
+	}
+	return cs
+}
+
+func encodeShort(c, a byte) []byte {
+	return []byte{frameShort, c, a, c + a, frameEnd}
+}
+
+func encodeLong(c, a, ci byte, data []byte) []byte {
+	l := byte(3 + len(data))
+	buf := make([]byte, 0, 6+int(l))
+	buf = append(buf, frameLong, l, l, frameLong, c, a, ci)
+	buf = append(buf, data...)
+	return append(buf, checksum(buf[4:]), frameEnd)
+}
+
+// decodeFrame 从 buf 开头解析一帧
+// M-Bus 没有唤醒字节, 开头不是 E5、10、68 时说明多个从站同时应答或有干扰
+func decodeFrame(buf []byte) (f frame, err error) {
+	if len(buf) == 0 {
+		return f, ErrFrameIncomplete
+	}
+	f.Start = buf[0]
+	switch buf[0] {
+	case frameAck:
+		if len(buf) > 1 {
+			return f, fmt.Errorf("%w: % X", ErrFrameInvalid, buf)
+		}
+		return f, nil
+	case frameShort:
+		if len(buf) < 5 {
+			return f, ErrFrameIncomplete
+		}
+		if buf[4] != frameEnd || checksum(buf[1:3]) != buf[3] {
+			return f, fmt.Errorf("%w: % X", ErrFrameInvalid, buf)
+		}
+		f.Control, f.Address = buf[1], buf[2]
+		return f, nil
+	case frameLong:
+		if len(buf) < 4 {
+			return f, ErrFrameIncomplete
+		}
+		l := int(buf[1])
+		if buf[2] != buf[1] || buf[3] != frameLong || l < 3 {
+			return f, fmt.Errorf("%w: % X", ErrFrameInvalid, buf[:4])
+		}
+		if len(buf) < l+6 {
+			return f, ErrFrameIncomplete
+		}
+		if buf[l+5] != frameEnd || checksum(buf[4:4+l]) != buf[4+l] {
+			return f, fmt.Errorf("%w: checksum or end", ErrFrameInvalid)
+		}
+		f.Control, f.Address, f.CI = buf[4], buf[5], buf[6]
+		f.Data = append([]byte(nil), buf[7:4+l]...)
+		return f, nil
+	}
+	return f, fmt.Errorf("%w: start 0x%02X", ErrFrameInvalid, buf[0])
+}
+
+// secondaryAddress 二次地址 16 位十六进制: 表号 8 位 BCD, 厂商 4 位, 版本 2 位, 介质 2 位
+// 表号可以用 F 作通配符, 只写表号时其余部分为通配
+type secondaryAddress string
+
+func parseSecondaryAddress(s string) (secondaryAddress, error) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	if len(s) == 8 {
+		s += "FFFFFFFF"
+	}
+	if len(s) != 16 {
+		return "", fmt.Errorf("invalid secondary address: %s", s)
+	}
+	for _, r := range s[:8] {
+		if (r < '0' || r > '9') && r != 'F' {
+			return "", fmt.Errorf("invalid secondary address: %s", s)
+		}
+	}
+	if _, err := strconv.ParseUint(s[8:], 16, 32); err != nil {
+		return "", fmt.Errorf("invalid secondary address: %s", s)
+	}
+	return secondaryAddress(s), nil
+}
+
+// selectData 二次地址选择报文的数据域, 均为低字节在前
+func (s secondaryAddress) selectData() []byte {
+	id, _ := strconv.ParseUint(string(s[:8]), 16, 32)
+	man, _ := strconv.ParseUint(string(s[8:12]), 16, 16)
+	ver, _ := strconv.ParseUint(string(s[12:14]), 16, 8)
+	med, _ := strconv.ParseUint(string(s[14:16]), 16, 8)
+	return []byte{
+		byte(id), byte(id >> 8), byte(id >> 16), byte(id >> 24),
+		byte(man), byte(man >> 8),
+		byte(ver), byte(med),
+	}
+}
+
+// header 可变数据结构的报文头
+type header struct {
+	ID           string // 表号 8 位
+	Manufacturer uint16
+	Version      byte
+	Medium       byte
+	Access       byte
+	Status       byte
+}
+
+func (h header) secondaryAddress() secondaryAddress {
+	return secondaryAddress(fmt.Sprintf("%s%04X%02X%02X", h.ID, h.Manufacturer, h.Version, h.Medium))
+}
+
+// manufacturerCode 厂商代码转为 3 个字母
+func manufacturerCode(m uint16) string {
+	return string([]byte{
+		byte((m>>10)&0x1F) + 64,
+		byte((m>>5)&0x1F) + 64,
+		byte(m&0x1F) + 64,
+	})
+}
+
+// 介质
+var mediums = map[byte]string{
+	0x00: "other",
+	0x01: "oil",
+	0x02: "electricity",
+	0x03: "gas",
+	0x04: "heat",
+	0x05: "steam",
+	0x06: "hot water",
+	0x07: "water",
+	0x08: "heat cost allocator",
+	0x0A: "cooling load (return)",
+	0x0B: "cooling load (flow)",
+	0x0C: "heat (flow)",
+	0x0D: "heat / cooling load",
+	0x15: "hot water",
+	0x16: "cold water",
+	0x17: "dual water",
+	0x18: "pressure",
+	0x19: "a/d converter",
+}
+
+func mediumName(m byte) string {
+	if s, ok := mediums[m]; ok {
+		return s
+	}
+	return fmt.Sprintf("0x%02X", m)
+}
+
+// decodeBCD 低字节在前的 BCD 码, 最高半字节为 F 时是负数
+func decodeBCD(bs []byte) (float64, error) {
+	var v float64
+	negative := false
+	for i := len(bs) - 1; i >= 0; i-- {
+		hi, lo := bs[i]>>4, bs[i]&0x0F
+		if i == len(bs)-1 && hi == 0x0F {
+			negative = true
+			hi = 0
+		}
+		if hi > 9 || lo > 9 {
+			return 0, fmt.Errorf("%w: % X", ErrInvalidBCD, bs)
+		}
+		v = v*100 + float64(hi*10+lo)
+	}
+	if negative {
+		v = -v
+	}
+	return v, nil
+}
+
+// bcdString 低字节在前的 BCD 码转为数字串, 不校验数字
+func bcdString(bs []byte) string {
+	var sb strings.Builder
+	for i := len(bs) - 1; i >= 0; i-- {
+		fmt.Fprintf(&sb, "%02X", bs[i])
+	}
+	return sb.String()
+}
@@ -0,0 +1,440 @@
+package internal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// 可变数据结构 (EN 13757-3): 每条记录为 DIF [DIFE...] VIF [VIFE...] DATA
+// 记录按物理量命名, 换算到 kWh、m³、kg、kW、m³/h、kg/h、℃、K、bar、V、A、s
+// 同一物理量的其他记录加后缀: 最大值 _max, 最小值 _min, 错误值 _err, 存储号 _s1, 费率 _t1, 子单元 _u1
+// 仍然重名时再加 _2、_3
+
+const (
+	difExtension  byte = 0x80
+	difStorageLSB byte = 0x40
+	difFunction   byte = 0x30
+	difData       byte = 0x0F
+
+	difManufacturer byte = 0x0F // 后面都是厂商数据
+	difMoreRecords  byte = 0x1F // 厂商数据, 还有后续报文
+	difIdleFiller   byte = 0x2F // 填充字节
+
+	vifExtension byte = 0x80
+)
+
+// 记录的值的类型
+const (
+	kindNumber   = iota // 数值, 乘以 scale
+	kindDate            // G 型日期
+	kindDateTime        // F 型日期时间
+	kindRaw             // 原样输出的数字, 如表号
+	kindText            // 字符串
+)
+
+type vifInfo struct {
+	name  string
+	scale float64
+	kind  int
+}
+
+func pow10(n int) float64 {
+	return math.Pow10(n)
+}
+
+// durationScale 时间单位 nn: 秒、分、时、天
+func durationScale(nn byte) float64 {
+	return [...]float64{1, 60, 3600, 86400}[nn&0x03]
+}
+
+// primaryVIF 主 VIF 表
+func primaryVIF(vif byte) vifInfo {
+	n := int(vif & 0x07)
+	nn := int(vif & 0x03)
+	switch code := vif & 0x7F; {
+	case code <= 0x07:
+		return vifInfo{"energy", pow10(n - 6), kindNumber} // 10^(n-3) Wh
+	case code <= 0x0F:
+		return vifInfo{"energy", pow10(n) / 3.6e6, kindNumber} // 10^n J
+	case code <= 0x17:
+		return vifInfo{"volume", pow10(n - 6), kindNumber}
+	case code <= 0x1F:
+		return vifInfo{"mass", pow10(n - 3), kindNumber}
+	case code <= 0x23:
+		return vifInfo{"onTime", durationScale(code), kindNumber}
+	case code <= 0x27:
+		return vifInfo{"operatingTime", durationScale(code), kindNumber}
+	case code <= 0x2F:
+		return vifInfo{"power", pow10(n - 6), kindNumber} // 10^(n-3) W
+	case code <= 0x37:
+		return vifInfo{"power", pow10(n) / 3.6e6, kindNumber} // 10^n J/h
+	case code <= 0x3F:
+		return vifInfo{"volumeFlow", pow10(n - 6), kindNumber}
+	case code <= 0x47:
+		return vifInfo{"volumeFlow", pow10(n-7) * 60, kindNumber} // m³/min
+	case code <= 0x4F:
+		return vifInfo{"volumeFlow", pow10(n-9) * 3600, kindNumber} // m³/s
+	case code <= 0x57:
+		return vifInfo{"massFlow", pow10(n - 3), kindNumber}
+	case code <= 0x5B:
+		return vifInfo{"flowTemperature", pow10(nn - 3), kindNumber}
+	case code <= 0x5F:
+		return vifInfo{"returnTemperature", pow10(nn - 3), kindNumber}
+	case code <= 0x63:
+		return vifInfo{"temperatureDifference", pow10(nn - 3), kindNumber}
+	case code <= 0x67:
+		return vifInfo{"externalTemperature", pow10(nn - 3), kindNumber}
+	case code <= 0x6B:
+		return vifInfo{"pressure", pow10(nn - 3), kindNumber}
+	case code == 0x6C:
+		return vifInfo{"date", 1, kindDate}
+	case code == 0x6D:
+		return vifInfo{"dateTime", 1, kindDateTime}
+	case code == 0x6E:
+		return vifInfo{"hcaUnits", 1, kindNumber}
+	case code >= 0x70 && code <= 0x73: // 0x6F 保留
+		return vifInfo{"averagingDuration", durationScale(code), kindNumber}
+	case code >= 0x74 && code <= 0x77:
+		return vifInfo{"actualityDuration", durationScale(code), kindNumber}
+	case code == 0x78:
+		return vifInfo{"fabricationNo", 1, kindRaw}
+	case code == 0x79:
+		return vifInfo{"identification", 1, kindRaw}
+	case code == 0x7A:
+		return vifInfo{"busAddress", 1, kindRaw}
+	}
+	return vifInfo{fmt.Sprintf("vif_%02X", vif&0x7F), 1, kindNumber}
+}
+
+// extensionFD 第一扩展表 0xFD
+func extensionFD(vife byte) vifInfo {
+	code := vife & 0x7F
+	switch {
+	case code == 0x08:
+		return vifInfo{"accessNo", 1, kindRaw}
+	case code == 0x09:
+		return vifInfo{"medium", 1, kindRaw}
+	case code == 0x0A:
+		return vifInfo{"manufacturer", 1, kindRaw}
+	case code == 0x0B:
+		return vifInfo{"parameterSet", 1, kindRaw}
+	case code == 0x0C:
+		return vifInfo{"modelVersion", 1, kindRaw}
+	case code == 0x0D:
+		return vifInfo{"hardwareVersion", 1, kindRaw}
+	case code == 0x0E:
+		return vifInfo{"firmwareVersion", 1, kindRaw}
+	case code == 0x0F:
+		return vifInfo{"softwareVersion", 1, kindRaw}
+	case code == 0x17:
+		return vifInfo{"errorFlags", 1, kindRaw}
+	case code == 0x1A:
+		return vifInfo{"digitalOutput", 1, kindRaw}
+	case code == 0x1B:
+		return vifInfo{"digitalInput", 1, kindRaw}
+	case code == 0x3A:
+		return vifInfo{"dimensionless", 1, kindNumber}
+	case code >= 0x40 && code <= 0x4F:
+		return vifInfo{"voltage", pow10(int(code&0x0F) - 9), kindNumber}
+	case code >= 0x50 && code <= 0x5F:
+		return vifInfo{"current", pow10(int(code&0x0F) - 12), kindNumber}
+	case code == 0x74:
+		return vifInfo{"batteryDays", 1, kindNumber}
+	}
+	return vifInfo{fmt.Sprintf("fd_%02X", code), 1, kindNumber}
+}
+
+// extensionFB 第二扩展表 0xFB
+func extensionFB(vife byte) vifInfo {
+	code := vife & 0x7F
+	n := int(code & 0x01)
+	switch {
+	case code <= 0x01:
+		return vifInfo{"energy", pow10(n + 2), kindNumber} // 10^(n-1) MWh
+	case code >= 0x08 && code <= 0x09:
+		return vifInfo{"energy", pow10(n+8) / 3.6e6, kindNumber} // 10^(n-1) GJ
+	case code >= 0x10 && code <= 0x11:
+		return vifInfo{"volume", pow10(n + 2), kindNumber}
+	case code >= 0x18 && code <= 0x19:
+		return vifInfo{"mass", pow10(n + 5), kindNumber} // 10^(n+2) t
+	case code >= 0x28 && code <= 0x29:
+		return vifInfo{"power", pow10(n + 2), kindNumber} // 10^(n-1) MW
+	case code >= 0x30 && code <= 0x31:
+		return vifInfo{"power", pow10(n+8) / 3.6e6, kindNumber} // 10^(n-1) GJ/h
+	}
+	return vifInfo{fmt.Sprintf("fb_%02X", code), 1, kindNumber}
+}
+
+// record 一条数据记录
+type record struct {
+	Name  string
+	Value interface{}
+}
+
+// parseResponse 解析 RSP_UD 的数据域, more 为 true 时还有后续报文
+func parseResponse(ci byte, data []byte) (h *header, records []record, more bool, err error) {
+	switch ci {
+	case ciLongHeader:
+		if len(data) < 12 {
+			return nil, nil, false, fmt.Errorf("%w: header length %d", ErrFrameInvalid, len(data))
+		}
+		h = &header{
+			ID:           bcdString(data[0:4]),
+			Manufacturer: binary.LittleEndian.Uint16(data[4:6]),
+			Version:      data[6],
+			Medium:       data[7],
+			Access:       data[8],
+			Status:       data[9],
+		}
+		data = data[12:]
+	case ciShortHead:
+		if len(data) < 4 {
+			return nil, nil, false, fmt.Errorf("%w: header length %d", ErrFrameInvalid, len(data))
+		}
+		h = &header{Access: data[0], Status: data[1]}
+		data = data[4:]
+	case ciNoHeader:
+	default:
+		return nil, nil, false, fmt.Errorf("unsupported mbus ci 0x%02X", ci)
+	}
+	records, more, err = parseRecords(data)
+	return
+}
+
+func parseRecords(data []byte) (records []record, more bool, err error) {
+	names := make(map[string]int)
+	for i := 0; i < len(data); {
+		dif := data[i]
+		i++
+		switch dif {
+		case difIdleFiller:
+			continue
+		case difManufacturer, difMoreRecords:
+			return records, dif == difMoreRecords, nil
+		}
+
+		// DIFE: 存储号、费率、子单元
+		storage := int(dif&difStorageLSB) >> 6
+		tariff, subunit := 0, 0
+		ext := dif&difExtension != 0
+		for j := 0; ext; j++ {
+			if i >= len(data) {
+				return records, false, fmt.Errorf("%w: dife truncated", ErrFrameInvalid)
+			}
+			dife := data[i]
+			i++
+			storage |= int(dife&0x0F) << (1 + 4*j)
+			tariff |= int(dife>>4&0x03) << (2 * j)
+			subunit |= int(dife>>6&0x01) << j
+			ext = dife&difExtension != 0
+		}
+
+		// VIF
+		if i >= len(data) {
+			return records, false, fmt.Errorf("%w: vif truncated", ErrFrameInvalid)
+		}
+		vif := data[i]
+		i++
+		var info vifInfo
+		switch vif & 0x7F {
+		case 0x7B, 0x7D:
+			if i >= len(data) {
+				return records, false, fmt.Errorf("%w: vife truncated", ErrFrameInvalid)
+			}
+			if vif&0x7F == 0x7B {
+				info = extensionFB(data[i])
+			} else {
+				info = extensionFD(data[i])
+			}
+			vif = data[i]
+			i++
+		case 0x7C:
+			// 明文 VIF: 长度和倒序的 ASCII
+			if i >= len(data) || i+1+int(data[i]) > len(data) {
+				return records, false, fmt.Errorf("%w: plain text vif truncated", ErrFrameInvalid)
+			}
+			n := int(data[i])
+			text := make([]byte, n)
+			for k := range n {
+				text[k] = data[i+n-k]
+			}
+			info = vifInfo{string(text), 1, kindNumber}
+			i += 1 + n
+		case 0x7E:
+			info = vifInfo{"any", 1, kindNumber}
+		case 0x7F:
+			info = vifInfo{"manufacturerSpecific", 1, kindRaw}
+		default:
+			info = primaryVIF(vif)
+		}
+		// VIFE: 只处理乘法修正系数, 其他跳过
+		for vif&vifExtension != 0 {
+			if i >= len(data) {
+				return records, false, fmt.Errorf("%w: vife truncated", ErrFrameInvalid)
+			}
+			vif = data[i]
+			i++
+			if vif&0x78 == 0x70 {
+				info.scale *= pow10(int(vif&0x07) - 6)
+			}
+		}
+
+		value, n, e := decodeData(dif&difData, data[i:], info)
+		if e != nil {
+			return records, false, fmt.Errorf("record %s: %w", info.name, e)
+		}
+		i += n
+		if value == nil {
+			continue
+		}
+
+		name := info.name
+		switch dif & difFunction {
+		case 0x10:
+			name += "_max"
+		case 0x20:
+			name += "_min"
+		case 0x30:
+			name += "_err"
+		}
+		if storage > 0 {
+			name += "_s" + strconv.Itoa(storage)
+		}
+		if tariff > 0 {
+			name += "_t" + strconv.Itoa(tariff)
+		}
+		if subunit > 0 {
+			name += "_u" + strconv.Itoa(subunit)
+		}
+		names[name]++
+		if c := names[name]; c > 1 {
+			name += "_" + strconv.Itoa(c)
+		}
+		records = append(records, record{Name: name, Value: value})
+	}
+	return records, false, nil
+}
+
+// decodeData 按 DIF 的数据域解码, 返回值和占用的字节数
+func decodeData(field byte, data []byte, info vifInfo) (value interface{}, n int, err error) {
+	lengths := [16]int{0, 1, 2, 3, 4, 4, 6, 8, 0, 1, 2, 3, 4, -1, 6, 0}
+	n = lengths[field]
+	if field == 0x0D {
+		if len(data) == 0 {
+			return nil, 0, fmt.Errorf("%w: lvar truncated", ErrFrameInvalid)
+		}
+		return decodeVariable(data, info)
+	}
+	if len(data) < n {
+		return nil, 0, fmt.Errorf("%w: data truncated", ErrFrameInvalid)
+	}
+	bs := data[:n]
+
+	var v float64
+	switch field {
+	case 0x00, 0x08, 0x0F:
+		// 无数据、读出选择
+		return nil, n, nil
+	case 0x01, 0x02, 0x03, 0x04, 0x06, 0x07:
+		switch info.kind {
+		case kindDate:
+			if n == 2 {
+				return decodeDate(bs), n, nil
+			}
+		case kindDateTime:
+			if n == 4 {
+				return decodeDateTime(bs), n, nil
+			}
+		}
+		v = float64(decodeInt(bs))
+	case 0x05:
+		v = float64(math.Float32frombits(binary.LittleEndian.Uint32(bs)))
+	case 0x09, 0x0A, 0x0B, 0x0C, 0x0E:
+		if info.kind == kindRaw {
+			return bcdString(bs), n, nil
+		}
+		if v, err = decodeBCD(bs); err != nil {
+			return nil, n, err
+		}
+	}
+	if info.kind == kindRaw {
+		return int64(v), n, nil
+	}
+	return v * info.scale, n, nil
+}
+
+// decodeVariable 变长数据, LVAR: 00-BF 字符串, C0-C9 正 BCD, D0-D9 负 BCD, E0-EF 二进制
+func decodeVariable(data []byte, info vifInfo) (value interface{}, n int, err error) {
+	lvar := int(data[0])
+	var size int
+	switch {
+	case lvar <= 0xBF:
+		size = lvar
+	case lvar >= 0xC0 && lvar <= 0xC9, lvar >= 0xD0 && lvar <= 0xD9:
+		size = lvar & 0x0F
+	case lvar >= 0xE0 && lvar <= 0xEF:
+		size = lvar - 0xE0
+	default:
+		return nil, 0, fmt.Errorf("%w: unsupported lvar 0x%02X", ErrFrameInvalid, lvar)
+	}
+	if len(data) < 1+size {
+		return nil, 0, fmt.Errorf("%w: data truncated", ErrFrameInvalid)
+	}
+	bs := data[1 : 1+size]
+	n = 1 + size
+
+	switch {
+	case lvar <= 0xBF:
+		// 字符串倒序传输
+		text := make([]byte, size)
+		for k := range size {
+			text[k] = bs[size-1-k]
+		}
+		return string(text), n, nil
+	case lvar >= 0xC0 && lvar <= 0xD9:
+		v, err := decodeBCD(bs)
+		if err != nil {
+			return nil, n, err
+		}
+		if lvar >= 0xD0 {
+			v = -v
+		}
+		return v * info.scale, n, nil
+	}
+	return hexString(bs), n, nil
+}
+
+// decodeInt 低字节在前的有符号整数
+func decodeInt(bs []byte) int64 {
+	var v uint64
+	for i := len(bs) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(bs[i])
+	}
+	shift := 64 - 8*len(bs)
+	return int64(v<<shift) >> shift
+}
+
+// decodeDate G 型日期
+func decodeDate(bs []byte) string {
+	day := int(bs[0] & 0x1F)
+	month := int(bs[1] & 0x0F)
+	year := int(bs[0]&0xE0)>>5 | int(bs[1]&0xF0)>>1
+	return time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.Local).Format(time.DateOnly)
+}
+
+// decodeDateTime F 型日期时间
+func decodeDateTime(bs []byte) string {
+	minute := int(bs[0] & 0x3F)
+	hour := int(bs[1] & 0x1F)
+	day := int(bs[2] & 0x1F)
+	month := int(bs[3] & 0x0F)
+	year := int(bs[2]&0xE0)>>5 | int(bs[3]&0xF0)>>1
+	return time.Date(2000+year, time.Month(month), day, hour, minute, 0, 0, time.Local).Format("2006-01-02 15:04")
+}
+
+func hexString(bs []byte) string {
+	return fmt.Sprintf("%X", bs)
+}
@@ -0,0 +1,202 @@
+package internal
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/twiglab/h2o/nab/box/pkg/testutil"
+)
+
+const (
+	// EN 13757-3 附录的水表应答: 12.565m³, 最大流量 0.113m³/h, 费率 2 子单元 1 的热量 218.37kWh
+	waterResp = "68 1F 1F 68 08 02 72 78 56 34 12 24 40 01 07 55 00 00 00 03 13 15 31 00 DA 02 3B 13 01 8B 60 04 37 18 02 18 16"
+	// 短报文头: 表内时间、结算日、表号、错误标志、MWh 扩展、电压、带修正系数的体积, 填充字节后是厂商数据
+	heatResp = "68 33 33 68 08 05 7A 01 00 00 00 04 6D 1E 0C 0F 36 42 6C FF 2C 0C 78 78 56 34 12 02 FD 17 04 00 0C FB 00 12 00 00 00 02 FD 48 E6 08 04 93 74 10 27 00 00 2F 0F 01 02 C4 16"
+	// 无报文头, 1F 表示还有后续报文
+	moreResp = "68 08 08 68 28 05 78 01 FD 09 07 1F D2 16"
+)
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+}
+
+func TestPrimaryVIF(t *testing.T) {
+	tests := []struct {
+		vif   byte
+		name  string
+		scale float64
+		kind  int
+	}{
+		{0x04, "energy", 0.01, kindNumber},          // 10 Wh
+		{0x06, "energy", 1, kindNumber},             // kWh
+		{0x0E, "energy", 1e6 / 3.6e6, kindNumber},   // MJ
+		{0x13, "volume", 0.001, kindNumber},         // L
+		{0x16, "volume", 1, kindNumber},             // m³
+		{0x1B, "mass", 1, kindNumber},               // kg
+		{0x22, "onTime", 3600, kindNumber},          // h
+		{0x24, "operatingTime", 1, kindNumber},      // s
+		{0x2E, "power", 1, kindNumber},              // kW
+		{0x3B, "volumeFlow", 0.001, kindNumber},     // L/h
+		{0x44, "volumeFlow", 0.06, kindNumber},      // L/min
+		{0x59, "flowTemperature", 0.01, kindNumber}, // 0.01℃
+		{0x5D, "returnTemperature", 0.01, kindNumber},
+		{0x61, "temperatureDifference", 0.01, kindNumber},
+		{0x68, "pressure", 0.001, kindNumber}, // mbar
+		{0x6C, "date", 1, kindDate},
+		{0x6D, "dateTime", 1, kindDateTime},
+		{0x74, "actualityDuration", 1, kindNumber},
+		{0x78, "fabricationNo", 1, kindRaw},
+		{0x93, "volume", 0.001, kindNumber}, // 扩展位不影响查表
+		{0x7A, "busAddress", 1, kindRaw},
+		{0x6F, "vif_6F", 1, kindNumber},
+	}
+	for _, tt := range tests {
+		got := primaryVIF(tt.vif)
+		if got.name != tt.name || !near(got.scale, tt.scale) || got.kind != tt.kind {
+			t.Fatalf("primaryVIF(%02X) = %+v, want %s %v %d", tt.vif, got, tt.name, tt.scale, tt.kind)
+		}
+	}
+}
+
+func TestExtensionFD(t *testing.T) {
+	tests := []struct {
+		vife  byte
+		name  string
+		scale float64
+		kind  int
+	}{
+		{0x08, "accessNo", 1, kindRaw},
+		{0x09, "medium", 1, kindRaw},
+		{0x0E, "firmwareVersion", 1, kindRaw},
+		{0x17, "errorFlags", 1, kindRaw},
+		{0x48, "voltage", 0.1, kindNumber},
+		{0x49, "voltage", 1, kindNumber},
+		{0x59, "current", 0.001, kindNumber},
+		{0x74, "batteryDays", 1, kindNumber},
+		{0x97, "errorFlags", 1, kindRaw},
+		{0x30, "fd_30", 1, kindNumber},
+	}
+	for _, tt := range tests {
+		got := extensionFD(tt.vife)
+		if got.name != tt.name || !near(got.scale, tt.scale) || got.kind != tt.kind {
+			t.Fatalf("extensionFD(%02X) = %+v, want %s %v %d", tt.vife, got, tt.name, tt.scale, tt.kind)
+		}
+	}
+}
+
+func TestExtensionFB(t *testing.T) {
+	tests := []struct {
+		vife  byte
+		name  string
+		scale float64
+	}{
+		{0x00, "energy", 100},         // 0.1 MWh
+		{0x01, "energy", 1000},        // MWh
+		{0x08, "energy", 1e8 / 3.6e6}, // 0.1 GJ
+		{0x09, "energy", 1e9 / 3.6e6}, // GJ
+		{0x10, "volume", 100},
+		{0x19, "mass", 1e6}, // 1000 t
+		{0x28, "power", 100},
+		{0x31, "power", 1e9 / 3.6e6}, // GJ/h
+		{0x20, "fb_20", 1},
+	}
+	for _, tt := range tests {
+		got := extensionFB(tt.vife)
+		if got.name != tt.name || !near(got.scale, tt.scale) || got.kind != kindNumber {
+			t.Fatalf("extensionFB(%02X) = %+v, want %s %v", tt.vife, got, tt.name, tt.scale)
+		}
+	}
+}
+
+func TestParseResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		header  *header
+		records []record
+		more    bool
+	}{
+		{"长报文头", waterResp,
+			&header{ID: "12345678", Manufacturer: 0x4024, Version: 0x01, Medium: 0x07, Access: 0x55},
+			[]record{
+				{"volume", 12.565},
+				{"volumeFlow_max_s5", 0.113},
+				{"energy_t2_u1", 218.37},
+			}, false},
+		{"短报文头", heatResp,
+			&header{Access: 0x01},
+			[]record{
+				{"dateTime", "2024-06-15 12:30"},
+				{"date_s1", "2023-12-31"},
+				{"fabricationNo", "12345678"},
+				{"errorFlags", int64(4)},
+				{"energy", 1200.0},
+				{"voltage", 227.8},
+				{"volume", 0.1},
+			}, false},
+		{"后续报文", moreResp, nil, []record{{"medium", int64(7)}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := decodeFrame(testutil.Unhex(t, tt.in))
+			if err != nil {
+				t.Fatal(err)
+			}
+			h, records, more, err := parseResponse(f.CI, f.Data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if (h == nil) != (tt.header == nil) || h != nil && *h != *tt.header {
+				t.Fatalf("header = %+v, want %+v", h, tt.header)
+			}
+			if more != tt.more || len(records) != len(tt.records) {
+				t.Fatalf("got %v more %v, want %v more %v", records, more, tt.records, tt.more)
+			}
+			for i, r := range records {
+				want := tt.records[i]
+				if w, ok := want.Value.(float64); ok {
+					if g, ok := r.Value.(float64); !ok || r.Name != want.Name || !near(g, w) {
+						t.Fatalf("record %d = %v, want %v", i, r, want)
+					}
+					continue
+				}
+				if r != want {
+					t.Fatalf("record %d = %v, want %v", i, r, want)
+				}
+			}
+		})
+	}
+	if h, _, _, _ := parseResponse(0x72, testutil.Unhex(t, waterResp)[7:35]); h.secondaryAddress() != "1234567840240107" {
+		t.Fatalf("secondary address = %s", h.secondaryAddress())
+	}
+	if manufacturerCode(0x4024) != "PAD" {
+		t.Fatalf("manufacturer = %s, want PAD", manufacturerCode(0x4024))
+	}
+}
+
+func TestParseResponseInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		ci   byte
+		data string
+		err  error
+	}{
+		{"长报文头不完整", ciLongHeader, "78 56 34 12 24 40 01 07", ErrFrameInvalid},
+		{"短报文头不完整", ciShortHead, "01 00", ErrFrameInvalid},
+		{"数据不完整", ciNoHeader, "04 13 15 31", ErrFrameInvalid},
+		{"缺少 VIFE", ciNoHeader, "02 FD", ErrFrameInvalid},
+		{"缺少 DIFE", ciNoHeader, "8B", ErrFrameInvalid},
+		{"非法 BCD", ciNoHeader, "0A 13 1A 00", ErrInvalidBCD},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := parseResponse(tt.ci, testutil.Unhex(t, tt.data)); !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+		})
+	}
+	if _, _, _, err := parseResponse(0x51, nil); err == nil {
+		t.Fatal("got nil error for ci 51")
+	}
+}
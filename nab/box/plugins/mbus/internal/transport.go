@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/twiglab/h2o/nab/box/pkg/serialport"
+)
+
+// exchange 发送一帧并等待应答
+// 没有收到任何字节返回超时, 二次地址搜索时表示没有从站;
+// 收到无法解析的字节返回 ErrFrameInvalid, 搜索时表示多个从站同时应答.
+// 收到过字节的出错都关闭连接, 丢弃残留的数据, 下次请求重新打开
+func (c *connector) exchange(req []byte) (resp frame, err error) {
+	buf, err := c.transport.Request(req, func(buf []byte) (e error) {
+		resp, e = decodeFrame(buf)
+		return e
+	})
+	if err == nil {
+		return resp, nil
+	}
+	if errors.Is(err, serialport.ErrTimeout) && len(buf) > 0 {
+		err = fmt.Errorf("%w: incomplete % X", ErrFrameInvalid, buf)
+	}
+	if !errors.Is(err, serialport.ErrTimeout) {
+		c.transport.Close()
+	}
+	return resp, err
+}
@@ -0,0 +1,10 @@
+package mbus
+
+import (
+	"github.com/twiglab/h2o/nab/box/driverbox"
+	"github.com/twiglab/h2o/nab/box/plugins/mbus/internal"
+)
+
+func EnablePlugin() {
+	driverbox.EnablePlugin(internal.ProtocolName, new(internal.Plugin))
+}
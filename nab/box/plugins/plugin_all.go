@@ -1,8 +1,12 @@
 package plugins
 
 import (
+	"github.com/twiglab/h2o/nab/box/plugins/bacnet"
+	"github.com/twiglab/h2o/nab/box/plugins/cjt188"
+	"github.com/twiglab/h2o/nab/box/plugins/dlt645"
 	"github.com/twiglab/h2o/nab/box/plugins/httpclient"
 	"github.com/twiglab/h2o/nab/box/plugins/httpserver"
+	"github.com/twiglab/h2o/nab/box/plugins/mbus"
 	"github.com/twiglab/h2o/nab/box/plugins/modbus"
 	"github.com/twiglab/h2o/nab/box/plugins/mqtt"
 	"github.com/twiglab/h2o/nab/box/plugins/s7"
@@ -12,13 +16,15 @@ import (
 
 func EnableAll() {
 	modbus.EnablePlugin()
-	//bacnet.EnablePlugin()
+	bacnet.EnablePlugin()
 	httpserver.EnablePlugin()
 	httpclient.EnablePlugin()
 	websocket.EnablePlugin()
 	tcpserver.EnablePlugin()
 	mqtt.EnablePlugin()
-	//dlt645.EnablePlugin()
+	dlt645.EnablePlugin()
+	cjt188.EnablePlugin()
+	mbus.EnablePlugin()
 	//opcua.EnablePlugin()
 	s7.EnablePlugin()
 }
@@ -0,0 +1,82 @@
+package auth
+
+// 认证和授权
+// 对外的 HTTP 入口都经过 Middleware, 请求的 context 中一定有 Claims
+// context 中没有 Claims 的是服务内部的调用(消息消费, 定时任务, 命令行), 不做限制
+
+import (
+	"context"
+	"errors"
+	"slices"
+)
+
+const (
+	RoleAdmin    = "admin"     // 全部权限
+	RoleOperator = "operator"  // 维护设备和位置
+	RoleFinance  = "finance"   // 查看, 维护计费相关的数据
+	RoleReadOnly = "read-only" // 只读
+)
+
+// AllProjects 不限项目
+const AllProjects = "*"
+
+var (
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+type Claims struct {
+	Subject  string   `json:"sub"`
+	Name     string   `json:"name,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+	Projects []string `json:"projects,omitempty"`
+}
+
+// Has 有其中任意一个角色, admin 视为拥有全部角色
+func (c *Claims) Has(roles ...string) bool {
+	if slices.Contains(c.Roles, RoleAdmin) {
+		return true
+	}
+	for _, r := range roles {
+		if slices.Contains(c.Roles, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanRead 有任意一个已知的角色
+func (c *Claims) CanRead() bool {
+	return c.Has(RoleOperator, RoleFinance, RoleReadOnly)
+}
+
+// Scope 可以访问的项目, all 为 true 时不限项目
+// admin 没有指定项目时不限, 其他角色必须指定项目或者 AllProjects
+func (c *Claims) Scope() (projects []string, all bool) {
+	if slices.Contains(c.Projects, AllProjects) {
+		return nil, true
+	}
+	if len(c.Projects) == 0 && slices.Contains(c.Roles, RoleAdmin) {
+		return nil, true
+	}
+	return c.Projects, false
+}
+
+func (c *Claims) InScope(project string) bool {
+	ps, all := c.Scope()
+	return all || slices.Contains(ps, project)
+}
+
+// Anonymous 不启用认证时使用
+var Anonymous = &Claims{Subject: "anonymous", Roles: []string{RoleAdmin}}
+
+type ctxKey struct{}
+
+func NewContext(ctx context.Context, c *Claims) context.Context {
+	return context.WithValue(ctx, ctxKey{}, c)
+}
+
+func FromContext(ctx context.Context) (*Claims, bool) {
+	c, ok := ctx.Value(ctxKey{}).(*Claims)
+	return c, ok
+}
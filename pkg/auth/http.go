@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Middleware 验证 Authorization: Bearer token, 把 Claims 放入 context
+// v 为 nil 时不启用认证, 所有请求都是 Anonymous
+func Middleware(v Verifier) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if v == nil {
+				next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), Anonymous)))
+				return
+			}
+
+			token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" {
+				unauthorized(w, ErrUnauthenticated)
+				return
+			}
+
+			c, err := v.Verify(r.Context(), strings.TrimSpace(token))
+			if err != nil {
+				unauthorized(w, err)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(NewContext(r.Context(), c)))
+		})
+	}
+}
+
+// RequireAllProjects 只允许不限项目的调用方, 如服务之间同步全量数据
+func RequireAllProjects(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, ok := FromContext(r.Context())
+		if !ok {
+			unauthorized(w, ErrUnauthenticated)
+			return
+		}
+		if _, all := c.Scope(); !all || !c.CanRead() {
+			http.Error(w, ErrForbidden.Error(), http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func unauthorized(w http.ResponseWriter, err error) {
+	if !errors.Is(err, ErrUnauthenticated) {
+		err = ErrUnauthenticated
+	}
+	w.Header().Set("WWW-Authenticate", `Bearer realm="h2o"`)
+	http.Error(w, err.Error(), http.StatusUnauthorized)
+}
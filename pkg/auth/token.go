@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+type staticClaims struct {
+	Name     string   `json:"name,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+	Projects []string `json:"projects,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Static 本地签发和验证 HS256 的 token, 用于测试和没有 OIDC 的部署
+// Issuer 为空时不检查签发方
+type Static struct {
+	Secret []byte
+	Issuer string
+}
+
+func (s *Static) Issue(c *Claims, ttl time.Duration) (string, error) {
+	now := time.Now()
+	sc := staticClaims{
+		Name:     c.Name,
+		Roles:    c.Roles,
+		Projects: c.Projects,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.Issuer,
+			Subject:   c.Subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, sc).SignedString(s.Secret)
+}
+
+func (s *Static) Verify(_ context.Context, token string) (*Claims, error) {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if s.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(s.Issuer))
+	}
+
+	var sc staticClaims
+	_, err := jwt.ParseWithClaims(token, &sc, func(*jwt.Token) (any, error) { return s.Secret, nil }, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	return &Claims{Subject: sc.Subject, Name: sc.Name, Roles: sc.Roles, Projects: sc.Projects}, nil
+}
+
+// OIDC 验证 OIDC 提供方签发的 id token 或者 JWT 格式的 access token
+// 角色和项目所在的 claim 可以配置, 支持用 . 分隔的路径, 如 realm_access.roles
+type OIDC struct {
+	verifier *oidc.IDTokenVerifier
+
+	RolesClaim    string
+	ProjectsClaim string
+}
+
+func NewOIDC(ctx context.Context, issuer, clientID string) (*OIDC, error) {
+	p, err := oidc.NewProvider(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+	return &OIDC{
+		verifier:      p.Verifier(&oidc.Config{ClientID: clientID, SkipClientIDCheck: clientID == ""}),
+		RolesClaim:    "roles",
+		ProjectsClaim: "projects",
+	}, nil
+}
+
+func (o *OIDC) Verify(ctx context.Context, token string) (*Claims, error) {
+	t, err := o.verifier.Verify(ctx, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+
+	var raw map[string]any
+	if err := t.Claims(&raw); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnauthenticated, err)
+	}
+	name, _ := raw["name"].(string)
+	return &Claims{
+		Subject:  t.Subject,
+		Name:     name,
+		Roles:    strs(lookup(raw, o.RolesClaim)),
+		Projects: strs(lookup(raw, o.ProjectsClaim)),
+	}, nil
+}
+
+func lookup(m map[string]any, path string) any {
+	var v any = m
+	for p := range strings.SplitSeq(path, ".") {
+		mm, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = mm[p]
+	}
+	return v
+}
+
+func strs(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return strings.Fields(v)
+	case []any:
+		ss := make([]string, 0, len(v))
+		for _, x := range v {
+			if s, ok := x.(string); ok {
+				ss = append(ss, s)
+			}
+		}
+		return ss
+	}
+	return nil
+}
+
+var ErrModeEmpty = errors.New("auth: mode is empty, set none to disable auth")
+
+type Conf struct {
+	// Mode none, static, oidc
+	Mode string
+
+	Secret string
+	Issuer string
+
+	ClientID      string
+	RolesClaim    string
+	ProjectsClaim string
+}
+
+// NewVerifier Mode 为 none 时返回 nil, 表示不启用认证
+// 不认证时所有请求都是 admin, 必须明确配置 none, 为空时报错
+func NewVerifier(ctx context.Context, c Conf) (Verifier, error) {
+	switch c.Mode {
+	case "":
+		return nil, ErrModeEmpty
+	case "none":
+		slog.Warn("auth disabled, all requests are anonymous admin")
+		return nil, nil
+	case "static":
+		if c.Secret == "" {
+			return nil, errors.New("auth: static mode needs a secret")
+		}
+		return &Static{Secret: []byte(c.Secret), Issuer: c.Issuer}, nil
+	case "oidc":
+		o, err := NewOIDC(ctx, c.Issuer, c.ClientID)
+		if err != nil {
+			return nil, err
+		}
+		if c.RolesClaim != "" {
+			o.RolesClaim = c.RolesClaim
+		}
+		if c.ProjectsClaim != "" {
+			o.ProjectsClaim = c.ProjectsClaim
+		}
+		return o, nil
+	}
+	return nil, fmt.Errorf("auth: unknown mode %q", c.Mode)
+}
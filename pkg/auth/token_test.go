@@ -0,0 +1,26 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestNewVerifierMode(t *testing.T) {
+	ctx := context.Background()
+	if _, err := NewVerifier(ctx, Conf{}); !errors.Is(err, ErrModeEmpty) {
+		t.Fatalf("empty mode: err = %v, want ErrModeEmpty", err)
+	}
+	if v, err := NewVerifier(ctx, Conf{Mode: "none"}); v != nil || err != nil {
+		t.Fatalf("none mode = %v %v, want nil nil", v, err)
+	}
+	if _, err := NewVerifier(ctx, Conf{Mode: "static"}); err == nil {
+		t.Fatal("static mode without secret: want error")
+	}
+	if _, err := NewVerifier(ctx, Conf{Mode: "basic"}); err == nil {
+		t.Fatal("unknown mode: want error")
+	}
+	if v, err := NewVerifier(ctx, Conf{Mode: "static", Secret: "s"}); err != nil || v == nil {
+		t.Fatalf("static mode = %v %v, want verifier", v, err)
+	}
+}
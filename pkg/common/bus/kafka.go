@@ -0,0 +1,80 @@
+package bus
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+const (
+	minRetry = time.Second
+	maxRetry = time.Minute
+)
+
+// Handler 处理一条消息, 各服务只需要提供自己的处理和错误分类
+type Handler struct {
+	Handle func(ctx context.Context, topic string, payload []byte) error
+	// Retryable 返回false的错误(解析失败, 重复数据等)重试也不会成功, 直接丢弃
+	Retryable func(err error) bool
+	Logger    *slog.Logger
+}
+
+// ConsumeKafka 客户端需要关闭自动提交并阻塞poll期间的rebalance, offset只在处理成功后提交
+func ConsumeKafka(ctx context.Context, cli *kgo.Client, h Handler) error {
+	for {
+		fs := cli.PollFetches(ctx)
+		if fs.IsClientClosed() {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			// poll之后不放开rebalance, 关闭客户端时离开消费组会一直阻塞
+			cli.AllowRebalance()
+			return err
+		}
+
+		fs.EachError(func(topic string, p int32, err error) {
+			h.Logger.ErrorContext(ctx, "kafka fetch error", slog.String("topic", topic), slog.Int("partition", int(p)), slog.Any("err", err))
+		})
+
+		var done []*kgo.Record
+		fs.EachRecord(func(r *kgo.Record) {
+			if ctx.Err() != nil {
+				return
+			}
+			if err := h.handleRecord(ctx, r); err != nil {
+				return
+			}
+			done = append(done, r)
+		})
+
+		if err := cli.CommitRecords(context.WithoutCancel(ctx), done...); err != nil {
+			h.Logger.ErrorContext(ctx, "kafka commit error", slog.Any("err", err))
+		}
+		cli.AllowRebalance()
+	}
+}
+
+// handleRecord 处理失败时一直重试, 直到成功或者退出, 避免跳过数据
+func (h Handler) handleRecord(ctx context.Context, r *kgo.Record) error {
+	retry := minRetry
+	for {
+		err := h.Handle(ctx, r.Topic, r.Value)
+		if err == nil {
+			return nil
+		}
+		if !h.Retryable(err) {
+			h.Logger.ErrorContext(ctx, "kafka drop record", slog.String("topic", r.Topic), slog.String("key", string(r.Key)), slog.Any("err", err))
+			return nil
+		}
+
+		h.Logger.ErrorContext(ctx, "kafka handle error", slog.String("topic", r.Topic), slog.Int64("offset", r.Offset), slog.Duration("retry", retry), slog.Any("err", err))
+		select {
+		case <-time.After(retry):
+			retry = min(retry*2, maxRetry)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
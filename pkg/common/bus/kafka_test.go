@@ -0,0 +1,86 @@
+package bus
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kfake"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+var errDup = errors.New("duplicate")
+
+func TestConsumeKafkaCommitsDropped(t *testing.T) {
+	const topic, group = "h2o.E", "test"
+	c, err := kfake.NewCluster(kfake.NumBrokers(1), kfake.SeedTopics(1, topic))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	prod, err := kgo.NewClient(kgo.SeedBrokers(c.ListenAddrs()...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prod.Close()
+	for _, v := range []string{"a", "dup", "b"} {
+		if err := prod.ProduceSync(ctx, &kgo.Record{Topic: topic, Value: []byte(v)}).FirstErr(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cli, err := kgo.NewClient(
+		kgo.SeedBrokers(c.ListenAddrs()...),
+		kgo.ConsumerGroup(group),
+		kgo.ConsumeTopics(topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DisableAutoCommit(),
+		kgo.BlockRebalanceOnPoll(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	var got []string
+	cctx, stop := context.WithCancel(ctx)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- ConsumeKafka(cctx, cli, Handler{
+			Handle: func(ctx context.Context, topic string, payload []byte) error {
+				got = append(got, string(payload))
+				if string(payload) == "dup" {
+					return errDup
+				}
+				if len(got) == 3 {
+					stop()
+				}
+				return nil
+			},
+			Retryable: func(err error) bool { return !errors.Is(err, errDup) },
+			Logger:    slog.New(slog.DiscardHandler),
+		})
+	}()
+	<-errc
+
+	if len(got) != 3 {
+		t.Fatalf("handled %v, want 3 records", got)
+	}
+
+	// 不可重试的记录也要提交, 否则重启后卡在这条
+	offsets, err := kadm.NewClient(prod).FetchOffsets(ctx, group)
+	if err != nil {
+		t.Fatal(err)
+	}
+	o, ok := offsets.Lookup(topic, 0)
+	if !ok || o.At != 3 {
+		t.Fatalf("committed offset = %d, want 3", o.At)
+	}
+}
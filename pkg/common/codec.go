@@ -0,0 +1,103 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+const (
+	ContentTypeJSON = "application/json"
+	ContentTypeCBOR = "application/cbor"
+
+	HeaderContentType = "Content-Type"
+)
+
+// WireVersion 二进制格式的版本号, 写在消息头
+const WireVersion byte = 1
+
+// 二进制消息头: 'h' '2' 'o' version, 之后是cbor编码的数据
+// json 总是以 '{' 开头, 两者不会混淆
+var wireMagic = []byte{'h', '2', 'o'}
+
+var (
+	ErrWireVersion = errors.New("unsupported wire version")
+	ErrCodec       = errors.New("unknown codec")
+)
+
+type Codec interface {
+	ContentType() string
+	Marshal(v any) ([]byte, error)
+}
+
+var (
+	JSON Codec = jsonCodec{}
+	CBOR Codec = cborCodec{}
+)
+
+// CodecOf 按名称或者 Content-Type 查找编码, 未知的名称返回错误, 避免配置写错时悄悄退回json
+func CodecOf(name string) (Codec, error) {
+	switch name {
+	case "json", ContentTypeJSON:
+		return JSON, nil
+	case "cbor", ContentTypeCBOR:
+		return CBOR, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrCodec, name)
+}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string {
+	return ContentTypeJSON
+}
+
+func (jsonCodec) Marshal(v any) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// 读数类型本身实现了 BinaryMarshaler, cbor 不能再用它
+var (
+	cborEnc, _ = cbor.EncOptions{Time: cbor.TimeRFC3339Nano, BinaryMarshaler: cbor.BinaryMarshalerNone}.EncMode()
+	cborDec, _ = cbor.DecOptions{BinaryUnmarshaler: cbor.BinaryUnmarshalerNone}.DecMode()
+)
+
+type cborCodec struct{}
+
+func (cborCodec) ContentType() string {
+	return ContentTypeCBOR
+}
+
+func (cborCodec) Marshal(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Write(wireMagic)
+	buf.WriteByte(WireVersion)
+	if err := cborEnc.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// ContentTypeOf 根据消息头判断编码
+func ContentTypeOf(data []byte) string {
+	if len(data) > len(wireMagic) && bytes.HasPrefix(data, wireMagic) {
+		return ContentTypeCBOR
+	}
+	return ContentTypeJSON
+}
+
+// Unmarshal 自动识别编码, 没有消息头的按json处理, 兼容旧数据
+func Unmarshal(data []byte, v any) error {
+	if ContentTypeOf(data) == ContentTypeJSON {
+		return json.Unmarshal(data, v)
+	}
+
+	ver := data[len(wireMagic)]
+	if ver > WireVersion {
+		return fmt.Errorf("%w: %d", ErrWireVersion, ver)
+	}
+	return cborDec.Unmarshal(data[len(wireMagic)+1:], v)
+}
@@ -0,0 +1,86 @@
+package common
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func testMeter() ElectricityMeter {
+	now := time.Date(2026, 10, 1, 23, 59, 59, 0, time.Local)
+	var em ElectricityMeter
+	em.Code = "PT-1-IN"
+	em.Type = ELECTRICITY
+	em.DataTime = now
+	em.DataTs = Ts(now)
+	em.DataCode = "0199a1f0-0000-7000-8000-000000000000"
+	em.Pos.Project = "1006"
+	em.Flag = 1
+	em.Data.DataValue = 123456
+	em.Data.VoltageA = 2200
+	return em
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	for _, c := range []Codec{JSON, CBOR} {
+		em := testMeter()
+		bs, err := c.Marshal(em)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ct := ContentTypeOf(bs); ct != c.ContentType() {
+			t.Errorf("content type %s, want %s", ct, c.ContentType())
+		}
+
+		var got ElectricityMeter
+		if err := Unmarshal(bs, &got); err != nil {
+			t.Fatal(c.ContentType(), err)
+		}
+		if !got.DataTime.Equal(em.DataTime) {
+			t.Errorf("%s: data time %v, want %v", c.ContentType(), got.DataTime, em.DataTime)
+		}
+		got.DataTime = em.DataTime
+		if got != em {
+			t.Errorf("%s: got %+v, want %+v", c.ContentType(), got, em)
+		}
+	}
+}
+
+func TestUnmarshalLegacyJSON(t *testing.T) {
+	legacy := `{"code":"PT-2-IN","type":"E","data_time":"2026-10-01T12:00:00+08:00","data_ts":"20261001120000","data_code":"x","status":0,"pos":{"project":"1006"},"data":{"data_value":42}}`
+
+	var em ElectricityMeter
+	if err := Unmarshal([]byte(legacy), &em); err != nil {
+		t.Fatal(err)
+	}
+	if em.Code != "PT-2-IN" || em.Pos.Project != "1006" || em.Data.DataValue != 42 || em.Flag != 0 {
+		t.Errorf("got %+v", em)
+	}
+}
+
+func TestUnmarshalFutureVersion(t *testing.T) {
+	bs, _ := CBOR.Marshal(testMeter())
+	bs[len(wireMagic)] = WireVersion + 1
+
+	var em ElectricityMeter
+	if err := Unmarshal(bs, &em); !errors.Is(err, ErrWireVersion) {
+		t.Errorf("err = %v, want ErrWireVersion", err)
+	}
+}
+
+func TestCodecOf(t *testing.T) {
+	for name, want := range map[string]Codec{
+		"json":          JSON,
+		ContentTypeJSON: JSON,
+		"cbor":          CBOR,
+		ContentTypeCBOR: CBOR,
+	} {
+		c, err := CodecOf(name)
+		if err != nil || c != want {
+			t.Errorf("%s: got %v %v, want %v", name, c, err, want)
+		}
+	}
+	if _, err := CodecOf("cbro"); !errors.Is(err, ErrCodec) {
+		t.Errorf("err = %v, want ErrCodec", err)
+	}
+}
@@ -0,0 +1,45 @@
+package common
+
+// 总线上传输的读数, 各服务共用, 字段变化需要同步修改 WireVersion
+
+// Flag 数据标志位
+type Flag uint32
+
+const (
+	// FlagFreeze 日冻结读数, 零点前后采集, 作为前后两天的分界
+	FlagFreeze Flag = 1 << iota
+	// FlagMonthFreeze 月冻结读数, 每月1日零点的日冻结读数同时带这个标志
+	FlagMonthFreeze
+)
+
+func (f Flag) Has(x Flag) bool {
+	return f&x == x
+}
+
+type Meter struct {
+	Device
+	Pos  Pos  `json:"pos,omitzero"`
+	Flag Flag `json:"flag,omitzero"`
+}
+
+func (m Meter) Topic() string {
+	return Topic(m.Device)
+}
+
+type ElectricityMeter struct {
+	Meter
+	Data Electricity `json:"data"`
+}
+
+func (m ElectricityMeter) MarshalBinary() ([]byte, error) {
+	return JSON.Marshal(m)
+}
+
+type WaterMeter struct {
+	Meter
+	Data Water `json:"data"`
+}
+
+func (m WaterMeter) MarshalBinary() ([]byte, error) {
+	return JSON.Marshal(m)
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"context"
+	"encoding"
+)
+
+type SendObject interface {
+	encoding.BinaryMarshaler
+	Topic() string
+}
+
+type Sender interface {
+	SendData(ctx context.Context, obj SendObject) error
+}
+
+// RawObject 已经序列化好的数据, 原样发送
+type RawObject struct {
+	T    string
+	Data []byte
+}
+
+func (r RawObject) Topic() string {
+	return r.T
+}
+
+func (r RawObject) MarshalBinary() ([]byte, error) {
+	return r.Data, nil
+}
+
+// CodecSender 按指定的编码序列化后再交给 Sender
+type CodecSender struct {
+	Codec  Codec
+	Sender Sender
+}
+
+func (c CodecSender) SendData(ctx context.Context, obj SendObject) error {
+	bs, err := c.Codec.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return c.Sender.SendData(ctx, RawObject{T: obj.Topic(), Data: bs})
+}
+
+// Check 健康检查交给下层的 Sender
+func (c CodecSender) Check(ctx context.Context) error {
+	if ch, ok := c.Sender.(interface{ Check(context.Context) error }); ok {
+		return ch.Check(ctx)
+	}
+	return nil
+}
@@ -16,6 +16,22 @@ const (
 	GasTopic         = "h2o/+/G"
 )
 
+const (
+	WaterKafkaTopic       = H2O + "." + WATER
+	ElectricityKafkaTopic = H2O + "." + ELECTRICITY
+	GasKafkaTopic         = H2O + "." + GAS
+)
+
+const (
+	NatsStream         = "H2O"
+	NatsSubjects       = H2O + ".>"
+	NatsDLQStream      = "H2O_DLQ"
+	NatsDeadLetter     = "dlq." + H2O
+	WaterSubject       = H2O + ".*." + WATER
+	ElectricitySubject = H2O + ".*." + ELECTRICITY
+	GasSubject         = H2O + ".*." + GAS
+)
+
 func Topic(d Device) string {
 	return H2O + "/" + d.Code + "/" + d.Type
 }
@@ -38,3 +54,36 @@ func TopicType(topic string) string {
 	}
 	panic(topic + " not supports")
 }
+
+// KafkaTopic kafka的topic不能包含'/', 按设备类型映射, 设备code作为key
+func KafkaTopic(topic string) (string, string) {
+	_, code, t := TopicPart(topic)
+	return H2O + "." + t, code
+}
+
+func KafkaType(topic string) string {
+	_, t, _ := strings.Cut(topic, ".")
+	return t
+}
+
+// natsEscaper code里的'.'会多出subject token, 通配符和空白nats不允许, 按百分号编码
+var natsEscaper = strings.NewReplacer(
+	"%", "%25",
+	".", "%2E",
+	"*", "%2A",
+	">", "%3E",
+	" ", "%20",
+	"\t", "%09",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+// NatsSubject nats的subject以'.'分隔, h2o/code/type => h2o.code.type
+func NatsSubject(topic string) string {
+	_, code, t := TopicPart(topic)
+	return H2O + "." + natsEscaper.Replace(code) + "." + t
+}
+
+func NatsType(subject string) string {
+	return subject[strings.LastIndexByte(subject, '.')+1:]
+}
@@ -0,0 +1,32 @@
+package common
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNatsSubject(t *testing.T) {
+	tests := []struct {
+		topic string
+		want  string
+	}{
+		{"h2o/PT-1-IN/E", "h2o.PT-1-IN.E"},
+		{"h2o/B1.F2.M3/W", "h2o.B1%2EF2%2EM3.W"},
+		{"h2o/a*b>c/G", "h2o.a%2Ab%3Ec.G"},
+		{"h2o/a b/W", "h2o.a%20b.W"},
+		{"h2o/100%2E/W", "h2o.100%252E.W"},
+	}
+	for _, tt := range tests {
+		got := NatsSubject(tt.topic)
+		if got != tt.want {
+			t.Fatalf("NatsSubject(%q) = %q, want %q", tt.topic, got, tt.want)
+		}
+		// 固定三个token, 能被 h2o.*.type 匹配
+		if n := strings.Count(got, "."); n != 2 {
+			t.Fatalf("NatsSubject(%q) = %q has %d dots", tt.topic, got, n)
+		}
+		if NatsType(got) != tt.topic[len(tt.topic)-1:] {
+			t.Fatalf("NatsType(%q) = %q", got, NatsType(got))
+		}
+	}
+}
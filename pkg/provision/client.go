@@ -0,0 +1,77 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// response driver-box REST 接口的通用响应
+type response[T any] struct {
+	Success   bool   `json:"success"`
+	ErrorCode int    `json:"errorCode"`
+	ErrorMsg  string `json:"errorMsg"`
+	Data      T      `json:"data"`
+}
+
+// Client 访问网关的 REST 接口, URL 如 http://192.168.1.10:8081
+type Client struct {
+	URL    string
+	Client *http.Client
+	// Token 不为空时作为 Bearer token, 和网关的 EXPORT_PROVISION_TOKEN 一致
+	Token string
+}
+
+func (c *Client) do(req *http.Request) (Report, error) {
+	if c.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.Token)
+	}
+	cli := c.Client
+	if cli == nil {
+		cli = http.DefaultClient
+	}
+	resp, err := cli.Do(req)
+	if err != nil {
+		return Report{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Report{}, fmt.Errorf("edge box %s: %s", c.URL, resp.Status)
+	}
+	var r response[Report]
+	if err := json.UnmarshalRead(resp.Body, &r); err != nil {
+		return Report{}, err
+	}
+	if !r.Success {
+		return r.Data, errors.New(r.ErrorMsg)
+	}
+	return r.Data, nil
+}
+
+// Apply 下发配置, 校验失败时 Report.Errors 不为空, 不返回 error
+func (c *Client) Apply(ctx context.Context, cfg Config) (Report, error) {
+	body, err := json.Marshal(cfg)
+	if err != nil {
+		return Report{}, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimRight(c.URL, "/")+ApplyPath, bytes.NewReader(body))
+	if err != nil {
+		return Report{}, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return c.do(req)
+}
+
+// Status 网关最后一次下发的结果
+func (c *Client) Status(ctx context.Context) (Report, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimRight(c.URL, "/")+StatusPath, nil)
+	if err != nil {
+		return Report{}, err
+	}
+	return c.do(req)
+}
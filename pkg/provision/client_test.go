@@ -0,0 +1,61 @@
+package provision
+
+import (
+	"context"
+	"encoding/json/v2"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestClient(t *testing.T) {
+	var got Config
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+ApplyPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		if err := json.UnmarshalRead(r.Body, &got); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		rep := Report{Box: got.Box, Version: got.Version, DryRun: got.DryRun}
+		if got.Version == "bad" {
+			rep.Errors = []string{"modbus: plugin not enabled"}
+		}
+		json.MarshalWrite(w, response[Report]{Success: true, Data: rep})
+	})
+	mux.HandleFunc("GET "+StatusPath, func(w http.ResponseWriter, r *http.Request) {
+		json.MarshalWrite(w, response[Report]{ErrorCode: 500, ErrorMsg: "busy"})
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	// URL 末尾的 / 不影响路径
+	c := &Client{URL: srv.URL + "/", Token: "secret"}
+
+	rep, err := c.Apply(ctx, Config{Box: "B1", Version: "v1", DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !rep.OK() || rep.Box != "B1" || rep.Version != "v1" || !rep.DryRun || got.Box != "B1" {
+		t.Fatalf("got %+v, sent %+v", rep, got)
+	}
+
+	// 校验失败在 Report 中返回, 不是 error
+	rep, err = c.Apply(ctx, Config{Box: "B1", Version: "bad"})
+	if err != nil || rep.OK() {
+		t.Fatalf("got %+v %v, want report with errors", rep, err)
+	}
+
+	if _, err := c.Status(ctx); err == nil || err.Error() != "busy" {
+		t.Fatalf("status: err = %v, want busy", err)
+	}
+
+	c.Token = "wrong"
+	if _, err := c.Apply(ctx, Config{Box: "B1"}); err == nil {
+		t.Fatal("apply with wrong token: got nil error")
+	}
+}
@@ -0,0 +1,80 @@
+package provision
+
+import "time"
+
+// archon 向 nab 边缘网关(driver-box)下发设备配置
+// 下发: POST ApplyPath 提交 Config, 网关校验后写入核心缓存, 重启涉及的插件, 返回 Report
+// 状态: GET StatusPath 返回最后一次下发的 Report
+// 网关的 REST 接口统一用 response 包装, 见 Client
+
+const (
+	ApplyPath  = "/api/v1/provision"
+	StatusPath = "/api/v1/provision/status"
+)
+
+// Connection archon 中网关的连接, Options 为插件的连接参数, 原样下发
+// 如 modbus: {"mode": "rtu", "address": "/dev/ttyS0", "baudRate": 9600}
+type Connection struct {
+	Plugin  string         `json:"plugin"`
+	Options map[string]any `json:"options,omitempty"`
+}
+
+// Device 和 driver-box 的 config.Device 一致, ID 为设备号
+type Device struct {
+	ID            string            `json:"id"`
+	Description   string            `json:"description"`
+	ConnectionKey string            `json:"connectionKey"`
+	Properties    map[string]string `json:"properties,omitempty"`
+	DriverKey     string            `json:"driverKey,omitempty"`
+}
+
+// Model 和 driver-box 的 config.DeviceModel 一致
+type Model struct {
+	Name         string           `json:"name"`
+	ModelID      string           `json:"modelId"`
+	Description  string           `json:"description"`
+	Attributes   map[string]any   `json:"attributes,omitempty"`
+	DevicePoints []map[string]any `json:"devicePoints"`
+	Devices      []Device         `json:"devices"`
+}
+
+// Plugin 一个插件的配置, 和 res/driver/<plugin>/config.json 的格式一致
+type Plugin struct {
+	Name         string         `json:"protocolName"`
+	Connections  map[string]any `json:"connections"`
+	DeviceModels []Model        `json:"deviceModels"`
+}
+
+type Config struct {
+	Box string `json:"box"`
+	// Version 配置内容的摘要, 用于比较网关上的配置是否最新
+	Version string   `json:"version"`
+	Plugins []Plugin `json:"plugins"`
+
+	// Prune 删除这些插件下不在配置中的设备, 不涉及的插件不受影响
+	Prune bool `json:"prune"`
+	// DryRun 只校验不写入
+	DryRun bool `json:"dryRun"`
+}
+
+type PluginReport struct {
+	Plugin  string   `json:"plugin"`
+	Added   []string `json:"added,omitempty"`
+	Updated []string `json:"updated,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+type Report struct {
+	Box     string    `json:"box"`
+	Version string    `json:"version"`
+	Time    time.Time `json:"time"`
+	DryRun  bool      `json:"dryRun"`
+
+	// Errors 校验或写入的错误, 校验有错误时不写入
+	Errors  []string       `json:"errors,omitempty"`
+	Plugins []PluginReport `json:"plugins,omitempty"`
+}
+
+func (r Report) OK() bool {
+	return len(r.Errors) == 0
+}
@@ -0,0 +1,244 @@
+package registry
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json/v2"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	minRetry = time.Second
+	maxRetry = time.Minute
+)
+
+var errResync = errors.New("registry resync")
+
+// Mirror 本地的设备档案副本, 启动时拉取快照, 之后跟随变更
+type Mirror struct {
+	URL    string
+	Client *http.Client
+	// Token 不为空时作为 Bearer token 访问档案服务
+	Token  string
+	Logger *slog.Logger
+
+	mu       sync.RWMutex
+	devices  map[string]Device
+	epoch    string
+	rev      uint64
+	syncedAt time.Time
+	online   bool
+}
+
+func NewMirror(baseURL string) *Mirror {
+	return &Mirror{
+		URL:     baseURL,
+		Client:  &http.Client{},
+		Logger:  slog.Default(),
+		devices: make(map[string]Device),
+	}
+}
+
+func (m *Mirror) Get(code string) (Device, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	d, ok := m.devices[code]
+	return d, ok
+}
+
+// Devices 当前全部设备的副本
+func (m *Mirror) Devices() []Device {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	ds := make([]Device, 0, len(m.devices))
+	for _, d := range m.devices {
+		ds = append(ds, d)
+	}
+	return ds
+}
+
+func (m *Mirror) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.devices)
+}
+
+// Check 没有拉取过快照, 或者变更流断开, 认为数据不可信
+func (m *Mirror) Check(_ context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.syncedAt.IsZero() {
+		return errors.New("registry never synced")
+	}
+	if !m.online {
+		return fmt.Errorf("registry stream offline, last synced at %s", m.syncedAt.Format(time.DateTime))
+	}
+	return nil
+}
+
+func (m *Mirror) do(req *http.Request) (*http.Response, error) {
+	if m.Token != "" {
+		req.Header.Set("Authorization", "Bearer "+m.Token)
+	}
+	return m.Client.Do(req)
+}
+
+// Load 同步拉取一次快照
+func (m *Mirror) Load(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.URL+SnapshotPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := m.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry snapshot: %s", resp.Status)
+	}
+
+	var snap Snapshot
+	if err := json.UnmarshalRead(resp.Body, &snap); err != nil {
+		return err
+	}
+
+	devices := make(map[string]Device, len(snap.Devices))
+	for _, d := range snap.Devices {
+		devices[d.Code] = d
+	}
+
+	m.mu.Lock()
+	m.devices = devices
+	m.epoch = snap.Epoch
+	m.rev = snap.Rev
+	m.syncedAt = time.Now()
+	m.mu.Unlock()
+
+	m.Logger.InfoContext(ctx, "registry snapshot", slog.String("epoch", snap.Epoch), slog.Uint64("rev", snap.Rev), slog.Int("devices", len(devices)))
+	return nil
+}
+
+// Run 跟随变更流, 断线重连, 需要时重新拉取快照, 直到 ctx 结束
+func (m *Mirror) Run(ctx context.Context) {
+	retry := minRetry
+	m.mu.RLock()
+	resync := m.syncedAt.IsZero()
+	m.mu.RUnlock()
+	for {
+		err := m.follow(ctx, resync)
+		if ctx.Err() != nil {
+			return
+		}
+
+		// 连续两次要求重新同步, 说明服务端有问题, 按错误处理
+		again := errors.Is(err, errResync)
+		if again && !resync {
+			resync = true
+			retry = minRetry
+			continue
+		}
+		resync = again
+
+		m.Logger.ErrorContext(ctx, "registry stream error", slog.Duration("retry", retry), slog.Any("error", err))
+		select {
+		case <-time.After(retry):
+			retry = min(retry*2, maxRetry)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (m *Mirror) follow(ctx context.Context, resync bool) error {
+	if resync {
+		if err := m.Load(ctx); err != nil {
+			return err
+		}
+	}
+
+	m.mu.RLock()
+	q := url.Values{}
+	q.Set("epoch", m.epoch)
+	q.Set("since", strconv.FormatUint(m.rev, 10))
+	m.mu.RUnlock()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.URL+EventsPath+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+
+	resp, err := m.do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("registry events: %s", resp.Status)
+	}
+
+	m.setOnline(true)
+	defer m.setOnline(false)
+
+	sc := bufio.NewScanner(resp.Body)
+	for sc.Scan() {
+		data, ok := bytes.CutPrefix(sc.Bytes(), []byte("data:"))
+		if !ok {
+			continue
+		}
+
+		var ev Event
+		if err := json.Unmarshal(bytes.TrimSpace(data), &ev); err != nil {
+			return err
+		}
+		if err := m.apply(ev); err != nil {
+			return err
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+	return errors.New("registry stream closed")
+}
+
+func (m *Mirror) apply(ev Event) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ev.Op == OpResync || ev.Epoch != m.epoch {
+		return errResync
+	}
+	if ev.Rev <= m.rev {
+		return nil
+	}
+	if ev.Rev != m.rev+1 {
+		return errResync
+	}
+
+	switch ev.Op {
+	case OpCreate, OpModify:
+		m.devices[ev.Device.Code] = ev.Device
+	case OpRemove:
+		delete(m.devices, ev.Device.Code)
+	}
+	m.rev = ev.Rev
+	m.syncedAt = time.Now()
+	return nil
+}
+
+func (m *Mirror) setOnline(b bool) {
+	m.mu.Lock()
+	m.online = b
+	m.mu.Unlock()
+}
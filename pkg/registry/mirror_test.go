@@ -0,0 +1,42 @@
+package registry
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMirrorApply(t *testing.T) {
+	m := NewMirror("")
+	m.epoch = "e1"
+	m.rev = 10
+	m.devices["A"] = Device{Code: "A", Project: "P1"}
+
+	for _, tc := range []struct {
+		name   string
+		ev     Event
+		resync bool
+		rev    uint64
+	}{
+		{"modify", Event{Epoch: "e1", Rev: 11, Op: OpModify, Device: Device{Code: "A", Project: "P2"}}, false, 11},
+		{"replayed", Event{Epoch: "e1", Rev: 11, Op: OpRemove, Device: Device{Code: "A"}}, false, 11},
+		{"gap", Event{Epoch: "e1", Rev: 13, Op: OpCreate, Device: Device{Code: "B"}}, true, 11},
+		{"other epoch", Event{Epoch: "e2", Rev: 12, Op: OpCreate, Device: Device{Code: "B"}}, true, 11},
+		{"resync", Event{Epoch: "e1", Rev: 12, Op: OpResync}, true, 11},
+		{"create", Event{Epoch: "e1", Rev: 12, Op: OpCreate, Device: Device{Code: "B"}}, false, 12},
+	} {
+		err := m.apply(tc.ev)
+		if got := errors.Is(err, errResync); got != tc.resync || (err != nil && !got) {
+			t.Errorf("%s: err = %v, want resync %v", tc.name, err, tc.resync)
+		}
+		if m.rev != tc.rev {
+			t.Errorf("%s: rev = %d, want %d", tc.name, m.rev, tc.rev)
+		}
+	}
+
+	if d, ok := m.Get("A"); !ok || d.Project != "P2" {
+		t.Errorf("A = %+v %v, want project P2", d, ok)
+	}
+	if _, ok := m.Get("B"); !ok {
+		t.Error("B missing")
+	}
+}
@@ -0,0 +1,50 @@
+package registry
+
+// archon 设备档案的同步协议
+// 快照: GET SnapshotPath 返回 Snapshot
+// 变更: GET EventsPath?epoch=xx&since=rev 以 SSE 推送 Event
+// epoch 是 archon 启动时生成的标识, epoch 变化或者 rev 太旧, 服务端推送 OpResync, 客户端需要重新拉取快照
+
+const (
+	SnapshotPath = "/registry/snapshot"
+	EventsPath   = "/registry/events"
+)
+
+const (
+	OpCreate = "create"
+	OpModify = "modify"
+	OpRemove = "remove"
+	OpResync = "resync"
+)
+
+type Device struct {
+	ID   string `json:"id"`
+	Code string `json:"code"`
+	Type string `json:"type"`
+	SN   string `json:"sn,omitempty"`
+	Name string `json:"name,omitempty"`
+
+	Project  string `json:"project"`
+	PosCode  string `json:"pos_code,omitempty"`
+	AreaCode string `json:"area_code,omitempty"`
+	Pcode    string `json:"pcode,omitempty"`
+
+	// Parent 上级表(总表)的 ID
+	Parent string `json:"parent,omitempty"`
+
+	Rate   int `json:"rate"`
+	Status int `json:"status"`
+}
+
+type Snapshot struct {
+	Epoch   string   `json:"epoch"`
+	Rev     uint64   `json:"rev"`
+	Devices []Device `json:"devices"`
+}
+
+type Event struct {
+	Epoch  string `json:"epoch"`
+	Rev    uint64 `json:"rev"`
+	Op     string `json:"op"`
+	Device Device `json:"device,omitzero"`
+}
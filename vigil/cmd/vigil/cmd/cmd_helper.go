@@ -2,19 +2,25 @@ package cmd
 
 import (
 	"cmp"
+	"context"
 	"fmt"
 	"log"
 	"log/slog"
+	"net/http"
+	"time"
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/spf13/viper"
 	"github.com/twiglab/h2o/clog"
 	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/auth"
 	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/pkg/registry"
 	"github.com/twiglab/h2o/vigil"
 	"github.com/twiglab/h2o/vigil/orm"
 	"github.com/twiglab/h2o/vigil/orm/ent"
 	"github.com/twiglab/h2o/vigil/tsdb"
+	"github.com/twmb/franz-go/pkg/kgo"
 )
 
 func mqttcli() mqtt.Client {
@@ -29,6 +35,65 @@ func mqttcli() mqtt.Client {
 	return cli
 }
 
+func kafkacli() *kgo.Client {
+	brokers := viper.GetStringSlice("vigil.kafka.brokers")
+	if len(brokers) == 0 {
+		log.Fatalf("no kafka brokers")
+	}
+	group := cmp.Or(viper.GetString("vigil.kafka.group"), vigil.CLIENT_ID)
+	cli, err := vigil.NewKafkaClient(group, brokers...)
+	if err != nil {
+		log.Fatal(fmt.Errorf("kafkacli err: %w", err))
+	}
+	return cli
+}
+
+func natsConsumer() *vigil.NatsConsumer {
+	url := viper.GetString("vigil.nats.url")
+	if url == "" {
+		log.Fatalf("no nats url")
+	}
+	conf := vigil.NatsConf{
+		URL:        url,
+		Durable:    cmp.Or(viper.GetString("vigil.nats.durable"), vigil.CLIENT_ID),
+		MaxDeliver: cmp.Or(viper.GetInt("vigil.nats.max_deliver"), 5),
+		NakDelay:   cmp.Or(viper.GetDuration("vigil.nats.nak_delay"), 10*time.Second),
+		AckWait:    cmp.Or(viper.GetDuration("vigil.nats.ack_wait"), 30*time.Second),
+		DeadLetter: cmp.Or(viper.GetString("vigil.nats.dead_letter"), common.NatsDeadLetter),
+	}
+	n, err := vigil.NewNatsConsumer(context.Background(), conf)
+	if err != nil {
+		log.Fatal(fmt.Errorf("nats err: %w", err))
+	}
+	return n
+}
+
+func consume(hub *vigil.Hub) {
+	use := viper.GetString("vigil.bus.use")
+	switch use {
+	case "nats":
+		log.Println("using nats")
+		if _, err := natsConsumer().Consume(context.Background(), hub); err != nil {
+			log.Fatal(fmt.Errorf("nats consume err: %w", err))
+		}
+		return
+	case "kafka":
+		log.Println("using kafka")
+		cli := kafkacli()
+		go func() {
+			if err := vigil.ConsumeKafka(context.Background(), cli, hub); err != nil {
+				log.Fatal(fmt.Errorf("kafka consume err: %w", err))
+			}
+		}()
+		return
+	}
+
+	log.Println("using mqtt")
+	mcli := mqttcli()
+	token := mcli.SubscribeMultiple(topics(), vigil.Handle(hub))
+	token.Wait()
+}
+
 func webaddr() string {
 	addr := viper.GetString("vigil.web.addr")
 	return cmp.Or(addr, ":10003")
@@ -95,3 +160,47 @@ func wallog() *wal.WAL {
 	log.Println("wal file:", logf)
 	return wal.New(wal.Conf{Filename: logf})
 }
+
+// reconcile 配置了 vigil.loss.archon.url 才启用总分表对账
+func reconcile(db *orm.DBx, logger *slog.Logger) {
+	url := viper.GetString("vigil.loss.archon.url")
+	if url == "" {
+		return
+	}
+
+	m := registry.NewMirror(url)
+	m.Token = viper.GetString("vigil.loss.archon.token")
+	m.Logger = logger
+	if err := m.Load(context.Background()); err != nil {
+		log.Fatal(fmt.Errorf("registry err: %w", err))
+	}
+	go m.Run(context.Background())
+
+	r := &vigil.Reconciler{
+		Topology: m,
+		Readings: db,
+		Store:    db,
+		Interval: cmp.Or(viper.GetDuration("vigil.loss.interval"), time.Hour),
+		Delay:    cmp.Or(viper.GetDuration("vigil.loss.delay"), 10*time.Minute),
+		High:     cmp.Or(viper.GetFloat64("vigil.loss.high"), 5),
+		Negative: cmp.Or(viper.GetFloat64("vigil.loss.negative"), 2),
+		Logger:   logger,
+	}
+	go r.Run(context.Background())
+}
+
+// authMW vigil.auth.mode 为 none 时不认证, 为空时不能启动
+func authMW() func(http.Handler) http.Handler {
+	v, err := auth.NewVerifier(context.Background(), auth.Conf{
+		Mode:          viper.GetString("vigil.auth.mode"),
+		Secret:        viper.GetString("vigil.auth.secret"),
+		Issuer:        viper.GetString("vigil.auth.issuer"),
+		ClientID:      viper.GetString("vigil.auth.client_id"),
+		RolesClaim:    viper.GetString("vigil.auth.roles_claim"),
+		ProjectsClaim: viper.GetString("vigil.auth.projects_claim"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	return auth.Middleware(v)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/twiglab/h2o/vigil"
+	"github.com/twiglab/h2o/vigil/gql"
 
 	"github.com/spf13/cobra"
 )
@@ -33,20 +34,21 @@ func run() {
 	rootLog()
 
 	cli := entcli()
+	db := dbx(cli)
 
 	hub := &vigil.Hub{
-		DB:     vigil.WithRecorder(dbx(cli)),
+		DB:     vigil.WithRecorder(db),
 		TSDB:   tdb(),
 		Logger: serverLog(),
 		WAL:    wallog(),
 	}
-	mcli := mqttcli()
-	token := mcli.SubscribeMultiple(topics(), vigil.Handle(hub))
-	token.Wait()
+	consume(hub)
 
-	//gqlc := gql.NewConf(cli)
+	reconcile(db, hub.Logger)
+
+	gqlc := gql.NewConf(cli)
 
 	mux := chi.NewMux()
-	//mux.Mount("/gql", gql.Handle(gqlc))
+	mux.Mount("/gql", gql.Handle(gqlc, authMW()))
 	http.ListenAndServe(webaddr(), mux)
 }
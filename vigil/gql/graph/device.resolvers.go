@@ -0,0 +1,47 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/vigil/gql/graph/model"
+	"github.com/twiglab/h2o/vigil/orm/ent"
+	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
+)
+
+// Readings is the resolver for the readings field.
+func (r *deviceResolver) Readings(ctx context.Context, obj *model.Device, start *time.Time, end *time.Time, limit *int) ([]*ent.NhRecord, error) {
+	q := r.Client.NhRecord.Query().
+		Where(nhrecord.DeviceCode(obj.DeviceCode)).
+		Order(ent.Desc(nhrecord.FieldDataTime)).
+		Limit(min(max(deref(limit, 100), 1), maxReadings))
+	if start != nil {
+		q.Where(nhrecord.DataTimeGTE(*start))
+	}
+	if end != nil {
+		q.Where(nhrecord.DataTimeLT(*end))
+	}
+	return q.All(ctx)
+}
+
+// LatestReading is the resolver for the latestReading field.
+func (r *deviceResolver) LatestReading(ctx context.Context, obj *model.Device) (*ent.NhRecord, error) {
+	rec, err := r.Client.NhRecord.Query().
+		Where(nhrecord.DeviceCode(obj.DeviceCode)).
+		Order(ent.Desc(nhrecord.FieldDataTime)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return nil, nil
+	}
+	return rec, err
+}
+
+// Device returns DeviceResolver implementation.
+func (r *Resolver) Device() DeviceResolver { return &deviceResolver{r} }
+
+type deviceResolver struct{ *Resolver }
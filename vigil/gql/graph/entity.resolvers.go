@@ -0,0 +1,27 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.94
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/vigil/gql/graph/model"
+)
+
+// FindManyDeviceByDeviceCodes is the resolver for the findManyDeviceByDeviceCodes field.
+func (r *entityResolver) FindManyDeviceByDeviceCodes(ctx context.Context, reps []*model.DeviceByDeviceCodesInput) ([]*model.Device, error) {
+	// 档案在 archon, 这里只需要 deviceCode
+	ds := make([]*model.Device, len(reps))
+	for i, rep := range reps {
+		ds[i] = &model.Device{DeviceCode: rep.DeviceCode}
+	}
+	return ds, nil
+}
+
+// Entity returns EntityResolver implementation.
+func (r *Resolver) Entity() EntityResolver { return &entityResolver{r} }
+
+type entityResolver struct{ *Resolver }
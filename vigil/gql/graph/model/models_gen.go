@@ -6,6 +6,18 @@ import (
 	"github.com/twiglab/h2o/vigil/orm/ent"
 )
 
+type Device struct {
+	DeviceCode    string          `json:"deviceCode"`
+	Readings      []*ent.NhRecord `json:"readings"`
+	LatestReading *ent.NhRecord   `json:"latestReading,omitempty"`
+}
+
+func (Device) IsEntity() {}
+
+type DeviceByDeviceCodesInput struct {
+	DeviceCode string `json:"DeviceCode"`
+}
+
 type NhRecordBeforeIn struct {
 	DeviceCode string `json:"deviceCode"`
 	DataTs     string `json:"dataTs"`
@@ -10,3 +10,13 @@ import "github.com/twiglab/h2o/vigil/orm/ent"
 type Resolver struct {
 	Client *ent.Client
 }
+
+// 一次最多返回的读数
+const maxReadings = 1000
+
+func deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
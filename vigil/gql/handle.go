@@ -1,6 +1,8 @@
 package gql
 
 import (
+	"net/http"
+
 	"github.com/go-chi/chi/v5"
 
 	"github.com/99designs/gqlgen/graphql/handler"
@@ -14,7 +16,8 @@ import (
 	"github.com/twiglab/h2o/vigil/orm/ent"
 )
 
-func Handle(conf graph.Config) *chi.Mux {
+// Handle mws 只作用于 /query, 如认证
+func Handle(conf graph.Config, mws ...func(http.Handler) http.Handler) *chi.Mux {
 	srv := handler.New(graph.NewExecutableSchema(conf))
 
 	srv.AddTransport(transport.Options{})
@@ -30,7 +33,7 @@ func Handle(conf graph.Config) *chi.Mux {
 	r := chi.NewMux()
 
 	r.Handle("/", playground.ApolloSandboxHandler("GraphQL playground", "/gql/query"))
-	r.Handle("/query", srv)
+	r.With(mws...).Handle("/query", srv)
 
 	return r
 }
@@ -2,11 +2,23 @@ package vigil
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/twiglab/h2o/clog/wal"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/vigil/orm/ent"
 )
 
+// ErrDecode 数据无法解析, 重试也没有意义
+var ErrDecode = errors.New("decode error")
+
+// Retryable 解析错误, 重复数据(已经入库), 重试也不会成功
+func Retryable(err error) bool {
+	return !errors.Is(err, ErrDecode) && !ent.IsConstraintError(err)
+}
+
 type Hub struct {
 	TSDB Recorder
 	DB   Recorder
@@ -43,3 +55,27 @@ func (h *Hub) HandleElecty(ctx context.Context, data ElectricityMeter) error {
 	}
 	return nil
 }
+
+// HandlePayload 按设备类型解析并处理, 与具体的消息总线无关
+func (h *Hub) HandlePayload(ctx context.Context, typ string, payload []byte) error {
+	switch typ {
+	case common.WATER:
+		var wm WaterMeter
+		if err := wm.UnmarshalBinary(payload); err != nil {
+			return fmt.Errorf("%w: water: %w", ErrDecode, err)
+		}
+		wm.setup()
+		return h.HandleWater(ctx, wm)
+
+	case common.ELECTRICITY:
+		var em ElectricityMeter
+		if err := em.UnmarshalBinary(payload); err != nil {
+			return fmt.Errorf("%w: electy: %w", ErrDecode, err)
+		}
+		em.setup()
+		return h.HandleElecty(ctx, em)
+
+	case common.GAS:
+	}
+	return nil
+}
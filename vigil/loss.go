@@ -0,0 +1,213 @@
+package vigil
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+// 对账结果
+const (
+	LossOK         = "ok"
+	LossHigh       = "high"       // 损耗率超过上限, 线损或者有未计量的用电/用水
+	LossNegative   = "negative"   // 分表之和明显大于总表, 一般是表计故障或者拓扑配置错误
+	LossIncomplete = "incomplete" // 有表缺少读数, 不判断
+)
+
+type Readings interface {
+	// Reading at 时刻(含)之前最后一次表显
+	Reading(ctx context.Context, code string, at time.Time) (value int64, ok bool, err error)
+}
+
+type LossStore interface {
+	SaveLoss(ctx context.Context, r LossReport) error
+}
+
+type Topology interface {
+	Devices() []registry.Device
+}
+
+type LossReport struct {
+	Code    string
+	Type    string
+	Project string
+
+	Start time.Time
+	End   time.Time
+
+	ParentUsage   int64
+	ChildrenUsage int64
+	Children      int
+	LossPct       float64
+
+	Missing []string
+	State   string
+}
+
+// Reconciler 按周期比较总表用量和分表用量之和
+// 用量 = (周期结束时表显 - 周期开始时表显) * 倍率
+type Reconciler struct {
+	Topology Topology
+	Readings Readings
+	Store    LossStore
+
+	Interval time.Duration
+	// Delay 周期结束后等待数据到齐的时间
+	Delay time.Duration
+
+	// High 损耗率上限 %
+	High float64
+	// Negative 允许分表之和超过总表的比例 %, 超过则告警
+	Negative float64
+
+	Logger *slog.Logger
+}
+
+// Run 在每个周期结束 Delay 之后对上一个周期对账, 周期按 Interval 对齐
+func (r *Reconciler) Run(ctx context.Context) {
+	for {
+		at, start, end := r.next(time.Now())
+
+		select {
+		case <-time.After(time.Until(at)):
+		case <-ctx.Done():
+			return
+		}
+
+		if _, err := r.Reconcile(ctx, start, end); err != nil {
+			r.Logger.ErrorContext(ctx, "reconcile error", slog.Time("start", start), slog.Any("error", err))
+		}
+	}
+}
+
+// next now 之后下一次对账的时间 at 和对账的周期 [start, end)
+func (r *Reconciler) next(now time.Time) (at, start, end time.Time) {
+	start = now.Add(-r.Delay).Truncate(r.Interval)
+	end = start.Add(r.Interval)
+	return end.Add(r.Delay), start, end
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, start, end time.Time) ([]LossReport, error) {
+	devices := r.Topology.Devices()
+
+	byID := make(map[string]registry.Device, len(devices))
+	for _, d := range devices {
+		byID[d.ID] = d
+	}
+	children := make(map[string][]registry.Device)
+	for _, d := range devices {
+		if _, ok := byID[d.Parent]; ok {
+			children[d.Parent] = append(children[d.Parent], d)
+		}
+	}
+
+	// 单个总表出错不影响其他总表
+	var errs []error
+	reports := make([]LossReport, 0, len(children))
+	for id, cs := range children {
+		rep, err := r.reconcile(ctx, byID[id], cs, start, end)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", byID[id].Code, err))
+			continue
+		}
+		if err := r.Store.SaveLoss(ctx, rep); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", rep.Code, err))
+		}
+		r.alarm(ctx, rep)
+		reports = append(reports, rep)
+	}
+	slices.SortFunc(reports, func(a, b LossReport) int { return cmp.Compare(a.Code, b.Code) })
+	return reports, errors.Join(errs...)
+}
+
+func (r *Reconciler) reconcile(ctx context.Context, parent registry.Device, children []registry.Device, start, end time.Time) (LossReport, error) {
+	rep := LossReport{
+		Code:     parent.Code,
+		Type:     parent.Type,
+		Project:  parent.Project,
+		Start:    start,
+		End:      end,
+		Children: len(children),
+	}
+
+	var err error
+	var ok bool
+	if rep.ParentUsage, ok, err = r.usage(ctx, parent, start, end); err != nil {
+		return rep, err
+	} else if !ok {
+		rep.Missing = append(rep.Missing, parent.Code)
+	}
+
+	for _, c := range children {
+		u, ok, err := r.usage(ctx, c, start, end)
+		if err != nil {
+			return rep, err
+		}
+		if !ok {
+			rep.Missing = append(rep.Missing, c.Code)
+			continue
+		}
+		rep.ChildrenUsage += u
+	}
+
+	if rep.ParentUsage != 0 {
+		rep.LossPct = float64(rep.ParentUsage-rep.ChildrenUsage) / float64(rep.ParentUsage) * 100
+	}
+
+	switch {
+	case len(rep.Missing) > 0:
+		rep.State = LossIncomplete
+	case rep.ParentUsage == 0 && rep.ChildrenUsage == 0:
+		rep.State = LossOK
+	case rep.ParentUsage == 0 || rep.LossPct < -r.Negative:
+		rep.State = LossNegative
+	case rep.LossPct > r.High:
+		rep.State = LossHigh
+	default:
+		rep.State = LossOK
+	}
+	return rep, nil
+}
+
+func (r *Reconciler) usage(ctx context.Context, d registry.Device, start, end time.Time) (int64, bool, error) {
+	v0, ok0, err := r.Readings.Reading(ctx, d.Code, start)
+	if err != nil || !ok0 {
+		return 0, false, err
+	}
+	v1, ok1, err := r.Readings.Reading(ctx, d.Code, end)
+	if err != nil || !ok1 {
+		return 0, false, err
+	}
+	// 换表或者表显回绕, 这个周期的用量算不出来, 按缺少读数处理
+	if v1 < v0 {
+		return 0, false, nil
+	}
+	return (v1 - v0) * int64(max(d.Rate, 1)), true, nil
+}
+
+func (r *Reconciler) alarm(ctx context.Context, rep LossReport) {
+	attrs := []any{
+		slog.String("code", rep.Code),
+		slog.String("project", rep.Project),
+		slog.Time("start", rep.Start),
+		slog.Time("end", rep.End),
+		slog.Int64("parent", rep.ParentUsage),
+		slog.Int64("children", rep.ChildrenUsage),
+		slog.Float64("loss_pct", rep.LossPct),
+		slog.String("state", rep.State),
+	}
+	switch rep.State {
+	case LossHigh, LossNegative:
+		r.Logger.WarnContext(ctx, "loss alarm", attrs...)
+	case LossIncomplete:
+		r.Logger.InfoContext(ctx, "loss incomplete", append(attrs, slog.Any("missing", rep.Missing))...)
+	default:
+		r.Logger.DebugContext(ctx, "loss", attrs...)
+	}
+}
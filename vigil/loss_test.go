@@ -0,0 +1,137 @@
+package vigil
+
+import (
+	"context"
+	"log/slog"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/twiglab/h2o/pkg/registry"
+)
+
+type fakeTopology []registry.Device
+
+func (f fakeTopology) Devices() []registry.Device { return f }
+
+// fakeReadings 每块表周期开始和结束时的表显, 没有的表缺少读数
+type fakeReadings struct {
+	start  time.Time
+	values map[string][2]int64
+}
+
+func (f fakeReadings) Reading(_ context.Context, code string, at time.Time) (int64, bool, error) {
+	v, ok := f.values[code]
+	if !ok {
+		return 0, false, nil
+	}
+	if at.Equal(f.start) {
+		return v[0], true, nil
+	}
+	return v[1], true, nil
+}
+
+type fakeLossStore []LossReport
+
+func (f *fakeLossStore) SaveLoss(_ context.Context, r LossReport) error {
+	*f = append(*f, r)
+	return nil
+}
+
+func TestReconcileState(t *testing.T) {
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		parent   [2]int64
+		children map[string][2]int64
+		rate     int
+
+		state   string
+		usage   [2]int64
+		lossPct float64
+		missing []string
+	}{
+		{"正常", [2]int64{1000, 1100}, map[string][2]int64{"C1": {0, 50}, "C2": {0, 45}}, 0,
+			LossOK, [2]int64{100, 95}, 5, nil},
+		{"损耗过高", [2]int64{1000, 1100}, map[string][2]int64{"C1": {0, 50}, "C2": {0, 30}}, 0,
+			LossHigh, [2]int64{100, 80}, 20, nil},
+		{"分表之和过大", [2]int64{1000, 1100}, map[string][2]int64{"C1": {0, 60}, "C2": {0, 50}}, 0,
+			LossNegative, [2]int64{100, 110}, -10, nil},
+		{"允许的负损耗", [2]int64{1000, 1100}, map[string][2]int64{"C1": {0, 51}, "C2": {0, 50}}, 0,
+			LossOK, [2]int64{100, 101}, -1, nil},
+		{"缺少读数", [2]int64{1000, 1100}, map[string][2]int64{"C1": {0, 50}}, 0,
+			LossIncomplete, [2]int64{100, 50}, 50, []string{"C2"}},
+		{"总表没有用量", [2]int64{1000, 1000}, map[string][2]int64{"C1": {0, 10}, "C2": {0, 0}}, 0,
+			LossNegative, [2]int64{0, 10}, 0, nil},
+		{"都没有用量", [2]int64{1000, 1000}, map[string][2]int64{"C1": {0, 0}, "C2": {0, 0}}, 0,
+			LossOK, [2]int64{0, 0}, 0, nil},
+		// 换表后表显变小, 不能算成负用量
+		{"换表", [2]int64{1000, 1100}, map[string][2]int64{"C1": {900, 10}, "C2": {0, 45}}, 0,
+			LossIncomplete, [2]int64{100, 45}, 55, []string{"C1"}},
+		{"总表倍率", [2]int64{1000, 1001}, map[string][2]int64{"C1": {0, 40}, "C2": {0, 36}}, 80,
+			LossOK, [2]int64{80, 76}, 5, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string][2]int64{"P": tt.parent}
+			for code, v := range tt.children {
+				values[code] = v
+			}
+			var store fakeLossStore
+			r := &Reconciler{
+				Topology: fakeTopology{
+					{ID: "1", Code: "P", Rate: tt.rate},
+					{ID: "2", Code: "C1", Parent: "1"},
+					{ID: "3", Code: "C2", Parent: "1"},
+					{ID: "4", Code: "X", Parent: "99"}, // 上级不在档案里, 不参与对账
+				},
+				Readings: fakeReadings{start: start, values: values},
+				Store:    &store,
+				High:     10,
+				Negative: 2,
+				Logger:   slog.New(slog.DiscardHandler),
+			}
+
+			reps, err := r.Reconcile(context.Background(), start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(reps) != 1 || len(store) != 1 {
+				t.Fatalf("got %d reports, %d saved, want 1", len(reps), len(store))
+			}
+			rep := reps[0]
+			if rep.State != tt.state || rep.ParentUsage != tt.usage[0] || rep.ChildrenUsage != tt.usage[1] ||
+				math.Abs(rep.LossPct-tt.lossPct) > 1e-9 || rep.Children != 2 || !slices.Equal(rep.Missing, tt.missing) {
+				t.Fatalf("got %s %d/%d %.1f%% missing %v, want %s %d/%d %.1f%% missing %v",
+					rep.State, rep.ParentUsage, rep.ChildrenUsage, rep.LossPct, rep.Missing,
+					tt.state, tt.usage[0], tt.usage[1], tt.lossPct, tt.missing)
+			}
+		})
+	}
+}
+
+func TestReconcilerNext(t *testing.T) {
+	r := &Reconciler{Interval: time.Hour, Delay: 5 * time.Minute}
+	at := func(h, m int) time.Time { return time.Date(2026, 10, 1, h, m, 0, 0, time.UTC) }
+
+	tests := []struct {
+		now              time.Time
+		fire, start, end time.Time
+	}{
+		{at(10, 3), at(10, 5), at(9, 0), at(10, 0)},
+		// 上一个周期刚对完账, 等下一个周期
+		{at(10, 5), at(11, 5), at(10, 0), at(11, 0)},
+		{at(10, 30), at(11, 5), at(10, 0), at(11, 0)},
+		{at(0, 0), at(0, 5), at(23, 0).AddDate(0, 0, -1), at(0, 0)},
+	}
+	for _, tt := range tests {
+		fire, start, end := r.next(tt.now)
+		if !fire.Equal(tt.fire) || !start.Equal(tt.start) || !end.Equal(tt.end) {
+			t.Fatalf("next(%s) = %s [%s, %s), want %s [%s, %s)",
+				tt.now.Format(time.TimeOnly), fire, start, end, tt.fire, tt.start, tt.end)
+		}
+	}
+}
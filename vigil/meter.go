@@ -1,26 +1,20 @@
 package vigil
 
 import (
-	"encoding/json/v2"
-
 	"github.com/montanaflynn/stats"
 	"github.com/twiglab/h2o/pkg/common"
 )
 
-type Meter struct {
-	common.Device
-	Pos common.Pos `json:"pos,omitzero"`
-}
+type Meter = common.Meter
 
 type ElectricityMeter struct {
-	Meter
-	Data common.Electricity `json:"data,omitzero"`
+	common.ElectricityMeter
 
 	STD float64
 }
 
 func (d *ElectricityMeter) UnmarshalBinary(data []byte) error {
-	return json.Unmarshal(data, d)
+	return common.Unmarshal(data, &d.ElectricityMeter)
 }
 
 func (d *ElectricityMeter) setup() {
@@ -29,12 +23,11 @@ func (d *ElectricityMeter) setup() {
 }
 
 type WaterMeter struct {
-	Meter
-	Data common.Water `json:"data,omitzero"`
+	common.WaterMeter
 }
 
 func (d *WaterMeter) UnmarshalBinary(data []byte) error {
-	return json.Unmarshal(data, d)
+	return common.Unmarshal(data, &d.WaterMeter)
 }
 
 func (d *WaterMeter) setup() {
@@ -23,32 +23,9 @@ func Handle(s *Hub) mqtt.MessageHandler {
 
 		defer msg.Ack()
 
-		switch common.TopicType(msg.Topic()) {
-		case common.WaterTopic:
-			var wm WaterMeter
-			if err := wm.UnmarshalBinary(msg.Payload()); err != nil {
-				s.Logger.ErrorContext(ctx, "unmarshal water error", slog.Any("err", err))
-				return
-			}
-			wm.setup()
-			if err := s.HandleWater(ctx, wm); err != nil {
-				s.Logger.ErrorContext(ctx, "handle water error", slog.Any("err", err))
-				return
-			}
-
-		case common.ElectricityTopic:
-			var em ElectricityMeter
-			if err := em.UnmarshalBinary(msg.Payload()); err != nil {
-				s.Logger.ErrorContext(ctx, "unmarshal electy error", slog.Any("err", err))
-				return
-			}
-			em.setup()
-			if err := s.HandleElecty(ctx, em); err != nil {
-				s.Logger.ErrorContext(ctx, "handle electy error", slog.Any("err", err))
-				return
-			}
-
-		case common.GasTopic:
+		_, _, typ := common.TopicPart(msg.Topic())
+		if err := s.HandlePayload(ctx, typ, msg.Payload()); err != nil {
+			s.Logger.ErrorContext(ctx, "handle payload error", slog.String("topic", msg.Topic()), slog.Any("err", err))
 		}
 	}
 }
@@ -0,0 +1,113 @@
+package vigil
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/vigil/orm/ent"
+)
+
+// fakeMsg 记录 ack/nak/term, 只实现 handle 用到的方法
+type fakeMsg struct {
+	jetstream.Msg
+	subject   string
+	data      []byte
+	delivered uint64
+
+	acked, termed bool
+	nakDelay      time.Duration
+}
+
+func (m *fakeMsg) Subject() string { return m.subject }
+func (m *fakeMsg) Data() []byte    { return m.data }
+func (m *fakeMsg) Metadata() (*jetstream.MsgMetadata, error) {
+	return &jetstream.MsgMetadata{NumDelivered: m.delivered}, nil
+}
+func (m *fakeMsg) Ack() error  { m.acked = true; return nil }
+func (m *fakeMsg) Term() error { m.termed = true; return nil }
+func (m *fakeMsg) NakWithDelay(d time.Duration) error {
+	m.nakDelay = d
+	return nil
+}
+
+type fakeJetStream struct {
+	jetstream.JetStream
+	err  error
+	msgs []*nats.Msg
+}
+
+func (j *fakeJetStream) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	if j.err != nil {
+		return nil, j.err
+	}
+	j.msgs = append(j.msgs, msg)
+	return &jetstream.PubAck{}, nil
+}
+
+type fakeRecorder struct {
+	err error
+}
+
+func (r fakeRecorder) TabbElecty(ctx context.Context, data ElectricityMeter) error { return r.err }
+func (r fakeRecorder) TabbWater(ctx context.Context, data WaterMeter) error        { return r.err }
+
+func TestNatsHandle(t *testing.T) {
+	var wm common.WaterMeter
+	wm.Code, wm.Type = "PT-1", common.WATER
+	payload, err := wm.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	errDown := errors.New("db down")
+
+	tests := []struct {
+		name      string
+		data      []byte
+		dbErr     error
+		pubErr    error
+		delivered uint64
+
+		acked, termed, dead bool
+		nak                 bool
+	}{
+		{"成功", payload, nil, nil, 1, true, false, false, false},
+		{"解析失败转死信", []byte("{"), nil, nil, 1, false, true, true, false},
+		{"重复数据丢弃", payload, &ent.ConstraintError{}, nil, 1, true, false, false, false},
+		{"可重试延时重投", payload, errDown, nil, 2, false, false, false, true},
+		{"超过最大投递次数转死信", payload, errDown, nil, 3, false, true, true, false},
+		{"死信发送失败重投", payload, errDown, errDown, 3, false, false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			js := &fakeJetStream{err: tt.pubErr}
+			n := &NatsConsumer{js: js, conf: NatsConf{MaxDeliver: 3, NakDelay: time.Second, DeadLetter: common.NatsDeadLetter}}
+			h := &Hub{TSDB: fakeRecorder{}, DB: fakeRecorder{err: tt.dbErr}, Logger: slog.New(slog.DiscardHandler)}
+			msg := &fakeMsg{subject: "h2o.PT-1.W", data: tt.data, delivered: tt.delivered}
+
+			n.handle(context.Background(), h, msg)
+
+			if msg.acked != tt.acked || msg.termed != tt.termed || (msg.nakDelay > 0) != tt.nak {
+				t.Fatalf("ack %v term %v nak %v, want ack %v term %v nak %v",
+					msg.acked, msg.termed, msg.nakDelay, tt.acked, tt.termed, tt.nak)
+			}
+			if tt.nak && msg.nakDelay != time.Second {
+				t.Fatalf("nak delay = %v, want 1s", msg.nakDelay)
+			}
+			if (len(js.msgs) == 1) != tt.dead {
+				t.Fatalf("dead letters = %d, want %v", len(js.msgs), tt.dead)
+			}
+			if tt.dead {
+				dl := js.msgs[0]
+				if dl.Subject != "dlq.h2o.h2o.PT-1.W" || dl.Header.Get(HeaderSubject) != msg.subject || dl.Header.Get(HeaderError) == "" {
+					t.Fatalf("dead letter %s %v", dl.Subject, dl.Header)
+				}
+			}
+		})
+	}
+}
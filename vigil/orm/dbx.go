@@ -2,9 +2,13 @@ package orm
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/twiglab/h2o/vigil"
 	"github.com/twiglab/h2o/vigil/orm/ent"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
 )
 
 type DBx struct {
@@ -42,3 +46,36 @@ func (d *DBx) TabbWater(ctx context.Context, data vigil.WaterMeter) error {
 	cr.SetOwner(data.Pos.Owner)
 	return cr.Exec(ctx)
 }
+
+func (d *DBx) Reading(ctx context.Context, code string, at time.Time) (int64, bool, error) {
+	r, err := d.Client.NhRecord.Query().
+		Where(nhrecord.DeviceCodeEQ(code), nhrecord.DataTimeLTE(at)).
+		Order(ent.Desc(nhrecord.FieldDataTime)).
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return r.DataValue, true, nil
+}
+
+// SaveLoss 同一总表同一周期重复对账时覆盖
+func (d *DBx) SaveLoss(ctx context.Context, r vigil.LossReport) error {
+	return d.Client.LossRecord.Create().
+		SetDeviceCode(r.Code).
+		SetDeviceType(r.Type).
+		SetProject(r.Project).
+		SetStartTime(r.Start).
+		SetEndTime(r.End).
+		SetParentUsage(r.ParentUsage).
+		SetChildrenUsage(r.ChildrenUsage).
+		SetChildren(r.Children).
+		SetLossPct(r.LossPct).
+		SetMissing(strings.Join(r.Missing, ",")).
+		SetState(r.State).
+		OnConflictColumns(lossrecord.FieldDeviceCode, lossrecord.FieldStartTime).
+		UpdateNewValues().
+		Exec(ctx)
+}
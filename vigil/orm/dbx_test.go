@@ -0,0 +1,62 @@
+package orm
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/twiglab/h2o/vigil"
+	"github.com/twiglab/h2o/vigil/orm/ent"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+)
+
+func TestSaveLossOverwrites(t *testing.T) {
+	ctx := context.Background()
+	cli, err := OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+	d := &DBx{Client: cli}
+
+	start := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	r := vigil.LossReport{
+		Code: "PT-1", Type: "E", Project: "P1",
+		Start: start, End: start.Add(time.Hour),
+		ParentUsage: 100, ChildrenUsage: 60, Children: 3, LossPct: 40,
+		Missing: []string{"PT-1-1"},
+		State:   "incomplete",
+	}
+	if err := d.SaveLoss(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	// 补齐读数后重新对账, 同一周期覆盖上一次的结果
+	r.ChildrenUsage, r.LossPct, r.Missing, r.State = 95, 5, nil, "ok"
+	if err := d.SaveLoss(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+	// 缺少读数的设备很多时不能截断
+	r.Start, r.End = r.End, r.End.Add(time.Hour)
+	r.Missing = strings.Split(strings.Repeat("PT-1-0000,", 200), ",")
+	if err := d.SaveLoss(ctx, r); err != nil {
+		t.Fatal(err)
+	}
+
+	rs := cli.LossRecord.Query().Order(ent.Asc(lossrecord.FieldStartTime)).AllX(ctx)
+	if len(rs) != 2 {
+		t.Fatalf("%d records, want 2", len(rs))
+	}
+	got := rs[0]
+	if got.ChildrenUsage != 95 || got.LossPct != 5 || got.Missing != "" || got.State != "ok" {
+		t.Errorf("record not overwritten: %+v", got)
+	}
+	if n := len(strings.Split(rs[1].Missing, ",")); n != len(r.Missing) {
+		t.Errorf("missing has %d devices, want %d", n, len(r.Missing))
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
 	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
 
 	stdsql "database/sql"
@@ -24,6 +25,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// LossRecord is the client for interacting with the LossRecord builders.
+	LossRecord *LossRecordClient
 	// NhRecord is the client for interacting with the NhRecord builders.
 	NhRecord *NhRecordClient
 }
@@ -37,6 +40,7 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.LossRecord = NewLossRecordClient(c.config)
 	c.NhRecord = NewNhRecordClient(c.config)
 }
 
@@ -128,9 +132,10 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		NhRecord: NewNhRecordClient(cfg),
+		ctx:        ctx,
+		config:     cfg,
+		LossRecord: NewLossRecordClient(cfg),
+		NhRecord:   NewNhRecordClient(cfg),
 	}, nil
 }
 
@@ -148,16 +153,17 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		NhRecord: NewNhRecordClient(cfg),
+		ctx:        ctx,
+		config:     cfg,
+		LossRecord: NewLossRecordClient(cfg),
+		NhRecord:   NewNhRecordClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		NhRecord.
+//		LossRecord.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -179,18 +185,22 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.LossRecord.Use(hooks...)
 	c.NhRecord.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.LossRecord.Intercept(interceptors...)
 	c.NhRecord.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *LossRecordMutation:
+		return c.LossRecord.mutate(ctx, m)
 	case *NhRecordMutation:
 		return c.NhRecord.mutate(ctx, m)
 	default:
@@ -198,6 +208,140 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// LossRecordClient is a client for the LossRecord schema.
+type LossRecordClient struct {
+	config
+}
+
+// NewLossRecordClient returns a client for the LossRecord from the given config.
+func NewLossRecordClient(c config) *LossRecordClient {
+	return &LossRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `lossrecord.Hooks(f(g(h())))`.
+func (c *LossRecordClient) Use(hooks ...Hook) {
+	c.hooks.LossRecord = append(c.hooks.LossRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `lossrecord.Intercept(f(g(h())))`.
+func (c *LossRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.LossRecord = append(c.inters.LossRecord, interceptors...)
+}
+
+// Create returns a builder for creating a LossRecord entity.
+func (c *LossRecordClient) Create() *LossRecordCreate {
+	mutation := newLossRecordMutation(c.config, OpCreate)
+	return &LossRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of LossRecord entities.
+func (c *LossRecordClient) CreateBulk(builders ...*LossRecordCreate) *LossRecordCreateBulk {
+	return &LossRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *LossRecordClient) MapCreateBulk(slice any, setFunc func(*LossRecordCreate, int)) *LossRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &LossRecordCreateBulk{err: fmt.Errorf("calling to LossRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*LossRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &LossRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for LossRecord.
+func (c *LossRecordClient) Update() *LossRecordUpdate {
+	mutation := newLossRecordMutation(c.config, OpUpdate)
+	return &LossRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *LossRecordClient) UpdateOne(_m *LossRecord) *LossRecordUpdateOne {
+	mutation := newLossRecordMutation(c.config, OpUpdateOne, withLossRecord(_m))
+	return &LossRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *LossRecordClient) UpdateOneID(id string) *LossRecordUpdateOne {
+	mutation := newLossRecordMutation(c.config, OpUpdateOne, withLossRecordID(id))
+	return &LossRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for LossRecord.
+func (c *LossRecordClient) Delete() *LossRecordDelete {
+	mutation := newLossRecordMutation(c.config, OpDelete)
+	return &LossRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *LossRecordClient) DeleteOne(_m *LossRecord) *LossRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *LossRecordClient) DeleteOneID(id string) *LossRecordDeleteOne {
+	builder := c.Delete().Where(lossrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &LossRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for LossRecord.
+func (c *LossRecordClient) Query() *LossRecordQuery {
+	return &LossRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeLossRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a LossRecord entity by its id.
+func (c *LossRecordClient) Get(ctx context.Context, id string) (*LossRecord, error) {
+	return c.Query().Where(lossrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *LossRecordClient) GetX(ctx context.Context, id string) *LossRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *LossRecordClient) Hooks() []Hook {
+	hooks := c.hooks.LossRecord
+	return append(hooks[:len(hooks):len(hooks)], lossrecord.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *LossRecordClient) Interceptors() []Interceptor {
+	return c.inters.LossRecord
+}
+
+func (c *LossRecordClient) mutate(ctx context.Context, m *LossRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&LossRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&LossRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&LossRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&LossRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown LossRecord mutation op: %q", m.Op())
+	}
+}
+
 // NhRecordClient is a client for the NhRecord schema.
 type NhRecordClient struct {
 	config
@@ -308,7 +452,8 @@ func (c *NhRecordClient) GetX(ctx context.Context, id string) *NhRecord {
 
 // Hooks returns the client hooks.
 func (c *NhRecordClient) Hooks() []Hook {
-	return c.hooks.NhRecord
+	hooks := c.hooks.NhRecord
+	return append(hooks[:len(hooks):len(hooks)], nhrecord.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -334,10 +479,10 @@ func (c *NhRecordClient) mutate(ctx context.Context, m *NhRecordMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		NhRecord []ent.Hook
+		LossRecord, NhRecord []ent.Hook
 	}
 	inters struct {
-		NhRecord []ent.Interceptor
+		LossRecord, NhRecord []ent.Interceptor
 	}
 )
 
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
 	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
 )
 
@@ -73,7 +74,8 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			nhrecord.Table: nhrecord.ValidColumn,
+			lossrecord.Table: lossrecord.ValidColumn,
+			nhrecord.Table:   nhrecord.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -9,6 +9,18 @@ import (
 	"github.com/twiglab/h2o/vigil/orm/ent"
 )
 
+// The LossRecordFunc type is an adapter to allow the use of ordinary
+// function as LossRecord mutator.
+type LossRecordFunc func(context.Context, *ent.LossRecordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f LossRecordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.LossRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.LossRecordMutation", m)
+}
+
 // The NhRecordFunc type is an adapter to allow the use of ordinary
 // function as NhRecord mutator.
 type NhRecordFunc func(context.Context, *ent.NhRecordMutation) (ent.Value, error)
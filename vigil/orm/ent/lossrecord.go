@@ -0,0 +1,240 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+)
+
+// LossRecord is the model entity for the LossRecord schema.
+type LossRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID string `json:"id,omitempty"`
+	// CreateTime holds the value of the "create_time" field.
+	CreateTime time.Time `json:"create_time,omitempty"`
+	// UpdateTime holds the value of the "update_time" field.
+	UpdateTime time.Time `json:"update_time,omitempty"`
+	// 总表设备号
+	DeviceCode string `json:"device_code,omitempty"`
+	// 设备类型
+	DeviceType string `json:"device_type,omitempty"`
+	// 项目编号
+	Project string `json:"project,omitempty"`
+	// 周期开始
+	StartTime time.Time `json:"start_time,omitempty"`
+	// 周期结束
+	EndTime time.Time `json:"end_time,omitempty"`
+	// 总表用量
+	ParentUsage int64 `json:"parent_usage,omitempty"`
+	// 分表用量之和
+	ChildrenUsage int64 `json:"children_usage,omitempty"`
+	// 分表个数
+	Children int `json:"children,omitempty"`
+	// 损耗率 %, 负数表示分表之和大于总表
+	LossPct float64 `json:"loss_pct,omitempty"`
+	// 缺少读数的设备, 逗号分隔
+	Missing string `json:"missing,omitempty"`
+	// ok, high, negative, incomplete
+	State        string `json:"state,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*LossRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case lossrecord.FieldLossPct:
+			values[i] = new(sql.NullFloat64)
+		case lossrecord.FieldParentUsage, lossrecord.FieldChildrenUsage, lossrecord.FieldChildren:
+			values[i] = new(sql.NullInt64)
+		case lossrecord.FieldID, lossrecord.FieldDeviceCode, lossrecord.FieldDeviceType, lossrecord.FieldProject, lossrecord.FieldMissing, lossrecord.FieldState:
+			values[i] = new(sql.NullString)
+		case lossrecord.FieldCreateTime, lossrecord.FieldUpdateTime, lossrecord.FieldStartTime, lossrecord.FieldEndTime:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the LossRecord fields.
+func (_m *LossRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case lossrecord.FieldID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value.Valid {
+				_m.ID = value.String
+			}
+		case lossrecord.FieldCreateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field create_time", values[i])
+			} else if value.Valid {
+				_m.CreateTime = value.Time
+			}
+		case lossrecord.FieldUpdateTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field update_time", values[i])
+			} else if value.Valid {
+				_m.UpdateTime = value.Time
+			}
+		case lossrecord.FieldDeviceCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_code", values[i])
+			} else if value.Valid {
+				_m.DeviceCode = value.String
+			}
+		case lossrecord.FieldDeviceType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field device_type", values[i])
+			} else if value.Valid {
+				_m.DeviceType = value.String
+			}
+		case lossrecord.FieldProject:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field project", values[i])
+			} else if value.Valid {
+				_m.Project = value.String
+			}
+		case lossrecord.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case lossrecord.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case lossrecord.FieldParentUsage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field parent_usage", values[i])
+			} else if value.Valid {
+				_m.ParentUsage = value.Int64
+			}
+		case lossrecord.FieldChildrenUsage:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field children_usage", values[i])
+			} else if value.Valid {
+				_m.ChildrenUsage = value.Int64
+			}
+		case lossrecord.FieldChildren:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field children", values[i])
+			} else if value.Valid {
+				_m.Children = int(value.Int64)
+			}
+		case lossrecord.FieldLossPct:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field loss_pct", values[i])
+			} else if value.Valid {
+				_m.LossPct = value.Float64
+			}
+		case lossrecord.FieldMissing:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field missing", values[i])
+			} else if value.Valid {
+				_m.Missing = value.String
+			}
+		case lossrecord.FieldState:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field state", values[i])
+			} else if value.Valid {
+				_m.State = value.String
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the LossRecord.
+// This includes values selected through modifiers, order, etc.
+func (_m *LossRecord) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this LossRecord.
+// Note that you need to call LossRecord.Unwrap() before calling this method if this LossRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *LossRecord) Update() *LossRecordUpdateOne {
+	return NewLossRecordClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the LossRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *LossRecord) Unwrap() *LossRecord {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: LossRecord is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *LossRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("LossRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("create_time=")
+	builder.WriteString(_m.CreateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("update_time=")
+	builder.WriteString(_m.UpdateTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("device_code=")
+	builder.WriteString(_m.DeviceCode)
+	builder.WriteString(", ")
+	builder.WriteString("device_type=")
+	builder.WriteString(_m.DeviceType)
+	builder.WriteString(", ")
+	builder.WriteString("project=")
+	builder.WriteString(_m.Project)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("parent_usage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ParentUsage))
+	builder.WriteString(", ")
+	builder.WriteString("children_usage=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChildrenUsage))
+	builder.WriteString(", ")
+	builder.WriteString("children=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Children))
+	builder.WriteString(", ")
+	builder.WriteString("loss_pct=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LossPct))
+	builder.WriteString(", ")
+	builder.WriteString("missing=")
+	builder.WriteString(_m.Missing)
+	builder.WriteString(", ")
+	builder.WriteString("state=")
+	builder.WriteString(_m.State)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// LossRecords is a parsable slice of LossRecord.
+type LossRecords []*LossRecord
@@ -0,0 +1,182 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lossrecord
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the lossrecord type in the database.
+	Label = "loss_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreateTime holds the string denoting the create_time field in the database.
+	FieldCreateTime = "create_time"
+	// FieldUpdateTime holds the string denoting the update_time field in the database.
+	FieldUpdateTime = "update_time"
+	// FieldDeviceCode holds the string denoting the device_code field in the database.
+	FieldDeviceCode = "device_code"
+	// FieldDeviceType holds the string denoting the device_type field in the database.
+	FieldDeviceType = "device_type"
+	// FieldProject holds the string denoting the project field in the database.
+	FieldProject = "project"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldParentUsage holds the string denoting the parent_usage field in the database.
+	FieldParentUsage = "parent_usage"
+	// FieldChildrenUsage holds the string denoting the children_usage field in the database.
+	FieldChildrenUsage = "children_usage"
+	// FieldChildren holds the string denoting the children field in the database.
+	FieldChildren = "children"
+	// FieldLossPct holds the string denoting the loss_pct field in the database.
+	FieldLossPct = "loss_pct"
+	// FieldMissing holds the string denoting the missing field in the database.
+	FieldMissing = "missing"
+	// FieldState holds the string denoting the state field in the database.
+	FieldState = "state"
+	// Table holds the table name of the lossrecord in the database.
+	Table = "loss_record"
+)
+
+// Columns holds all SQL columns for lossrecord fields.
+var Columns = []string{
+	FieldID,
+	FieldCreateTime,
+	FieldUpdateTime,
+	FieldDeviceCode,
+	FieldDeviceType,
+	FieldProject,
+	FieldStartTime,
+	FieldEndTime,
+	FieldParentUsage,
+	FieldChildrenUsage,
+	FieldChildren,
+	FieldLossPct,
+	FieldMissing,
+	FieldState,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/twiglab/h2o/vigil/orm/ent/runtime"
+var (
+	Hooks  [1]ent.Hook
+	Policy ent.Policy
+	// DefaultCreateTime holds the default value on creation for the "create_time" field.
+	DefaultCreateTime func() time.Time
+	// DefaultUpdateTime holds the default value on creation for the "update_time" field.
+	DefaultUpdateTime func() time.Time
+	// UpdateDefaultUpdateTime holds the default value on update for the "update_time" field.
+	UpdateDefaultUpdateTime func() time.Time
+	// DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	DeviceCodeValidator func(string) error
+	// DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	DeviceTypeValidator func(string) error
+	// ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	ProjectValidator func(string) error
+	// DefaultParentUsage holds the default value on creation for the "parent_usage" field.
+	DefaultParentUsage int64
+	// DefaultChildrenUsage holds the default value on creation for the "children_usage" field.
+	DefaultChildrenUsage int64
+	// DefaultChildren holds the default value on creation for the "children" field.
+	DefaultChildren int
+	// DefaultLossPct holds the default value on creation for the "loss_pct" field.
+	DefaultLossPct float64
+	// StateValidator is a validator for the "state" field. It is called by the builders before save.
+	StateValidator func(string) error
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() string
+	// IDValidator is a validator for the "id" field. It is called by the builders before save.
+	IDValidator func(string) error
+)
+
+// OrderOption defines the ordering options for the LossRecord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreateTime orders the results by the create_time field.
+func ByCreateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreateTime, opts...).ToFunc()
+}
+
+// ByUpdateTime orders the results by the update_time field.
+func ByUpdateTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdateTime, opts...).ToFunc()
+}
+
+// ByDeviceCode orders the results by the device_code field.
+func ByDeviceCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceCode, opts...).ToFunc()
+}
+
+// ByDeviceType orders the results by the device_type field.
+func ByDeviceType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeviceType, opts...).ToFunc()
+}
+
+// ByProject orders the results by the project field.
+func ByProject(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProject, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByParentUsage orders the results by the parent_usage field.
+func ByParentUsage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParentUsage, opts...).ToFunc()
+}
+
+// ByChildrenUsage orders the results by the children_usage field.
+func ByChildrenUsage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChildrenUsage, opts...).ToFunc()
+}
+
+// ByChildren orders the results by the children field.
+func ByChildren(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChildren, opts...).ToFunc()
+}
+
+// ByLossPct orders the results by the loss_pct field.
+func ByLossPct(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLossPct, opts...).ToFunc()
+}
+
+// ByMissing orders the results by the missing field.
+func ByMissing(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMissing, opts...).ToFunc()
+}
+
+// ByState orders the results by the state field.
+func ByState(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldState, opts...).ToFunc()
+}
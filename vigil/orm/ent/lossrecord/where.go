@@ -0,0 +1,800 @@
+// Code generated by ent, DO NOT EDIT.
+
+package lossrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/vigil/orm/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldID, id))
+}
+
+// IDEqualFold applies the EqualFold predicate on the ID field.
+func IDEqualFold(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldID, id))
+}
+
+// IDContainsFold applies the ContainsFold predicate on the ID field.
+func IDContainsFold(id string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldID, id))
+}
+
+// CreateTime applies equality check predicate on the "create_time" field. It's identical to CreateTimeEQ.
+func CreateTime(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// UpdateTime applies equality check predicate on the "update_time" field. It's identical to UpdateTimeEQ.
+func UpdateTime(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// DeviceCode applies equality check predicate on the "device_code" field. It's identical to DeviceCodeEQ.
+func DeviceCode(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// DeviceType applies equality check predicate on the "device_type" field. It's identical to DeviceTypeEQ.
+func DeviceType(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// Project applies equality check predicate on the "project" field. It's identical to ProjectEQ.
+func Project(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldProject, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldEndTime, v))
+}
+
+// ParentUsage applies equality check predicate on the "parent_usage" field. It's identical to ParentUsageEQ.
+func ParentUsage(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldParentUsage, v))
+}
+
+// ChildrenUsage applies equality check predicate on the "children_usage" field. It's identical to ChildrenUsageEQ.
+func ChildrenUsage(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldChildrenUsage, v))
+}
+
+// Children applies equality check predicate on the "children" field. It's identical to ChildrenEQ.
+func Children(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldChildren, v))
+}
+
+// LossPct applies equality check predicate on the "loss_pct" field. It's identical to LossPctEQ.
+func LossPct(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldLossPct, v))
+}
+
+// Missing applies equality check predicate on the "missing" field. It's identical to MissingEQ.
+func Missing(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldMissing, v))
+}
+
+// State applies equality check predicate on the "state" field. It's identical to StateEQ.
+func State(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldState, v))
+}
+
+// CreateTimeEQ applies the EQ predicate on the "create_time" field.
+func CreateTimeEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldCreateTime, v))
+}
+
+// CreateTimeNEQ applies the NEQ predicate on the "create_time" field.
+func CreateTimeNEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldCreateTime, v))
+}
+
+// CreateTimeIn applies the In predicate on the "create_time" field.
+func CreateTimeIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeNotIn applies the NotIn predicate on the "create_time" field.
+func CreateTimeNotIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldCreateTime, vs...))
+}
+
+// CreateTimeGT applies the GT predicate on the "create_time" field.
+func CreateTimeGT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldCreateTime, v))
+}
+
+// CreateTimeGTE applies the GTE predicate on the "create_time" field.
+func CreateTimeGTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldCreateTime, v))
+}
+
+// CreateTimeLT applies the LT predicate on the "create_time" field.
+func CreateTimeLT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldCreateTime, v))
+}
+
+// CreateTimeLTE applies the LTE predicate on the "create_time" field.
+func CreateTimeLTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldCreateTime, v))
+}
+
+// UpdateTimeEQ applies the EQ predicate on the "update_time" field.
+func UpdateTimeEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeNEQ applies the NEQ predicate on the "update_time" field.
+func UpdateTimeNEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldUpdateTime, v))
+}
+
+// UpdateTimeIn applies the In predicate on the "update_time" field.
+func UpdateTimeIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeNotIn applies the NotIn predicate on the "update_time" field.
+func UpdateTimeNotIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldUpdateTime, vs...))
+}
+
+// UpdateTimeGT applies the GT predicate on the "update_time" field.
+func UpdateTimeGT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldUpdateTime, v))
+}
+
+// UpdateTimeGTE applies the GTE predicate on the "update_time" field.
+func UpdateTimeGTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldUpdateTime, v))
+}
+
+// UpdateTimeLT applies the LT predicate on the "update_time" field.
+func UpdateTimeLT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldUpdateTime, v))
+}
+
+// UpdateTimeLTE applies the LTE predicate on the "update_time" field.
+func UpdateTimeLTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldUpdateTime, v))
+}
+
+// DeviceCodeEQ applies the EQ predicate on the "device_code" field.
+func DeviceCodeEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeNEQ applies the NEQ predicate on the "device_code" field.
+func DeviceCodeNEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldDeviceCode, v))
+}
+
+// DeviceCodeIn applies the In predicate on the "device_code" field.
+func DeviceCodeIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeNotIn applies the NotIn predicate on the "device_code" field.
+func DeviceCodeNotIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldDeviceCode, vs...))
+}
+
+// DeviceCodeGT applies the GT predicate on the "device_code" field.
+func DeviceCodeGT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldDeviceCode, v))
+}
+
+// DeviceCodeGTE applies the GTE predicate on the "device_code" field.
+func DeviceCodeGTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeLT applies the LT predicate on the "device_code" field.
+func DeviceCodeLT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldDeviceCode, v))
+}
+
+// DeviceCodeLTE applies the LTE predicate on the "device_code" field.
+func DeviceCodeLTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldDeviceCode, v))
+}
+
+// DeviceCodeContains applies the Contains predicate on the "device_code" field.
+func DeviceCodeContains(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContains(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasPrefix applies the HasPrefix predicate on the "device_code" field.
+func DeviceCodeHasPrefix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasPrefix(FieldDeviceCode, v))
+}
+
+// DeviceCodeHasSuffix applies the HasSuffix predicate on the "device_code" field.
+func DeviceCodeHasSuffix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasSuffix(FieldDeviceCode, v))
+}
+
+// DeviceCodeEqualFold applies the EqualFold predicate on the "device_code" field.
+func DeviceCodeEqualFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldDeviceCode, v))
+}
+
+// DeviceCodeContainsFold applies the ContainsFold predicate on the "device_code" field.
+func DeviceCodeContainsFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldDeviceCode, v))
+}
+
+// DeviceTypeEQ applies the EQ predicate on the "device_type" field.
+func DeviceTypeEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeNEQ applies the NEQ predicate on the "device_type" field.
+func DeviceTypeNEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldDeviceType, v))
+}
+
+// DeviceTypeIn applies the In predicate on the "device_type" field.
+func DeviceTypeIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeNotIn applies the NotIn predicate on the "device_type" field.
+func DeviceTypeNotIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldDeviceType, vs...))
+}
+
+// DeviceTypeGT applies the GT predicate on the "device_type" field.
+func DeviceTypeGT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldDeviceType, v))
+}
+
+// DeviceTypeGTE applies the GTE predicate on the "device_type" field.
+func DeviceTypeGTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldDeviceType, v))
+}
+
+// DeviceTypeLT applies the LT predicate on the "device_type" field.
+func DeviceTypeLT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldDeviceType, v))
+}
+
+// DeviceTypeLTE applies the LTE predicate on the "device_type" field.
+func DeviceTypeLTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldDeviceType, v))
+}
+
+// DeviceTypeContains applies the Contains predicate on the "device_type" field.
+func DeviceTypeContains(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContains(FieldDeviceType, v))
+}
+
+// DeviceTypeHasPrefix applies the HasPrefix predicate on the "device_type" field.
+func DeviceTypeHasPrefix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasPrefix(FieldDeviceType, v))
+}
+
+// DeviceTypeHasSuffix applies the HasSuffix predicate on the "device_type" field.
+func DeviceTypeHasSuffix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasSuffix(FieldDeviceType, v))
+}
+
+// DeviceTypeEqualFold applies the EqualFold predicate on the "device_type" field.
+func DeviceTypeEqualFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldDeviceType, v))
+}
+
+// DeviceTypeContainsFold applies the ContainsFold predicate on the "device_type" field.
+func DeviceTypeContainsFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldDeviceType, v))
+}
+
+// ProjectEQ applies the EQ predicate on the "project" field.
+func ProjectEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldProject, v))
+}
+
+// ProjectNEQ applies the NEQ predicate on the "project" field.
+func ProjectNEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldProject, v))
+}
+
+// ProjectIn applies the In predicate on the "project" field.
+func ProjectIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldProject, vs...))
+}
+
+// ProjectNotIn applies the NotIn predicate on the "project" field.
+func ProjectNotIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldProject, vs...))
+}
+
+// ProjectGT applies the GT predicate on the "project" field.
+func ProjectGT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldProject, v))
+}
+
+// ProjectGTE applies the GTE predicate on the "project" field.
+func ProjectGTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldProject, v))
+}
+
+// ProjectLT applies the LT predicate on the "project" field.
+func ProjectLT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldProject, v))
+}
+
+// ProjectLTE applies the LTE predicate on the "project" field.
+func ProjectLTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldProject, v))
+}
+
+// ProjectContains applies the Contains predicate on the "project" field.
+func ProjectContains(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContains(FieldProject, v))
+}
+
+// ProjectHasPrefix applies the HasPrefix predicate on the "project" field.
+func ProjectHasPrefix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasPrefix(FieldProject, v))
+}
+
+// ProjectHasSuffix applies the HasSuffix predicate on the "project" field.
+func ProjectHasSuffix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasSuffix(FieldProject, v))
+}
+
+// ProjectEqualFold applies the EqualFold predicate on the "project" field.
+func ProjectEqualFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldProject, v))
+}
+
+// ProjectContainsFold applies the ContainsFold predicate on the "project" field.
+func ProjectContainsFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldProject, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldEndTime, v))
+}
+
+// ParentUsageEQ applies the EQ predicate on the "parent_usage" field.
+func ParentUsageEQ(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldParentUsage, v))
+}
+
+// ParentUsageNEQ applies the NEQ predicate on the "parent_usage" field.
+func ParentUsageNEQ(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldParentUsage, v))
+}
+
+// ParentUsageIn applies the In predicate on the "parent_usage" field.
+func ParentUsageIn(vs ...int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldParentUsage, vs...))
+}
+
+// ParentUsageNotIn applies the NotIn predicate on the "parent_usage" field.
+func ParentUsageNotIn(vs ...int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldParentUsage, vs...))
+}
+
+// ParentUsageGT applies the GT predicate on the "parent_usage" field.
+func ParentUsageGT(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldParentUsage, v))
+}
+
+// ParentUsageGTE applies the GTE predicate on the "parent_usage" field.
+func ParentUsageGTE(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldParentUsage, v))
+}
+
+// ParentUsageLT applies the LT predicate on the "parent_usage" field.
+func ParentUsageLT(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldParentUsage, v))
+}
+
+// ParentUsageLTE applies the LTE predicate on the "parent_usage" field.
+func ParentUsageLTE(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldParentUsage, v))
+}
+
+// ChildrenUsageEQ applies the EQ predicate on the "children_usage" field.
+func ChildrenUsageEQ(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldChildrenUsage, v))
+}
+
+// ChildrenUsageNEQ applies the NEQ predicate on the "children_usage" field.
+func ChildrenUsageNEQ(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldChildrenUsage, v))
+}
+
+// ChildrenUsageIn applies the In predicate on the "children_usage" field.
+func ChildrenUsageIn(vs ...int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldChildrenUsage, vs...))
+}
+
+// ChildrenUsageNotIn applies the NotIn predicate on the "children_usage" field.
+func ChildrenUsageNotIn(vs ...int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldChildrenUsage, vs...))
+}
+
+// ChildrenUsageGT applies the GT predicate on the "children_usage" field.
+func ChildrenUsageGT(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldChildrenUsage, v))
+}
+
+// ChildrenUsageGTE applies the GTE predicate on the "children_usage" field.
+func ChildrenUsageGTE(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldChildrenUsage, v))
+}
+
+// ChildrenUsageLT applies the LT predicate on the "children_usage" field.
+func ChildrenUsageLT(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldChildrenUsage, v))
+}
+
+// ChildrenUsageLTE applies the LTE predicate on the "children_usage" field.
+func ChildrenUsageLTE(v int64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldChildrenUsage, v))
+}
+
+// ChildrenEQ applies the EQ predicate on the "children" field.
+func ChildrenEQ(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldChildren, v))
+}
+
+// ChildrenNEQ applies the NEQ predicate on the "children" field.
+func ChildrenNEQ(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldChildren, v))
+}
+
+// ChildrenIn applies the In predicate on the "children" field.
+func ChildrenIn(vs ...int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldChildren, vs...))
+}
+
+// ChildrenNotIn applies the NotIn predicate on the "children" field.
+func ChildrenNotIn(vs ...int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldChildren, vs...))
+}
+
+// ChildrenGT applies the GT predicate on the "children" field.
+func ChildrenGT(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldChildren, v))
+}
+
+// ChildrenGTE applies the GTE predicate on the "children" field.
+func ChildrenGTE(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldChildren, v))
+}
+
+// ChildrenLT applies the LT predicate on the "children" field.
+func ChildrenLT(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldChildren, v))
+}
+
+// ChildrenLTE applies the LTE predicate on the "children" field.
+func ChildrenLTE(v int) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldChildren, v))
+}
+
+// LossPctEQ applies the EQ predicate on the "loss_pct" field.
+func LossPctEQ(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldLossPct, v))
+}
+
+// LossPctNEQ applies the NEQ predicate on the "loss_pct" field.
+func LossPctNEQ(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldLossPct, v))
+}
+
+// LossPctIn applies the In predicate on the "loss_pct" field.
+func LossPctIn(vs ...float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldLossPct, vs...))
+}
+
+// LossPctNotIn applies the NotIn predicate on the "loss_pct" field.
+func LossPctNotIn(vs ...float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldLossPct, vs...))
+}
+
+// LossPctGT applies the GT predicate on the "loss_pct" field.
+func LossPctGT(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldLossPct, v))
+}
+
+// LossPctGTE applies the GTE predicate on the "loss_pct" field.
+func LossPctGTE(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldLossPct, v))
+}
+
+// LossPctLT applies the LT predicate on the "loss_pct" field.
+func LossPctLT(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldLossPct, v))
+}
+
+// LossPctLTE applies the LTE predicate on the "loss_pct" field.
+func LossPctLTE(v float64) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldLossPct, v))
+}
+
+// MissingEQ applies the EQ predicate on the "missing" field.
+func MissingEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldMissing, v))
+}
+
+// MissingNEQ applies the NEQ predicate on the "missing" field.
+func MissingNEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldMissing, v))
+}
+
+// MissingIn applies the In predicate on the "missing" field.
+func MissingIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldMissing, vs...))
+}
+
+// MissingNotIn applies the NotIn predicate on the "missing" field.
+func MissingNotIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldMissing, vs...))
+}
+
+// MissingGT applies the GT predicate on the "missing" field.
+func MissingGT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldMissing, v))
+}
+
+// MissingGTE applies the GTE predicate on the "missing" field.
+func MissingGTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldMissing, v))
+}
+
+// MissingLT applies the LT predicate on the "missing" field.
+func MissingLT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldMissing, v))
+}
+
+// MissingLTE applies the LTE predicate on the "missing" field.
+func MissingLTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldMissing, v))
+}
+
+// MissingContains applies the Contains predicate on the "missing" field.
+func MissingContains(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContains(FieldMissing, v))
+}
+
+// MissingHasPrefix applies the HasPrefix predicate on the "missing" field.
+func MissingHasPrefix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasPrefix(FieldMissing, v))
+}
+
+// MissingHasSuffix applies the HasSuffix predicate on the "missing" field.
+func MissingHasSuffix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasSuffix(FieldMissing, v))
+}
+
+// MissingIsNil applies the IsNil predicate on the "missing" field.
+func MissingIsNil() predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIsNull(FieldMissing))
+}
+
+// MissingNotNil applies the NotNil predicate on the "missing" field.
+func MissingNotNil() predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotNull(FieldMissing))
+}
+
+// MissingEqualFold applies the EqualFold predicate on the "missing" field.
+func MissingEqualFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldMissing, v))
+}
+
+// MissingContainsFold applies the ContainsFold predicate on the "missing" field.
+func MissingContainsFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldMissing, v))
+}
+
+// StateEQ applies the EQ predicate on the "state" field.
+func StateEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEQ(FieldState, v))
+}
+
+// StateNEQ applies the NEQ predicate on the "state" field.
+func StateNEQ(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNEQ(FieldState, v))
+}
+
+// StateIn applies the In predicate on the "state" field.
+func StateIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldIn(FieldState, vs...))
+}
+
+// StateNotIn applies the NotIn predicate on the "state" field.
+func StateNotIn(vs ...string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldNotIn(FieldState, vs...))
+}
+
+// StateGT applies the GT predicate on the "state" field.
+func StateGT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGT(FieldState, v))
+}
+
+// StateGTE applies the GTE predicate on the "state" field.
+func StateGTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldGTE(FieldState, v))
+}
+
+// StateLT applies the LT predicate on the "state" field.
+func StateLT(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLT(FieldState, v))
+}
+
+// StateLTE applies the LTE predicate on the "state" field.
+func StateLTE(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldLTE(FieldState, v))
+}
+
+// StateContains applies the Contains predicate on the "state" field.
+func StateContains(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContains(FieldState, v))
+}
+
+// StateHasPrefix applies the HasPrefix predicate on the "state" field.
+func StateHasPrefix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasPrefix(FieldState, v))
+}
+
+// StateHasSuffix applies the HasSuffix predicate on the "state" field.
+func StateHasSuffix(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldHasSuffix(FieldState, v))
+}
+
+// StateEqualFold applies the EqualFold predicate on the "state" field.
+func StateEqualFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldEqualFold(FieldState, v))
+}
+
+// StateContainsFold applies the ContainsFold predicate on the "state" field.
+func StateContainsFold(v string) predicate.LossRecord {
+	return predicate.LossRecord(sql.FieldContainsFold(FieldState, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.LossRecord) predicate.LossRecord {
+	return predicate.LossRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.LossRecord) predicate.LossRecord {
+	return predicate.LossRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.LossRecord) predicate.LossRecord {
+	return predicate.LossRecord(sql.NotPredicates(p))
+}
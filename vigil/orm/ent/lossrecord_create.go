@@ -0,0 +1,1256 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+)
+
+// LossRecordCreate is the builder for creating a LossRecord entity.
+type LossRecordCreate struct {
+	config
+	mutation *LossRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreateTime sets the "create_time" field.
+func (_c *LossRecordCreate) SetCreateTime(v time.Time) *LossRecordCreate {
+	_c.mutation.SetCreateTime(v)
+	return _c
+}
+
+// SetNillableCreateTime sets the "create_time" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableCreateTime(v *time.Time) *LossRecordCreate {
+	if v != nil {
+		_c.SetCreateTime(*v)
+	}
+	return _c
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_c *LossRecordCreate) SetUpdateTime(v time.Time) *LossRecordCreate {
+	_c.mutation.SetUpdateTime(v)
+	return _c
+}
+
+// SetNillableUpdateTime sets the "update_time" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableUpdateTime(v *time.Time) *LossRecordCreate {
+	if v != nil {
+		_c.SetUpdateTime(*v)
+	}
+	return _c
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (_c *LossRecordCreate) SetDeviceCode(v string) *LossRecordCreate {
+	_c.mutation.SetDeviceCode(v)
+	return _c
+}
+
+// SetDeviceType sets the "device_type" field.
+func (_c *LossRecordCreate) SetDeviceType(v string) *LossRecordCreate {
+	_c.mutation.SetDeviceType(v)
+	return _c
+}
+
+// SetProject sets the "project" field.
+func (_c *LossRecordCreate) SetProject(v string) *LossRecordCreate {
+	_c.mutation.SetProject(v)
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *LossRecordCreate) SetStartTime(v time.Time) *LossRecordCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *LossRecordCreate) SetEndTime(v time.Time) *LossRecordCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (_c *LossRecordCreate) SetParentUsage(v int64) *LossRecordCreate {
+	_c.mutation.SetParentUsage(v)
+	return _c
+}
+
+// SetNillableParentUsage sets the "parent_usage" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableParentUsage(v *int64) *LossRecordCreate {
+	if v != nil {
+		_c.SetParentUsage(*v)
+	}
+	return _c
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (_c *LossRecordCreate) SetChildrenUsage(v int64) *LossRecordCreate {
+	_c.mutation.SetChildrenUsage(v)
+	return _c
+}
+
+// SetNillableChildrenUsage sets the "children_usage" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableChildrenUsage(v *int64) *LossRecordCreate {
+	if v != nil {
+		_c.SetChildrenUsage(*v)
+	}
+	return _c
+}
+
+// SetChildren sets the "children" field.
+func (_c *LossRecordCreate) SetChildren(v int) *LossRecordCreate {
+	_c.mutation.SetChildren(v)
+	return _c
+}
+
+// SetNillableChildren sets the "children" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableChildren(v *int) *LossRecordCreate {
+	if v != nil {
+		_c.SetChildren(*v)
+	}
+	return _c
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (_c *LossRecordCreate) SetLossPct(v float64) *LossRecordCreate {
+	_c.mutation.SetLossPct(v)
+	return _c
+}
+
+// SetNillableLossPct sets the "loss_pct" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableLossPct(v *float64) *LossRecordCreate {
+	if v != nil {
+		_c.SetLossPct(*v)
+	}
+	return _c
+}
+
+// SetMissing sets the "missing" field.
+func (_c *LossRecordCreate) SetMissing(v string) *LossRecordCreate {
+	_c.mutation.SetMissing(v)
+	return _c
+}
+
+// SetNillableMissing sets the "missing" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableMissing(v *string) *LossRecordCreate {
+	if v != nil {
+		_c.SetMissing(*v)
+	}
+	return _c
+}
+
+// SetState sets the "state" field.
+func (_c *LossRecordCreate) SetState(v string) *LossRecordCreate {
+	_c.mutation.SetState(v)
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *LossRecordCreate) SetID(v string) *LossRecordCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *LossRecordCreate) SetNillableID(v *string) *LossRecordCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the LossRecordMutation object of the builder.
+func (_c *LossRecordCreate) Mutation() *LossRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the LossRecord in the database.
+func (_c *LossRecordCreate) Save(ctx context.Context) (*LossRecord, error) {
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *LossRecordCreate) SaveX(ctx context.Context) *LossRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LossRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LossRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *LossRecordCreate) defaults() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		if lossrecord.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized lossrecord.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
+		v := lossrecord.DefaultCreateTime()
+		_c.mutation.SetCreateTime(v)
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if lossrecord.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lossrecord.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lossrecord.DefaultUpdateTime()
+		_c.mutation.SetUpdateTime(v)
+	}
+	if _, ok := _c.mutation.ParentUsage(); !ok {
+		v := lossrecord.DefaultParentUsage
+		_c.mutation.SetParentUsage(v)
+	}
+	if _, ok := _c.mutation.ChildrenUsage(); !ok {
+		v := lossrecord.DefaultChildrenUsage
+		_c.mutation.SetChildrenUsage(v)
+	}
+	if _, ok := _c.mutation.Children(); !ok {
+		v := lossrecord.DefaultChildren
+		_c.mutation.SetChildren(v)
+	}
+	if _, ok := _c.mutation.LossPct(); !ok {
+		v := lossrecord.DefaultLossPct
+		_c.mutation.SetLossPct(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		if lossrecord.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized lossrecord.DefaultID (forgotten import ent/runtime?)")
+		}
+		v := lossrecord.DefaultID()
+		_c.mutation.SetID(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *LossRecordCreate) check() error {
+	if _, ok := _c.mutation.CreateTime(); !ok {
+		return &ValidationError{Name: "create_time", err: errors.New(`ent: missing required field "LossRecord.create_time"`)}
+	}
+	if _, ok := _c.mutation.UpdateTime(); !ok {
+		return &ValidationError{Name: "update_time", err: errors.New(`ent: missing required field "LossRecord.update_time"`)}
+	}
+	if _, ok := _c.mutation.DeviceCode(); !ok {
+		return &ValidationError{Name: "device_code", err: errors.New(`ent: missing required field "LossRecord.device_code"`)}
+	}
+	if v, ok := _c.mutation.DeviceCode(); ok {
+		if err := lossrecord.DeviceCodeValidator(v); err != nil {
+			return &ValidationError{Name: "device_code", err: fmt.Errorf(`ent: validator failed for field "LossRecord.device_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DeviceType(); !ok {
+		return &ValidationError{Name: "device_type", err: errors.New(`ent: missing required field "LossRecord.device_type"`)}
+	}
+	if v, ok := _c.mutation.DeviceType(); ok {
+		if err := lossrecord.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "LossRecord.device_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Project(); !ok {
+		return &ValidationError{Name: "project", err: errors.New(`ent: missing required field "LossRecord.project"`)}
+	}
+	if v, ok := _c.mutation.Project(); ok {
+		if err := lossrecord.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "LossRecord.project": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "LossRecord.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "LossRecord.end_time"`)}
+	}
+	if _, ok := _c.mutation.ParentUsage(); !ok {
+		return &ValidationError{Name: "parent_usage", err: errors.New(`ent: missing required field "LossRecord.parent_usage"`)}
+	}
+	if _, ok := _c.mutation.ChildrenUsage(); !ok {
+		return &ValidationError{Name: "children_usage", err: errors.New(`ent: missing required field "LossRecord.children_usage"`)}
+	}
+	if _, ok := _c.mutation.Children(); !ok {
+		return &ValidationError{Name: "children", err: errors.New(`ent: missing required field "LossRecord.children"`)}
+	}
+	if _, ok := _c.mutation.LossPct(); !ok {
+		return &ValidationError{Name: "loss_pct", err: errors.New(`ent: missing required field "LossRecord.loss_pct"`)}
+	}
+	if _, ok := _c.mutation.State(); !ok {
+		return &ValidationError{Name: "state", err: errors.New(`ent: missing required field "LossRecord.state"`)}
+	}
+	if v, ok := _c.mutation.State(); ok {
+		if err := lossrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "LossRecord.state": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.ID(); ok {
+		if err := lossrecord.IDValidator(v); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`ent: validator failed for field "LossRecord.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *LossRecordCreate) sqlSave(ctx context.Context) (*LossRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(string); ok {
+			_node.ID = id
+		} else {
+			return nil, fmt.Errorf("unexpected LossRecord.ID type: %T", _spec.ID.Value)
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *LossRecordCreate) createSpec() (*LossRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &LossRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(lossrecord.Table, sqlgraph.NewFieldSpec(lossrecord.FieldID, field.TypeString))
+	)
+	_spec.OnConflict = _c.conflict
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := _c.mutation.CreateTime(); ok {
+		_spec.SetField(lossrecord.FieldCreateTime, field.TypeTime, value)
+		_node.CreateTime = value
+	}
+	if value, ok := _c.mutation.UpdateTime(); ok {
+		_spec.SetField(lossrecord.FieldUpdateTime, field.TypeTime, value)
+		_node.UpdateTime = value
+	}
+	if value, ok := _c.mutation.DeviceCode(); ok {
+		_spec.SetField(lossrecord.FieldDeviceCode, field.TypeString, value)
+		_node.DeviceCode = value
+	}
+	if value, ok := _c.mutation.DeviceType(); ok {
+		_spec.SetField(lossrecord.FieldDeviceType, field.TypeString, value)
+		_node.DeviceType = value
+	}
+	if value, ok := _c.mutation.Project(); ok {
+		_spec.SetField(lossrecord.FieldProject, field.TypeString, value)
+		_node.Project = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(lossrecord.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(lossrecord.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.ParentUsage(); ok {
+		_spec.SetField(lossrecord.FieldParentUsage, field.TypeInt64, value)
+		_node.ParentUsage = value
+	}
+	if value, ok := _c.mutation.ChildrenUsage(); ok {
+		_spec.SetField(lossrecord.FieldChildrenUsage, field.TypeInt64, value)
+		_node.ChildrenUsage = value
+	}
+	if value, ok := _c.mutation.Children(); ok {
+		_spec.SetField(lossrecord.FieldChildren, field.TypeInt, value)
+		_node.Children = value
+	}
+	if value, ok := _c.mutation.LossPct(); ok {
+		_spec.SetField(lossrecord.FieldLossPct, field.TypeFloat64, value)
+		_node.LossPct = value
+	}
+	if value, ok := _c.mutation.Missing(); ok {
+		_spec.SetField(lossrecord.FieldMissing, field.TypeString, value)
+		_node.Missing = value
+	}
+	if value, ok := _c.mutation.State(); ok {
+		_spec.SetField(lossrecord.FieldState, field.TypeString, value)
+		_node.State = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LossRecord.Create().
+//		SetCreateTime(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LossRecordUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LossRecordCreate) OnConflict(opts ...sql.ConflictOption) *LossRecordUpsertOne {
+	_c.conflict = opts
+	return &LossRecordUpsertOne{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LossRecordCreate) OnConflictColumns(columns ...string) *LossRecordUpsertOne {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LossRecordUpsertOne{
+		create: _c,
+	}
+}
+
+type (
+	// LossRecordUpsertOne is the builder for "upsert"-ing
+	//  one LossRecord node.
+	LossRecordUpsertOne struct {
+		create *LossRecordCreate
+	}
+
+	// LossRecordUpsert is the "OnConflict" setter.
+	LossRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LossRecordUpsert) SetUpdateTime(v time.Time) *LossRecordUpsert {
+	u.Set(lossrecord.FieldUpdateTime, v)
+	return u
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateUpdateTime() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldUpdateTime)
+	return u
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *LossRecordUpsert) SetDeviceType(v string) *LossRecordUpsert {
+	u.Set(lossrecord.FieldDeviceType, v)
+	return u
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateDeviceType() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldDeviceType)
+	return u
+}
+
+// SetProject sets the "project" field.
+func (u *LossRecordUpsert) SetProject(v string) *LossRecordUpsert {
+	u.Set(lossrecord.FieldProject, v)
+	return u
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateProject() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldProject)
+	return u
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *LossRecordUpsert) SetEndTime(v time.Time) *LossRecordUpsert {
+	u.Set(lossrecord.FieldEndTime, v)
+	return u
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateEndTime() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldEndTime)
+	return u
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (u *LossRecordUpsert) SetParentUsage(v int64) *LossRecordUpsert {
+	u.Set(lossrecord.FieldParentUsage, v)
+	return u
+}
+
+// UpdateParentUsage sets the "parent_usage" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateParentUsage() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldParentUsage)
+	return u
+}
+
+// AddParentUsage adds v to the "parent_usage" field.
+func (u *LossRecordUpsert) AddParentUsage(v int64) *LossRecordUpsert {
+	u.Add(lossrecord.FieldParentUsage, v)
+	return u
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (u *LossRecordUpsert) SetChildrenUsage(v int64) *LossRecordUpsert {
+	u.Set(lossrecord.FieldChildrenUsage, v)
+	return u
+}
+
+// UpdateChildrenUsage sets the "children_usage" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateChildrenUsage() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldChildrenUsage)
+	return u
+}
+
+// AddChildrenUsage adds v to the "children_usage" field.
+func (u *LossRecordUpsert) AddChildrenUsage(v int64) *LossRecordUpsert {
+	u.Add(lossrecord.FieldChildrenUsage, v)
+	return u
+}
+
+// SetChildren sets the "children" field.
+func (u *LossRecordUpsert) SetChildren(v int) *LossRecordUpsert {
+	u.Set(lossrecord.FieldChildren, v)
+	return u
+}
+
+// UpdateChildren sets the "children" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateChildren() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldChildren)
+	return u
+}
+
+// AddChildren adds v to the "children" field.
+func (u *LossRecordUpsert) AddChildren(v int) *LossRecordUpsert {
+	u.Add(lossrecord.FieldChildren, v)
+	return u
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (u *LossRecordUpsert) SetLossPct(v float64) *LossRecordUpsert {
+	u.Set(lossrecord.FieldLossPct, v)
+	return u
+}
+
+// UpdateLossPct sets the "loss_pct" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateLossPct() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldLossPct)
+	return u
+}
+
+// AddLossPct adds v to the "loss_pct" field.
+func (u *LossRecordUpsert) AddLossPct(v float64) *LossRecordUpsert {
+	u.Add(lossrecord.FieldLossPct, v)
+	return u
+}
+
+// SetMissing sets the "missing" field.
+func (u *LossRecordUpsert) SetMissing(v string) *LossRecordUpsert {
+	u.Set(lossrecord.FieldMissing, v)
+	return u
+}
+
+// UpdateMissing sets the "missing" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateMissing() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldMissing)
+	return u
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (u *LossRecordUpsert) ClearMissing() *LossRecordUpsert {
+	u.SetNull(lossrecord.FieldMissing)
+	return u
+}
+
+// SetState sets the "state" field.
+func (u *LossRecordUpsert) SetState(v string) *LossRecordUpsert {
+	u.Set(lossrecord.FieldState, v)
+	return u
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *LossRecordUpsert) UpdateState() *LossRecordUpsert {
+	u.SetExcluded(lossrecord.FieldState)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lossrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LossRecordUpsertOne) UpdateNewValues() *LossRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(lossrecord.FieldID)
+		}
+		if _, exists := u.create.mutation.CreateTime(); exists {
+			s.SetIgnore(lossrecord.FieldCreateTime)
+		}
+		if _, exists := u.create.mutation.DeviceCode(); exists {
+			s.SetIgnore(lossrecord.FieldDeviceCode)
+		}
+		if _, exists := u.create.mutation.StartTime(); exists {
+			s.SetIgnore(lossrecord.FieldStartTime)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *LossRecordUpsertOne) Ignore() *LossRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LossRecordUpsertOne) DoNothing() *LossRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LossRecordCreate.OnConflict
+// documentation for more info.
+func (u *LossRecordUpsertOne) Update(set func(*LossRecordUpsert)) *LossRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LossRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LossRecordUpsertOne) SetUpdateTime(v time.Time) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateUpdateTime() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *LossRecordUpsertOne) SetDeviceType(v string) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetDeviceType(v)
+	})
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateDeviceType() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateDeviceType()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *LossRecordUpsertOne) SetProject(v string) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateProject() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *LossRecordUpsertOne) SetEndTime(v time.Time) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateEndTime() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (u *LossRecordUpsertOne) SetParentUsage(v int64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetParentUsage(v)
+	})
+}
+
+// AddParentUsage adds v to the "parent_usage" field.
+func (u *LossRecordUpsertOne) AddParentUsage(v int64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddParentUsage(v)
+	})
+}
+
+// UpdateParentUsage sets the "parent_usage" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateParentUsage() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateParentUsage()
+	})
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (u *LossRecordUpsertOne) SetChildrenUsage(v int64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetChildrenUsage(v)
+	})
+}
+
+// AddChildrenUsage adds v to the "children_usage" field.
+func (u *LossRecordUpsertOne) AddChildrenUsage(v int64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddChildrenUsage(v)
+	})
+}
+
+// UpdateChildrenUsage sets the "children_usage" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateChildrenUsage() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateChildrenUsage()
+	})
+}
+
+// SetChildren sets the "children" field.
+func (u *LossRecordUpsertOne) SetChildren(v int) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetChildren(v)
+	})
+}
+
+// AddChildren adds v to the "children" field.
+func (u *LossRecordUpsertOne) AddChildren(v int) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddChildren(v)
+	})
+}
+
+// UpdateChildren sets the "children" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateChildren() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateChildren()
+	})
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (u *LossRecordUpsertOne) SetLossPct(v float64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetLossPct(v)
+	})
+}
+
+// AddLossPct adds v to the "loss_pct" field.
+func (u *LossRecordUpsertOne) AddLossPct(v float64) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddLossPct(v)
+	})
+}
+
+// UpdateLossPct sets the "loss_pct" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateLossPct() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateLossPct()
+	})
+}
+
+// SetMissing sets the "missing" field.
+func (u *LossRecordUpsertOne) SetMissing(v string) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetMissing(v)
+	})
+}
+
+// UpdateMissing sets the "missing" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateMissing() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateMissing()
+	})
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (u *LossRecordUpsertOne) ClearMissing() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.ClearMissing()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *LossRecordUpsertOne) SetState(v string) *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *LossRecordUpsertOne) UpdateState() *LossRecordUpsertOne {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *LossRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LossRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LossRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *LossRecordUpsertOne) ID(ctx context.Context) (id string, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: LossRecordUpsertOne.ID is not supported by MySQL driver. Use LossRecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *LossRecordUpsertOne) IDX(ctx context.Context) string {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// LossRecordCreateBulk is the builder for creating many LossRecord entities in bulk.
+type LossRecordCreateBulk struct {
+	config
+	err      error
+	builders []*LossRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the LossRecord entities in the database.
+func (_c *LossRecordCreateBulk) Save(ctx context.Context) ([]*LossRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*LossRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*LossRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = _c.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *LossRecordCreateBulk) SaveX(ctx context.Context) []*LossRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *LossRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *LossRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.LossRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.LossRecordUpsert) {
+//			SetCreateTime(v+v).
+//		}).
+//		Exec(ctx)
+func (_c *LossRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *LossRecordUpsertBulk {
+	_c.conflict = opts
+	return &LossRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (_c *LossRecordCreateBulk) OnConflictColumns(columns ...string) *LossRecordUpsertBulk {
+	_c.conflict = append(_c.conflict, sql.ConflictColumns(columns...))
+	return &LossRecordUpsertBulk{
+		create: _c,
+	}
+}
+
+// LossRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of LossRecord nodes.
+type LossRecordUpsertBulk struct {
+	create *LossRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(lossrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *LossRecordUpsertBulk) UpdateNewValues() *LossRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(lossrecord.FieldID)
+			}
+			if _, exists := b.mutation.CreateTime(); exists {
+				s.SetIgnore(lossrecord.FieldCreateTime)
+			}
+			if _, exists := b.mutation.DeviceCode(); exists {
+				s.SetIgnore(lossrecord.FieldDeviceCode)
+			}
+			if _, exists := b.mutation.StartTime(); exists {
+				s.SetIgnore(lossrecord.FieldStartTime)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.LossRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *LossRecordUpsertBulk) Ignore() *LossRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *LossRecordUpsertBulk) DoNothing() *LossRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the LossRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *LossRecordUpsertBulk) Update(set func(*LossRecordUpsert)) *LossRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&LossRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (u *LossRecordUpsertBulk) SetUpdateTime(v time.Time) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetUpdateTime(v)
+	})
+}
+
+// UpdateUpdateTime sets the "update_time" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateUpdateTime() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateUpdateTime()
+	})
+}
+
+// SetDeviceType sets the "device_type" field.
+func (u *LossRecordUpsertBulk) SetDeviceType(v string) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetDeviceType(v)
+	})
+}
+
+// UpdateDeviceType sets the "device_type" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateDeviceType() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateDeviceType()
+	})
+}
+
+// SetProject sets the "project" field.
+func (u *LossRecordUpsertBulk) SetProject(v string) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetProject(v)
+	})
+}
+
+// UpdateProject sets the "project" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateProject() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateProject()
+	})
+}
+
+// SetEndTime sets the "end_time" field.
+func (u *LossRecordUpsertBulk) SetEndTime(v time.Time) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetEndTime(v)
+	})
+}
+
+// UpdateEndTime sets the "end_time" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateEndTime() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateEndTime()
+	})
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (u *LossRecordUpsertBulk) SetParentUsage(v int64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetParentUsage(v)
+	})
+}
+
+// AddParentUsage adds v to the "parent_usage" field.
+func (u *LossRecordUpsertBulk) AddParentUsage(v int64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddParentUsage(v)
+	})
+}
+
+// UpdateParentUsage sets the "parent_usage" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateParentUsage() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateParentUsage()
+	})
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (u *LossRecordUpsertBulk) SetChildrenUsage(v int64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetChildrenUsage(v)
+	})
+}
+
+// AddChildrenUsage adds v to the "children_usage" field.
+func (u *LossRecordUpsertBulk) AddChildrenUsage(v int64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddChildrenUsage(v)
+	})
+}
+
+// UpdateChildrenUsage sets the "children_usage" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateChildrenUsage() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateChildrenUsage()
+	})
+}
+
+// SetChildren sets the "children" field.
+func (u *LossRecordUpsertBulk) SetChildren(v int) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetChildren(v)
+	})
+}
+
+// AddChildren adds v to the "children" field.
+func (u *LossRecordUpsertBulk) AddChildren(v int) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddChildren(v)
+	})
+}
+
+// UpdateChildren sets the "children" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateChildren() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateChildren()
+	})
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (u *LossRecordUpsertBulk) SetLossPct(v float64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetLossPct(v)
+	})
+}
+
+// AddLossPct adds v to the "loss_pct" field.
+func (u *LossRecordUpsertBulk) AddLossPct(v float64) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.AddLossPct(v)
+	})
+}
+
+// UpdateLossPct sets the "loss_pct" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateLossPct() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateLossPct()
+	})
+}
+
+// SetMissing sets the "missing" field.
+func (u *LossRecordUpsertBulk) SetMissing(v string) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetMissing(v)
+	})
+}
+
+// UpdateMissing sets the "missing" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateMissing() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateMissing()
+	})
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (u *LossRecordUpsertBulk) ClearMissing() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.ClearMissing()
+	})
+}
+
+// SetState sets the "state" field.
+func (u *LossRecordUpsertBulk) SetState(v string) *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.SetState(v)
+	})
+}
+
+// UpdateState sets the "state" field to the value that was provided on create.
+func (u *LossRecordUpsertBulk) UpdateState() *LossRecordUpsertBulk {
+	return u.Update(func(s *LossRecordUpsert) {
+		s.UpdateState()
+	})
+}
+
+// Exec executes the query.
+func (u *LossRecordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the LossRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for LossRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *LossRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
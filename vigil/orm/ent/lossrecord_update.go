@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/predicate"
+)
+
+// LossRecordUpdate is the builder for updating LossRecord entities.
+type LossRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *LossRecordMutation
+}
+
+// Where appends a list predicates to the LossRecordUpdate builder.
+func (_u *LossRecordUpdate) Where(ps ...predicate.LossRecord) *LossRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LossRecordUpdate) SetUpdateTime(v time.Time) *LossRecordUpdate {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDeviceType sets the "device_type" field.
+func (_u *LossRecordUpdate) SetDeviceType(v string) *LossRecordUpdate {
+	_u.mutation.SetDeviceType(v)
+	return _u
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableDeviceType(v *string) *LossRecordUpdate {
+	if v != nil {
+		_u.SetDeviceType(*v)
+	}
+	return _u
+}
+
+// SetProject sets the "project" field.
+func (_u *LossRecordUpdate) SetProject(v string) *LossRecordUpdate {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableProject(v *string) *LossRecordUpdate {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *LossRecordUpdate) SetEndTime(v time.Time) *LossRecordUpdate {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableEndTime(v *time.Time) *LossRecordUpdate {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (_u *LossRecordUpdate) SetParentUsage(v int64) *LossRecordUpdate {
+	_u.mutation.ResetParentUsage()
+	_u.mutation.SetParentUsage(v)
+	return _u
+}
+
+// SetNillableParentUsage sets the "parent_usage" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableParentUsage(v *int64) *LossRecordUpdate {
+	if v != nil {
+		_u.SetParentUsage(*v)
+	}
+	return _u
+}
+
+// AddParentUsage adds value to the "parent_usage" field.
+func (_u *LossRecordUpdate) AddParentUsage(v int64) *LossRecordUpdate {
+	_u.mutation.AddParentUsage(v)
+	return _u
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (_u *LossRecordUpdate) SetChildrenUsage(v int64) *LossRecordUpdate {
+	_u.mutation.ResetChildrenUsage()
+	_u.mutation.SetChildrenUsage(v)
+	return _u
+}
+
+// SetNillableChildrenUsage sets the "children_usage" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableChildrenUsage(v *int64) *LossRecordUpdate {
+	if v != nil {
+		_u.SetChildrenUsage(*v)
+	}
+	return _u
+}
+
+// AddChildrenUsage adds value to the "children_usage" field.
+func (_u *LossRecordUpdate) AddChildrenUsage(v int64) *LossRecordUpdate {
+	_u.mutation.AddChildrenUsage(v)
+	return _u
+}
+
+// SetChildren sets the "children" field.
+func (_u *LossRecordUpdate) SetChildren(v int) *LossRecordUpdate {
+	_u.mutation.ResetChildren()
+	_u.mutation.SetChildren(v)
+	return _u
+}
+
+// SetNillableChildren sets the "children" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableChildren(v *int) *LossRecordUpdate {
+	if v != nil {
+		_u.SetChildren(*v)
+	}
+	return _u
+}
+
+// AddChildren adds value to the "children" field.
+func (_u *LossRecordUpdate) AddChildren(v int) *LossRecordUpdate {
+	_u.mutation.AddChildren(v)
+	return _u
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (_u *LossRecordUpdate) SetLossPct(v float64) *LossRecordUpdate {
+	_u.mutation.ResetLossPct()
+	_u.mutation.SetLossPct(v)
+	return _u
+}
+
+// SetNillableLossPct sets the "loss_pct" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableLossPct(v *float64) *LossRecordUpdate {
+	if v != nil {
+		_u.SetLossPct(*v)
+	}
+	return _u
+}
+
+// AddLossPct adds value to the "loss_pct" field.
+func (_u *LossRecordUpdate) AddLossPct(v float64) *LossRecordUpdate {
+	_u.mutation.AddLossPct(v)
+	return _u
+}
+
+// SetMissing sets the "missing" field.
+func (_u *LossRecordUpdate) SetMissing(v string) *LossRecordUpdate {
+	_u.mutation.SetMissing(v)
+	return _u
+}
+
+// SetNillableMissing sets the "missing" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableMissing(v *string) *LossRecordUpdate {
+	if v != nil {
+		_u.SetMissing(*v)
+	}
+	return _u
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (_u *LossRecordUpdate) ClearMissing() *LossRecordUpdate {
+	_u.mutation.ClearMissing()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *LossRecordUpdate) SetState(v string) *LossRecordUpdate {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *LossRecordUpdate) SetNillableState(v *string) *LossRecordUpdate {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LossRecordMutation object of the builder.
+func (_u *LossRecordUpdate) Mutation() *LossRecordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *LossRecordUpdate) Save(ctx context.Context) (int, error) {
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LossRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *LossRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LossRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LossRecordUpdate) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if lossrecord.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lossrecord.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lossrecord.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LossRecordUpdate) check() error {
+	if v, ok := _u.mutation.DeviceType(); ok {
+		if err := lossrecord.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "LossRecord.device_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := lossrecord.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "LossRecord.project": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := lossrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "LossRecord.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LossRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lossrecord.Table, lossrecord.Columns, sqlgraph.NewFieldSpec(lossrecord.FieldID, field.TypeString))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(lossrecord.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeviceType(); ok {
+		_spec.SetField(lossrecord.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(lossrecord.FieldProject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(lossrecord.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ParentUsage(); ok {
+		_spec.SetField(lossrecord.FieldParentUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentUsage(); ok {
+		_spec.AddField(lossrecord.FieldParentUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChildrenUsage(); ok {
+		_spec.SetField(lossrecord.FieldChildrenUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChildrenUsage(); ok {
+		_spec.AddField(lossrecord.FieldChildrenUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Children(); ok {
+		_spec.SetField(lossrecord.FieldChildren, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChildren(); ok {
+		_spec.AddField(lossrecord.FieldChildren, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LossPct(); ok {
+		_spec.SetField(lossrecord.FieldLossPct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLossPct(); ok {
+		_spec.AddField(lossrecord.FieldLossPct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Missing(); ok {
+		_spec.SetField(lossrecord.FieldMissing, field.TypeString, value)
+	}
+	if _u.mutation.MissingCleared() {
+		_spec.ClearField(lossrecord.FieldMissing, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(lossrecord.FieldState, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lossrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// LossRecordUpdateOne is the builder for updating a single LossRecord entity.
+type LossRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *LossRecordMutation
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (_u *LossRecordUpdateOne) SetUpdateTime(v time.Time) *LossRecordUpdateOne {
+	_u.mutation.SetUpdateTime(v)
+	return _u
+}
+
+// SetDeviceType sets the "device_type" field.
+func (_u *LossRecordUpdateOne) SetDeviceType(v string) *LossRecordUpdateOne {
+	_u.mutation.SetDeviceType(v)
+	return _u
+}
+
+// SetNillableDeviceType sets the "device_type" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableDeviceType(v *string) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetDeviceType(*v)
+	}
+	return _u
+}
+
+// SetProject sets the "project" field.
+func (_u *LossRecordUpdateOne) SetProject(v string) *LossRecordUpdateOne {
+	_u.mutation.SetProject(v)
+	return _u
+}
+
+// SetNillableProject sets the "project" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableProject(v *string) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetProject(*v)
+	}
+	return _u
+}
+
+// SetEndTime sets the "end_time" field.
+func (_u *LossRecordUpdateOne) SetEndTime(v time.Time) *LossRecordUpdateOne {
+	_u.mutation.SetEndTime(v)
+	return _u
+}
+
+// SetNillableEndTime sets the "end_time" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableEndTime(v *time.Time) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetEndTime(*v)
+	}
+	return _u
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (_u *LossRecordUpdateOne) SetParentUsage(v int64) *LossRecordUpdateOne {
+	_u.mutation.ResetParentUsage()
+	_u.mutation.SetParentUsage(v)
+	return _u
+}
+
+// SetNillableParentUsage sets the "parent_usage" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableParentUsage(v *int64) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetParentUsage(*v)
+	}
+	return _u
+}
+
+// AddParentUsage adds value to the "parent_usage" field.
+func (_u *LossRecordUpdateOne) AddParentUsage(v int64) *LossRecordUpdateOne {
+	_u.mutation.AddParentUsage(v)
+	return _u
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (_u *LossRecordUpdateOne) SetChildrenUsage(v int64) *LossRecordUpdateOne {
+	_u.mutation.ResetChildrenUsage()
+	_u.mutation.SetChildrenUsage(v)
+	return _u
+}
+
+// SetNillableChildrenUsage sets the "children_usage" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableChildrenUsage(v *int64) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetChildrenUsage(*v)
+	}
+	return _u
+}
+
+// AddChildrenUsage adds value to the "children_usage" field.
+func (_u *LossRecordUpdateOne) AddChildrenUsage(v int64) *LossRecordUpdateOne {
+	_u.mutation.AddChildrenUsage(v)
+	return _u
+}
+
+// SetChildren sets the "children" field.
+func (_u *LossRecordUpdateOne) SetChildren(v int) *LossRecordUpdateOne {
+	_u.mutation.ResetChildren()
+	_u.mutation.SetChildren(v)
+	return _u
+}
+
+// SetNillableChildren sets the "children" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableChildren(v *int) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetChildren(*v)
+	}
+	return _u
+}
+
+// AddChildren adds value to the "children" field.
+func (_u *LossRecordUpdateOne) AddChildren(v int) *LossRecordUpdateOne {
+	_u.mutation.AddChildren(v)
+	return _u
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (_u *LossRecordUpdateOne) SetLossPct(v float64) *LossRecordUpdateOne {
+	_u.mutation.ResetLossPct()
+	_u.mutation.SetLossPct(v)
+	return _u
+}
+
+// SetNillableLossPct sets the "loss_pct" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableLossPct(v *float64) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetLossPct(*v)
+	}
+	return _u
+}
+
+// AddLossPct adds value to the "loss_pct" field.
+func (_u *LossRecordUpdateOne) AddLossPct(v float64) *LossRecordUpdateOne {
+	_u.mutation.AddLossPct(v)
+	return _u
+}
+
+// SetMissing sets the "missing" field.
+func (_u *LossRecordUpdateOne) SetMissing(v string) *LossRecordUpdateOne {
+	_u.mutation.SetMissing(v)
+	return _u
+}
+
+// SetNillableMissing sets the "missing" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableMissing(v *string) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetMissing(*v)
+	}
+	return _u
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (_u *LossRecordUpdateOne) ClearMissing() *LossRecordUpdateOne {
+	_u.mutation.ClearMissing()
+	return _u
+}
+
+// SetState sets the "state" field.
+func (_u *LossRecordUpdateOne) SetState(v string) *LossRecordUpdateOne {
+	_u.mutation.SetState(v)
+	return _u
+}
+
+// SetNillableState sets the "state" field if the given value is not nil.
+func (_u *LossRecordUpdateOne) SetNillableState(v *string) *LossRecordUpdateOne {
+	if v != nil {
+		_u.SetState(*v)
+	}
+	return _u
+}
+
+// Mutation returns the LossRecordMutation object of the builder.
+func (_u *LossRecordUpdateOne) Mutation() *LossRecordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the LossRecordUpdate builder.
+func (_u *LossRecordUpdateOne) Where(ps ...predicate.LossRecord) *LossRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *LossRecordUpdateOne) Select(field string, fields ...string) *LossRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated LossRecord entity.
+func (_u *LossRecordUpdateOne) Save(ctx context.Context) (*LossRecord, error) {
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *LossRecordUpdateOne) SaveX(ctx context.Context) *LossRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *LossRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *LossRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *LossRecordUpdateOne) defaults() error {
+	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if lossrecord.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized lossrecord.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
+		v := lossrecord.UpdateDefaultUpdateTime()
+		_u.mutation.SetUpdateTime(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *LossRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.DeviceType(); ok {
+		if err := lossrecord.DeviceTypeValidator(v); err != nil {
+			return &ValidationError{Name: "device_type", err: fmt.Errorf(`ent: validator failed for field "LossRecord.device_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Project(); ok {
+		if err := lossrecord.ProjectValidator(v); err != nil {
+			return &ValidationError{Name: "project", err: fmt.Errorf(`ent: validator failed for field "LossRecord.project": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.State(); ok {
+		if err := lossrecord.StateValidator(v); err != nil {
+			return &ValidationError{Name: "state", err: fmt.Errorf(`ent: validator failed for field "LossRecord.state": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *LossRecordUpdateOne) sqlSave(ctx context.Context) (_node *LossRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(lossrecord.Table, lossrecord.Columns, sqlgraph.NewFieldSpec(lossrecord.FieldID, field.TypeString))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "LossRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, lossrecord.FieldID)
+		for _, f := range fields {
+			if !lossrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != lossrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdateTime(); ok {
+		_spec.SetField(lossrecord.FieldUpdateTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.DeviceType(); ok {
+		_spec.SetField(lossrecord.FieldDeviceType, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Project(); ok {
+		_spec.SetField(lossrecord.FieldProject, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.EndTime(); ok {
+		_spec.SetField(lossrecord.FieldEndTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ParentUsage(); ok {
+		_spec.SetField(lossrecord.FieldParentUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedParentUsage(); ok {
+		_spec.AddField(lossrecord.FieldParentUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ChildrenUsage(); ok {
+		_spec.SetField(lossrecord.FieldChildrenUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedChildrenUsage(); ok {
+		_spec.AddField(lossrecord.FieldChildrenUsage, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Children(); ok {
+		_spec.SetField(lossrecord.FieldChildren, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedChildren(); ok {
+		_spec.AddField(lossrecord.FieldChildren, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LossPct(); ok {
+		_spec.SetField(lossrecord.FieldLossPct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLossPct(); ok {
+		_spec.AddField(lossrecord.FieldLossPct, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Missing(); ok {
+		_spec.SetField(lossrecord.FieldMissing, field.TypeString, value)
+	}
+	if _u.mutation.MissingCleared() {
+		_spec.ClearField(lossrecord.FieldMissing, field.TypeString)
+	}
+	if value, ok := _u.mutation.State(); ok {
+		_spec.SetField(lossrecord.FieldState, field.TypeString, value)
+	}
+	_node = &LossRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{lossrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
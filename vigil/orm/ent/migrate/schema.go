@@ -9,6 +9,46 @@ import (
 )
 
 var (
+	// LossRecordColumns holds the columns for the "loss_record" table.
+	LossRecordColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
+		{Name: "create_time", Type: field.TypeTime},
+		{Name: "update_time", Type: field.TypeTime},
+		{Name: "device_code", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "device_type", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "project", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(64)", "postgres": "varchar(64)", "sqlite3": "varchar(64)"}},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "parent_usage", Type: field.TypeInt64, Default: 0},
+		{Name: "children_usage", Type: field.TypeInt64, Default: 0},
+		{Name: "children", Type: field.TypeInt, Default: 0},
+		{Name: "loss_pct", Type: field.TypeFloat64, Default: 0},
+		{Name: "missing", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "state", Type: field.TypeString, SchemaType: map[string]string{"mysql": "varchar(16)", "postgres": "varchar(16)", "sqlite3": "varchar(16)"}},
+	}
+	// LossRecordTable holds the schema information for the "loss_record" table.
+	LossRecordTable = &schema.Table{
+		Name:       "loss_record",
+		Columns:    LossRecordColumns,
+		PrimaryKey: []*schema.Column{LossRecordColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "lossrecord_device_code_start_time",
+				Unique:  true,
+				Columns: []*schema.Column{LossRecordColumns[3], LossRecordColumns[6]},
+			},
+			{
+				Name:    "lossrecord_project_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{LossRecordColumns[5], LossRecordColumns[6]},
+			},
+			{
+				Name:    "lossrecord_state",
+				Unique:  false,
+				Columns: []*schema.Column{LossRecordColumns[13]},
+			},
+		},
+	}
 	// NhRecordColumns holds the columns for the "nh_record" table.
 	NhRecordColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString, SchemaType: map[string]string{"mysql": "char(36)", "postgres": "char(36)", "sqlite3": "char(36)"}},
@@ -76,11 +116,15 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		LossRecordTable,
 		NhRecordTable,
 	}
 )
 
 func init() {
+	LossRecordTable.Annotation = &entsql.Annotation{
+		Table: "loss_record",
+	}
 	NhRecordTable.Annotation = &entsql.Annotation{
 		Table: "nh_record",
 	}
@@ -11,6 +11,7 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
 	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
 	"github.com/twiglab/h2o/vigil/orm/ent/predicate"
 )
@@ -24,9 +25,1147 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeNhRecord = "NhRecord"
+	TypeLossRecord = "LossRecord"
+	TypeNhRecord   = "NhRecord"
 )
 
+// LossRecordMutation represents an operation that mutates the LossRecord nodes in the graph.
+type LossRecordMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *string
+	create_time       *time.Time
+	update_time       *time.Time
+	device_code       *string
+	device_type       *string
+	project           *string
+	start_time        *time.Time
+	end_time          *time.Time
+	parent_usage      *int64
+	addparent_usage   *int64
+	children_usage    *int64
+	addchildren_usage *int64
+	children          *int
+	addchildren       *int
+	loss_pct          *float64
+	addloss_pct       *float64
+	missing           *string
+	state             *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*LossRecord, error)
+	predicates        []predicate.LossRecord
+}
+
+var _ ent.Mutation = (*LossRecordMutation)(nil)
+
+// lossrecordOption allows management of the mutation configuration using functional options.
+type lossrecordOption func(*LossRecordMutation)
+
+// newLossRecordMutation creates new mutation for the LossRecord entity.
+func newLossRecordMutation(c config, op Op, opts ...lossrecordOption) *LossRecordMutation {
+	m := &LossRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLossRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLossRecordID sets the ID field of the mutation.
+func withLossRecordID(id string) lossrecordOption {
+	return func(m *LossRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LossRecord
+		)
+		m.oldValue = func(ctx context.Context) (*LossRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LossRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLossRecord sets the old LossRecord of the mutation.
+func withLossRecord(node *LossRecord) lossrecordOption {
+	return func(m *LossRecordMutation) {
+		m.oldValue = func(context.Context) (*LossRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LossRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LossRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LossRecord entities.
+func (m *LossRecordMutation) SetID(id string) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LossRecordMutation) ID() (id string, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LossRecordMutation) IDs(ctx context.Context) ([]string, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []string{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LossRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreateTime sets the "create_time" field.
+func (m *LossRecordMutation) SetCreateTime(t time.Time) {
+	m.create_time = &t
+}
+
+// CreateTime returns the value of the "create_time" field in the mutation.
+func (m *LossRecordMutation) CreateTime() (r time.Time, exists bool) {
+	v := m.create_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreateTime returns the old "create_time" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldCreateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreateTime: %w", err)
+	}
+	return oldValue.CreateTime, nil
+}
+
+// ResetCreateTime resets all changes to the "create_time" field.
+func (m *LossRecordMutation) ResetCreateTime() {
+	m.create_time = nil
+}
+
+// SetUpdateTime sets the "update_time" field.
+func (m *LossRecordMutation) SetUpdateTime(t time.Time) {
+	m.update_time = &t
+}
+
+// UpdateTime returns the value of the "update_time" field in the mutation.
+func (m *LossRecordMutation) UpdateTime() (r time.Time, exists bool) {
+	v := m.update_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdateTime returns the old "update_time" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldUpdateTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdateTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdateTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdateTime: %w", err)
+	}
+	return oldValue.UpdateTime, nil
+}
+
+// ResetUpdateTime resets all changes to the "update_time" field.
+func (m *LossRecordMutation) ResetUpdateTime() {
+	m.update_time = nil
+}
+
+// SetDeviceCode sets the "device_code" field.
+func (m *LossRecordMutation) SetDeviceCode(s string) {
+	m.device_code = &s
+}
+
+// DeviceCode returns the value of the "device_code" field in the mutation.
+func (m *LossRecordMutation) DeviceCode() (r string, exists bool) {
+	v := m.device_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceCode returns the old "device_code" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldDeviceCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceCode: %w", err)
+	}
+	return oldValue.DeviceCode, nil
+}
+
+// ResetDeviceCode resets all changes to the "device_code" field.
+func (m *LossRecordMutation) ResetDeviceCode() {
+	m.device_code = nil
+}
+
+// SetDeviceType sets the "device_type" field.
+func (m *LossRecordMutation) SetDeviceType(s string) {
+	m.device_type = &s
+}
+
+// DeviceType returns the value of the "device_type" field in the mutation.
+func (m *LossRecordMutation) DeviceType() (r string, exists bool) {
+	v := m.device_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceType returns the old "device_type" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldDeviceType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceType: %w", err)
+	}
+	return oldValue.DeviceType, nil
+}
+
+// ResetDeviceType resets all changes to the "device_type" field.
+func (m *LossRecordMutation) ResetDeviceType() {
+	m.device_type = nil
+}
+
+// SetProject sets the "project" field.
+func (m *LossRecordMutation) SetProject(s string) {
+	m.project = &s
+}
+
+// Project returns the value of the "project" field in the mutation.
+func (m *LossRecordMutation) Project() (r string, exists bool) {
+	v := m.project
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProject returns the old "project" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldProject(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProject is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProject requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProject: %w", err)
+	}
+	return oldValue.Project, nil
+}
+
+// ResetProject resets all changes to the "project" field.
+func (m *LossRecordMutation) ResetProject() {
+	m.project = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *LossRecordMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *LossRecordMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *LossRecordMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *LossRecordMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *LossRecordMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *LossRecordMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetParentUsage sets the "parent_usage" field.
+func (m *LossRecordMutation) SetParentUsage(i int64) {
+	m.parent_usage = &i
+	m.addparent_usage = nil
+}
+
+// ParentUsage returns the value of the "parent_usage" field in the mutation.
+func (m *LossRecordMutation) ParentUsage() (r int64, exists bool) {
+	v := m.parent_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParentUsage returns the old "parent_usage" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldParentUsage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParentUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParentUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParentUsage: %w", err)
+	}
+	return oldValue.ParentUsage, nil
+}
+
+// AddParentUsage adds i to the "parent_usage" field.
+func (m *LossRecordMutation) AddParentUsage(i int64) {
+	if m.addparent_usage != nil {
+		*m.addparent_usage += i
+	} else {
+		m.addparent_usage = &i
+	}
+}
+
+// AddedParentUsage returns the value that was added to the "parent_usage" field in this mutation.
+func (m *LossRecordMutation) AddedParentUsage() (r int64, exists bool) {
+	v := m.addparent_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParentUsage resets all changes to the "parent_usage" field.
+func (m *LossRecordMutation) ResetParentUsage() {
+	m.parent_usage = nil
+	m.addparent_usage = nil
+}
+
+// SetChildrenUsage sets the "children_usage" field.
+func (m *LossRecordMutation) SetChildrenUsage(i int64) {
+	m.children_usage = &i
+	m.addchildren_usage = nil
+}
+
+// ChildrenUsage returns the value of the "children_usage" field in the mutation.
+func (m *LossRecordMutation) ChildrenUsage() (r int64, exists bool) {
+	v := m.children_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChildrenUsage returns the old "children_usage" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldChildrenUsage(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChildrenUsage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChildrenUsage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChildrenUsage: %w", err)
+	}
+	return oldValue.ChildrenUsage, nil
+}
+
+// AddChildrenUsage adds i to the "children_usage" field.
+func (m *LossRecordMutation) AddChildrenUsage(i int64) {
+	if m.addchildren_usage != nil {
+		*m.addchildren_usage += i
+	} else {
+		m.addchildren_usage = &i
+	}
+}
+
+// AddedChildrenUsage returns the value that was added to the "children_usage" field in this mutation.
+func (m *LossRecordMutation) AddedChildrenUsage() (r int64, exists bool) {
+	v := m.addchildren_usage
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChildrenUsage resets all changes to the "children_usage" field.
+func (m *LossRecordMutation) ResetChildrenUsage() {
+	m.children_usage = nil
+	m.addchildren_usage = nil
+}
+
+// SetChildren sets the "children" field.
+func (m *LossRecordMutation) SetChildren(i int) {
+	m.children = &i
+	m.addchildren = nil
+}
+
+// Children returns the value of the "children" field in the mutation.
+func (m *LossRecordMutation) Children() (r int, exists bool) {
+	v := m.children
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChildren returns the old "children" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldChildren(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChildren is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChildren requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChildren: %w", err)
+	}
+	return oldValue.Children, nil
+}
+
+// AddChildren adds i to the "children" field.
+func (m *LossRecordMutation) AddChildren(i int) {
+	if m.addchildren != nil {
+		*m.addchildren += i
+	} else {
+		m.addchildren = &i
+	}
+}
+
+// AddedChildren returns the value that was added to the "children" field in this mutation.
+func (m *LossRecordMutation) AddedChildren() (r int, exists bool) {
+	v := m.addchildren
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetChildren resets all changes to the "children" field.
+func (m *LossRecordMutation) ResetChildren() {
+	m.children = nil
+	m.addchildren = nil
+}
+
+// SetLossPct sets the "loss_pct" field.
+func (m *LossRecordMutation) SetLossPct(f float64) {
+	m.loss_pct = &f
+	m.addloss_pct = nil
+}
+
+// LossPct returns the value of the "loss_pct" field in the mutation.
+func (m *LossRecordMutation) LossPct() (r float64, exists bool) {
+	v := m.loss_pct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLossPct returns the old "loss_pct" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldLossPct(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLossPct is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLossPct requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLossPct: %w", err)
+	}
+	return oldValue.LossPct, nil
+}
+
+// AddLossPct adds f to the "loss_pct" field.
+func (m *LossRecordMutation) AddLossPct(f float64) {
+	if m.addloss_pct != nil {
+		*m.addloss_pct += f
+	} else {
+		m.addloss_pct = &f
+	}
+}
+
+// AddedLossPct returns the value that was added to the "loss_pct" field in this mutation.
+func (m *LossRecordMutation) AddedLossPct() (r float64, exists bool) {
+	v := m.addloss_pct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLossPct resets all changes to the "loss_pct" field.
+func (m *LossRecordMutation) ResetLossPct() {
+	m.loss_pct = nil
+	m.addloss_pct = nil
+}
+
+// SetMissing sets the "missing" field.
+func (m *LossRecordMutation) SetMissing(s string) {
+	m.missing = &s
+}
+
+// Missing returns the value of the "missing" field in the mutation.
+func (m *LossRecordMutation) Missing() (r string, exists bool) {
+	v := m.missing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMissing returns the old "missing" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldMissing(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMissing is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMissing requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMissing: %w", err)
+	}
+	return oldValue.Missing, nil
+}
+
+// ClearMissing clears the value of the "missing" field.
+func (m *LossRecordMutation) ClearMissing() {
+	m.missing = nil
+	m.clearedFields[lossrecord.FieldMissing] = struct{}{}
+}
+
+// MissingCleared returns if the "missing" field was cleared in this mutation.
+func (m *LossRecordMutation) MissingCleared() bool {
+	_, ok := m.clearedFields[lossrecord.FieldMissing]
+	return ok
+}
+
+// ResetMissing resets all changes to the "missing" field.
+func (m *LossRecordMutation) ResetMissing() {
+	m.missing = nil
+	delete(m.clearedFields, lossrecord.FieldMissing)
+}
+
+// SetState sets the "state" field.
+func (m *LossRecordMutation) SetState(s string) {
+	m.state = &s
+}
+
+// State returns the value of the "state" field in the mutation.
+func (m *LossRecordMutation) State() (r string, exists bool) {
+	v := m.state
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldState returns the old "state" field's value of the LossRecord entity.
+// If the LossRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LossRecordMutation) OldState(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldState is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldState requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldState: %w", err)
+	}
+	return oldValue.State, nil
+}
+
+// ResetState resets all changes to the "state" field.
+func (m *LossRecordMutation) ResetState() {
+	m.state = nil
+}
+
+// Where appends a list predicates to the LossRecordMutation builder.
+func (m *LossRecordMutation) Where(ps ...predicate.LossRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LossRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LossRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LossRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LossRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LossRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LossRecord).
+func (m *LossRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LossRecordMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.create_time != nil {
+		fields = append(fields, lossrecord.FieldCreateTime)
+	}
+	if m.update_time != nil {
+		fields = append(fields, lossrecord.FieldUpdateTime)
+	}
+	if m.device_code != nil {
+		fields = append(fields, lossrecord.FieldDeviceCode)
+	}
+	if m.device_type != nil {
+		fields = append(fields, lossrecord.FieldDeviceType)
+	}
+	if m.project != nil {
+		fields = append(fields, lossrecord.FieldProject)
+	}
+	if m.start_time != nil {
+		fields = append(fields, lossrecord.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, lossrecord.FieldEndTime)
+	}
+	if m.parent_usage != nil {
+		fields = append(fields, lossrecord.FieldParentUsage)
+	}
+	if m.children_usage != nil {
+		fields = append(fields, lossrecord.FieldChildrenUsage)
+	}
+	if m.children != nil {
+		fields = append(fields, lossrecord.FieldChildren)
+	}
+	if m.loss_pct != nil {
+		fields = append(fields, lossrecord.FieldLossPct)
+	}
+	if m.missing != nil {
+		fields = append(fields, lossrecord.FieldMissing)
+	}
+	if m.state != nil {
+		fields = append(fields, lossrecord.FieldState)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LossRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case lossrecord.FieldCreateTime:
+		return m.CreateTime()
+	case lossrecord.FieldUpdateTime:
+		return m.UpdateTime()
+	case lossrecord.FieldDeviceCode:
+		return m.DeviceCode()
+	case lossrecord.FieldDeviceType:
+		return m.DeviceType()
+	case lossrecord.FieldProject:
+		return m.Project()
+	case lossrecord.FieldStartTime:
+		return m.StartTime()
+	case lossrecord.FieldEndTime:
+		return m.EndTime()
+	case lossrecord.FieldParentUsage:
+		return m.ParentUsage()
+	case lossrecord.FieldChildrenUsage:
+		return m.ChildrenUsage()
+	case lossrecord.FieldChildren:
+		return m.Children()
+	case lossrecord.FieldLossPct:
+		return m.LossPct()
+	case lossrecord.FieldMissing:
+		return m.Missing()
+	case lossrecord.FieldState:
+		return m.State()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LossRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case lossrecord.FieldCreateTime:
+		return m.OldCreateTime(ctx)
+	case lossrecord.FieldUpdateTime:
+		return m.OldUpdateTime(ctx)
+	case lossrecord.FieldDeviceCode:
+		return m.OldDeviceCode(ctx)
+	case lossrecord.FieldDeviceType:
+		return m.OldDeviceType(ctx)
+	case lossrecord.FieldProject:
+		return m.OldProject(ctx)
+	case lossrecord.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case lossrecord.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case lossrecord.FieldParentUsage:
+		return m.OldParentUsage(ctx)
+	case lossrecord.FieldChildrenUsage:
+		return m.OldChildrenUsage(ctx)
+	case lossrecord.FieldChildren:
+		return m.OldChildren(ctx)
+	case lossrecord.FieldLossPct:
+		return m.OldLossPct(ctx)
+	case lossrecord.FieldMissing:
+		return m.OldMissing(ctx)
+	case lossrecord.FieldState:
+		return m.OldState(ctx)
+	}
+	return nil, fmt.Errorf("unknown LossRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LossRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case lossrecord.FieldCreateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreateTime(v)
+		return nil
+	case lossrecord.FieldUpdateTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdateTime(v)
+		return nil
+	case lossrecord.FieldDeviceCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceCode(v)
+		return nil
+	case lossrecord.FieldDeviceType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceType(v)
+		return nil
+	case lossrecord.FieldProject:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProject(v)
+		return nil
+	case lossrecord.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case lossrecord.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case lossrecord.FieldParentUsage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParentUsage(v)
+		return nil
+	case lossrecord.FieldChildrenUsage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChildrenUsage(v)
+		return nil
+	case lossrecord.FieldChildren:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChildren(v)
+		return nil
+	case lossrecord.FieldLossPct:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLossPct(v)
+		return nil
+	case lossrecord.FieldMissing:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMissing(v)
+		return nil
+	case lossrecord.FieldState:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetState(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LossRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LossRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.addparent_usage != nil {
+		fields = append(fields, lossrecord.FieldParentUsage)
+	}
+	if m.addchildren_usage != nil {
+		fields = append(fields, lossrecord.FieldChildrenUsage)
+	}
+	if m.addchildren != nil {
+		fields = append(fields, lossrecord.FieldChildren)
+	}
+	if m.addloss_pct != nil {
+		fields = append(fields, lossrecord.FieldLossPct)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LossRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case lossrecord.FieldParentUsage:
+		return m.AddedParentUsage()
+	case lossrecord.FieldChildrenUsage:
+		return m.AddedChildrenUsage()
+	case lossrecord.FieldChildren:
+		return m.AddedChildren()
+	case lossrecord.FieldLossPct:
+		return m.AddedLossPct()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LossRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case lossrecord.FieldParentUsage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParentUsage(v)
+		return nil
+	case lossrecord.FieldChildrenUsage:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChildrenUsage(v)
+		return nil
+	case lossrecord.FieldChildren:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddChildren(v)
+		return nil
+	case lossrecord.FieldLossPct:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLossPct(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LossRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LossRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(lossrecord.FieldMissing) {
+		fields = append(fields, lossrecord.FieldMissing)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LossRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LossRecordMutation) ClearField(name string) error {
+	switch name {
+	case lossrecord.FieldMissing:
+		m.ClearMissing()
+		return nil
+	}
+	return fmt.Errorf("unknown LossRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LossRecordMutation) ResetField(name string) error {
+	switch name {
+	case lossrecord.FieldCreateTime:
+		m.ResetCreateTime()
+		return nil
+	case lossrecord.FieldUpdateTime:
+		m.ResetUpdateTime()
+		return nil
+	case lossrecord.FieldDeviceCode:
+		m.ResetDeviceCode()
+		return nil
+	case lossrecord.FieldDeviceType:
+		m.ResetDeviceType()
+		return nil
+	case lossrecord.FieldProject:
+		m.ResetProject()
+		return nil
+	case lossrecord.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case lossrecord.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case lossrecord.FieldParentUsage:
+		m.ResetParentUsage()
+		return nil
+	case lossrecord.FieldChildrenUsage:
+		m.ResetChildrenUsage()
+		return nil
+	case lossrecord.FieldChildren:
+		m.ResetChildren()
+		return nil
+	case lossrecord.FieldLossPct:
+		m.ResetLossPct()
+		return nil
+	case lossrecord.FieldMissing:
+		m.ResetMissing()
+		return nil
+	case lossrecord.FieldState:
+		m.ResetState()
+		return nil
+	}
+	return fmt.Errorf("unknown LossRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LossRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LossRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LossRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LossRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LossRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LossRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LossRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown LossRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LossRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown LossRecord edge %s", name)
+}
+
 // NhRecordMutation represents an operation that mutates the NhRecord nodes in the graph.
 type NhRecordMutation struct {
 	config
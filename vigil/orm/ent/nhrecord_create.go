@@ -178,7 +178,9 @@ func (_c *NhRecordCreate) Mutation() *NhRecordMutation {
 
 // Save creates the NhRecord in the database.
 func (_c *NhRecordCreate) Save(ctx context.Context) (*NhRecord, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -205,12 +207,18 @@ func (_c *NhRecordCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *NhRecordCreate) defaults() {
+func (_c *NhRecordCreate) defaults() error {
 	if _, ok := _c.mutation.CreateTime(); !ok {
+		if nhrecord.DefaultCreateTime == nil {
+			return fmt.Errorf("ent: uninitialized nhrecord.DefaultCreateTime (forgotten import ent/runtime?)")
+		}
 		v := nhrecord.DefaultCreateTime()
 		_c.mutation.SetCreateTime(v)
 	}
 	if _, ok := _c.mutation.UpdateTime(); !ok {
+		if nhrecord.DefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized nhrecord.DefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := nhrecord.DefaultUpdateTime()
 		_c.mutation.SetUpdateTime(v)
 	}
@@ -219,9 +227,13 @@ func (_c *NhRecordCreate) defaults() {
 		_c.mutation.SetDataValue(v)
 	}
 	if _, ok := _c.mutation.ID(); !ok {
+		if nhrecord.DefaultID == nil {
+			return fmt.Errorf("ent: uninitialized nhrecord.DefaultID (forgotten import ent/runtime?)")
+		}
 		v := nhrecord.DefaultID()
 		_c.mutation.SetID(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -41,7 +41,9 @@ func (_u *NhRecordUpdate) Mutation() *NhRecordMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *NhRecordUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -68,11 +70,15 @@ func (_u *NhRecordUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *NhRecordUpdate) defaults() {
+func (_u *NhRecordUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if nhrecord.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized nhrecord.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := nhrecord.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 func (_u *NhRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -145,7 +151,9 @@ func (_u *NhRecordUpdateOne) Select(field string, fields ...string) *NhRecordUpd
 
 // Save executes the query and returns the updated NhRecord entity.
 func (_u *NhRecordUpdateOne) Save(ctx context.Context) (*NhRecord, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -172,11 +180,15 @@ func (_u *NhRecordUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *NhRecordUpdateOne) defaults() {
+func (_u *NhRecordUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdateTime(); !ok {
+		if nhrecord.UpdateDefaultUpdateTime == nil {
+			return fmt.Errorf("ent: uninitialized nhrecord.UpdateDefaultUpdateTime (forgotten import ent/runtime?)")
+		}
 		v := nhrecord.UpdateDefaultUpdateTime()
 		_u.mutation.SetUpdateTime(v)
 	}
+	return nil
 }
 
 func (_u *NhRecordUpdateOne) sqlSave(ctx context.Context) (_node *NhRecord, err error) {
@@ -6,5 +6,8 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// LossRecord is the predicate function for lossrecord builders.
+type LossRecord func(*sql.Selector)
+
 // NhRecord is the predicate function for nhrecord builders.
 type NhRecord func(*sql.Selector)
@@ -110,6 +110,30 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The LossRecordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type LossRecordQueryRuleFunc func(context.Context, *ent.LossRecordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f LossRecordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.LossRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.LossRecordQuery", q)
+}
+
+// The LossRecordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type LossRecordMutationRuleFunc func(context.Context, *ent.LossRecordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f LossRecordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.LossRecordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.LossRecordMutation", m)
+}
+
 // The NhRecordQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type NhRecordQueryRuleFunc func(context.Context, *ent.NhRecordQuery) error
@@ -2,60 +2,4 @@
 
 package ent
 
-import (
-	"time"
-
-	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
-	"github.com/twiglab/h2o/vigil/orm/schema"
-)
-
-// The init function reads all schema descriptors with runtime code
-// (default values, validators, hooks and policies) and stitches it
-// to their package variables.
-func init() {
-	nhrecordMixin := schema.NhRecord{}.Mixin()
-	nhrecordMixinFields0 := nhrecordMixin[0].Fields()
-	_ = nhrecordMixinFields0
-	nhrecordFields := schema.NhRecord{}.Fields()
-	_ = nhrecordFields
-	// nhrecordDescCreateTime is the schema descriptor for create_time field.
-	nhrecordDescCreateTime := nhrecordMixinFields0[0].Descriptor()
-	// nhrecord.DefaultCreateTime holds the default value on creation for the create_time field.
-	nhrecord.DefaultCreateTime = nhrecordDescCreateTime.Default.(func() time.Time)
-	// nhrecordDescUpdateTime is the schema descriptor for update_time field.
-	nhrecordDescUpdateTime := nhrecordMixinFields0[1].Descriptor()
-	// nhrecord.DefaultUpdateTime holds the default value on creation for the update_time field.
-	nhrecord.DefaultUpdateTime = nhrecordDescUpdateTime.Default.(func() time.Time)
-	// nhrecord.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
-	nhrecord.UpdateDefaultUpdateTime = nhrecordDescUpdateTime.UpdateDefault.(func() time.Time)
-	// nhrecordDescDeviceCode is the schema descriptor for device_code field.
-	nhrecordDescDeviceCode := nhrecordFields[2].Descriptor()
-	// nhrecord.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
-	nhrecord.DeviceCodeValidator = nhrecordDescDeviceCode.Validators[0].(func(string) error)
-	// nhrecordDescDeviceType is the schema descriptor for device_type field.
-	nhrecordDescDeviceType := nhrecordFields[3].Descriptor()
-	// nhrecord.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
-	nhrecord.DeviceTypeValidator = nhrecordDescDeviceType.Validators[0].(func(string) error)
-	// nhrecordDescDataValue is the schema descriptor for data_value field.
-	nhrecordDescDataValue := nhrecordFields[5].Descriptor()
-	// nhrecord.DefaultDataValue holds the default value on creation for the data_value field.
-	nhrecord.DefaultDataValue = nhrecordDescDataValue.Default.(int64)
-	// nhrecordDescDataCode is the schema descriptor for data_code field.
-	nhrecordDescDataCode := nhrecordFields[6].Descriptor()
-	// nhrecord.DataCodeValidator is a validator for the "data_code" field. It is called by the builders before save.
-	nhrecord.DataCodeValidator = nhrecordDescDataCode.Validators[0].(func(string) error)
-	// nhrecordDescDataTs is the schema descriptor for data_ts field.
-	nhrecordDescDataTs := nhrecordFields[8].Descriptor()
-	// nhrecord.DataTsValidator is a validator for the "data_ts" field. It is called by the builders before save.
-	nhrecord.DataTsValidator = nhrecordDescDataTs.Validators[0].(func(string) error)
-	// nhrecordDescProject is the schema descriptor for project field.
-	nhrecordDescProject := nhrecordFields[9].Descriptor()
-	// nhrecord.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
-	nhrecord.ProjectValidator = nhrecordDescProject.Validators[0].(func(string) error)
-	// nhrecordDescID is the schema descriptor for id field.
-	nhrecordDescID := nhrecordFields[0].Descriptor()
-	// nhrecord.DefaultID holds the default value on creation for the id field.
-	nhrecord.DefaultID = nhrecordDescID.Default.(func() string)
-	// nhrecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
-	nhrecord.IDValidator = nhrecordDescID.Validators[0].(func(string) error)
-}
+// The schema-stitching logic is generated in github.com/twiglab/h2o/vigil/orm/ent/runtime/runtime.go
@@ -2,7 +2,139 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/twiglab/h2o/vigil/orm/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
+	"github.com/twiglab/h2o/vigil/orm/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	lossrecordMixin := schema.LossRecord{}.Mixin()
+	lossrecord.Policy = privacy.NewPolicies(schema.LossRecord{})
+	lossrecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := lossrecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	lossrecordMixinFields0 := lossrecordMixin[0].Fields()
+	_ = lossrecordMixinFields0
+	lossrecordFields := schema.LossRecord{}.Fields()
+	_ = lossrecordFields
+	// lossrecordDescCreateTime is the schema descriptor for create_time field.
+	lossrecordDescCreateTime := lossrecordMixinFields0[0].Descriptor()
+	// lossrecord.DefaultCreateTime holds the default value on creation for the create_time field.
+	lossrecord.DefaultCreateTime = lossrecordDescCreateTime.Default.(func() time.Time)
+	// lossrecordDescUpdateTime is the schema descriptor for update_time field.
+	lossrecordDescUpdateTime := lossrecordMixinFields0[1].Descriptor()
+	// lossrecord.DefaultUpdateTime holds the default value on creation for the update_time field.
+	lossrecord.DefaultUpdateTime = lossrecordDescUpdateTime.Default.(func() time.Time)
+	// lossrecord.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	lossrecord.UpdateDefaultUpdateTime = lossrecordDescUpdateTime.UpdateDefault.(func() time.Time)
+	// lossrecordDescDeviceCode is the schema descriptor for device_code field.
+	lossrecordDescDeviceCode := lossrecordFields[1].Descriptor()
+	// lossrecord.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	lossrecord.DeviceCodeValidator = lossrecordDescDeviceCode.Validators[0].(func(string) error)
+	// lossrecordDescDeviceType is the schema descriptor for device_type field.
+	lossrecordDescDeviceType := lossrecordFields[2].Descriptor()
+	// lossrecord.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	lossrecord.DeviceTypeValidator = lossrecordDescDeviceType.Validators[0].(func(string) error)
+	// lossrecordDescProject is the schema descriptor for project field.
+	lossrecordDescProject := lossrecordFields[3].Descriptor()
+	// lossrecord.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	lossrecord.ProjectValidator = lossrecordDescProject.Validators[0].(func(string) error)
+	// lossrecordDescParentUsage is the schema descriptor for parent_usage field.
+	lossrecordDescParentUsage := lossrecordFields[6].Descriptor()
+	// lossrecord.DefaultParentUsage holds the default value on creation for the parent_usage field.
+	lossrecord.DefaultParentUsage = lossrecordDescParentUsage.Default.(int64)
+	// lossrecordDescChildrenUsage is the schema descriptor for children_usage field.
+	lossrecordDescChildrenUsage := lossrecordFields[7].Descriptor()
+	// lossrecord.DefaultChildrenUsage holds the default value on creation for the children_usage field.
+	lossrecord.DefaultChildrenUsage = lossrecordDescChildrenUsage.Default.(int64)
+	// lossrecordDescChildren is the schema descriptor for children field.
+	lossrecordDescChildren := lossrecordFields[8].Descriptor()
+	// lossrecord.DefaultChildren holds the default value on creation for the children field.
+	lossrecord.DefaultChildren = lossrecordDescChildren.Default.(int)
+	// lossrecordDescLossPct is the schema descriptor for loss_pct field.
+	lossrecordDescLossPct := lossrecordFields[9].Descriptor()
+	// lossrecord.DefaultLossPct holds the default value on creation for the loss_pct field.
+	lossrecord.DefaultLossPct = lossrecordDescLossPct.Default.(float64)
+	// lossrecordDescState is the schema descriptor for state field.
+	lossrecordDescState := lossrecordFields[11].Descriptor()
+	// lossrecord.StateValidator is a validator for the "state" field. It is called by the builders before save.
+	lossrecord.StateValidator = lossrecordDescState.Validators[0].(func(string) error)
+	// lossrecordDescID is the schema descriptor for id field.
+	lossrecordDescID := lossrecordFields[0].Descriptor()
+	// lossrecord.DefaultID holds the default value on creation for the id field.
+	lossrecord.DefaultID = lossrecordDescID.Default.(func() string)
+	// lossrecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	lossrecord.IDValidator = lossrecordDescID.Validators[0].(func(string) error)
+	nhrecordMixin := schema.NhRecord{}.Mixin()
+	nhrecord.Policy = privacy.NewPolicies(schema.NhRecord{})
+	nhrecord.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := nhrecord.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	nhrecordMixinFields0 := nhrecordMixin[0].Fields()
+	_ = nhrecordMixinFields0
+	nhrecordFields := schema.NhRecord{}.Fields()
+	_ = nhrecordFields
+	// nhrecordDescCreateTime is the schema descriptor for create_time field.
+	nhrecordDescCreateTime := nhrecordMixinFields0[0].Descriptor()
+	// nhrecord.DefaultCreateTime holds the default value on creation for the create_time field.
+	nhrecord.DefaultCreateTime = nhrecordDescCreateTime.Default.(func() time.Time)
+	// nhrecordDescUpdateTime is the schema descriptor for update_time field.
+	nhrecordDescUpdateTime := nhrecordMixinFields0[1].Descriptor()
+	// nhrecord.DefaultUpdateTime holds the default value on creation for the update_time field.
+	nhrecord.DefaultUpdateTime = nhrecordDescUpdateTime.Default.(func() time.Time)
+	// nhrecord.UpdateDefaultUpdateTime holds the default value on update for the update_time field.
+	nhrecord.UpdateDefaultUpdateTime = nhrecordDescUpdateTime.UpdateDefault.(func() time.Time)
+	// nhrecordDescDeviceCode is the schema descriptor for device_code field.
+	nhrecordDescDeviceCode := nhrecordFields[2].Descriptor()
+	// nhrecord.DeviceCodeValidator is a validator for the "device_code" field. It is called by the builders before save.
+	nhrecord.DeviceCodeValidator = nhrecordDescDeviceCode.Validators[0].(func(string) error)
+	// nhrecordDescDeviceType is the schema descriptor for device_type field.
+	nhrecordDescDeviceType := nhrecordFields[3].Descriptor()
+	// nhrecord.DeviceTypeValidator is a validator for the "device_type" field. It is called by the builders before save.
+	nhrecord.DeviceTypeValidator = nhrecordDescDeviceType.Validators[0].(func(string) error)
+	// nhrecordDescDataValue is the schema descriptor for data_value field.
+	nhrecordDescDataValue := nhrecordFields[5].Descriptor()
+	// nhrecord.DefaultDataValue holds the default value on creation for the data_value field.
+	nhrecord.DefaultDataValue = nhrecordDescDataValue.Default.(int64)
+	// nhrecordDescDataCode is the schema descriptor for data_code field.
+	nhrecordDescDataCode := nhrecordFields[6].Descriptor()
+	// nhrecord.DataCodeValidator is a validator for the "data_code" field. It is called by the builders before save.
+	nhrecord.DataCodeValidator = nhrecordDescDataCode.Validators[0].(func(string) error)
+	// nhrecordDescDataTs is the schema descriptor for data_ts field.
+	nhrecordDescDataTs := nhrecordFields[8].Descriptor()
+	// nhrecord.DataTsValidator is a validator for the "data_ts" field. It is called by the builders before save.
+	nhrecord.DataTsValidator = nhrecordDescDataTs.Validators[0].(func(string) error)
+	// nhrecordDescProject is the schema descriptor for project field.
+	nhrecordDescProject := nhrecordFields[9].Descriptor()
+	// nhrecord.ProjectValidator is a validator for the "project" field. It is called by the builders before save.
+	nhrecord.ProjectValidator = nhrecordDescProject.Validators[0].(func(string) error)
+	// nhrecordDescID is the schema descriptor for id field.
+	nhrecordDescID := nhrecordFields[0].Descriptor()
+	// nhrecord.DefaultID holds the default value on creation for the id field.
+	nhrecord.DefaultID = nhrecordDescID.Default.(func() string)
+	// nhrecord.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	nhrecord.IDValidator = nhrecordDescID.Validators[0].(func(string) error)
+}
 
 const (
 	Version = "v0.14.6"                                         // Version of ent codegen.
@@ -14,6 +14,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// LossRecord is the client for interacting with the LossRecord builders.
+	LossRecord *LossRecordClient
 	// NhRecord is the client for interacting with the NhRecord builders.
 	NhRecord *NhRecordClient
 
@@ -147,6 +149,7 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.LossRecord = NewLossRecordClient(tx.config)
 	tx.NhRecord = NewNhRecordClient(tx.config)
 }
 
@@ -157,7 +160,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: NhRecord.QueryXXX(), the query will be executed
+// applies a query, for example: LossRecord.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
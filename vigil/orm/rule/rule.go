@@ -0,0 +1,66 @@
+// Package rule ent 的权限规则
+// context 中没有 auth.Claims 的是服务内部的调用(消费读数, 对账), 不做限制
+// 读数和对账结果只能由服务写入, 对外只按项目查询
+package rule
+
+import (
+	"context"
+
+	"github.com/twiglab/h2o/pkg/auth"
+	"github.com/twiglab/h2o/vigil/orm/ent"
+	"github.com/twiglab/h2o/vigil/orm/ent/lossrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/privacy"
+)
+
+// read 返回 nil 表示不限项目
+func read(ctx context.Context) ([]string, bool, error) {
+	c, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, true, nil
+	}
+	if !c.CanRead() {
+		return nil, false, privacy.Denyf("%s: no role", c.Subject)
+	}
+	ps, all := c.Scope()
+	return ps, all, nil
+}
+
+func NhRecordQuery() privacy.QueryRule {
+	return privacy.NhRecordQueryRuleFunc(func(ctx context.Context, q *ent.NhRecordQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(nhrecord.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+func LossRecordQuery() privacy.QueryRule {
+	return privacy.LossRecordQueryRuleFunc(func(ctx context.Context, q *ent.LossRecordQuery) error {
+		ps, all, err := read(ctx)
+		if err != nil || all {
+			return decide(err)
+		}
+		q.Where(lossrecord.ProjectIn(ps...))
+		return privacy.Allow
+	})
+}
+
+// Internal 只允许服务内部写入
+func Internal() privacy.MutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if c, ok := auth.FromContext(ctx); ok {
+			return privacy.Denyf("%s: records are read only", c.Subject)
+		}
+		return privacy.Allow
+	})
+}
+
+func decide(err error) error {
+	if err != nil {
+		return err
+	}
+	return privacy.Allow
+}
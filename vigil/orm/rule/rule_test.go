@@ -0,0 +1,77 @@
+package rule_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/twiglab/h2o/pkg/auth"
+	"github.com/twiglab/h2o/pkg/common"
+	"github.com/twiglab/h2o/vigil/orm"
+	"github.com/twiglab/h2o/vigil/orm/ent"
+	"github.com/twiglab/h2o/vigil/orm/ent/nhrecord"
+	"github.com/twiglab/h2o/vigil/orm/ent/privacy"
+)
+
+func as(roles []string, projects ...string) context.Context {
+	return auth.NewContext(context.Background(), &auth.Claims{Subject: "u", Roles: roles, Projects: projects})
+}
+
+func createRecord(cli *ent.Client, code, project string) *ent.NhRecordCreate {
+	return cli.NhRecord.Create().
+		SetDeviceCode(code).SetDeviceType("E").SetDataCode(code).
+		SetDataTime(time.Now()).SetDataTs(common.Ts(time.Now())).SetProject(project)
+}
+
+func TestRecordRules(t *testing.T) {
+	ctx := context.Background()
+	cli, err := orm.OpenEntClient("sqlite3", "file:"+t.Name()+"?mode=memory&cache=shared&_fk=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if err := cli.Schema.Create(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// 没有登录信息的是服务内部写入
+	for _, p := range []string{"P1", "P2"} {
+		if err := createRecord(cli, "D-"+p, p).Exec(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// 登录的用户, 包括 admin, 都不能写入读数
+	for _, roles := range [][]string{{auth.RoleOperator}, {auth.RoleAdmin}} {
+		if err := createRecord(cli, "D-X", "P1").Exec(as(roles, auth.AllProjects)); !errors.Is(err, privacy.Deny) {
+			t.Fatalf("create by %v: err = %v, want deny", roles, err)
+		}
+		if _, err := cli.NhRecord.Delete().Exec(as(roles, auth.AllProjects)); !errors.Is(err, privacy.Deny) {
+			t.Fatalf("delete by %v: err = %v, want deny", roles, err)
+		}
+	}
+
+	reads := []struct {
+		name string
+		ctx  context.Context
+		want []string
+	}{
+		{"内部调用", ctx, []string{"D-P1", "D-P2"}},
+		{"只读 P1", as([]string{auth.RoleReadOnly}, "P1"), []string{"D-P1"}},
+		{"全部项目", as([]string{auth.RoleFinance}, auth.AllProjects), []string{"D-P1", "D-P2"}},
+	}
+	for _, tt := range reads {
+		got, err := cli.NhRecord.Query().Order(ent.Asc(nhrecord.FieldDeviceCode)).Select(nhrecord.FieldDeviceCode).Strings(tt.ctx)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.name, got, tt.want)
+		}
+	}
+	if _, err := cli.LossRecord.Query().All(as(nil, "P1")); !errors.Is(err, privacy.Deny) {
+		t.Fatalf("query without role: err = %v, want deny", err)
+	}
+}
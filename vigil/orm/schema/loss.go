@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/vigil/orm/ent/privacy"
+	"github.com/twiglab/h2o/vigil/orm/rule"
+)
+
+// LossRecord 总表和分表对账的结果, 每个周期每个总表一条, 重新对账时覆盖结果
+type LossRecord struct {
+	ent.Schema
+}
+
+func (LossRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("id").Immutable().NotEmpty().DefaultFunc(cdrid).SchemaType(char(36)),
+
+		field.String("device_code").Immutable().NotEmpty().SchemaType(varchar(64)).Comment("总表设备号"),
+		field.String("device_type").NotEmpty().SchemaType(varchar(64)).Comment("设备类型"),
+		field.String("project").NotEmpty().SchemaType(varchar(64)).Comment("项目编号"),
+
+		field.Time("start_time").Immutable().Comment("周期开始"),
+		field.Time("end_time").Comment("周期结束"),
+
+		field.Int64("parent_usage").Default(0).Comment("总表用量"),
+		field.Int64("children_usage").Default(0).Comment("分表用量之和"),
+		field.Int("children").Default(0).Comment("分表个数"),
+		field.Float("loss_pct").Default(0).Comment("损耗率 %, 负数表示分表之和大于总表"),
+
+		field.Text("missing").Optional().Comment("缺少读数的设备, 逗号分隔"),
+		field.String("state").NotEmpty().SchemaType(varchar(16)).Comment("ok, high, negative, incomplete"),
+	}
+}
+
+func (LossRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.Time{},
+	}
+}
+
+func (LossRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_code", "start_time").Unique(),
+		index.Fields("project", "start_time"),
+		index.Fields("state"),
+	}
+}
+
+func (LossRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.Annotation{Table: "loss_record"},
+	}
+}
+
+func (LossRecord) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.LossRecordQuery()},
+		Mutation: privacy.MutationPolicy{rule.Internal()},
+	}
+}
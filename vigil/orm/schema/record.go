@@ -7,6 +7,8 @@ import (
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
 	"entgo.io/ent/schema/mixin"
+	"github.com/twiglab/h2o/vigil/orm/ent/privacy"
+	"github.com/twiglab/h2o/vigil/orm/rule"
 )
 
 type NhRecord struct {
@@ -59,3 +61,10 @@ func (NhRecord) Annotations() []schema.Annotation {
 		entsql.Annotation{Table: "nh_record"},
 	}
 }
+
+func (NhRecord) Policy() ent.Policy {
+	return privacy.Policy{
+		Query:    privacy.QueryPolicy{rule.NhRecordQuery()},
+		Mutation: privacy.MutationPolicy{rule.Internal()},
+	}
+}